@@ -0,0 +1,700 @@
+package cdk_ffi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+)
+
+// Wallet backup archive layout, all integers big endian:
+//
+//	magic      8 bytes  "CDKGOBAK"
+//	version    1 byte
+//	iterations 4 bytes  PBKDF2-SHA256 iterations
+//	salt       16 bytes
+//	nonce      12 bytes
+//	payload    AES-256-GCM(gzip(JSON)), the header above is the additional data
+const (
+	backupMagic      = "CDKGOBAK"
+	backupVersion    = 1
+	backupSaltSize   = 16
+	backupNonceSize  = 12
+	backupHeaderSize = len(backupMagic) + 1 + 4 + backupSaltSize + backupNonceSize
+
+	// Iterations used for new backups, in line with current OWASP guidance
+	// for PBKDF2-HMAC-SHA256.
+	backupIterations = 600_000
+	// Upper bound accepted when reading, so a crafted header cannot make
+	// key derivation run for hours.
+	backupMaxIterations = 10_000_000
+)
+
+var (
+	// ErrBackupFormat is returned when the input is not a wallet backup or
+	// was written by an unsupported version.
+	ErrBackupFormat = errors.New("not a supported wallet backup")
+	// ErrBackupPassphrase is returned when the backup cannot be decrypted,
+	// either because the passphrase is wrong or the file was modified.
+	ErrBackupPassphrase = errors.New("wrong passphrase or corrupted wallet backup")
+)
+
+// BackupOptions configures ExportBackup.
+type BackupOptions struct {
+	// Only export data of these mints, all mints if empty. Melt quotes
+	// record no mint, so with a filter only those a transaction of an
+	// exported mint refers to are included.
+	MintUrls []MintUrl
+}
+
+// BackupSummary counts the records in a wallet backup.
+type BackupSummary struct {
+	CreatedAt      time.Time
+	Mints          int
+	Keysets        int
+	KeysetCounters int
+	MintQuotes     int
+	MeltQuotes     int
+	Proofs         int
+	Transactions   int
+	// Proofs of the backup left out of an import because the mint reports
+	// them spent
+	SpentProofs int
+}
+
+type backupPayload struct {
+	CreatedAt    int64             `json:"created_at"`
+	Mints        []backupMint      `json:"mints"`
+	MintQuotes   []json.RawMessage `json:"mint_quotes"`
+	MeltQuotes   []json.RawMessage `json:"melt_quotes"`
+	Proofs       []json.RawMessage `json:"proofs"`
+	Transactions []json.RawMessage `json:"transactions"`
+}
+
+type backupMint struct {
+	Url     string          `json:"url"`
+	Info    json.RawMessage `json:"info,omitempty"`
+	Keysets []backupKeyset  `json:"keysets"`
+}
+
+type backupKeyset struct {
+	Info    json.RawMessage `json:"info"`
+	Keys    json.RawMessage `json:"keys,omitempty"`
+	Counter uint32          `json:"counter"`
+}
+
+// Export writes a backup of the wallet's mint to w, see ExportBackup. db
+// must be the database the wallet was created with, the bindings don't
+// expose it.
+func (_self *Wallet) Export(db WalletDatabase, w io.Writer, passphrase string) (BackupSummary, error) {
+	return ExportBackup(db, w, passphrase, BackupOptions{MintUrls: []MintUrl{_self.MintUrl()}})
+}
+
+// Import merges the records of the wallet's mint from a backup into db, the
+// database the wallet was created with. Records db already holds are kept
+// as they are and keyset counters are only ever raised. The wallet deletes
+// spent proofs, so the state of the backed up proofs db lacks is checked
+// with the mint first: spent ones are left out, and those the mint reports
+// pending or could not be asked about are written as pending, to be
+// reclaimed with CheckAllPendingProofs once known unspent.
+func (_self *Wallet) Import(ctx context.Context, db WalletDatabase, r io.Reader, passphrase string) (BackupSummary, error) {
+	payload, err := readBackup(r, passphrase)
+	if err != nil {
+		return BackupSummary{}, err
+	}
+	return restoreBackup(payload, db, backupRestore{
+		mintUrls:    []MintUrl{_self.MintUrl()},
+		merge:       true,
+		proofStates: backupProofStates(ctx),
+	})
+}
+
+// Export writes a backup of every mint of the wallet to w, see
+// ExportBackup. db must be the database the wallet was created with.
+func (_self *MultiMintWallet) Export(ctx context.Context, db WalletDatabase, w io.Writer, passphrase string) (BackupSummary, error) {
+	urls, err := _self.GetMintUrlsCtx(ctx)
+	if err != nil {
+		return BackupSummary{}, err
+	}
+	if len(urls) == 0 {
+		return BackupSummary{}, errors.New("wallet has no mints to back up")
+	}
+	mintUrls := make([]MintUrl, len(urls))
+	for i, url := range urls {
+		mintUrls[i] = MintUrl{Url: url}
+	}
+	return ExportBackup(db, w, passphrase, BackupOptions{MintUrls: mintUrls})
+}
+
+// Import merges a backup into db, the database the wallet was created with,
+// like Wallet.Import, and adds the backed up mints the wallet doesn't have
+// yet.
+func (_self *MultiMintWallet) Import(ctx context.Context, db WalletDatabase, r io.Reader, passphrase string) (BackupSummary, error) {
+	payload, err := readBackup(r, passphrase)
+	if err != nil {
+		return BackupSummary{}, err
+	}
+	summary, err := restoreBackup(payload, db, backupRestore{merge: true, proofStates: backupProofStates(ctx)})
+	if err != nil {
+		return summary, err
+	}
+	for _, mint := range payload.Mints {
+		mintUrl := MintUrl{Url: mint.Url}
+		ok, err := _self.HasMintCtx(ctx, mintUrl)
+		if err != nil {
+			return summary, err
+		}
+		if ok {
+			continue
+		}
+		if err := _self.AddMintCtx(ctx, mintUrl, nil); err != nil {
+			return summary, fmt.Errorf("add mint %s: %w", mint.Url, err)
+		}
+	}
+	return summary, nil
+}
+
+// ExportBackup writes an encrypted, compressed backup of db to w. It
+// contains every mint, keyset with its keys and counter, mint and melt
+// quote, transaction and every proof that is not spent, so the exact wallet
+// state, memos and metadata included, can be restored with ImportBackup.
+//
+// Pass the database the Wallet or MultiMintWallet was created with.
+func ExportBackup(db WalletDatabase, w io.Writer, passphrase string, opts BackupOptions) (BackupSummary, error) {
+	payload, summary, err := collectBackup(db, opts)
+	if err != nil {
+		return summary, err
+	}
+
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	if err := json.NewEncoder(zw).Encode(payload); err != nil {
+		return summary, fmt.Errorf("encode backup: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return summary, fmt.Errorf("compress backup: %w", err)
+	}
+
+	header := make([]byte, 0, backupHeaderSize)
+	header = append(header, backupMagic...)
+	header = append(header, backupVersion)
+	header = binary.BigEndian.AppendUint32(header, backupIterations)
+	salt := make([]byte, backupSaltSize)
+	nonce := make([]byte, backupNonceSize)
+	if _, err := rand.Read(salt); err != nil {
+		return summary, fmt.Errorf("generate salt: %w", err)
+	}
+	if _, err := rand.Read(nonce); err != nil {
+		return summary, fmt.Errorf("generate nonce: %w", err)
+	}
+	header = append(header, salt...)
+	header = append(header, nonce...)
+
+	aead, err := backupCipher(passphrase, salt, backupIterations)
+	if err != nil {
+		return summary, err
+	}
+	sealed := aead.Seal(nil, nonce, compressed.Bytes(), header)
+
+	if _, err := w.Write(header); err != nil {
+		return summary, fmt.Errorf("write backup: %w", err)
+	}
+	if _, err := w.Write(sealed); err != nil {
+		return summary, fmt.Errorf("write backup: %w", err)
+	}
+	return summary, nil
+}
+
+// ImportBackup restores a backup written by ExportBackup into db, which must
+// be empty (ErrDestinationNotEmpty otherwise). A Wallet or MultiMintWallet
+// created on db afterwards sees the backed up state.
+func ImportBackup(r io.Reader, passphrase string, db WalletDatabase) (BackupSummary, error) {
+	payload, err := readBackup(r, passphrase)
+	if err != nil {
+		return BackupSummary{}, err
+	}
+
+	empty, err := walletDatabaseIsEmpty(db)
+	if err != nil {
+		return BackupSummary{}, fmt.Errorf("check destination: %w", err)
+	}
+	if !empty {
+		return BackupSummary{}, ErrDestinationNotEmpty
+	}
+
+	return restoreBackup(payload, db, backupRestore{})
+}
+
+// InspectBackup decrypts a backup and returns what it contains without
+// writing anything.
+func InspectBackup(r io.Reader, passphrase string) (BackupSummary, error) {
+	payload, err := readBackup(r, passphrase)
+	if err != nil {
+		return BackupSummary{}, err
+	}
+	return restoreBackup(payload, nil, backupRestore{})
+}
+
+func backupCipher(passphrase string, salt []byte, iterations uint32) (cipher.AEAD, error) {
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, int(iterations), 32)
+	if err != nil {
+		return nil, fmt.Errorf("derive backup key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("aes.NewCipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+func collectBackup(db WalletDatabase, opts BackupOptions) (backupPayload, BackupSummary, error) {
+	payload := backupPayload{CreatedAt: time.Now().Unix()}
+	summary := BackupSummary{CreatedAt: time.Unix(payload.CreatedAt, 0)}
+	included := func(mintUrl MintUrl) bool { return backupIncludes(opts.MintUrls, mintUrl) }
+
+	mints, err := db.GetMints()
+	if err != nil {
+		return payload, summary, fmt.Errorf("read mints: %w", err)
+	}
+	for mintUrl, info := range mints {
+		if !included(mintUrl) {
+			continue
+		}
+		mint := backupMint{Url: mintUrl.Url}
+		if info != nil {
+			encoded, err := EncodeMintInfo(*info)
+			if err != nil {
+				return payload, summary, err
+			}
+			mint.Info = json.RawMessage(encoded)
+		}
+
+		keysets, err := db.GetMintKeysets(mintUrl)
+		if err != nil {
+			return payload, summary, fmt.Errorf("read keysets of %s: %w", mintUrl.Url, err)
+		}
+		if keysets != nil {
+			for _, keyset := range *keysets {
+				entry, err := collectBackupKeyset(db, keyset)
+				if err != nil {
+					return payload, summary, err
+				}
+				mint.Keysets = append(mint.Keysets, entry)
+				summary.Keysets++
+				if entry.Counter > 0 {
+					summary.KeysetCounters++
+				}
+			}
+		}
+		payload.Mints = append(payload.Mints, mint)
+		summary.Mints++
+	}
+
+	mintQuotes, err := db.GetMintQuotes()
+	if err != nil {
+		return payload, summary, fmt.Errorf("read mint quotes: %w", err)
+	}
+	for _, quote := range mintQuotes {
+		if !included(quote.MintUrl) {
+			continue
+		}
+		encoded, err := EncodeMintQuote(quote)
+		if err != nil {
+			return payload, summary, err
+		}
+		payload.MintQuotes = append(payload.MintQuotes, json.RawMessage(encoded))
+		summary.MintQuotes++
+	}
+
+	transactions, err := db.ListTransactions(nil, nil, nil)
+	if err != nil {
+		return payload, summary, fmt.Errorf("read transactions: %w", err)
+	}
+
+	meltQuotes, err := db.GetMeltQuotes()
+	if err != nil {
+		return payload, summary, fmt.Errorf("read melt quotes: %w", err)
+	}
+	meltQuoteMints := backupMeltQuoteMints(transactions)
+	for _, quote := range meltQuotes {
+		if len(opts.MintUrls) > 0 && !included(meltQuoteMints[quote.Id]) {
+			continue
+		}
+		encoded, err := EncodeMeltQuote(quote)
+		if err != nil {
+			return payload, summary, err
+		}
+		payload.MeltQuotes = append(payload.MeltQuotes, json.RawMessage(encoded))
+		summary.MeltQuotes++
+	}
+
+	states := []ProofState{ProofStateUnspent, ProofStatePending, ProofStateReserved, ProofStatePendingSpent}
+	proofs, err := db.GetProofs(nil, nil, &states, nil)
+	if err != nil {
+		return payload, summary, fmt.Errorf("read proofs: %w", err)
+	}
+	for _, info := range proofs {
+		if !included(info.MintUrl) {
+			continue
+		}
+		encoded, err := EncodeProofInfo(info)
+		if err != nil {
+			return payload, summary, err
+		}
+		payload.Proofs = append(payload.Proofs, json.RawMessage(encoded))
+		summary.Proofs++
+	}
+
+	for _, transaction := range transactions {
+		if !included(transaction.MintUrl) {
+			continue
+		}
+		encoded, err := EncodeTransaction(transaction)
+		if err != nil {
+			return payload, summary, err
+		}
+		payload.Transactions = append(payload.Transactions, json.RawMessage(encoded))
+		summary.Transactions++
+	}
+
+	return payload, summary, nil
+}
+
+func collectBackupKeyset(db WalletDatabase, keyset KeySetInfo) (backupKeyset, error) {
+	var entry backupKeyset
+	encoded, err := EncodeKeySetInfo(keyset)
+	if err != nil {
+		return entry, err
+	}
+	entry.Info = json.RawMessage(encoded)
+
+	id := Id{Hex: keyset.Id}
+	keys, err := db.GetKeys(id)
+	if err != nil {
+		return entry, fmt.Errorf("read keys of keyset %s: %w", keyset.Id, err)
+	}
+	if keys != nil {
+		encoded, err := EncodeKeys(*keys)
+		if err != nil {
+			return entry, err
+		}
+		entry.Keys = json.RawMessage(encoded)
+	}
+
+	// The SQL backends have no counter getter; ReadKeysetCounter falls back
+	// to incrementing by zero for them, which leaves the counter unchanged.
+	if entry.Counter, _, err = ReadKeysetCounter(db, id, false); err != nil {
+		return entry, fmt.Errorf("read counter of keyset %s: %w", keyset.Id, err)
+	}
+	return entry, nil
+}
+
+func readBackup(r io.Reader, passphrase string) (backupPayload, error) {
+	var payload backupPayload
+
+	header := make([]byte, backupHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return payload, ErrBackupFormat
+	}
+	if string(header[:len(backupMagic)]) != backupMagic || header[len(backupMagic)] != backupVersion {
+		return payload, ErrBackupFormat
+	}
+	rest := header[len(backupMagic)+1:]
+	iterations := binary.BigEndian.Uint32(rest)
+	if iterations == 0 || iterations > backupMaxIterations {
+		return payload, ErrBackupFormat
+	}
+	salt := rest[4 : 4+backupSaltSize]
+	nonce := rest[4+backupSaltSize:]
+
+	sealed, err := io.ReadAll(r)
+	if err != nil {
+		return payload, fmt.Errorf("read backup: %w", err)
+	}
+	aead, err := backupCipher(passphrase, salt, iterations)
+	if err != nil {
+		return payload, err
+	}
+	compressed, err := aead.Open(nil, nonce, sealed, header)
+	if err != nil {
+		return payload, ErrBackupPassphrase
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return payload, fmt.Errorf("decompress backup: %w", err)
+	}
+	defer zr.Close()
+	if err := json.NewDecoder(zr).Decode(&payload); err != nil {
+		return payload, fmt.Errorf("decode backup: %w", err)
+	}
+	return payload, nil
+}
+
+// backupRestore selects what restoreBackup writes.
+type backupRestore struct {
+	// Only restore records of these mints, all mints if empty. Melt quotes
+	// are filtered as in BackupOptions.
+	mintUrls []MintUrl
+	// Skip records db already holds and only raise keyset counters, instead
+	// of expecting an empty db.
+	merge bool
+	// Looks up the state of proofs to write with their mint, if set
+	proofStates func(mintUrl MintUrl, ys []string) (map[string]ProofState, error)
+}
+
+// backupProofStates asks the mints for the NUT-07 state of proofs.
+func backupProofStates(ctx context.Context) func(MintUrl, []string) (map[string]ProofState, error) {
+	return func(mintUrl MintUrl, ys []string) (map[string]ProofState, error) {
+		return mintProofStates(ctx, nil, mintUrl, ys)
+	}
+}
+
+// checkBackupProofs drops the proofs the mint reports spent and marks
+// pending those whose state is pending or unknown. It returns the proofs to
+// write and the number dropped.
+func checkBackupProofs(proofs []ProofInfo, proofStates func(MintUrl, []string) (map[string]ProofState, error)) ([]ProofInfo, int) {
+	byMint := map[MintUrl][]string{}
+	for _, info := range proofs {
+		byMint[info.MintUrl] = append(byMint[info.MintUrl], info.Y.Hex)
+	}
+	states := map[string]ProofState{}
+	for mintUrl, ys := range byMint {
+		// Without an answer the proofs stay pending.
+		mintStates, err := proofStates(mintUrl, ys)
+		if err != nil {
+			continue
+		}
+		for y, state := range mintStates {
+			states[y] = state
+		}
+	}
+
+	checked := proofs[:0]
+	spent := 0
+	for _, info := range proofs {
+		state, ok := states[info.Y.Hex]
+		switch {
+		case ok && state == ProofStateSpent:
+			spent++
+			continue
+		case !ok || state == ProofStatePending:
+			info.State = ProofStatePending
+		}
+		checked = append(checked, info)
+	}
+	return checked, spent
+}
+
+func backupIncludes(mintUrls []MintUrl, mintUrl MintUrl) bool {
+	return len(mintUrls) == 0 || slices.Contains(mintUrls, mintUrl)
+}
+
+// Maps melt quote ids to the mint of the transaction that refers to them.
+func backupMeltQuoteMints(transactions []Transaction) map[string]MintUrl {
+	mints := map[string]MintUrl{}
+	for _, transaction := range transactions {
+		if transaction.QuoteId != nil {
+			mints[*transaction.QuoteId] = transaction.MintUrl
+		}
+	}
+	return mints
+}
+
+// Decodes every included record of payload and writes it to db. With a nil
+// db it only validates and counts.
+func restoreBackup(payload backupPayload, db WalletDatabase, opts backupRestore) (BackupSummary, error) {
+	summary := BackupSummary{CreatedAt: time.Unix(payload.CreatedAt, 0)}
+	write := db != nil
+
+	var existingMints map[MintUrl]*MintInfo
+	if write && opts.merge {
+		var err error
+		if existingMints, err = db.GetMints(); err != nil {
+			return summary, fmt.Errorf("read mints: %w", err)
+		}
+	}
+
+	for _, mint := range payload.Mints {
+		mintUrl := MintUrl{Url: mint.Url}
+		if !backupIncludes(opts.mintUrls, mintUrl) {
+			continue
+		}
+		var info *MintInfo
+		if len(mint.Info) > 0 {
+			decoded, err := DecodeMintInfo(string(mint.Info))
+			if err != nil {
+				return summary, err
+			}
+			info = &decoded
+		}
+		if _, ok := existingMints[mintUrl]; write && !ok {
+			if err := db.AddMint(mintUrl, info); err != nil {
+				return summary, fmt.Errorf("write mint %s: %w", mint.Url, err)
+			}
+		}
+		summary.Mints++
+
+		keysets := make([]KeySetInfo, 0, len(mint.Keysets))
+		for _, entry := range mint.Keysets {
+			keyset, err := DecodeKeySetInfo(string(entry.Info))
+			if err != nil {
+				return summary, err
+			}
+			keysets = append(keysets, keyset)
+		}
+		if write && len(keysets) > 0 {
+			if err := db.AddMintKeysets(mintUrl, keysets); err != nil {
+				return summary, fmt.Errorf("write keysets of %s: %w", mint.Url, err)
+			}
+		}
+		for i, entry := range mint.Keysets {
+			summary.Keysets++
+			if len(entry.Keys) > 0 {
+				keys, err := DecodeKeys(string(entry.Keys))
+				if err != nil {
+					return summary, err
+				}
+				if write {
+					if err := db.AddKeys(KeySet{Id: keys.Id, Unit: keys.Unit, Keys: keys.Keys}); err != nil {
+						return summary, fmt.Errorf("write keys of keyset %s: %w", keys.Id, err)
+					}
+				}
+			}
+			if entry.Counter > 0 {
+				if write {
+					if err := raiseKeysetCounter(db, Id{Hex: keysets[i].Id}, entry.Counter); err != nil {
+						return summary, fmt.Errorf("write counter of keyset %s: %w", keysets[i].Id, err)
+					}
+				}
+				summary.KeysetCounters++
+			}
+		}
+	}
+
+	for _, raw := range payload.MintQuotes {
+		quote, err := DecodeMintQuote(string(raw))
+		if err != nil {
+			return summary, err
+		}
+		if !backupIncludes(opts.mintUrls, quote.MintUrl) {
+			continue
+		}
+		if write && opts.merge {
+			existing, err := db.GetMintQuote(quote.Id)
+			if err != nil {
+				return summary, fmt.Errorf("read mint quote %s: %w", quote.Id, err)
+			}
+			if existing != nil {
+				continue
+			}
+		}
+		if write {
+			if err := db.AddMintQuote(quote); err != nil {
+				return summary, fmt.Errorf("write mint quote %s: %w", quote.Id, err)
+			}
+		}
+		summary.MintQuotes++
+	}
+
+	transactions := make([]Transaction, 0, len(payload.Transactions))
+	for _, raw := range payload.Transactions {
+		transaction, err := DecodeTransaction(string(raw))
+		if err != nil {
+			return summary, err
+		}
+		transactions = append(transactions, transaction)
+	}
+	meltQuoteMints := backupMeltQuoteMints(transactions)
+
+	for _, raw := range payload.MeltQuotes {
+		quote, err := DecodeMeltQuote(string(raw))
+		if err != nil {
+			return summary, err
+		}
+		if len(opts.mintUrls) > 0 && !backupIncludes(opts.mintUrls, meltQuoteMints[quote.Id]) {
+			continue
+		}
+		if write && opts.merge {
+			existing, err := db.GetMeltQuote(quote.Id)
+			if err != nil {
+				return summary, fmt.Errorf("read melt quote %s: %w", quote.Id, err)
+			}
+			if existing != nil {
+				continue
+			}
+		}
+		if write {
+			if err := db.AddMeltQuote(quote); err != nil {
+				return summary, fmt.Errorf("write melt quote %s: %w", quote.Id, err)
+			}
+		}
+		summary.MeltQuotes++
+	}
+
+	var existingProofs map[string]bool
+	if write && opts.merge {
+		stored, err := db.GetProofs(nil, nil, nil, nil)
+		if err != nil {
+			return summary, fmt.Errorf("read proofs: %w", err)
+		}
+		existingProofs = make(map[string]bool, len(stored))
+		for _, info := range stored {
+			existingProofs[info.Y.Hex] = true
+		}
+	}
+	proofs := make([]ProofInfo, 0, len(payload.Proofs))
+	for _, raw := range payload.Proofs {
+		info, err := DecodeProofInfo(string(raw))
+		if err != nil {
+			return summary, err
+		}
+		if !backupIncludes(opts.mintUrls, info.MintUrl) || existingProofs[info.Y.Hex] {
+			continue
+		}
+		proofs = append(proofs, info)
+	}
+	if write && opts.proofStates != nil && len(proofs) > 0 {
+		proofs, summary.SpentProofs = checkBackupProofs(proofs, opts.proofStates)
+	}
+	if write && len(proofs) > 0 {
+		if err := db.UpdateProofs(proofs, nil); err != nil {
+			return summary, fmt.Errorf("write proofs: %w", err)
+		}
+	}
+	summary.Proofs = len(proofs)
+
+	for _, transaction := range transactions {
+		if !backupIncludes(opts.mintUrls, transaction.MintUrl) {
+			continue
+		}
+		if write && opts.merge {
+			existing, err := db.GetTransaction(transaction.Id)
+			if err != nil {
+				return summary, fmt.Errorf("read transaction %s: %w", transaction.Id.Hex, err)
+			}
+			if existing != nil {
+				continue
+			}
+		}
+		if write {
+			if err := db.AddTransaction(transaction); err != nil {
+				return summary, fmt.Errorf("write transaction %s: %w", transaction.Id.Hex, err)
+			}
+		}
+		summary.Transactions++
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,38 @@
+package cdk_ffi
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckBackupProofs(t *testing.T) {
+	mintA, mintB := MintUrl{Url: "https://a.example"}, MintUrl{Url: "https://b.example"}
+	proofs := []ProofInfo{
+		{Y: PublicKey{Hex: "01"}, MintUrl: mintA, State: ProofStateUnspent},
+		{Y: PublicKey{Hex: "02"}, MintUrl: mintA, State: ProofStateUnspent},
+		{Y: PublicKey{Hex: "03"}, MintUrl: mintA, State: ProofStateReserved},
+		{Y: PublicKey{Hex: "04"}, MintUrl: mintA, State: ProofStateUnspent},
+		{Y: PublicKey{Hex: "05"}, MintUrl: mintB, State: ProofStateUnspent},
+	}
+	states := func(mintUrl MintUrl, ys []string) (map[string]ProofState, error) {
+		if mintUrl == mintB {
+			return nil, errors.New("mint unreachable")
+		}
+		// 04 is missing from the answer.
+		return map[string]ProofState{"01": ProofStateUnspent, "02": ProofStateSpent, "03": ProofStatePending}, nil
+	}
+
+	checked, spent := checkBackupProofs(proofs, states)
+	if spent != 1 {
+		t.Fatalf("left out %d spent proofs, want 1", spent)
+	}
+	want := map[string]ProofState{"01": ProofStateUnspent, "03": ProofStatePending, "04": ProofStatePending, "05": ProofStatePending}
+	if len(checked) != len(want) {
+		t.Fatalf("kept %d proofs, want %d", len(checked), len(want))
+	}
+	for _, info := range checked {
+		if state, ok := want[info.Y.Hex]; !ok || info.State != state {
+			t.Errorf("proof %s kept as %v", info.Y.Hex, info.State)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package cdk_ffi_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	cdk "github.com/lescuer97/cdkgo"
+	"github.com/lescuer97/cdkgo/mockmint"
+)
+
+func testContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+// newTestWallet returns a wallet of mint on db with a new mnemonic.
+func newTestWallet(t *testing.T, mint *mockmint.Mint, db cdk.WalletDatabase) *cdk.Wallet {
+	t.Helper()
+	mnemonic, err := cdk.GenerateMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wallet, err := cdk.NewWallet(mint.URL(), cdk.CurrencyUnitSat{}, mnemonic, db, cdk.WalletConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(wallet.Destroy)
+	return wallet
+}
+
+// fund mints amount into wallet through a paid mint quote.
+func fund(t *testing.T, ctx context.Context, mint *mockmint.Mint, wallet *cdk.Wallet, amount uint64) {
+	t.Helper()
+	quote, err := wallet.MintQuoteCtx(ctx, cdk.Amount{Value: amount}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mint.PayMintQuote(quote.Id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := wallet.MintCtx(ctx, quote.Id, cdk.SplitTargetNone{}, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func balance(t *testing.T, ctx context.Context, wallet *cdk.Wallet) uint64 {
+	t.Helper()
+	total, err := wallet.TotalBalanceCtx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return total.Value
+}
+
+func TestWalletImportSpentProofs(t *testing.T) {
+	ctx := testContext(t)
+	mint := mockmint.New(mockmint.Options{})
+	defer mint.Close()
+	db := cdk.NewMemoryWalletDatabase()
+	wallet := newTestWallet(t, mint, db)
+	fund(t, ctx, mint, wallet, 64)
+
+	var backup bytes.Buffer
+	exported, err := wallet.Export(db, &backup, "passphrase")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Swapping spends the backed up proofs, and the wallet deletes them.
+	inputs, err := wallet.GetProofsByStatesCtx(ctx, []cdk.ProofState{cdk.ProofStateUnspent})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := wallet.SwapCtx(ctx, nil, cdk.SplitTargetNone{}, inputs, nil, false); err != nil {
+		t.Fatal(err)
+	}
+
+	summary, err := wallet.Import(ctx, db, &backup, "passphrase")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.Proofs != 0 || summary.SpentProofs != exported.Proofs {
+		t.Fatalf("imported %d proofs and left out %d of %d", summary.Proofs, summary.SpentProofs, exported.Proofs)
+	}
+	if got := balance(t, ctx, wallet); got != 64 {
+		t.Fatalf("balance %d after the import, want 64", got)
+	}
+}
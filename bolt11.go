@@ -0,0 +1,146 @@
+package cdk_ffi
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lescuer97/cdkgo/internal/bech32"
+)
+
+// Bolt11Invoice holds the fields of a BOLT11 invoice the wallet helpers need.
+type Bolt11Invoice struct {
+	// Currency prefix, e.g. "bc", "tb" or "bcrt"
+	Network string
+	// Nil for invoices without an amount
+	AmountMsat  *uint64
+	Timestamp   uint64
+	PaymentHash string
+	// Set if the invoice carries a description rather than its hash
+	Description     *string
+	DescriptionHash *string
+	// Seconds after Timestamp the invoice expires, 3600 if not given
+	Expiry uint64
+}
+
+// ExpiresAt returns the unix time the invoice expires at.
+func (i Bolt11Invoice) ExpiresAt() uint64 {
+	return i.Timestamp + i.Expiry
+}
+
+// DecodeBolt11 reads an invoice without checking its signature, which the
+// paying node does anyway.
+func DecodeBolt11(invoice string) (Bolt11Invoice, error) {
+	var decoded Bolt11Invoice
+	invoice = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(invoice), "lightning:"))
+	hrp, data, err := bech32.Decode(invoice)
+	if err != nil {
+		return decoded, fmt.Errorf("bolt11: %w", err)
+	}
+	if !strings.HasPrefix(hrp, "ln") {
+		return decoded, errors.New("bolt11: not a lightning invoice")
+	}
+	hrp = hrp[2:]
+	digits := strings.IndexAny(hrp, "0123456789")
+	if digits < 0 {
+		decoded.Network = hrp
+	} else {
+		decoded.Network = hrp[:digits]
+		amount, err := bolt11AmountMsat(hrp[digits:])
+		if err != nil {
+			return decoded, err
+		}
+		decoded.AmountMsat = &amount
+	}
+
+	// 7 words of timestamp, tagged fields, 104 words of signature
+	if len(data) < 7+104 {
+		return decoded, errors.New("bolt11: too short")
+	}
+	fields := data[:len(data)-104]
+	decoded.Timestamp = wordsToUint(fields[:7])
+	decoded.Expiry = 3600
+	fields = fields[7:]
+	for len(fields) >= 3 {
+		tag, length := fields[0], int(fields[1])<<5|int(fields[2])
+		if len(fields) < 3+length {
+			return decoded, errors.New("bolt11: truncated tagged field")
+		}
+		value := fields[3 : 3+length]
+		fields = fields[3+length:]
+		switch tag {
+		case 1: // p
+			if length != 52 {
+				continue
+			}
+			raw, err := bech32.ConvertBits(value, 5, 8, false)
+			if err != nil {
+				return decoded, fmt.Errorf("bolt11 payment hash: %w", err)
+			}
+			decoded.PaymentHash = hex.EncodeToString(raw)
+		case 13: // d
+			raw, err := bech32.ConvertBits(value, 5, 8, false)
+			if err != nil {
+				return decoded, fmt.Errorf("bolt11 description: %w", err)
+			}
+			description := string(raw)
+			decoded.Description = &description
+		case 23: // h
+			if length != 52 {
+				continue
+			}
+			raw, err := bech32.ConvertBits(value, 5, 8, false)
+			if err != nil {
+				return decoded, fmt.Errorf("bolt11 description hash: %w", err)
+			}
+			hash := hex.EncodeToString(raw)
+			decoded.DescriptionHash = &hash
+		case 6: // x
+			decoded.Expiry = wordsToUint(value)
+		}
+	}
+	if decoded.PaymentHash == "" {
+		return decoded, errors.New("bolt11: missing payment hash")
+	}
+	return decoded, nil
+}
+
+func bolt11AmountMsat(amount string) (uint64, error) {
+	multiplier := amount[len(amount)-1]
+	if multiplier >= '0' && multiplier <= '9' {
+		multiplier = 0
+	} else {
+		amount = amount[:len(amount)-1]
+	}
+	value, err := strconv.ParseUint(amount, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("bolt11 amount: %w", err)
+	}
+	switch multiplier {
+	case 0:
+		return value * 100_000_000_000, nil
+	case 'm':
+		return value * 100_000_000, nil
+	case 'u':
+		return value * 100_000, nil
+	case 'n':
+		return value * 100, nil
+	case 'p':
+		if value%10 != 0 {
+			return 0, errors.New("bolt11 amount: sub-millisatoshi precision")
+		}
+		return value / 10, nil
+	default:
+		return 0, fmt.Errorf("bolt11 amount: unknown multiplier %q", multiplier)
+	}
+}
+
+func wordsToUint(words []byte) uint64 {
+	var value uint64
+	for _, word := range words {
+		value = value<<5 | uint64(word)
+	}
+	return value
+}
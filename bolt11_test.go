@@ -0,0 +1,42 @@
+package cdk_ffi_test
+
+import (
+	"strings"
+	"testing"
+
+	cdk "github.com/lescuer97/cdkgo"
+	"github.com/lescuer97/cdkgo/mockmint"
+)
+
+func TestDecodeBolt11(t *testing.T) {
+	lightning := mockmint.NewFakeLightning()
+	for _, amountMsat := range []uint64{21_000, 1_500, 1} {
+		invoice, paymentHash, err := lightning.CreateInvoice(amountMsat, "coffee")
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded, err := cdk.DecodeBolt11("lightning:" + strings.ToUpper(invoice))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if decoded.Network != "bcrt" || decoded.AmountMsat == nil || *decoded.AmountMsat != amountMsat {
+			t.Errorf("%d msat: decoded %+v", amountMsat, decoded)
+		}
+		if decoded.PaymentHash != paymentHash || decoded.Description == nil || *decoded.Description != "coffee" || decoded.Expiry != 3600 {
+			t.Errorf("%d msat: decoded %+v", amountMsat, decoded)
+		}
+	}
+
+	invoice, _, err := lightning.CreateInvoice(1_000, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	corrupted := []byte(invoice)
+	corrupted[len(corrupted)-1] ^= 1
+	if _, err := cdk.DecodeBolt11(string(corrupted)); err == nil {
+		t.Error("decoded an invoice with a broken checksum")
+	}
+	if _, err := cdk.DecodeBolt11(cdk.EncodeLnurl("https://service.example/lnurl")); err == nil {
+		t.Error("decoded an lnurl as an invoice")
+	}
+}
@@ -0,0 +1,736 @@
+// Package boltdb implements the cdk_ffi WalletDatabase interface on top of a
+// bbolt embedded key-value store, for deployments that cannot ship the
+// SQLite backed WalletSqliteDatabase.
+//
+// Records are stored with the JSON encoders exported by the bindings
+// (EncodeProofInfo, EncodeMintQuote, ...), so the on-disk format matches the
+// one CDK uses for these types.
+package boltdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	cdk "github.com/lescuer97/cdkgo"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	bucketMints          = []byte("mints")
+	bucketKeysets        = []byte("keysets")
+	bucketMintKeysets    = []byte("mint_keysets")
+	bucketKeys           = []byte("keys")
+	bucketKeysetCounters = []byte("keyset_counters")
+	bucketMintQuotes     = []byte("mint_quotes")
+	bucketMeltQuotes     = []byte("melt_quotes")
+	bucketProofs         = []byte("proofs")
+	bucketProofStates    = []byte("proof_states")
+	bucketTransactions   = []byte("transactions")
+	bucketMelts          = []byte("melts")
+
+	allBuckets = [][]byte{
+		bucketMints,
+		bucketKeysets,
+		bucketMintKeysets,
+		bucketKeys,
+		bucketKeysetCounters,
+		bucketMintQuotes,
+		bucketMeltQuotes,
+		bucketProofs,
+		bucketProofStates,
+		bucketTransactions,
+		bucketMelts,
+	}
+)
+
+// Stored in the mints bucket for mints added without MintInfo.
+var noMintInfo = []byte{}
+
+// WalletBoltDatabase is a WalletDatabase stored in a bbolt file.
+//
+// Proofs are keyed by their Y value and additionally indexed by state, so
+// GetProofs with a state filter only reads the matching proofs.
+type WalletBoltDatabase struct {
+	db *bolt.DB
+}
+
+var (
+	_ cdk.WalletDatabase      = (*WalletBoltDatabase)(nil)
+	_ cdk.MeltStore           = (*WalletBoltDatabase)(nil)
+	_ cdk.KeysetCounterReader = (*WalletBoltDatabase)(nil)
+)
+
+// Open opens or creates the database file at path.
+func Open(path string) (*WalletBoltDatabase, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("open bolt database %s: %w", path, err)
+	}
+	wdb, err := New(db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return wdb, nil
+}
+
+// New uses an already open bbolt database, e.g. the one the application
+// keeps its own data in. The wallet buckets are created if missing; their
+// names are prefixed so they don't collide with the application's.
+func New(db *bolt.DB) (*WalletBoltDatabase, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		for _, name := range allBuckets {
+			if _, err := tx.CreateBucketIfNotExists(bucketName(name)); err != nil {
+				return fmt.Errorf("create bucket %s: %w", name, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &WalletBoltDatabase{db: db}, nil
+}
+
+// Close closes the underlying bbolt database.
+func (w *WalletBoltDatabase) Close() error {
+	return w.db.Close()
+}
+
+func bucketName(name []byte) []byte {
+	return append([]byte("cdk_wallet_"), name...)
+}
+
+func bucket(tx *bolt.Tx, name []byte) *bolt.Bucket {
+	return tx.Bucket(bucketName(name))
+}
+
+func (w *WalletBoltDatabase) view(fn func(tx *bolt.Tx) error) error {
+	if err := w.db.View(fn); err != nil {
+		return dbError(err)
+	}
+	return nil
+}
+
+func (w *WalletBoltDatabase) update(fn func(tx *bolt.Tx) error) error {
+	if err := w.db.Update(fn); err != nil {
+		return dbError(err)
+	}
+	return nil
+}
+
+func dbError(err error) error {
+	var ffiErr *cdk.FfiError
+	if errors.As(err, &ffiErr) {
+		return ffiErr
+	}
+	return cdk.DatabaseError(err)
+}
+
+func mintKeysetKey(mintUrl cdk.MintUrl, keysetId string) []byte {
+	return append(append([]byte(mintUrl.Url), 0), keysetId...)
+}
+
+func proofStateKey(state cdk.ProofState, y string) []byte {
+	return append([]byte{byte(state)}, y...)
+}
+
+// Add Mint to storage
+func (w *WalletBoltDatabase) AddMint(mintUrl cdk.MintUrl, mintInfo *cdk.MintInfo) error {
+	value := noMintInfo
+	if mintInfo != nil {
+		encoded, err := cdk.EncodeMintInfo(*mintInfo)
+		if err != nil {
+			return err
+		}
+		value = []byte(encoded)
+	}
+	return w.update(func(tx *bolt.Tx) error {
+		return bucket(tx, bucketMints).Put([]byte(mintUrl.Url), value)
+	})
+}
+
+// Remove Mint from storage
+//
+// The keysets of the mint are removed with it, as the SQL backends do.
+func (w *WalletBoltDatabase) RemoveMint(mintUrl cdk.MintUrl) error {
+	return w.update(func(tx *bolt.Tx) error {
+		if err := bucket(tx, bucketMints).Delete([]byte(mintUrl.Url)); err != nil {
+			return err
+		}
+		prefix := mintKeysetKey(mintUrl, "")
+		var mapped [][]byte
+		cursor := bucket(tx, bucketMintKeysets).Cursor()
+		for k, _ := cursor.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = cursor.Next() {
+			mapped = append(mapped, bytes.Clone(k))
+		}
+		for _, k := range mapped {
+			if err := bucket(tx, bucketKeysets).Delete(k[len(prefix):]); err != nil {
+				return err
+			}
+			if err := bucket(tx, bucketMintKeysets).Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func decodeMintInfo(value []byte) (*cdk.MintInfo, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+	info, err := cdk.DecodeMintInfo(string(value))
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// Get mint from storage
+func (w *WalletBoltDatabase) GetMint(mintUrl cdk.MintUrl) (*cdk.MintInfo, error) {
+	var info *cdk.MintInfo
+	err := w.view(func(tx *bolt.Tx) error {
+		var err error
+		info, err = decodeMintInfo(bucket(tx, bucketMints).Get([]byte(mintUrl.Url)))
+		return err
+	})
+	return info, err
+}
+
+// Get all mints from storage
+func (w *WalletBoltDatabase) GetMints() (map[cdk.MintUrl]*cdk.MintInfo, error) {
+	mints := map[cdk.MintUrl]*cdk.MintInfo{}
+	err := w.view(func(tx *bolt.Tx) error {
+		return bucket(tx, bucketMints).ForEach(func(k, v []byte) error {
+			info, err := decodeMintInfo(v)
+			if err != nil {
+				return err
+			}
+			mints[cdk.MintUrl{Url: string(k)}] = info
+			return nil
+		})
+	})
+	return mints, err
+}
+
+// Update mint url
+//
+// Like the SQL backends this moves the proofs and mint quotes of the old
+// url over to the new one.
+func (w *WalletBoltDatabase) UpdateMintUrl(oldMintUrl cdk.MintUrl, newMintUrl cdk.MintUrl) error {
+	return w.update(func(tx *bolt.Tx) error {
+		proofs := bucket(tx, bucketProofs)
+		var updatedProofs [][2][]byte
+		err := proofs.ForEach(func(k, v []byte) error {
+			info, err := cdk.DecodeProofInfo(string(v))
+			if err != nil {
+				return err
+			}
+			if info.MintUrl != oldMintUrl {
+				return nil
+			}
+			info.MintUrl = newMintUrl
+			encoded, err := cdk.EncodeProofInfo(info)
+			if err != nil {
+				return err
+			}
+			updatedProofs = append(updatedProofs, [2][]byte{bytes.Clone(k), []byte(encoded)})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, kv := range updatedProofs {
+			if err := proofs.Put(kv[0], kv[1]); err != nil {
+				return err
+			}
+		}
+
+		quotes := bucket(tx, bucketMintQuotes)
+		var updatedQuotes [][2][]byte
+		err = quotes.ForEach(func(k, v []byte) error {
+			quote, err := cdk.DecodeMintQuote(string(v))
+			if err != nil {
+				return err
+			}
+			if quote.MintUrl != oldMintUrl {
+				return nil
+			}
+			quote.MintUrl = newMintUrl
+			encoded, err := cdk.EncodeMintQuote(quote)
+			if err != nil {
+				return err
+			}
+			updatedQuotes = append(updatedQuotes, [2][]byte{bytes.Clone(k), []byte(encoded)})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, kv := range updatedQuotes {
+			if err := quotes.Put(kv[0], kv[1]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Add mint keyset to storage
+func (w *WalletBoltDatabase) AddMintKeysets(mintUrl cdk.MintUrl, keysets []cdk.KeySetInfo) error {
+	encoded := make([][]byte, len(keysets))
+	for i, keyset := range keysets {
+		value, err := cdk.EncodeKeySetInfo(keyset)
+		if err != nil {
+			return err
+		}
+		encoded[i] = []byte(value)
+	}
+	return w.update(func(tx *bolt.Tx) error {
+		for i, keyset := range keysets {
+			if err := bucket(tx, bucketKeysets).Put([]byte(keyset.Id), encoded[i]); err != nil {
+				return err
+			}
+			if err := bucket(tx, bucketMintKeysets).Put(mintKeysetKey(mintUrl, keyset.Id), nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Get mint keysets for mint url
+func (w *WalletBoltDatabase) GetMintKeysets(mintUrl cdk.MintUrl) (*[]cdk.KeySetInfo, error) {
+	var keysets []cdk.KeySetInfo
+	err := w.view(func(tx *bolt.Tx) error {
+		prefix := mintKeysetKey(mintUrl, "")
+		cursor := bucket(tx, bucketMintKeysets).Cursor()
+		for k, _ := cursor.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = cursor.Next() {
+			value := bucket(tx, bucketKeysets).Get(k[len(prefix):])
+			if value == nil {
+				continue
+			}
+			keyset, err := cdk.DecodeKeySetInfo(string(value))
+			if err != nil {
+				return err
+			}
+			keysets = append(keysets, keyset)
+		}
+		return nil
+	})
+	if err != nil || keysets == nil {
+		return nil, err
+	}
+	return &keysets, nil
+}
+
+// Get mint keyset by id
+func (w *WalletBoltDatabase) GetKeysetById(keysetId cdk.Id) (*cdk.KeySetInfo, error) {
+	var keyset *cdk.KeySetInfo
+	err := w.view(func(tx *bolt.Tx) error {
+		value := bucket(tx, bucketKeysets).Get([]byte(keysetId.Hex))
+		if value == nil {
+			return nil
+		}
+		decoded, err := cdk.DecodeKeySetInfo(string(value))
+		if err != nil {
+			return err
+		}
+		keyset = &decoded
+		return nil
+	})
+	return keyset, err
+}
+
+// Add mint quote to storage
+func (w *WalletBoltDatabase) AddMintQuote(quote cdk.MintQuote) error {
+	encoded, err := cdk.EncodeMintQuote(quote)
+	if err != nil {
+		return err
+	}
+	return w.update(func(tx *bolt.Tx) error {
+		return bucket(tx, bucketMintQuotes).Put([]byte(quote.Id), []byte(encoded))
+	})
+}
+
+// Get mint quote from storage
+func (w *WalletBoltDatabase) GetMintQuote(quoteId string) (*cdk.MintQuote, error) {
+	var quote *cdk.MintQuote
+	err := w.view(func(tx *bolt.Tx) error {
+		value := bucket(tx, bucketMintQuotes).Get([]byte(quoteId))
+		if value == nil {
+			return nil
+		}
+		decoded, err := cdk.DecodeMintQuote(string(value))
+		if err != nil {
+			return err
+		}
+		quote = &decoded
+		return nil
+	})
+	return quote, err
+}
+
+// Get mint quotes from storage
+func (w *WalletBoltDatabase) GetMintQuotes() ([]cdk.MintQuote, error) {
+	quotes := []cdk.MintQuote{}
+	err := w.view(func(tx *bolt.Tx) error {
+		return bucket(tx, bucketMintQuotes).ForEach(func(_, v []byte) error {
+			quote, err := cdk.DecodeMintQuote(string(v))
+			if err != nil {
+				return err
+			}
+			quotes = append(quotes, quote)
+			return nil
+		})
+	})
+	return quotes, err
+}
+
+// Remove mint quote from storage
+func (w *WalletBoltDatabase) RemoveMintQuote(quoteId string) error {
+	return w.update(func(tx *bolt.Tx) error {
+		return bucket(tx, bucketMintQuotes).Delete([]byte(quoteId))
+	})
+}
+
+// Add melt quote to storage
+func (w *WalletBoltDatabase) AddMeltQuote(quote cdk.MeltQuote) error {
+	encoded, err := cdk.EncodeMeltQuote(quote)
+	if err != nil {
+		return err
+	}
+	return w.update(func(tx *bolt.Tx) error {
+		return bucket(tx, bucketMeltQuotes).Put([]byte(quote.Id), []byte(encoded))
+	})
+}
+
+// Get melt quote from storage
+func (w *WalletBoltDatabase) GetMeltQuote(quoteId string) (*cdk.MeltQuote, error) {
+	var quote *cdk.MeltQuote
+	err := w.view(func(tx *bolt.Tx) error {
+		value := bucket(tx, bucketMeltQuotes).Get([]byte(quoteId))
+		if value == nil {
+			return nil
+		}
+		decoded, err := cdk.DecodeMeltQuote(string(value))
+		if err != nil {
+			return err
+		}
+		quote = &decoded
+		return nil
+	})
+	return quote, err
+}
+
+// Get melt quotes from storage
+func (w *WalletBoltDatabase) GetMeltQuotes() ([]cdk.MeltQuote, error) {
+	quotes := []cdk.MeltQuote{}
+	err := w.view(func(tx *bolt.Tx) error {
+		return bucket(tx, bucketMeltQuotes).ForEach(func(_, v []byte) error {
+			quote, err := cdk.DecodeMeltQuote(string(v))
+			if err != nil {
+				return err
+			}
+			quotes = append(quotes, quote)
+			return nil
+		})
+	})
+	return quotes, err
+}
+
+// Remove melt quote from storage
+func (w *WalletBoltDatabase) RemoveMeltQuote(quoteId string) error {
+	return w.update(func(tx *bolt.Tx) error {
+		return bucket(tx, bucketMeltQuotes).Delete([]byte(quoteId))
+	})
+}
+
+// Add Keys to storage
+func (w *WalletBoltDatabase) AddKeys(keyset cdk.KeySet) error {
+	encoded, err := cdk.EncodeKeys(cdk.Keys{Id: keyset.Id, Unit: keyset.Unit, Keys: keyset.Keys})
+	if err != nil {
+		return err
+	}
+	return w.update(func(tx *bolt.Tx) error {
+		return bucket(tx, bucketKeys).Put([]byte(keyset.Id), []byte(encoded))
+	})
+}
+
+// Get Keys from storage
+func (w *WalletBoltDatabase) GetKeys(id cdk.Id) (*cdk.Keys, error) {
+	var keys *cdk.Keys
+	err := w.view(func(tx *bolt.Tx) error {
+		value := bucket(tx, bucketKeys).Get([]byte(id.Hex))
+		if value == nil {
+			return nil
+		}
+		decoded, err := cdk.DecodeKeys(string(value))
+		if err != nil {
+			return err
+		}
+		keys = &decoded
+		return nil
+	})
+	return keys, err
+}
+
+// Remove Keys from storage
+func (w *WalletBoltDatabase) RemoveKeys(id cdk.Id) error {
+	return w.update(func(tx *bolt.Tx) error {
+		return bucket(tx, bucketKeys).Delete([]byte(id.Hex))
+	})
+}
+
+func putProof(tx *bolt.Tx, info cdk.ProofInfo) error {
+	encoded, err := cdk.EncodeProofInfo(info)
+	if err != nil {
+		return err
+	}
+	if err := deleteProof(tx, info.Y.Hex); err != nil {
+		return err
+	}
+	if err := bucket(tx, bucketProofs).Put([]byte(info.Y.Hex), []byte(encoded)); err != nil {
+		return err
+	}
+	return bucket(tx, bucketProofStates).Put(proofStateKey(info.State, info.Y.Hex), nil)
+}
+
+func deleteProof(tx *bolt.Tx, y string) error {
+	proofs := bucket(tx, bucketProofs)
+	value := proofs.Get([]byte(y))
+	if value == nil {
+		return nil
+	}
+	info, err := cdk.DecodeProofInfo(string(value))
+	if err != nil {
+		return err
+	}
+	if err := bucket(tx, bucketProofStates).Delete(proofStateKey(info.State, y)); err != nil {
+		return err
+	}
+	return proofs.Delete([]byte(y))
+}
+
+// Update the proofs in storage by adding new proofs or removing proofs by their Y value
+//
+// Additions and removals happen in a single bbolt transaction: either all of
+// them are applied or none is.
+func (w *WalletBoltDatabase) UpdateProofs(added []cdk.ProofInfo, removedYs []cdk.PublicKey) error {
+	return w.update(func(tx *bolt.Tx) error {
+		for _, info := range added {
+			if err := putProof(tx, info); err != nil {
+				return err
+			}
+		}
+		for _, y := range removedYs {
+			if err := deleteProof(tx, y.Hex); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Get proofs from storage
+//
+// Filtering follows cdk.ProofInfoMatches. With a state filter only the
+// state index is scanned.
+func (w *WalletBoltDatabase) GetProofs(mintUrl *cdk.MintUrl, unit *cdk.CurrencyUnit, state *[]cdk.ProofState, spendingConditions *[]cdk.SpendingConditions) ([]cdk.ProofInfo, error) {
+	proofs := []cdk.ProofInfo{}
+	collect := func(value []byte) error {
+		info, err := cdk.DecodeProofInfo(string(value))
+		if err != nil {
+			return err
+		}
+		if cdk.ProofInfoMatches(info, mintUrl, unit, state, spendingConditions) {
+			proofs = append(proofs, info)
+		}
+		return nil
+	}
+
+	err := w.view(func(tx *bolt.Tx) error {
+		if state == nil {
+			return bucket(tx, bucketProofs).ForEach(func(_, v []byte) error {
+				return collect(v)
+			})
+		}
+		cursor := bucket(tx, bucketProofStates).Cursor()
+		scanned := map[cdk.ProofState]bool{}
+		for _, s := range *state {
+			if scanned[s] {
+				continue
+			}
+			scanned[s] = true
+			prefix := []byte{byte(s)}
+			for k, _ := cursor.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = cursor.Next() {
+				value := bucket(tx, bucketProofs).Get(k[1:])
+				if value == nil {
+					continue
+				}
+				if err := collect(value); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(proofs, func(i, j int) bool { return proofs[i].Y.Hex < proofs[j].Y.Hex })
+	return proofs, nil
+}
+
+// Update proofs state in storage
+func (w *WalletBoltDatabase) UpdateProofsState(ys []cdk.PublicKey, state cdk.ProofState) error {
+	return w.update(func(tx *bolt.Tx) error {
+		for _, y := range ys {
+			value := bucket(tx, bucketProofs).Get([]byte(y.Hex))
+			if value == nil {
+				continue
+			}
+			info, err := cdk.DecodeProofInfo(string(value))
+			if err != nil {
+				return err
+			}
+			info.State = state
+			if err := putProof(tx, info); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Increment Keyset counter
+//
+// Returns the counter value after the increment.
+func (w *WalletBoltDatabase) IncrementKeysetCounter(keysetId cdk.Id, count uint32) (uint32, error) {
+	var counter uint32
+	err := w.update(func(tx *bolt.Tx) error {
+		counters := bucket(tx, bucketKeysetCounters)
+		if value := counters.Get([]byte(keysetId.Hex)); len(value) == 4 {
+			counter = binary.BigEndian.Uint32(value)
+		}
+		counter += count
+		return counters.Put([]byte(keysetId.Hex), binary.BigEndian.AppendUint32(nil, counter))
+	})
+	return counter, err
+}
+
+// GetKeysetCounter implements cdk.KeysetCounterReader.
+func (w *WalletBoltDatabase) GetKeysetCounter(keysetId cdk.Id) (uint32, error) {
+	var counter uint32
+	err := w.view(func(tx *bolt.Tx) error {
+		if value := bucket(tx, bucketKeysetCounters).Get([]byte(keysetId.Hex)); len(value) == 4 {
+			counter = binary.BigEndian.Uint32(value)
+		}
+		return nil
+	})
+	return counter, err
+}
+
+// Add transaction to storage
+func (w *WalletBoltDatabase) AddTransaction(transaction cdk.Transaction) error {
+	encoded, err := cdk.EncodeTransaction(transaction)
+	if err != nil {
+		return err
+	}
+	return w.update(func(tx *bolt.Tx) error {
+		return bucket(tx, bucketTransactions).Put([]byte(transaction.Id.Hex), []byte(encoded))
+	})
+}
+
+// Get transaction from storage
+func (w *WalletBoltDatabase) GetTransaction(transactionId cdk.TransactionId) (*cdk.Transaction, error) {
+	var transaction *cdk.Transaction
+	err := w.view(func(tx *bolt.Tx) error {
+		value := bucket(tx, bucketTransactions).Get([]byte(transactionId.Hex))
+		if value == nil {
+			return nil
+		}
+		decoded, err := cdk.DecodeTransaction(string(value))
+		if err != nil {
+			return err
+		}
+		transaction = &decoded
+		return nil
+	})
+	return transaction, err
+}
+
+// List transactions from storage
+//
+// Transactions are returned newest first.
+func (w *WalletBoltDatabase) ListTransactions(mintUrl *cdk.MintUrl, direction *cdk.TransactionDirection, unit *cdk.CurrencyUnit) ([]cdk.Transaction, error) {
+	transactions := []cdk.Transaction{}
+	err := w.view(func(tx *bolt.Tx) error {
+		return bucket(tx, bucketTransactions).ForEach(func(_, v []byte) error {
+			transaction, err := cdk.DecodeTransaction(string(v))
+			if err != nil {
+				return err
+			}
+			if cdk.TransactionMatches(transaction, mintUrl, direction, unit) {
+				transactions = append(transactions, transaction)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	cdk.SortTransactions(transactions)
+	return transactions, nil
+}
+
+// Remove transaction from storage
+func (w *WalletBoltDatabase) RemoveTransaction(transactionId cdk.TransactionId) error {
+	return w.update(func(tx *bolt.Tx) error {
+		return bucket(tx, bucketTransactions).Delete([]byte(transactionId.Hex))
+	})
+}
+
+// Add or replace the record of a melt
+func (w *WalletBoltDatabase) PutMelt(record cdk.MeltRecord) error {
+	encoded, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return w.update(func(tx *bolt.Tx) error {
+		return bucket(tx, bucketMelts).Put([]byte(record.QuoteId), encoded)
+	})
+}
+
+// Get the recorded melts, oldest first
+func (w *WalletBoltDatabase) GetMelts() ([]cdk.MeltRecord, error) {
+	records := []cdk.MeltRecord{}
+	err := w.view(func(tx *bolt.Tx) error {
+		return bucket(tx, bucketMelts).ForEach(func(_, v []byte) error {
+			var record cdk.MeltRecord
+			if err := json.Unmarshal(v, &record); err != nil {
+				return err
+			}
+			records = append(records, record)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].Started < records[j].Started })
+	return records, nil
+}
+
+// Remove the record of a melt
+func (w *WalletBoltDatabase) RemoveMelt(quoteId string) error {
+	return w.update(func(tx *bolt.Tx) error {
+		return bucket(tx, bucketMelts).Delete([]byte(quoteId))
+	})
+}
@@ -0,0 +1,26 @@
+package boltdb
+
+import (
+	"path/filepath"
+	"testing"
+
+	cdk "github.com/lescuer97/cdkgo"
+	"github.com/lescuer97/cdkgo/walletdbtest"
+)
+
+func open(t *testing.T) *WalletBoltDatabase {
+	db, err := Open(filepath.Join(t.TempDir(), "wallet.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestWalletBoltDatabase(t *testing.T) {
+	walletdbtest.Run(t, func(t *testing.T) cdk.WalletDatabase { return open(t) })
+}
+
+func TestMeltStore(t *testing.T) {
+	walletdbtest.RunMeltStore(t, func(t *testing.T) cdk.MeltStore { return open(t) })
+}
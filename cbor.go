@@ -0,0 +1,299 @@
+package cdk_ffi
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// A minimal CBOR (RFC 8949) codec, enough for the NUT-18 payment requests:
+// maps with text keys, arrays, text and byte strings, integers, booleans
+// and null. Decoding produces uint64, int64, float64, string, []byte, []any,
+// map[string]any, bool and nil values.
+
+const (
+	cborUint   = 0
+	cborNegInt = 1
+	cborBytes  = 2
+	cborText   = 3
+	cborArray  = 4
+	cborMap    = 5
+	cborTag    = 6
+	cborSimple = 7
+)
+
+// cborMapEntry is a map entry; maps are encoded from slices so the key
+// order is stable.
+type cborMapEntry struct {
+	Key   string
+	Value any
+}
+
+func cborEncode(value any) ([]byte, error) {
+	var out []byte
+	err := cborAppend(&out, value)
+	return out, err
+}
+
+func cborHead(out *[]byte, major byte, n uint64) {
+	switch {
+	case n < 24:
+		*out = append(*out, major<<5|byte(n))
+	case n <= math.MaxUint8:
+		*out = append(*out, major<<5|24, byte(n))
+	case n <= math.MaxUint16:
+		*out = binary.BigEndian.AppendUint16(append(*out, major<<5|25), uint16(n))
+	case n <= math.MaxUint32:
+		*out = binary.BigEndian.AppendUint32(append(*out, major<<5|26), uint32(n))
+	default:
+		*out = binary.BigEndian.AppendUint64(append(*out, major<<5|27), n)
+	}
+}
+
+func cborAppend(out *[]byte, value any) error {
+	switch value := value.(type) {
+	case nil:
+		*out = append(*out, 0xf6)
+	case bool:
+		if value {
+			*out = append(*out, 0xf5)
+		} else {
+			*out = append(*out, 0xf4)
+		}
+	case uint64:
+		cborHead(out, cborUint, value)
+	case int:
+		if value < 0 {
+			cborHead(out, cborNegInt, uint64(-1-value))
+		} else {
+			cborHead(out, cborUint, uint64(value))
+		}
+	case string:
+		cborHead(out, cborText, uint64(len(value)))
+		*out = append(*out, value...)
+	case []byte:
+		cborHead(out, cborBytes, uint64(len(value)))
+		*out = append(*out, value...)
+	case []string:
+		cborHead(out, cborArray, uint64(len(value)))
+		for _, item := range value {
+			cborAppend(out, item)
+		}
+	case []any:
+		cborHead(out, cborArray, uint64(len(value)))
+		for _, item := range value {
+			if err := cborAppend(out, item); err != nil {
+				return err
+			}
+		}
+	case []cborMapEntry:
+		cborHead(out, cborMap, uint64(len(value)))
+		for _, entry := range value {
+			cborAppend(out, entry.Key)
+			if err := cborAppend(out, entry.Value); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("cbor: cannot encode %T", value)
+	}
+	return nil
+}
+
+var errCborTruncated = errors.New("cbor: unexpected end of data")
+
+func cborDecode(data []byte) (any, error) {
+	value, rest, err := cborNext(data, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("cbor: trailing data")
+	}
+	return value, nil
+}
+
+func cborNext(data []byte, depth int) (any, []byte, error) {
+	if depth > 32 {
+		return nil, nil, errors.New("cbor: nesting too deep")
+	}
+	if len(data) == 0 {
+		return nil, nil, errCborTruncated
+	}
+	major, info := data[0]>>5, data[0]&0x1f
+	data = data[1:]
+
+	if major == cborSimple {
+		switch info {
+		case 20:
+			return false, data, nil
+		case 21:
+			return true, data, nil
+		case 22, 23:
+			return nil, data, nil
+		case 25:
+			if len(data) < 2 {
+				return nil, nil, errCborTruncated
+			}
+			return halfToFloat(binary.BigEndian.Uint16(data)), data[2:], nil
+		case 26:
+			if len(data) < 4 {
+				return nil, nil, errCborTruncated
+			}
+			return float64(math.Float32frombits(binary.BigEndian.Uint32(data))), data[4:], nil
+		case 27:
+			if len(data) < 8 {
+				return nil, nil, errCborTruncated
+			}
+			return math.Float64frombits(binary.BigEndian.Uint64(data)), data[8:], nil
+		}
+		return nil, nil, fmt.Errorf("cbor: unsupported simple value %d", info)
+	}
+
+	indefinite := info == 31
+	var n uint64
+	switch {
+	case info < 24:
+		n = uint64(info)
+	case info == 24:
+		if len(data) < 1 {
+			return nil, nil, errCborTruncated
+		}
+		n, data = uint64(data[0]), data[1:]
+	case info == 25:
+		if len(data) < 2 {
+			return nil, nil, errCborTruncated
+		}
+		n, data = uint64(binary.BigEndian.Uint16(data)), data[2:]
+	case info == 26:
+		if len(data) < 4 {
+			return nil, nil, errCborTruncated
+		}
+		n, data = uint64(binary.BigEndian.Uint32(data)), data[4:]
+	case info == 27:
+		if len(data) < 8 {
+			return nil, nil, errCborTruncated
+		}
+		n, data = binary.BigEndian.Uint64(data), data[8:]
+	case indefinite && major >= cborBytes && major <= cborMap:
+	default:
+		return nil, nil, fmt.Errorf("cbor: invalid additional info %d", info)
+	}
+
+	switch major {
+	case cborUint:
+		return n, data, nil
+	case cborNegInt:
+		if n > math.MaxInt64 {
+			return nil, nil, errors.New("cbor: integer overflow")
+		}
+		return -1 - int64(n), data, nil
+	case cborBytes, cborText:
+		var raw []byte
+		if indefinite {
+			for {
+				if len(data) == 0 {
+					return nil, nil, errCborTruncated
+				}
+				if data[0] == 0xff {
+					data = data[1:]
+					break
+				}
+				var chunk any
+				var err error
+				if chunk, data, err = cborNext(data, depth+1); err != nil {
+					return nil, nil, err
+				}
+				switch chunk := chunk.(type) {
+				case string:
+					raw = append(raw, chunk...)
+				case []byte:
+					raw = append(raw, chunk...)
+				default:
+					return nil, nil, errors.New("cbor: invalid string chunk")
+				}
+			}
+		} else {
+			if uint64(len(data)) < n {
+				return nil, nil, errCborTruncated
+			}
+			raw, data = data[:n], data[n:]
+		}
+		if major == cborText {
+			return string(raw), data, nil
+		}
+		return append([]byte(nil), raw...), data, nil
+	case cborArray:
+		var items []any
+		for i := uint64(0); indefinite || i < n; i++ {
+			if indefinite {
+				if len(data) == 0 {
+					return nil, nil, errCborTruncated
+				}
+				if data[0] == 0xff {
+					data = data[1:]
+					break
+				}
+			}
+			var item any
+			var err error
+			if item, data, err = cborNext(data, depth+1); err != nil {
+				return nil, nil, err
+			}
+			items = append(items, item)
+		}
+		return items, data, nil
+	case cborMap:
+		entries := map[string]any{}
+		for i := uint64(0); indefinite || i < n; i++ {
+			if indefinite {
+				if len(data) == 0 {
+					return nil, nil, errCborTruncated
+				}
+				if data[0] == 0xff {
+					data = data[1:]
+					break
+				}
+			}
+			key, rest, err := cborNext(data, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			text, ok := key.(string)
+			if !ok {
+				return nil, nil, errors.New("cbor: map key is not text")
+			}
+			var value any
+			if value, data, err = cborNext(rest, depth+1); err != nil {
+				return nil, nil, err
+			}
+			entries[text] = value
+		}
+		return entries, data, nil
+	default: // cborTag: the tag is ignored, the tagged value kept
+		return cborNext(data, depth+1)
+	}
+}
+
+func halfToFloat(h uint16) float64 {
+	exp := int(h>>10) & 0x1f
+	mant := float64(h & 0x3ff)
+	var value float64
+	switch exp {
+	case 0:
+		value = math.Ldexp(mant, -24)
+	case 31:
+		if mant == 0 {
+			value = math.Inf(1)
+		} else {
+			value = math.NaN()
+		}
+	default:
+		value = math.Ldexp(mant+1024, exp-25)
+	}
+	if h&0x8000 != 0 {
+		return -value
+	}
+	return value
+}
@@ -0,0 +1,133 @@
+package cdk_ffi
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestCborDecode(t *testing.T) {
+	// Examples of RFC 8949 appendix A.
+	tests := []struct {
+		hex  string
+		want any
+	}{
+		{"00", uint64(0)},
+		{"17", uint64(23)},
+		{"1818", uint64(24)},
+		{"1903e8", uint64(1000)},
+		{"1a000f4240", uint64(1000000)},
+		{"1b000000e8d4a51000", uint64(1000000000000)},
+		{"20", int64(-1)},
+		{"3863", int64(-100)},
+		{"f90000", 0.0},
+		{"f93c00", 1.0},
+		{"f9c400", -4.0},
+		{"f97bff", 65504.0},
+		{"fa47c35000", 100000.0},
+		{"fb3ff199999999999a", 1.1},
+		{"f4", false},
+		{"f5", true},
+		{"f6", nil},
+		{"4401020304", []byte{1, 2, 3, 4}},
+		{"6449455446", "IETF"},
+		{"62c3bc", "ü"},
+		{"83010203", []any{uint64(1), uint64(2), uint64(3)}},
+		{"8301820203820405", []any{uint64(1), []any{uint64(2), uint64(3)}, []any{uint64(4), uint64(5)}}},
+		{"a26161016162820203", map[string]any{"a": uint64(1), "b": []any{uint64(2), uint64(3)}}},
+		{"c074323031332d30332d32315432303a30343a30305a", "2013-03-21T20:04:00Z"},
+		{"5f42010243030405ff", []byte{1, 2, 3, 4, 5}},
+		{"7f657374726561646d696e67ff", "streaming"},
+		{"9f018202039f0405ffff", []any{uint64(1), []any{uint64(2), uint64(3)}, []any{uint64(4), uint64(5)}}},
+		{"bf61610161629f0203ffff", map[string]any{"a": uint64(1), "b": []any{uint64(2), uint64(3)}}},
+	}
+	for _, test := range tests {
+		raw, _ := hex.DecodeString(test.hex)
+		got, err := cborDecode(raw)
+		if err != nil {
+			t.Errorf("%s: %v", test.hex, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %#v, want %#v", test.hex, got, test.want)
+		}
+	}
+
+	for _, h := range []string{"f97c00", "f9fc00"} {
+		raw, _ := hex.DecodeString(h)
+		if got, err := cborDecode(raw); err != nil || !math.IsInf(got.(float64), 0) {
+			t.Errorf("%s: got %v (%v)", h, got, err)
+		}
+	}
+}
+
+func TestCborDecodeMalformed(t *testing.T) {
+	tests := map[string]string{
+		"empty":                    "",
+		"truncated integer":        "19 03",
+		"truncated text":           "64 4945",
+		"truncated array":          "83 0102",
+		"truncated map":            "a1 6161",
+		"unterminated indefinite":  "9f 0102",
+		"integer key":              "a1 01 02",
+		"reserved additional info": "1c",
+		"indefinite integer":       "1f",
+		"negative overflow":        "3b ffffffffffffffff",
+		"unsupported simple":       "f8 20",
+		"invalid string chunk":     "5f 01 ff",
+		"trailing data":            "01 02",
+	}
+	for name, h := range tests {
+		raw, _ := hex.DecodeString(string(bytes.ReplaceAll([]byte(h), []byte(" "), nil)))
+		if _, err := cborDecode(raw); err == nil {
+			t.Errorf("%s: decoded %q", name, h)
+		}
+	}
+
+	nested := append(bytes.Repeat([]byte{0x81}, 40), 0x00)
+	if _, err := cborDecode(nested); err == nil {
+		t.Fatal("decoded 40 nested arrays")
+	}
+	if _, err := cborDecode(append(bytes.Repeat([]byte{0x81}, 10), 0x00)); err != nil {
+		t.Fatalf("10 nested arrays: %v", err)
+	}
+	if _, err := cborDecode([]byte{0x65, 'a'}); !errors.Is(err, errCborTruncated) {
+		t.Fatalf("got %v, want errCborTruncated", err)
+	}
+}
+
+func TestCborRoundTrip(t *testing.T) {
+	value := []cborMapEntry{
+		{"u", uint64(1) << 40},
+		{"n", -300},
+		{"s", "text"},
+		{"b", []byte{0xde, 0xad}},
+		{"l", []string{"x", "y"}},
+		{"a", []any{true, false, nil, []cborMapEntry{{"k", "v"}}}},
+	}
+	raw, err := cborEncode(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := cborDecode(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"u": uint64(1) << 40,
+		"n": int64(-300),
+		"s": "text",
+		"b": []byte{0xde, 0xad},
+		"l": []any{"x", "y"},
+		"a": []any{true, false, nil, map[string]any{"k": "v"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %#v", got)
+	}
+	if _, err := cborEncode(1.5); err == nil {
+		t.Fatal("encoded a float")
+	}
+}
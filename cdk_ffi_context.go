@@ -0,0 +1,2535 @@
+package cdk_ffi
+
+// #include <cdk_ffi.h>
+import "C"
+
+import (
+	"context"
+	"runtime/cgo"
+	"unsafe"
+)
+
+// The *Ctx methods in this file mirror the generated async methods, but give
+// up on the call once ctx is done. When that happens the underlying Rust
+// future is cancelled through its rust_future_cancel entry point, released,
+// and ctx.Err() is returned. Cancellation only stops the Go caller from
+// waiting: work the mint has already accepted (e.g. a melt that reached the
+// Lightning backend) may still complete on the mint side.
+
+type rustFutureCancelFunc func(C.uint64_t)
+
+func uniffiRustCallAsyncContext[T any, F any](
+	ctx context.Context,
+	errConverter BufReader[*FfiError],
+	completeFunc rustFutureCompleteFunc[F],
+	liftFunc func(F) T,
+	rustFuture C.uint64_t,
+	pollFunc rustFuturePollFunc,
+	cancelFunc rustFutureCancelFunc,
+	freeFunc rustFutureFreeFunc,
+) (T, error) {
+	defer freeFunc(rustFuture)
+
+	var goValue T
+	if err := uniffiRustFutureWaitContext(ctx, rustFuture, pollFunc, cancelFunc); err != nil {
+		return goValue, err
+	}
+
+	ffiValue, err := rustCallWithError(errConverter, func(status *C.RustCallStatus) F {
+		return completeFunc(rustFuture, status)
+	})
+	if err != nil {
+		return goValue, err
+	}
+	return liftFunc(ffiValue), nil
+}
+
+// uniffiRustCallAsyncContextNoError is uniffiRustCallAsyncContext for the
+// async methods that cannot fail, the only error it returns is ctx.Err().
+func uniffiRustCallAsyncContextNoError[T any, F any](
+	ctx context.Context,
+	completeFunc rustFutureCompleteFunc[F],
+	liftFunc func(F) T,
+	rustFuture C.uint64_t,
+	pollFunc rustFuturePollFunc,
+	cancelFunc rustFutureCancelFunc,
+	freeFunc rustFutureFreeFunc,
+) (T, error) {
+	defer freeFunc(rustFuture)
+
+	var goValue T
+	if err := uniffiRustFutureWaitContext(ctx, rustFuture, pollFunc, cancelFunc); err != nil {
+		return goValue, err
+	}
+
+	ffiValue, _ := rustCallWithError[error](nil, func(status *C.RustCallStatus) F {
+		return completeFunc(rustFuture, status)
+	})
+	return liftFunc(ffiValue), nil
+}
+
+// uniffiRustFutureWaitContext polls rustFuture until it is ready, or cancels
+// it once ctx is done.
+func uniffiRustFutureWaitContext(
+	ctx context.Context,
+	rustFuture C.uint64_t,
+	pollFunc rustFuturePollFunc,
+	cancelFunc rustFutureCancelFunc,
+) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	pollResult := int8(-1)
+	waiter := make(chan int8, 1)
+
+	chanHandle := cgo.NewHandle(waiter)
+	defer chanHandle.Delete()
+
+	for pollResult != uniffiRustFuturePollReady {
+		pollFunc(
+			rustFuture,
+			(C.UniffiRustFutureContinuationCallback)(C.cdk_ffi_uniffiFutureContinuationCallback),
+			C.uint64_t(chanHandle),
+		)
+		select {
+		case pollResult = <-waiter:
+		case <-ctx.Done():
+			cancelFunc(rustFuture)
+			// Cancelling wakes the pending continuation, wait for it so the
+			// channel handle is not deleted while Rust still references it.
+			<-waiter
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// RecvCtx is the context-aware variant of Recv.
+func (_self *ActiveSubscription) RecvCtx(ctx context.Context) (NotificationPayload, error) {
+	_pointer := _self.ffiObject.incrementPointer("*ActiveSubscription")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) NotificationPayload {
+			return FfiConverterNotificationPayloadINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_activesubscription_recv(
+			_pointer),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// TryRecvCtx is the context-aware variant of TryRecv.
+func (_self *ActiveSubscription) TryRecvCtx(ctx context.Context) (*NotificationPayload, error) {
+	_pointer := _self.ffiObject.incrementPointer("*ActiveSubscription")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) *NotificationPayload {
+			return FfiConverterOptionalNotificationPayloadINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_activesubscription_try_recv(
+			_pointer),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// AddMintCtx is the context-aware variant of AddMint.
+func (_self *MultiMintWallet) AddMintCtx(ctx context.Context, mintUrl MintUrl, targetProofCount *uint32) error {
+	_pointer := _self.ffiObject.incrementPointer("*MultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	_, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) struct{} {
+			C.ffi_cdk_ffi_rust_future_complete_void(handle, status)
+			return struct{}{}
+		},
+		// liftFn
+		func(_ struct{}) struct{} { return struct{}{} },
+		C.uniffi_cdk_ffi_fn_method_multimintwallet_add_mint(
+			_pointer, FfiConverterMintUrlINSTANCE.Lower(mintUrl), FfiConverterOptionalUint32INSTANCE.Lower(targetProofCount)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_void(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_void(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_void(handle)
+		},
+	)
+
+	if err == nil {
+		return nil
+	}
+
+	return err
+}
+
+// CheckAllMintQuotesCtx is the context-aware variant of CheckAllMintQuotes.
+func (_self *MultiMintWallet) CheckAllMintQuotesCtx(ctx context.Context, mintUrl *MintUrl) (Amount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*MultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) Amount {
+			return FfiConverterAmountINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_multimintwallet_check_all_mint_quotes(
+			_pointer, FfiConverterOptionalMintUrlINSTANCE.Lower(mintUrl)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// CheckMintQuoteCtx is the context-aware variant of CheckMintQuote.
+func (_self *MultiMintWallet) CheckMintQuoteCtx(ctx context.Context, mintUrl MintUrl, quoteId string) (MintQuote, error) {
+	_pointer := _self.ffiObject.incrementPointer("*MultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) MintQuote {
+			return FfiConverterMintQuoteINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_multimintwallet_check_mint_quote(
+			_pointer, FfiConverterMintUrlINSTANCE.Lower(mintUrl), FfiConverterStringINSTANCE.Lower(quoteId)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// ConsolidateCtx is the context-aware variant of Consolidate.
+func (_self *MultiMintWallet) ConsolidateCtx(ctx context.Context) (Amount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*MultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) Amount {
+			return FfiConverterAmountINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_multimintwallet_consolidate(
+			_pointer),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// GetBalancesCtx is the context-aware variant of GetBalances.
+func (_self *MultiMintWallet) GetBalancesCtx(ctx context.Context) (map[string]Amount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*MultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) map[string]Amount {
+			return FfiConverterMapStringAmountINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_multimintwallet_get_balances(
+			_pointer),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// GetMintUrlsCtx is the context-aware variant of GetMintUrls.
+func (_self *MultiMintWallet) GetMintUrlsCtx(ctx context.Context) ([]string, error) {
+	_pointer := _self.ffiObject.incrementPointer("*MultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	return uniffiRustCallAsyncContextNoError(
+		ctx,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) []string {
+			return FfiConverterSequenceStringINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_multimintwallet_get_mint_urls(
+			_pointer),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+}
+
+// HasMintCtx is the context-aware variant of HasMint.
+func (_self *MultiMintWallet) HasMintCtx(ctx context.Context, mintUrl MintUrl) (bool, error) {
+	_pointer := _self.ffiObject.incrementPointer("*MultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	return uniffiRustCallAsyncContextNoError(
+		ctx,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) C.int8_t {
+			res := C.ffi_cdk_ffi_rust_future_complete_i8(handle, status)
+			return res
+		},
+		// liftFn
+		func(ffi C.int8_t) bool {
+			return FfiConverterBoolINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_multimintwallet_has_mint(
+			_pointer, FfiConverterMintUrlINSTANCE.Lower(mintUrl)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_i8(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_i8(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_i8(handle)
+		},
+	)
+}
+
+// ListProofsCtx is the context-aware variant of ListProofs.
+func (_self *MultiMintWallet) ListProofsCtx(ctx context.Context) (map[string][]*Proof, error) {
+	_pointer := _self.ffiObject.incrementPointer("*MultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) map[string][]*Proof {
+			return FfiConverterMapStringSequenceProofINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_multimintwallet_list_proofs(
+			_pointer),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// ListTransactionsCtx is the context-aware variant of ListTransactions.
+func (_self *MultiMintWallet) ListTransactionsCtx(ctx context.Context, direction *TransactionDirection) ([]Transaction, error) {
+	_pointer := _self.ffiObject.incrementPointer("*MultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) []Transaction {
+			return FfiConverterSequenceTransactionINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_multimintwallet_list_transactions(
+			_pointer, FfiConverterOptionalTransactionDirectionINSTANCE.Lower(direction)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// MeltCtx is the context-aware variant of Melt.
+func (_self *MultiMintWallet) MeltCtx(ctx context.Context, bolt11 string, options *MeltOptions, maxFee *Amount) (Melted, error) {
+	_pointer := _self.ffiObject.incrementPointer("*MultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) Melted {
+			return FfiConverterMeltedINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_multimintwallet_melt(
+			_pointer, FfiConverterStringINSTANCE.Lower(bolt11), FfiConverterOptionalMeltOptionsINSTANCE.Lower(options), FfiConverterOptionalAmountINSTANCE.Lower(maxFee)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// MeltQuoteCtx is the context-aware variant of MeltQuote.
+func (_self *MultiMintWallet) MeltQuoteCtx(ctx context.Context, mintUrl MintUrl, request string, options *MeltOptions) (MeltQuote, error) {
+	_pointer := _self.ffiObject.incrementPointer("*MultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) MeltQuote {
+			return FfiConverterMeltQuoteINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_multimintwallet_melt_quote(
+			_pointer, FfiConverterMintUrlINSTANCE.Lower(mintUrl), FfiConverterStringINSTANCE.Lower(request), FfiConverterOptionalMeltOptionsINSTANCE.Lower(options)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// MintCtx is the context-aware variant of Mint.
+func (_self *MultiMintWallet) MintCtx(ctx context.Context, mintUrl MintUrl, quoteId string, spendingConditions *SpendingConditions) ([]*Proof, error) {
+	_pointer := _self.ffiObject.incrementPointer("*MultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) []*Proof {
+			return FfiConverterSequenceProofINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_multimintwallet_mint(
+			_pointer, FfiConverterMintUrlINSTANCE.Lower(mintUrl), FfiConverterStringINSTANCE.Lower(quoteId), FfiConverterOptionalSpendingConditionsINSTANCE.Lower(spendingConditions)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// MintQuoteCtx is the context-aware variant of MintQuote.
+func (_self *MultiMintWallet) MintQuoteCtx(ctx context.Context, mintUrl MintUrl, amount Amount, description *string) (MintQuote, error) {
+	_pointer := _self.ffiObject.incrementPointer("*MultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) MintQuote {
+			return FfiConverterMintQuoteINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_multimintwallet_mint_quote(
+			_pointer, FfiConverterMintUrlINSTANCE.Lower(mintUrl), FfiConverterAmountINSTANCE.Lower(amount), FfiConverterOptionalStringINSTANCE.Lower(description)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// PrepareSendCtx is the context-aware variant of PrepareSend.
+func (_self *MultiMintWallet) PrepareSendCtx(ctx context.Context, mintUrl MintUrl, amount Amount, options MultiMintSendOptions) (*PreparedSend, error) {
+	_pointer := _self.ffiObject.incrementPointer("*MultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) unsafe.Pointer {
+			res := C.ffi_cdk_ffi_rust_future_complete_pointer(handle, status)
+			return res
+		},
+		// liftFn
+		func(ffi unsafe.Pointer) *PreparedSend {
+			return FfiConverterPreparedSendINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_multimintwallet_prepare_send(
+			_pointer, FfiConverterMintUrlINSTANCE.Lower(mintUrl), FfiConverterAmountINSTANCE.Lower(amount), FfiConverterMultiMintSendOptionsINSTANCE.Lower(options)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_pointer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_pointer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_pointer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// ReceiveCtx is the context-aware variant of Receive.
+func (_self *MultiMintWallet) ReceiveCtx(ctx context.Context, token *Token, options MultiMintReceiveOptions) (Amount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*MultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) Amount {
+			return FfiConverterAmountINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_multimintwallet_receive(
+			_pointer, FfiConverterTokenINSTANCE.Lower(token), FfiConverterMultiMintReceiveOptionsINSTANCE.Lower(options)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// RemoveMintCtx is the context-aware variant of RemoveMint.
+func (_self *MultiMintWallet) RemoveMintCtx(ctx context.Context, mintUrl MintUrl) error {
+	_pointer := _self.ffiObject.incrementPointer("*MultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	_, err := uniffiRustCallAsyncContextNoError(
+		ctx,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) struct{} {
+			C.ffi_cdk_ffi_rust_future_complete_void(handle, status)
+			return struct{}{}
+		},
+		// liftFn
+		func(_ struct{}) struct{} { return struct{}{} },
+		C.uniffi_cdk_ffi_fn_method_multimintwallet_remove_mint(
+			_pointer, FfiConverterMintUrlINSTANCE.Lower(mintUrl)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_void(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_void(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_void(handle)
+		},
+	)
+	return err
+}
+
+// RestoreCtx is the context-aware variant of Restore.
+func (_self *MultiMintWallet) RestoreCtx(ctx context.Context, mintUrl MintUrl) (Amount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*MultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) Amount {
+			return FfiConverterAmountINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_multimintwallet_restore(
+			_pointer, FfiConverterMintUrlINSTANCE.Lower(mintUrl)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// SwapCtx is the context-aware variant of Swap.
+func (_self *MultiMintWallet) SwapCtx(ctx context.Context, amount *Amount, spendingConditions *SpendingConditions) (*[]*Proof, error) {
+	_pointer := _self.ffiObject.incrementPointer("*MultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) *[]*Proof {
+			return FfiConverterOptionalSequenceProofINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_multimintwallet_swap(
+			_pointer, FfiConverterOptionalAmountINSTANCE.Lower(amount), FfiConverterOptionalSpendingConditionsINSTANCE.Lower(spendingConditions)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// TotalBalanceCtx is the context-aware variant of TotalBalance.
+func (_self *MultiMintWallet) TotalBalanceCtx(ctx context.Context) (Amount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*MultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) Amount {
+			return FfiConverterAmountINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_multimintwallet_total_balance(
+			_pointer),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// TransferCtx is the context-aware variant of Transfer.
+func (_self *MultiMintWallet) TransferCtx(ctx context.Context, sourceMint MintUrl, targetMint MintUrl, transferMode TransferMode) (TransferResult, error) {
+	_pointer := _self.ffiObject.incrementPointer("*MultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) TransferResult {
+			return FfiConverterTransferResultINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_multimintwallet_transfer(
+			_pointer, FfiConverterMintUrlINSTANCE.Lower(sourceMint), FfiConverterMintUrlINSTANCE.Lower(targetMint), FfiConverterTransferModeINSTANCE.Lower(transferMode)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// VerifyTokenDleqCtx is the context-aware variant of VerifyTokenDleq.
+func (_self *MultiMintWallet) VerifyTokenDleqCtx(ctx context.Context, token *Token) error {
+	_pointer := _self.ffiObject.incrementPointer("*MultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	_, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) struct{} {
+			C.ffi_cdk_ffi_rust_future_complete_void(handle, status)
+			return struct{}{}
+		},
+		// liftFn
+		func(_ struct{}) struct{} { return struct{}{} },
+		C.uniffi_cdk_ffi_fn_method_multimintwallet_verify_token_dleq(
+			_pointer, FfiConverterTokenINSTANCE.Lower(token)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_void(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_void(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_void(handle)
+		},
+	)
+
+	if err == nil {
+		return nil
+	}
+
+	return err
+}
+
+// WaitForMintQuoteCtx is the context-aware variant of WaitForMintQuote.
+func (_self *MultiMintWallet) WaitForMintQuoteCtx(ctx context.Context, mintUrl MintUrl, quoteId string, splitTarget SplitTarget, spendingConditions *SpendingConditions, timeoutSecs uint64) ([]*Proof, error) {
+	_pointer := _self.ffiObject.incrementPointer("*MultiMintWallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) []*Proof {
+			return FfiConverterSequenceProofINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_multimintwallet_wait_for_mint_quote(
+			_pointer, FfiConverterMintUrlINSTANCE.Lower(mintUrl), FfiConverterStringINSTANCE.Lower(quoteId), FfiConverterSplitTargetINSTANCE.Lower(splitTarget), FfiConverterOptionalSpendingConditionsINSTANCE.Lower(spendingConditions), FfiConverterUint64INSTANCE.Lower(timeoutSecs)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// CancelCtx is the context-aware variant of Cancel.
+func (_self *PreparedSend) CancelCtx(ctx context.Context) error {
+	_pointer := _self.ffiObject.incrementPointer("*PreparedSend")
+	defer _self.ffiObject.decrementPointer()
+	_, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) struct{} {
+			C.ffi_cdk_ffi_rust_future_complete_void(handle, status)
+			return struct{}{}
+		},
+		// liftFn
+		func(_ struct{}) struct{} { return struct{}{} },
+		C.uniffi_cdk_ffi_fn_method_preparedsend_cancel(
+			_pointer),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_void(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_void(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_void(handle)
+		},
+	)
+
+	if err == nil {
+		return nil
+	}
+
+	return err
+}
+
+// ConfirmCtx is the context-aware variant of Confirm.
+func (_self *PreparedSend) ConfirmCtx(ctx context.Context, memo *string) (*Token, error) {
+	_pointer := _self.ffiObject.incrementPointer("*PreparedSend")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) unsafe.Pointer {
+			res := C.ffi_cdk_ffi_rust_future_complete_pointer(handle, status)
+			return res
+		},
+		// liftFn
+		func(ffi unsafe.Pointer) *Token {
+			return FfiConverterTokenINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_preparedsend_confirm(
+			_pointer, FfiConverterOptionalStringINSTANCE.Lower(memo)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_pointer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_pointer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_pointer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// CalculateFeeCtx is the context-aware variant of CalculateFee.
+func (_self *Wallet) CalculateFeeCtx(ctx context.Context, proofCount uint32, keysetId string) (Amount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) Amount {
+			return FfiConverterAmountINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_calculate_fee(
+			_pointer, FfiConverterUint32INSTANCE.Lower(proofCount), FfiConverterStringINSTANCE.Lower(keysetId)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// CheckAllPendingProofsCtx is the context-aware variant of CheckAllPendingProofs.
+func (_self *Wallet) CheckAllPendingProofsCtx(ctx context.Context) (Amount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) Amount {
+			return FfiConverterAmountINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_check_all_pending_proofs(
+			_pointer),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// CheckProofsSpentCtx is the context-aware variant of CheckProofsSpent.
+func (_self *Wallet) CheckProofsSpentCtx(ctx context.Context, proofs []*Proof) ([]bool, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) []bool {
+			return FfiConverterSequenceBoolINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_check_proofs_spent(
+			_pointer, FfiConverterSequenceProofINSTANCE.Lower(proofs)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// GetActiveKeysetCtx is the context-aware variant of GetActiveKeyset.
+func (_self *Wallet) GetActiveKeysetCtx(ctx context.Context) (KeySetInfo, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) KeySetInfo {
+			return FfiConverterKeySetInfoINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_get_active_keyset(
+			_pointer),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// GetKeysetFeesByIdCtx is the context-aware variant of GetKeysetFeesById.
+func (_self *Wallet) GetKeysetFeesByIdCtx(ctx context.Context, keysetId string) (uint64, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) C.uint64_t {
+			res := C.ffi_cdk_ffi_rust_future_complete_u64(handle, status)
+			return res
+		},
+		// liftFn
+		func(ffi C.uint64_t) uint64 {
+			return FfiConverterUint64INSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_get_keyset_fees_by_id(
+			_pointer, FfiConverterStringINSTANCE.Lower(keysetId)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_u64(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_u64(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_u64(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// GetMintInfoCtx is the context-aware variant of GetMintInfo.
+func (_self *Wallet) GetMintInfoCtx(ctx context.Context) (*MintInfo, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) *MintInfo {
+			return FfiConverterOptionalMintInfoINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_get_mint_info(
+			_pointer),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// GetProofsByStatesCtx is the context-aware variant of GetProofsByStates.
+func (_self *Wallet) GetProofsByStatesCtx(ctx context.Context, states []ProofState) ([]*Proof, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) []*Proof {
+			return FfiConverterSequenceProofINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_get_proofs_by_states(
+			_pointer, FfiConverterSequenceProofStateINSTANCE.Lower(states)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// GetTransactionCtx is the context-aware variant of GetTransaction.
+func (_self *Wallet) GetTransactionCtx(ctx context.Context, id TransactionId) (*Transaction, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) *Transaction {
+			return FfiConverterOptionalTransactionINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_get_transaction(
+			_pointer, FfiConverterTransactionIdINSTANCE.Lower(id)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// GetUnspentAuthProofsCtx is the context-aware variant of GetUnspentAuthProofs.
+func (_self *Wallet) GetUnspentAuthProofsCtx(ctx context.Context) ([]AuthProof, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) []AuthProof {
+			return FfiConverterSequenceAuthProofINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_get_unspent_auth_proofs(
+			_pointer),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// ListTransactionsCtx is the context-aware variant of ListTransactions.
+func (_self *Wallet) ListTransactionsCtx(ctx context.Context, direction *TransactionDirection) ([]Transaction, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) []Transaction {
+			return FfiConverterSequenceTransactionINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_list_transactions(
+			_pointer, FfiConverterOptionalTransactionDirectionINSTANCE.Lower(direction)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// MeltCtx is the context-aware variant of Melt.
+func (_self *Wallet) MeltCtx(ctx context.Context, quoteId string) (Melted, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) Melted {
+			return FfiConverterMeltedINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_melt(
+			_pointer, FfiConverterStringINSTANCE.Lower(quoteId)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// MeltBip353QuoteCtx is the context-aware variant of MeltBip353Quote.
+func (_self *Wallet) MeltBip353QuoteCtx(ctx context.Context, bip353Address string, amountMsat Amount) (MeltQuote, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) MeltQuote {
+			return FfiConverterMeltQuoteINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_melt_bip353_quote(
+			_pointer, FfiConverterStringINSTANCE.Lower(bip353Address), FfiConverterAmountINSTANCE.Lower(amountMsat)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// MeltBolt12QuoteCtx is the context-aware variant of MeltBolt12Quote.
+func (_self *Wallet) MeltBolt12QuoteCtx(ctx context.Context, request string, options *MeltOptions) (MeltQuote, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) MeltQuote {
+			return FfiConverterMeltQuoteINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_melt_bolt12_quote(
+			_pointer, FfiConverterStringINSTANCE.Lower(request), FfiConverterOptionalMeltOptionsINSTANCE.Lower(options)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// MeltQuoteCtx is the context-aware variant of MeltQuote.
+func (_self *Wallet) MeltQuoteCtx(ctx context.Context, request string, options *MeltOptions) (MeltQuote, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) MeltQuote {
+			return FfiConverterMeltQuoteINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_melt_quote(
+			_pointer, FfiConverterStringINSTANCE.Lower(request), FfiConverterOptionalMeltOptionsINSTANCE.Lower(options)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// MintCtx is the context-aware variant of Mint.
+func (_self *Wallet) MintCtx(ctx context.Context, quoteId string, amountSplitTarget SplitTarget, spendingConditions *SpendingConditions) ([]*Proof, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) []*Proof {
+			return FfiConverterSequenceProofINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_mint(
+			_pointer, FfiConverterStringINSTANCE.Lower(quoteId), FfiConverterSplitTargetINSTANCE.Lower(amountSplitTarget), FfiConverterOptionalSpendingConditionsINSTANCE.Lower(spendingConditions)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// MintBlindAuthCtx is the context-aware variant of MintBlindAuth.
+func (_self *Wallet) MintBlindAuthCtx(ctx context.Context, amount Amount) ([]*Proof, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) []*Proof {
+			return FfiConverterSequenceProofINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_mint_blind_auth(
+			_pointer, FfiConverterAmountINSTANCE.Lower(amount)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// MintBolt12Ctx is the context-aware variant of MintBolt12.
+func (_self *Wallet) MintBolt12Ctx(ctx context.Context, quoteId string, amount *Amount, amountSplitTarget SplitTarget, spendingConditions *SpendingConditions) ([]*Proof, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) []*Proof {
+			return FfiConverterSequenceProofINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_mint_bolt12(
+			_pointer, FfiConverterStringINSTANCE.Lower(quoteId), FfiConverterOptionalAmountINSTANCE.Lower(amount), FfiConverterSplitTargetINSTANCE.Lower(amountSplitTarget), FfiConverterOptionalSpendingConditionsINSTANCE.Lower(spendingConditions)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// MintBolt12QuoteCtx is the context-aware variant of MintBolt12Quote.
+func (_self *Wallet) MintBolt12QuoteCtx(ctx context.Context, amount *Amount, description *string) (MintQuote, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) MintQuote {
+			return FfiConverterMintQuoteINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_mint_bolt12_quote(
+			_pointer, FfiConverterOptionalAmountINSTANCE.Lower(amount), FfiConverterOptionalStringINSTANCE.Lower(description)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// MintQuoteCtx is the context-aware variant of MintQuote.
+func (_self *Wallet) MintQuoteCtx(ctx context.Context, amount Amount, description *string) (MintQuote, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) MintQuote {
+			return FfiConverterMintQuoteINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_mint_quote(
+			_pointer, FfiConverterAmountINSTANCE.Lower(amount), FfiConverterOptionalStringINSTANCE.Lower(description)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// PrepareSendCtx is the context-aware variant of PrepareSend.
+func (_self *Wallet) PrepareSendCtx(ctx context.Context, amount Amount, options SendOptions) (*PreparedSend, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) unsafe.Pointer {
+			res := C.ffi_cdk_ffi_rust_future_complete_pointer(handle, status)
+			return res
+		},
+		// liftFn
+		func(ffi unsafe.Pointer) *PreparedSend {
+			return FfiConverterPreparedSendINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_prepare_send(
+			_pointer, FfiConverterAmountINSTANCE.Lower(amount), FfiConverterSendOptionsINSTANCE.Lower(options)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_pointer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_pointer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_pointer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// ReceiveCtx is the context-aware variant of Receive.
+func (_self *Wallet) ReceiveCtx(ctx context.Context, token *Token, options ReceiveOptions) (Amount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) Amount {
+			return FfiConverterAmountINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_receive(
+			_pointer, FfiConverterTokenINSTANCE.Lower(token), FfiConverterReceiveOptionsINSTANCE.Lower(options)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// ReceiveProofsCtx is the context-aware variant of ReceiveProofs.
+func (_self *Wallet) ReceiveProofsCtx(ctx context.Context, proofs []*Proof, options ReceiveOptions, memo *string) (Amount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) Amount {
+			return FfiConverterAmountINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_receive_proofs(
+			_pointer, FfiConverterSequenceProofINSTANCE.Lower(proofs), FfiConverterReceiveOptionsINSTANCE.Lower(options), FfiConverterOptionalStringINSTANCE.Lower(memo)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// ReclaimUnspentCtx is the context-aware variant of ReclaimUnspent.
+func (_self *Wallet) ReclaimUnspentCtx(ctx context.Context, proofs []*Proof) error {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	_, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) struct{} {
+			C.ffi_cdk_ffi_rust_future_complete_void(handle, status)
+			return struct{}{}
+		},
+		// liftFn
+		func(_ struct{}) struct{} { return struct{}{} },
+		C.uniffi_cdk_ffi_fn_method_wallet_reclaim_unspent(
+			_pointer, FfiConverterSequenceProofINSTANCE.Lower(proofs)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_void(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_void(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_void(handle)
+		},
+	)
+
+	if err == nil {
+		return nil
+	}
+
+	return err
+}
+
+// RefreshAccessTokenCtx is the context-aware variant of RefreshAccessToken.
+func (_self *Wallet) RefreshAccessTokenCtx(ctx context.Context) error {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	_, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) struct{} {
+			C.ffi_cdk_ffi_rust_future_complete_void(handle, status)
+			return struct{}{}
+		},
+		// liftFn
+		func(_ struct{}) struct{} { return struct{}{} },
+		C.uniffi_cdk_ffi_fn_method_wallet_refresh_access_token(
+			_pointer),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_void(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_void(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_void(handle)
+		},
+	)
+
+	if err == nil {
+		return nil
+	}
+
+	return err
+}
+
+// RefreshKeysetsCtx is the context-aware variant of RefreshKeysets.
+func (_self *Wallet) RefreshKeysetsCtx(ctx context.Context) ([]KeySetInfo, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) []KeySetInfo {
+			return FfiConverterSequenceKeySetInfoINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_refresh_keysets(
+			_pointer),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// RestoreCtx is the context-aware variant of Restore.
+func (_self *Wallet) RestoreCtx(ctx context.Context) (Amount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) Amount {
+			return FfiConverterAmountINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_restore(
+			_pointer),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// RevertTransactionCtx is the context-aware variant of RevertTransaction.
+func (_self *Wallet) RevertTransactionCtx(ctx context.Context, id TransactionId) error {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	_, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) struct{} {
+			C.ffi_cdk_ffi_rust_future_complete_void(handle, status)
+			return struct{}{}
+		},
+		// liftFn
+		func(_ struct{}) struct{} { return struct{}{} },
+		C.uniffi_cdk_ffi_fn_method_wallet_revert_transaction(
+			_pointer, FfiConverterTransactionIdINSTANCE.Lower(id)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_void(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_void(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_void(handle)
+		},
+	)
+
+	if err == nil {
+		return nil
+	}
+
+	return err
+}
+
+// SetCatCtx is the context-aware variant of SetCat.
+func (_self *Wallet) SetCatCtx(ctx context.Context, cat string) error {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	_, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) struct{} {
+			C.ffi_cdk_ffi_rust_future_complete_void(handle, status)
+			return struct{}{}
+		},
+		// liftFn
+		func(_ struct{}) struct{} { return struct{}{} },
+		C.uniffi_cdk_ffi_fn_method_wallet_set_cat(
+			_pointer, FfiConverterStringINSTANCE.Lower(cat)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_void(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_void(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_void(handle)
+		},
+	)
+
+	if err == nil {
+		return nil
+	}
+
+	return err
+}
+
+// SetRefreshTokenCtx is the context-aware variant of SetRefreshToken.
+func (_self *Wallet) SetRefreshTokenCtx(ctx context.Context, refreshToken string) error {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	_, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) struct{} {
+			C.ffi_cdk_ffi_rust_future_complete_void(handle, status)
+			return struct{}{}
+		},
+		// liftFn
+		func(_ struct{}) struct{} { return struct{}{} },
+		C.uniffi_cdk_ffi_fn_method_wallet_set_refresh_token(
+			_pointer, FfiConverterStringINSTANCE.Lower(refreshToken)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_void(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_void(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_void(handle)
+		},
+	)
+
+	if err == nil {
+		return nil
+	}
+
+	return err
+}
+
+// SubscribeCtx is the context-aware variant of Subscribe.
+func (_self *Wallet) SubscribeCtx(ctx context.Context, params SubscribeParams) (*ActiveSubscription, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) unsafe.Pointer {
+			res := C.ffi_cdk_ffi_rust_future_complete_pointer(handle, status)
+			return res
+		},
+		// liftFn
+		func(ffi unsafe.Pointer) *ActiveSubscription {
+			return FfiConverterActiveSubscriptionINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_subscribe(
+			_pointer, FfiConverterSubscribeParamsINSTANCE.Lower(params)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_pointer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_pointer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_pointer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// SwapCtx is the context-aware variant of Swap.
+func (_self *Wallet) SwapCtx(ctx context.Context, amount *Amount, amountSplitTarget SplitTarget, inputProofs []*Proof, spendingConditions *SpendingConditions, includeFees bool) (*[]*Proof, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) *[]*Proof {
+			return FfiConverterOptionalSequenceProofINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_swap(
+			_pointer, FfiConverterOptionalAmountINSTANCE.Lower(amount), FfiConverterSplitTargetINSTANCE.Lower(amountSplitTarget), FfiConverterSequenceProofINSTANCE.Lower(inputProofs), FfiConverterOptionalSpendingConditionsINSTANCE.Lower(spendingConditions), FfiConverterBoolINSTANCE.Lower(includeFees)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// TotalBalanceCtx is the context-aware variant of TotalBalance.
+func (_self *Wallet) TotalBalanceCtx(ctx context.Context) (Amount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) Amount {
+			return FfiConverterAmountINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_total_balance(
+			_pointer),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// TotalPendingBalanceCtx is the context-aware variant of TotalPendingBalance.
+func (_self *Wallet) TotalPendingBalanceCtx(ctx context.Context) (Amount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) Amount {
+			return FfiConverterAmountINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_total_pending_balance(
+			_pointer),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// TotalReservedBalanceCtx is the context-aware variant of TotalReservedBalance.
+func (_self *Wallet) TotalReservedBalanceCtx(ctx context.Context) (Amount, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	res, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) RustBufferI {
+			res := C.ffi_cdk_ffi_rust_future_complete_rust_buffer(handle, status)
+			return GoRustBuffer{
+				inner: res,
+			}
+		},
+		// liftFn
+		func(ffi RustBufferI) Amount {
+			return FfiConverterAmountINSTANCE.Lift(ffi)
+		},
+		C.uniffi_cdk_ffi_fn_method_wallet_total_reserved_balance(
+			_pointer),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_rust_buffer(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_rust_buffer(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_rust_buffer(handle)
+		},
+	)
+
+	if err == nil {
+		return res, nil
+	}
+
+	return res, err
+}
+
+// VerifyTokenDleqCtx is the context-aware variant of VerifyTokenDleq.
+func (_self *Wallet) VerifyTokenDleqCtx(ctx context.Context, token *Token) error {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	_, err := uniffiRustCallAsyncContext(
+		ctx,
+		FfiConverterFfiErrorINSTANCE,
+		// completeFn
+		func(handle C.uint64_t, status *C.RustCallStatus) struct{} {
+			C.ffi_cdk_ffi_rust_future_complete_void(handle, status)
+			return struct{}{}
+		},
+		// liftFn
+		func(_ struct{}) struct{} { return struct{}{} },
+		C.uniffi_cdk_ffi_fn_method_wallet_verify_token_dleq(
+			_pointer, FfiConverterTokenINSTANCE.Lower(token)),
+		// pollFn
+		func(handle C.uint64_t, continuation C.UniffiRustFutureContinuationCallback, data C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_poll_void(handle, continuation, data)
+		},
+		// cancelFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_cancel_void(handle)
+		},
+		// freeFn
+		func(handle C.uint64_t) {
+			C.ffi_cdk_ffi_rust_future_free_void(handle)
+		},
+	)
+
+	if err == nil {
+		return nil
+	}
+
+	return err
+}
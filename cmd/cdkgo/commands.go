@@ -0,0 +1,792 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	cdk "github.com/lescuer97/cdkgo"
+)
+
+// table renders rows as aligned columns.
+func table(header string, rows []string) string {
+	var out strings.Builder
+	writer := tabwriter.NewWriter(&out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(writer, header)
+	for _, row := range rows {
+		fmt.Fprintln(writer, row)
+	}
+	writer.Flush()
+	return out.String()
+}
+
+func parseAmount(raw string) (uint64, error) {
+	amount, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil || amount == 0 {
+		return 0, usageError{fmt.Sprintf("invalid amount %q", raw)}
+	}
+	return amount, nil
+}
+
+func quoteState(state cdk.QuoteState) string {
+	switch state {
+	case cdk.QuoteStateUnpaid:
+		return "UNPAID"
+	case cdk.QuoteStatePaid:
+		return "PAID"
+	case cdk.QuoteStatePending:
+		return "PENDING"
+	case cdk.QuoteStateIssued:
+		return "ISSUED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// proofsAmount sums proofs and destroys them.
+func proofsAmount(proofs []*cdk.Proof) uint64 {
+	var amount uint64
+	for _, proof := range proofs {
+		amount += proof.Amount().Value
+		proof.Destroy()
+	}
+	return amount
+}
+
+type MintBalance struct {
+	MintUrl string `json:"mint_url"`
+	Balance uint64 `json:"balance"`
+}
+
+type MintList struct {
+	Unit  string        `json:"unit"`
+	Mints []MintBalance `json:"mints"`
+}
+
+func (l MintList) String() string {
+	if len(l.Mints) == 0 {
+		return "no mints, see cdkgo mint add\n"
+	}
+	var rows []string
+	for _, mint := range l.Mints {
+		rows = append(rows, fmt.Sprintf("%s\t%d %s", mint.MintUrl, mint.Balance, l.Unit))
+	}
+	return table("MINT\tBALANCE", rows)
+}
+
+type MintChange struct {
+	MintUrl string `json:"mint_url"`
+	Added   bool   `json:"added"`
+}
+
+func (m MintChange) String() string {
+	if m.Added {
+		return fmt.Sprintf("added %s\n", m.MintUrl)
+	}
+	return fmt.Sprintf("removed %s\n", m.MintUrl)
+}
+
+type Minted struct {
+	MintUrl string `json:"mint_url"`
+	QuoteId string `json:"quote_id"`
+	Amount  uint64 `json:"amount"`
+	Unit    string `json:"unit"`
+}
+
+func (m Minted) String() string {
+	return fmt.Sprintf("minted %d %s from %s\n", m.Amount, m.Unit, m.MintUrl)
+}
+
+func runMint(ctx context.Context, c *cli, args []string) (any, error) {
+	if len(args) > 0 {
+		switch args[0] {
+		case "add":
+			return mintAdd(ctx, c, args[1:])
+		case "remove":
+			return mintRemove(c, args[1:])
+		case "list":
+			return mintList(ctx, c, args[1:])
+		}
+	}
+
+	flags := flag.NewFlagSet("mint", flag.ContinueOnError)
+	mintFlag := flags.String("mint", "", "mint of the quote")
+	wait := flags.Duration("wait", 0, "wait up to this long for the quote to be paid")
+	args, err := parse(flags, args, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	multi, err := c.open()
+	if err != nil {
+		return nil, err
+	}
+	mintUrl, err := c.mintFor(*mintFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	var proofs []*cdk.Proof
+	if *wait > 0 {
+		proofs, err = multi.WaitForMintQuoteCtx(ctx, cdk.MintUrl{Url: mintUrl}, args[0], cdk.SplitTargetNone{}, nil, uint64(wait.Seconds()))
+	} else {
+		proofs, err = multi.MintCtx(ctx, cdk.MintUrl{Url: mintUrl}, args[0], nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return Minted{MintUrl: mintUrl, QuoteId: args[0], Amount: proofsAmount(proofs), Unit: c.unit}, nil
+}
+
+func mintAdd(ctx context.Context, c *cli, args []string) (any, error) {
+	flags := flag.NewFlagSet("mint", flag.ContinueOnError)
+	target := flags.Uint("target-proofs", 0, "number of proofs of each denomination to keep, 0 for the default")
+	args, err := parse(flags, args, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	multi, err := c.open()
+	if err != nil {
+		return nil, err
+	}
+	var targetProofCount *uint32
+	if *target > 0 {
+		count := uint32(*target)
+		targetProofCount = &count
+	}
+	mintUrl := strings.TrimSuffix(args[0], "/")
+	if err := multi.AddMintCtx(ctx, cdk.MintUrl{Url: mintUrl}, targetProofCount); err != nil {
+		return nil, err
+	}
+	return MintChange{MintUrl: mintUrl, Added: true}, nil
+}
+
+func mintRemove(c *cli, args []string) (any, error) {
+	args, err := parse(flag.NewFlagSet("mint", flag.ContinueOnError), args, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	multi, err := c.open()
+	if err != nil {
+		return nil, err
+	}
+	mintUrl := cdk.MintUrl{Url: strings.TrimSuffix(args[0], "/")}
+	if !multi.HasMint(mintUrl) {
+		return nil, fmt.Errorf("mint %s is not added", mintUrl.Url)
+	}
+	multi.RemoveMint(mintUrl)
+	return MintChange{MintUrl: mintUrl.Url}, nil
+}
+
+func mintList(ctx context.Context, c *cli, args []string) (any, error) {
+	if _, err := parse(flag.NewFlagSet("mint", flag.ContinueOnError), args, 0, 0); err != nil {
+		return nil, err
+	}
+	multi, err := c.open()
+	if err != nil {
+		return nil, err
+	}
+	balances, err := multi.GetBalancesCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	urls := multi.GetMintUrls()
+	sort.Strings(urls)
+	list := MintList{Unit: c.unit, Mints: []MintBalance{}}
+	for _, url := range urls {
+		list.Mints = append(list.Mints, MintBalance{MintUrl: url, Balance: balances[url].Value})
+	}
+	return list, nil
+}
+
+type Balance struct {
+	Unit  string        `json:"unit"`
+	Total uint64        `json:"total"`
+	Mints []MintBalance `json:"mints"`
+}
+
+func (b Balance) String() string {
+	var out strings.Builder
+	for _, mint := range b.Mints {
+		fmt.Fprintf(&out, "%s: %d %s\n", mint.MintUrl, mint.Balance, b.Unit)
+	}
+	fmt.Fprintf(&out, "total: %d %s\n", b.Total, b.Unit)
+	return out.String()
+}
+
+func runBalance(ctx context.Context, c *cli, args []string) (any, error) {
+	list, err := mintList(ctx, c, args)
+	if err != nil {
+		return nil, err
+	}
+	balance := Balance{Unit: c.unit, Mints: list.(MintList).Mints}
+	for _, mint := range balance.Mints {
+		balance.Total += mint.Balance
+	}
+	return balance, nil
+}
+
+type MintQuote struct {
+	Id      string `json:"id"`
+	MintUrl string `json:"mint_url"`
+	Request string `json:"request"`
+	Amount  uint64 `json:"amount"`
+	Unit    string `json:"unit"`
+	State   string `json:"state"`
+	Expiry  uint64 `json:"expiry"`
+}
+
+func (q MintQuote) String() string {
+	return fmt.Sprintf("Pay this invoice to mint %d %s:\n\n%s\n\nthen run: cdkgo mint -mint %s %s\n", q.Amount, q.Unit, q.Request, q.MintUrl, q.Id)
+}
+
+func runMintQuote(ctx context.Context, c *cli, args []string) (any, error) {
+	flags := flag.NewFlagSet("mint-quote", flag.ContinueOnError)
+	mintFlag := flags.String("mint", "", "mint to mint at")
+	description := flags.String("description", "", "description of the invoice")
+	args, err := parse(flags, args, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := parseAmount(args[0])
+	if err != nil {
+		return nil, err
+	}
+	multi, err := c.open()
+	if err != nil {
+		return nil, err
+	}
+	mintUrl, err := c.mintFor(*mintFlag)
+	if err != nil {
+		return nil, err
+	}
+	var descriptionPtr *string
+	if *description != "" {
+		descriptionPtr = description
+	}
+	quote, err := multi.MintQuoteCtx(ctx, cdk.MintUrl{Url: mintUrl}, cdk.Amount{Value: amount}, descriptionPtr)
+	if err != nil {
+		return nil, err
+	}
+	return MintQuote{
+		Id:      quote.Id,
+		MintUrl: mintUrl,
+		Request: quote.Request,
+		Amount:  amount,
+		Unit:    c.unit,
+		State:   quoteState(quote.State),
+		Expiry:  quote.Expiry,
+	}, nil
+}
+
+type Payment struct {
+	MintUrl      string  `json:"mint_url"`
+	QuoteId      string  `json:"quote_id"`
+	State        string  `json:"state"`
+	Amount       uint64  `json:"amount"`
+	FeePaid      uint64  `json:"fee_paid"`
+	ChangeAmount uint64  `json:"change_amount"`
+	Unit         string  `json:"unit"`
+	Preimage     *string `json:"preimage"`
+}
+
+func (p Payment) String() string {
+	if p.State != "PAID" {
+		return fmt.Sprintf("payment of %d %s is %s, check it later with cdkgo proofs check\n", p.Amount, p.Unit, strings.ToLower(p.State))
+	}
+	out := fmt.Sprintf("paid %d %s with %d %s in fees\n", p.Amount, p.Unit, p.FeePaid, p.Unit)
+	if p.Preimage != nil {
+		out += fmt.Sprintf("preimage: %s\n", *p.Preimage)
+	}
+	return out
+}
+
+// isLnurl reports whether target is paid through LNURL-pay rather than
+// being an invoice.
+func isLnurl(target string) bool {
+	lower := strings.ToLower(target)
+	return strings.Contains(lower, "@") || strings.HasPrefix(lower, "lnurl") ||
+		strings.HasPrefix(lower, "https://") || strings.HasPrefix(lower, "http://")
+}
+
+func runPay(ctx context.Context, c *cli, args []string) (any, error) {
+	flags := flag.NewFlagSet("pay", flag.ContinueOnError)
+	mintFlag := flags.String("mint", "", "mint to pay from, by default the one with the largest balance")
+	amountMsat := flags.Uint64("amount-msat", 0, "amount in millisatoshis, for Lightning addresses, LNURLs and invoices without an amount")
+	comment := flags.String("comment", "", "comment sent to a Lightning address")
+	acceptDescription := flags.Bool("accept-description", false, "accept Lightning address invoices committing to the metadata with their description, as mint invoices do")
+	args, err := parse(flags, args, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	mintUrl, err := c.spendingMint(ctx, *mintFlag)
+	if err != nil {
+		return nil, err
+	}
+	wallet, err := c.wallet(mintUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	target := strings.TrimPrefix(strings.TrimSpace(args[0]), "lightning:")
+	var quote cdk.MeltQuote
+	if isLnurl(target) {
+		if *amountMsat == 0 {
+			return nil, usageError{"-amount-msat is required to pay a Lightning address or LNURL"}
+		}
+		quote, err = wallet.MeltLnurlQuote(ctx, target, *amountMsat, cdk.LnurlInvoiceOptions{Comment: *comment, AcceptDescription: *acceptDescription})
+	} else {
+		var options *cdk.MeltOptions
+		if *amountMsat > 0 {
+			var amountless cdk.MeltOptions = cdk.MeltOptionsAmountless{AmountMsat: cdk.Amount{Value: *amountMsat}}
+			options = &amountless
+		}
+		quote, err = wallet.MeltQuoteCtx(ctx, target, options)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := c.confirm(fmt.Sprintf("Pay %d %s plus up to %d %s in fees from %s?", quote.Amount.Value, c.unit, quote.FeeReserve.Value, c.unit, mintUrl))
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("payment cancelled")
+	}
+	melted, err := wallet.MeltCtx(ctx, quote.Id)
+	if err != nil {
+		return nil, err
+	}
+	payment := Payment{
+		MintUrl:  mintUrl,
+		QuoteId:  quote.Id,
+		State:    quoteState(melted.State),
+		Amount:   melted.Amount.Value,
+		FeePaid:  melted.FeePaid.Value,
+		Unit:     c.unit,
+		Preimage: melted.Preimage,
+	}
+	if melted.Change != nil {
+		payment.ChangeAmount = proofsAmount(*melted.Change)
+	}
+	return payment, nil
+}
+
+type Sent struct {
+	MintUrl string `json:"mint_url"`
+	Amount  uint64 `json:"amount"`
+	Fee     uint64 `json:"fee"`
+	Unit    string `json:"unit"`
+	Token   string `json:"token"`
+}
+
+func (s Sent) String() string {
+	return s.Token + "\n"
+}
+
+func runSend(ctx context.Context, c *cli, args []string) (any, error) {
+	flags := flag.NewFlagSet("send", flag.ContinueOnError)
+	mintFlag := flags.String("mint", "", "mint to send from, by default the one with the largest balance")
+	memo := flags.String("memo", "", "memo included in the token")
+	includeFee := flags.Bool("include-fee", false, "add the fee the receiver pays to redeem the token")
+	allowTransfer := flags.Bool("allow-transfer", false, "move funds from other mints if the mint has too little")
+	args, err := parse(flags, args, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := parseAmount(args[0])
+	if err != nil {
+		return nil, err
+	}
+	multi, err := c.open()
+	if err != nil {
+		return nil, err
+	}
+	mintUrl, err := c.spendingMint(ctx, *mintFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	options := cdk.MultiMintSendOptions{
+		AllowTransfer: *allowTransfer,
+		SendOptions: cdk.SendOptions{
+			AmountSplitTarget: cdk.SplitTargetNone{},
+			SendKind:          cdk.SendKindOnlineExact{},
+			IncludeFee:        *includeFee,
+		},
+	}
+	var memoPtr *string
+	if *memo != "" {
+		memoPtr = memo
+		options.SendOptions.Memo = &cdk.SendMemo{Memo: *memo, IncludeMemo: true}
+	}
+	prepared, err := multi.PrepareSendCtx(ctx, cdk.MintUrl{Url: mintUrl}, cdk.Amount{Value: amount}, options)
+	if err != nil {
+		return nil, err
+	}
+	defer prepared.Destroy()
+
+	fee := prepared.Fee().Value
+	ok, err := c.confirm(fmt.Sprintf("Send %d %s from %s with %d %s in fees?", prepared.Amount().Value, c.unit, mintUrl, fee, c.unit))
+	if err == nil && !ok {
+		err = errors.New("send cancelled")
+	}
+	if err != nil {
+		if cancelErr := prepared.CancelCtx(ctx); cancelErr != nil {
+			return nil, errors.Join(err, cancelErr)
+		}
+		return nil, err
+	}
+	token, err := prepared.ConfirmCtx(ctx, memoPtr)
+	if err != nil {
+		return nil, err
+	}
+	defer token.Destroy()
+	return Sent{MintUrl: mintUrl, Amount: prepared.Amount().Value, Fee: fee, Unit: c.unit, Token: token.Encode()}, nil
+}
+
+type Received struct {
+	Amount uint64 `json:"amount"`
+	Unit   string `json:"unit"`
+}
+
+func (r Received) String() string {
+	return fmt.Sprintf("received %d %s\n", r.Amount, r.Unit)
+}
+
+func runReceive(ctx context.Context, c *cli, args []string) (any, error) {
+	flags := flag.NewFlagSet("receive", flag.ContinueOnError)
+	allowUntrusted := flags.Bool("allow-untrusted", false, "receive tokens of mints not added yet, adding them")
+	args, err := parse(flags, args, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	token, err := cdk.TokenDecode(strings.TrimSpace(args[0]))
+	if err != nil {
+		return nil, err
+	}
+	defer token.Destroy()
+	multi, err := c.open()
+	if err != nil {
+		return nil, err
+	}
+	amount, err := multi.ReceiveCtx(ctx, token, cdk.MultiMintReceiveOptions{
+		AllowUntrusted: *allowUntrusted,
+		ReceiveOptions: cdk.ReceiveOptions{AmountSplitTarget: cdk.SplitTargetNone{}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return Received{Amount: amount.Value, Unit: c.unit}, nil
+}
+
+type Restored struct {
+	MintUrl string `json:"mint_url"`
+	Amount  uint64 `json:"amount"`
+	Unit    string `json:"unit"`
+}
+
+func (r Restored) String() string {
+	return fmt.Sprintf("restored %d %s from %s\n", r.Amount, r.Unit, r.MintUrl)
+}
+
+func runRestore(ctx context.Context, c *cli, args []string) (any, error) {
+	args, err := parse(flag.NewFlagSet("restore", flag.ContinueOnError), args, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	multi, err := c.open()
+	if err != nil {
+		return nil, err
+	}
+	mintUrl := cdk.MintUrl{Url: strings.TrimSuffix(args[0], "/")}
+	if !multi.HasMint(mintUrl) {
+		if err := multi.AddMintCtx(ctx, mintUrl, nil); err != nil {
+			return nil, err
+		}
+	}
+	amount, err := multi.RestoreCtx(ctx, mintUrl)
+	if err != nil {
+		return nil, err
+	}
+	return Restored{MintUrl: mintUrl.Url, Amount: amount.Value, Unit: c.unit}, nil
+}
+
+type Transaction struct {
+	Id        string  `json:"id"`
+	MintUrl   string  `json:"mint_url"`
+	Direction string  `json:"direction"`
+	Amount    uint64  `json:"amount"`
+	Fee       uint64  `json:"fee"`
+	Unit      string  `json:"unit"`
+	Timestamp uint64  `json:"timestamp"`
+	Memo      *string `json:"memo"`
+}
+
+type History []Transaction
+
+func (h History) String() string {
+	if len(h) == 0 {
+		return "no transactions\n"
+	}
+	var rows []string
+	for _, transaction := range h {
+		amount := fmt.Sprintf("+%d", transaction.Amount)
+		if transaction.Direction == "outgoing" {
+			amount = fmt.Sprintf("-%d", transaction.Amount)
+		}
+		memo := ""
+		if transaction.Memo != nil {
+			memo = *transaction.Memo
+		}
+		rows = append(rows, fmt.Sprintf("%s\t%s %s\t%d\t%s\t%s",
+			time.Unix(int64(transaction.Timestamp), 0).Format(time.DateTime), amount, transaction.Unit, transaction.Fee, transaction.MintUrl, memo))
+	}
+	return table("TIME\tAMOUNT\tFEE\tMINT\tMEMO", rows)
+}
+
+func runHistory(ctx context.Context, c *cli, args []string) (any, error) {
+	flags := flag.NewFlagSet("history", flag.ContinueOnError)
+	directionFlag := flags.String("direction", "", "only list in(coming) or out(going) transactions")
+	if _, err := parse(flags, args, 0, 0); err != nil {
+		return nil, err
+	}
+	var direction *cdk.TransactionDirection
+	switch *directionFlag {
+	case "":
+	case "in", "incoming":
+		incoming := cdk.TransactionDirectionIncoming
+		direction = &incoming
+	case "out", "outgoing":
+		outgoing := cdk.TransactionDirectionOutgoing
+		direction = &outgoing
+	default:
+		return nil, usageError{fmt.Sprintf("invalid direction %q", *directionFlag)}
+	}
+	multi, err := c.open()
+	if err != nil {
+		return nil, err
+	}
+	transactions, err := multi.ListTransactionsCtx(ctx, direction)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(transactions, func(i, j int) bool { return transactions[i].Timestamp > transactions[j].Timestamp })
+	history := History{}
+	for _, transaction := range transactions {
+		entry := Transaction{
+			Id:        transaction.Id.Hex,
+			MintUrl:   transaction.MintUrl.Url,
+			Direction: "incoming",
+			Amount:    transaction.Amount.Value,
+			Fee:       transaction.Fee.Value,
+			Unit:      cdk.CurrencyUnitString(transaction.Unit),
+			Timestamp: transaction.Timestamp,
+			Memo:      transaction.Memo,
+		}
+		if transaction.Direction == cdk.TransactionDirectionOutgoing {
+			entry.Direction = "outgoing"
+		}
+		history = append(history, entry)
+	}
+	return history, nil
+}
+
+type ProofEntry struct {
+	MintUrl  string `json:"mint_url"`
+	KeysetId string `json:"keyset_id"`
+	Amount   uint64 `json:"amount"`
+	Y        string `json:"y"`
+}
+
+type ProofList []ProofEntry
+
+func (l ProofList) String() string {
+	if len(l) == 0 {
+		return "no proofs\n"
+	}
+	var rows []string
+	for _, proof := range l {
+		rows = append(rows, fmt.Sprintf("%s\t%s\t%d\t%s", proof.MintUrl, proof.KeysetId, proof.Amount, proof.Y))
+	}
+	return table("MINT\tKEYSET\tAMOUNT\tY", rows)
+}
+
+type ProofCheck struct {
+	MintUrl string `json:"mint_url"`
+	// Unspent proofs of the wallet
+	Proofs int `json:"proofs"`
+	// Of those, the proofs the mint reports as spent
+	Spent int `json:"spent"`
+	// Amount of the pending proofs found unspent and made available again
+	Reclaimed uint64 `json:"reclaimed"`
+}
+
+type ProofChecks []ProofCheck
+
+func (checks ProofChecks) String() string {
+	var rows []string
+	for _, check := range checks {
+		rows = append(rows, fmt.Sprintf("%s\t%d\t%d\t%d", check.MintUrl, check.Proofs, check.Spent, check.Reclaimed))
+	}
+	return table("MINT\tPROOFS\tSPENT\tRECLAIMED", rows)
+}
+
+func runProofs(ctx context.Context, c *cli, args []string) (any, error) {
+	if len(args) == 0 || (args[0] != "list" && args[0] != "check") {
+		return nil, usageError{"expected list or check"}
+	}
+	flags := flag.NewFlagSet("proofs", flag.ContinueOnError)
+	reclaim := flags.Bool("reclaim", false, "make pending proofs the mints report unspent available again")
+	if _, err := parse(flags, args[1:], 0, 0); err != nil {
+		return nil, err
+	}
+	if *reclaim && args[0] == "list" {
+		return nil, usageError{"-reclaim only applies to check"}
+	}
+	if *reclaim {
+		// Pending proofs include those of sent tokens not received yet.
+		ok, err := c.confirm("Reclaim the pending proofs the mints report unspent? Tokens sent but not yet received will fail to redeem.")
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, errors.New("reclaim cancelled")
+		}
+	}
+	multi, err := c.open()
+	if err != nil {
+		return nil, err
+	}
+	proofs, err := multi.ListProofsCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	urls := make([]string, 0, len(proofs))
+	for url := range proofs {
+		urls = append(urls, url)
+	}
+	sort.Strings(urls)
+
+	if args[0] == "list" {
+		list := ProofList{}
+		for _, url := range urls {
+			for _, proof := range proofs[url] {
+				y, _ := proof.Y()
+				list = append(list, ProofEntry{MintUrl: url, KeysetId: proof.KeysetId(), Amount: proof.Amount().Value, Y: y})
+				proof.Destroy()
+			}
+		}
+		return list, nil
+	}
+
+	checks := ProofChecks{}
+	for _, url := range urls {
+		mintProofs := proofs[url]
+		wallet, err := c.wallet(url)
+		if err != nil {
+			return nil, err
+		}
+		check := ProofCheck{MintUrl: url, Proofs: len(mintProofs)}
+		if len(mintProofs) > 0 {
+			spent, err := wallet.CheckProofsSpentCtx(ctx, mintProofs)
+			if err != nil {
+				return nil, err
+			}
+			for _, isSpent := range spent {
+				if isSpent {
+					check.Spent++
+				}
+			}
+		}
+		for _, proof := range mintProofs {
+			proof.Destroy()
+		}
+		if *reclaim {
+			reclaimed, err := wallet.CheckAllPendingProofsCtx(ctx)
+			if err != nil {
+				return nil, err
+			}
+			check.Reclaimed = reclaimed.Value
+		}
+		checks = append(checks, check)
+	}
+	return checks, nil
+}
+
+type DecodedToken struct {
+	MintUrl    string       `json:"mint_url"`
+	Unit       string       `json:"unit"`
+	Amount     uint64       `json:"amount"`
+	Memo       *string      `json:"memo"`
+	Proofs     []ProofEntry `json:"proofs"`
+	P2pkKeys   []string     `json:"p2pk_pubkeys,omitempty"`
+	Locktimes  []uint64     `json:"locktimes,omitempty"`
+	HtlcHashes []string     `json:"htlc_hashes,omitempty"`
+}
+
+func (t DecodedToken) String() string {
+	var out strings.Builder
+	fmt.Fprintf(&out, "mint:   %s\namount: %d %s\nproofs: %d\n", t.MintUrl, t.Amount, t.Unit, len(t.Proofs))
+	if t.Memo != nil {
+		fmt.Fprintf(&out, "memo:   %s\n", *t.Memo)
+	}
+	if len(t.P2pkKeys) > 0 {
+		fmt.Fprintf(&out, "locked to: %s\n", strings.Join(t.P2pkKeys, ", "))
+	}
+	for _, locktime := range t.Locktimes {
+		fmt.Fprintf(&out, "locktime: %s\n", time.Unix(int64(locktime), 0).Format(time.DateTime))
+	}
+	for _, hash := range t.HtlcHashes {
+		fmt.Fprintf(&out, "htlc hash: %s\n", hash)
+	}
+	return out.String()
+}
+
+func runDecodeToken(ctx context.Context, c *cli, args []string) (any, error) {
+	args, err := parse(flag.NewFlagSet("decode-token", flag.ContinueOnError), args, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	token, err := cdk.TokenDecode(strings.TrimSpace(args[0]))
+	if err != nil {
+		return nil, err
+	}
+	defer token.Destroy()
+	mintUrl, err := token.MintUrl()
+	if err != nil {
+		return nil, err
+	}
+	amount, err := token.Value()
+	if err != nil {
+		return nil, err
+	}
+	proofs, err := token.ProofsSimple()
+	if err != nil {
+		return nil, err
+	}
+	decoded := DecodedToken{
+		MintUrl:    mintUrl.Url,
+		Amount:     amount.Value,
+		Memo:       token.Memo(),
+		Proofs:     []ProofEntry{},
+		P2pkKeys:   token.P2pkPubkeys(),
+		Locktimes:  token.Locktimes(),
+		HtlcHashes: token.HtlcHashes(),
+	}
+	if unit := token.Unit(); unit != nil {
+		decoded.Unit = cdk.CurrencyUnitString(*unit)
+	}
+	for _, proof := range proofs {
+		y, _ := proof.Y()
+		decoded.Proofs = append(decoded.Proofs, ProofEntry{MintUrl: mintUrl.Url, KeysetId: proof.KeysetId(), Amount: proof.Amount().Value, Y: y})
+		proof.Destroy()
+	}
+	return decoded, nil
+}
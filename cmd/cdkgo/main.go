@@ -0,0 +1,313 @@
+// Command cdkgo is a command-line Cashu wallet.
+//
+// The wallet is a MultiMintWallet stored in the SQLite database given with
+// -db. Its mnemonic is read from CDKGO_MNEMONIC or from the file mnemonic
+// next to the database, which is generated on first use.
+//
+//	cdkgo mint add https://mint.example.com
+//	cdkgo mint-quote 1000
+//	cdkgo mint <quote id>
+//	cdkgo send 100
+//
+// Melts, sends and proofs check -reclaim show what they will do and ask for
+// confirmation first; -yes skips the question. With -json every command
+// prints a JSON document instead of text, for scripts. Run cdkgo help for
+// the list of commands.
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+
+	cdk "github.com/lescuer97/cdkgo"
+	"github.com/lescuer97/cdkgo/internal/mnemonic"
+)
+
+type command struct {
+	usage   string
+	summary string
+	run     func(ctx context.Context, c *cli, args []string) (any, error)
+}
+
+var commands = map[string]command{
+	"mint":         {"mint add|remove|list [url] | mint [-mint url] [-wait] <quote id>", "manage mints, or mint the proofs of a paid quote", runMint},
+	"balance":      {"balance", "show the balance of every mint", runBalance},
+	"mint-quote":   {"mint-quote [-mint url] [-description text] <amount>", "request an invoice to mint amount", runMintQuote},
+	"pay":          {"pay [-mint url] [-amount-msat n] [-comment text] [-accept-description] <invoice or lightning address>", "pay an invoice, Lightning address or LNURL", runPay},
+	"melt":         {"melt [-mint url] [-amount-msat n] [-comment text] [-accept-description] <invoice or lightning address>", "same as pay", runPay},
+	"send":         {"send [-mint url] [-memo text] [-include-fee] [-allow-transfer] <amount>", "create a token of amount", runSend},
+	"receive":      {"receive [-allow-untrusted] <token>", "redeem a token", runReceive},
+	"restore":      {"restore <mint url>", "restore the proofs of the mnemonic from a mint", runRestore},
+	"history":      {"history [-direction in|out]", "list transactions", runHistory},
+	"proofs":       {"proofs list | proofs check [-reclaim]", "list the unspent proofs, or check their state with the mints reclaiming pending ones with -reclaim", runProofs},
+	"decode-token": {"decode-token <token>", "show the contents of a token", runDecodeToken},
+}
+
+// cli holds the wallet and the global options of a run.
+type cli struct {
+	dbPath   string
+	unit     string
+	json     bool
+	yes      bool
+	db       *cdk.WalletSqliteDatabase
+	mnemonic string
+	multi    *cdk.MultiMintWallet
+	wallets  map[string]*cdk.Wallet
+	stdin    *bufio.Reader
+}
+
+func main() {
+	c := &cli{wallets: map[string]*cdk.Wallet{}, stdin: bufio.NewReader(os.Stdin)}
+	flag.StringVar(&c.dbPath, "db", defaultDbPath(), "path of the wallet database")
+	flag.StringVar(&c.unit, "unit", "sat", "unit of the wallet")
+	flag.BoolVar(&c.json, "json", false, "print JSON instead of text")
+	flag.BoolVar(&c.yes, "yes", false, "do not ask for confirmation")
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 || flag.Arg(0) == "help" {
+		usage()
+		return
+	}
+	cmd, ok := commands[flag.Arg(0)]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "cdkgo: unknown command %q\n", flag.Arg(0))
+		usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	result, err := cmd.run(ctx, c, flag.Args()[1:])
+	stop()
+	c.close()
+	var usageErr usageError
+	if errors.As(err, &usageErr) {
+		fmt.Fprintf(os.Stderr, "cdkgo %s: %s\nusage: cdkgo %s\n", flag.Arg(0), usageErr.reason, cmd.usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		c.fail(err)
+	}
+	c.print(result)
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: cdkgo [-db path] [-unit unit] [-json] [-yes] <command> [arguments]\n\ncommands:\n")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-13s %s\n", name, commands[name].summary)
+	}
+	fmt.Fprintf(os.Stderr, "\nflags:\n")
+	flag.PrintDefaults()
+}
+
+func defaultDbPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "cdkgo.sqlite"
+	}
+	return filepath.Join(dir, "cdkgo", "wallet.sqlite")
+}
+
+// open opens the database and the wallet, on the first command that needs
+// them.
+func (c *cli) open() (*cdk.MultiMintWallet, error) {
+	if c.multi != nil {
+		return c.multi, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(c.dbPath), 0700); err != nil {
+		return nil, err
+	}
+	mnemonic, err := loadMnemonic(filepath.Join(filepath.Dir(c.dbPath), "mnemonic"))
+	if err != nil {
+		return nil, err
+	}
+	db, err := cdk.NewWalletSqliteDatabase(c.dbPath)
+	if err != nil {
+		return nil, fmt.Errorf("open wallet database: %w", err)
+	}
+	multi, err := cdk.NewMultiMintWallet(cdk.ParseCurrencyUnit(c.unit), mnemonic, db)
+	if err != nil {
+		db.Destroy()
+		return nil, fmt.Errorf("create wallet: %w", err)
+	}
+	c.db, c.mnemonic, c.multi = db, mnemonic, multi
+	return multi, nil
+}
+
+// wallet returns the single-mint wallet of mintUrl, for the operations
+// MultiMintWallet does not offer.
+func (c *cli) wallet(mintUrl string) (*cdk.Wallet, error) {
+	multi, err := c.open()
+	if err != nil {
+		return nil, err
+	}
+	if !multi.HasMint(cdk.MintUrl{Url: mintUrl}) {
+		return nil, fmt.Errorf("mint %s is not added, see cdkgo mint add", mintUrl)
+	}
+	if wallet, ok := c.wallets[mintUrl]; ok {
+		return wallet, nil
+	}
+	wallet, err := cdk.NewWallet(mintUrl, multi.Unit(), c.mnemonic, c.db, cdk.WalletConfig{})
+	if err != nil {
+		return nil, err
+	}
+	c.wallets[mintUrl] = wallet
+	return wallet, nil
+}
+
+// mintFor returns the mint given, else the only mint of the wallet.
+func (c *cli) mintFor(given string) (string, error) {
+	if given != "" {
+		return strings.TrimSuffix(given, "/"), nil
+	}
+	multi, err := c.open()
+	if err != nil {
+		return "", err
+	}
+	switch urls := multi.GetMintUrls(); len(urls) {
+	case 0:
+		return "", errors.New("no mint added, see cdkgo mint add")
+	case 1:
+		return urls[0], nil
+	default:
+		return "", errors.New("several mints added, pass -mint")
+	}
+}
+
+// spendingMint returns the mint given, else the mint with the largest
+// balance.
+func (c *cli) spendingMint(ctx context.Context, given string) (string, error) {
+	if given != "" {
+		return strings.TrimSuffix(given, "/"), nil
+	}
+	multi, err := c.open()
+	if err != nil {
+		return "", err
+	}
+	urls := multi.GetMintUrls()
+	if len(urls) == 0 {
+		return "", errors.New("no mint added, see cdkgo mint add")
+	}
+	balances, err := multi.GetBalancesCtx(ctx)
+	if err != nil {
+		return "", err
+	}
+	best := urls[0]
+	for _, url := range urls {
+		if balances[url].Value > balances[best].Value {
+			best = url
+		}
+	}
+	return best, nil
+}
+
+func (c *cli) close() {
+	for _, wallet := range c.wallets {
+		wallet.Destroy()
+	}
+	if c.multi != nil {
+		c.multi.Destroy()
+	}
+	if c.db != nil {
+		c.db.Destroy()
+	}
+}
+
+// confirm asks the user to go ahead, on stderr so the output of -json stays
+// clean. It refuses when there is no terminal to ask on.
+func (c *cli) confirm(question string) (bool, error) {
+	if c.yes {
+		return true, nil
+	}
+	if info, err := os.Stdin.Stat(); err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return false, errors.New("confirmation needed but stdin is not a terminal, pass -yes")
+	}
+	fmt.Fprintf(os.Stderr, "%s [y/N] ", question)
+	answer, err := c.stdin.ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, err
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes", nil
+}
+
+// print writes the result of a command: as JSON with -json, else as its
+// text.
+func (c *cli) print(result any) {
+	if result == nil {
+		return
+	}
+	if c.json {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		encoder.Encode(result)
+		return
+	}
+	if text, ok := result.(fmt.Stringer); ok {
+		fmt.Print(text.String())
+		return
+	}
+	fmt.Println(result)
+}
+
+func (c *cli) fail(err error) {
+	message := err.Error()
+	var ffiErr *cdk.FfiError
+	if errors.As(err, &ffiErr) {
+		message = ffiErr.Message()
+	}
+	if c.json {
+		json.NewEncoder(os.Stdout).Encode(map[string]string{"error": message})
+	} else {
+		fmt.Fprintf(os.Stderr, "cdkgo: %s\n", message)
+	}
+	os.Exit(1)
+}
+
+// loadMnemonic returns CDKGO_MNEMONIC, or the mnemonic stored at path,
+// generating it on first use.
+func loadMnemonic(path string) (string, error) {
+	phrase, generated, err := mnemonic.Load("CDKGO_MNEMONIC", path)
+	if generated {
+		fmt.Fprintf(os.Stderr, "generated a new mnemonic in %s, back it up\n", path)
+	}
+	return phrase, err
+}
+
+// usageError reports a command run with the wrong arguments.
+type usageError struct {
+	reason string
+}
+
+func (err usageError) Error() string {
+	return err.reason
+}
+
+// parse parses the flags of a command and checks the number of its
+// arguments.
+func parse(flags *flag.FlagSet, args []string, min, max int) ([]string, error) {
+	flags.SetOutput(io.Discard)
+	if err := flags.Parse(args); err != nil {
+		return nil, usageError{err.Error()}
+	}
+	if flags.NArg() < min || flags.NArg() > max {
+		return nil, usageError{"wrong number of arguments"}
+	}
+	return flags.Args(), nil
+}
@@ -0,0 +1,325 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	cdk "github.com/lescuer97/cdkgo"
+)
+
+// route is one endpoint of the API. The OpenAPI spec is generated from the
+// route table, so every endpoint is documented by registering it.
+type route struct {
+	method   string
+	path     string
+	tag      string
+	summary  string
+	request  reflect.Type
+	response reflect.Type
+	// Server-sent events; response is the type of the event data
+	stream  bool
+	handler http.HandlerFunc
+}
+
+type api struct {
+	mux    *http.ServeMux
+	routes []route
+	keys   [][]byte
+}
+
+func newApi(keys []string) *api {
+	a := &api{mux: http.NewServeMux()}
+	for _, key := range keys {
+		a.keys = append(a.keys, []byte(key))
+	}
+	a.mux.HandleFunc("GET /openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, a.openapi())
+	})
+	return a
+}
+
+func (a *api) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/openapi.json" && !a.authorized(r) {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="cdkgod"`)
+		writeError(w, &apiError{Status: http.StatusUnauthorized, Code: "unauthorized", Message: "missing or invalid API key"})
+		return
+	}
+	a.mux.ServeHTTP(w, r)
+}
+
+// authorized checks the API key of the request, given as a bearer token, an
+// X-Api-Key header or, for clients like EventSource that cannot set
+// headers, an api_key query parameter.
+func (a *api) authorized(r *http.Request) bool {
+	key := r.Header.Get("X-Api-Key")
+	if bearer, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		key = bearer
+	}
+	if key == "" {
+		key = r.URL.Query().Get("api_key")
+	}
+	if key == "" {
+		return false
+	}
+	for _, allowed := range a.keys {
+		if subtle.ConstantTimeCompare([]byte(key), allowed) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// apiError is the error body of every failed request.
+type apiError struct {
+	Status  int    `json:"-"`
+	Code    string `json:"code" doc:"Machine readable error code"`
+	Message string `json:"message"`
+	// NUT-00 code when the mint refused the request
+	MintCode int `json:"mint_code,omitempty" doc:"NUT-00 error code returned by the mint"`
+}
+
+func (err *apiError) Error() string {
+	return err.Code + ": " + err.Message
+}
+
+func badRequest(format string, args ...any) *apiError {
+	return &apiError{Status: http.StatusBadRequest, Code: "invalid_request", Message: fmt.Sprintf(format, args...)}
+}
+
+func notFound(format string, args ...any) *apiError {
+	return &apiError{Status: http.StatusNotFound, Code: "not_found", Message: fmt.Sprintf(format, args...)}
+}
+
+// toApiError maps wallet errors to HTTP statuses.
+func toApiError(err error) *apiError {
+	var apiErr *apiError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+	message := err.Error()
+	var ffiErr *cdk.FfiError
+	if errors.As(err, &ffiErr) {
+		message = ffiErr.Message()
+	}
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return &apiError{Status: http.StatusGatewayTimeout, Code: "timeout", Message: message}
+	case errors.Is(err, cdk.ErrFfiErrorInsufficientFunds):
+		return &apiError{Status: http.StatusPaymentRequired, Code: "insufficient_funds", Message: message}
+	case errors.Is(err, cdk.ErrFfiErrorPaymentFailed):
+		return &apiError{Status: http.StatusBadGateway, Code: "payment_failed", Message: message}
+	case errors.Is(err, cdk.ErrFfiErrorPaymentPending):
+		return &apiError{Status: http.StatusConflict, Code: "payment_pending", Message: message}
+	case errors.Is(err, cdk.ErrFfiErrorInvalidToken), errors.Is(err, cdk.ErrFfiErrorInvalidUrl),
+		errors.Is(err, cdk.ErrFfiErrorInvalidHex), errors.Is(err, cdk.ErrFfiErrorAmount),
+		errors.Is(err, cdk.ErrFfiErrorUnitNotSupported), errors.Is(err, cdk.ErrFfiErrorInvalidCryptographicKey),
+		errors.Is(err, cdk.ErrFfiErrorKeysetUnknown):
+		return &apiError{Status: http.StatusBadRequest, Code: "invalid_request", Message: message}
+	}
+	var mintErr *cdk.MintErrorResponse
+	if errors.As(err, &mintErr) {
+		return &apiError{Status: http.StatusBadRequest, Code: "mint_error", Message: mintErr.Detail, MintCode: mintErr.Code}
+	}
+	if errors.Is(err, cdk.ErrFfiErrorNetwork) {
+		return &apiError{Status: http.StatusBadGateway, Code: "mint_unreachable", Message: message}
+	}
+	return &apiError{Status: http.StatusInternalServerError, Code: "internal", Message: message}
+}
+
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	apiErr := toApiError(err)
+	writeJSON(w, apiErr.Status, map[string]*apiError{"error": apiErr})
+}
+
+// empty is the request or response of endpoints without one.
+type empty struct{}
+
+// handle registers a JSON endpoint. Fields of Req tagged path or query are
+// read from the URL, the rest from the JSON body.
+func handle[Req, Resp any](a *api, method, path, tag, summary string, fn func(ctx context.Context, req Req) (Resp, error)) {
+	a.add(route{
+		method:   method,
+		path:     path,
+		tag:      tag,
+		summary:  summary,
+		request:  reflect.TypeFor[Req](),
+		response: reflect.TypeFor[Resp](),
+		handler: func(w http.ResponseWriter, r *http.Request) {
+			var req Req
+			if err := bind(r, &req); err != nil {
+				writeError(w, err)
+				return
+			}
+			resp, err := fn(r.Context(), req)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			writeJSON(w, http.StatusOK, resp)
+		},
+	})
+}
+
+// handleStream registers a server-sent events endpoint. fn sends events
+// until it returns; the stream ends with an "error" event if it failed.
+func handleStream[Req, Event any](a *api, path, tag, summary string, fn func(ctx context.Context, req Req, send func(name string, event Event) error) error) {
+	a.add(route{
+		method:   http.MethodGet,
+		path:     path,
+		tag:      tag,
+		summary:  summary,
+		request:  reflect.TypeFor[Req](),
+		response: reflect.TypeFor[Event](),
+		stream:   true,
+		handler: func(w http.ResponseWriter, r *http.Request) {
+			var req Req
+			if err := bind(r, &req); err != nil {
+				writeError(w, err)
+				return
+			}
+			flusher, ok := w.(http.Flusher)
+			if !ok {
+				writeError(w, errors.New("streaming not supported"))
+				return
+			}
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.Header().Set("Cache-Control", "no-cache")
+			w.WriteHeader(http.StatusOK)
+			flusher.Flush()
+
+			send := func(name string, event any) error {
+				data, err := json.Marshal(event)
+				if err != nil {
+					return err
+				}
+				if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, data); err != nil {
+					return err
+				}
+				flusher.Flush()
+				return nil
+			}
+			err := fn(r.Context(), req, func(name string, event Event) error { return send(name, event) })
+			if err != nil && r.Context().Err() == nil {
+				send("error", map[string]*apiError{"error": toApiError(err)})
+			}
+		},
+	})
+}
+
+func (a *api) add(route route) {
+	a.routes = append(a.routes, route)
+	a.mux.HandleFunc(route.method+" "+route.path, route.handler)
+}
+
+func hasBody(method string) bool {
+	return method == http.MethodPost || method == http.MethodPut || method == http.MethodPatch
+}
+
+// bind fills req from the JSON body and the path and query parameters.
+func bind(r *http.Request, req any) error {
+	if hasBody(r.Method) && r.ContentLength != 0 {
+		decoder := json.NewDecoder(http.MaxBytesReader(nil, r.Body, 1<<20))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(req); err != nil {
+			return badRequest("invalid body: %v", err)
+		}
+	}
+
+	value := reflect.ValueOf(req).Elem()
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+	query := r.URL.Query()
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		var raw []string
+		name := field.Tag.Get("path")
+		if name != "" {
+			raw = []string{r.PathValue(name)}
+		} else if name = field.Tag.Get("query"); name != "" {
+			raw = query[name]
+		}
+		if len(raw) == 0 || name == "" {
+			continue
+		}
+		if err := setField(value.Field(i), raw); err != nil {
+			return badRequest("invalid %s: %v", name, err)
+		}
+	}
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if field.Tag.Get("required") == "true" && value.Field(i).IsZero() {
+			return badRequest("%s is required", fieldName(field))
+		}
+	}
+	return nil
+}
+
+func setField(field reflect.Value, raw []string) error {
+	switch field.Kind() {
+	case reflect.Pointer:
+		elem := reflect.New(field.Type().Elem())
+		if err := setField(elem.Elem(), raw); err != nil {
+			return err
+		}
+		field.Set(elem)
+	case reflect.Slice:
+		slice := reflect.MakeSlice(field.Type(), len(raw), len(raw))
+		for i, value := range raw {
+			if err := setField(slice.Index(i), []string{value}); err != nil {
+				return err
+			}
+		}
+		field.Set(slice)
+	case reflect.String:
+		field.SetString(raw[0])
+	case reflect.Bool:
+		value, err := strconv.ParseBool(raw[0])
+		if err != nil {
+			return err
+		}
+		field.SetBool(value)
+	case reflect.Int, reflect.Int64:
+		value, err := strconv.ParseInt(raw[0], 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(value)
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		value, err := strconv.ParseUint(raw[0], 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetUint(value)
+	default:
+		return fmt.Errorf("unsupported parameter type %s", field.Type())
+	}
+	return nil
+}
+
+// fieldName returns the name of a field in JSON or in the URL.
+func fieldName(field reflect.StructField) string {
+	for _, tag := range []string{"path", "query"} {
+		if name := field.Tag.Get(tag); name != "" {
+			return name
+		}
+	}
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
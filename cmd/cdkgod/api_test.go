@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type echoRequest struct {
+	Id     string   `json:"-" path:"id"`
+	Limit  *uint64  `json:"-" query:"limit"`
+	Offset int      `json:"-" query:"offset"`
+	Pretty bool     `json:"-" query:"pretty"`
+	Mints  []string `json:"-" query:"mint"`
+	Memo   string   `json:"memo"`
+	Amount uint64   `json:"amount" required:"true"`
+}
+
+// testApi serves an endpoint storing its bound request in bound.
+func testApi(bound *echoRequest, keys ...string) *api {
+	a := newApi(keys)
+	handle(a, http.MethodPost, "/v1/echo/{id}", "test", "Store the request", func(_ context.Context, req echoRequest) (empty, error) {
+		*bound = req
+		return empty{}, nil
+	})
+	return a
+}
+
+func serve(a *api, method, target, body string, header http.Header) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	for name, values := range header {
+		r.Header[name] = values
+	}
+	w := httptest.NewRecorder()
+	a.ServeHTTP(w, r)
+	return w
+}
+
+func errorCode(t *testing.T, w *httptest.ResponseRecorder) string {
+	t.Helper()
+	var body struct {
+		Error apiError `json:"error"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("error body %q: %v", w.Body, err)
+	}
+	return body.Error.Code
+}
+
+func TestAuthorization(t *testing.T) {
+	a := testApi(new(echoRequest), "k1", "k2")
+	const body = `{"amount": 1}`
+	tests := []struct {
+		name   string
+		target string
+		header http.Header
+		ok     bool
+	}{
+		{"no key", "/v1/echo/a", nil, false},
+		{"wrong bearer", "/v1/echo/a", http.Header{"Authorization": {"Bearer k3"}}, false},
+		{"wrong header", "/v1/echo/a", http.Header{"X-Api-Key": {"k"}}, false},
+		{"wrong query", "/v1/echo/a?api_key=k1x", nil, false},
+		{"basic scheme", "/v1/echo/a", http.Header{"Authorization": {"Basic k1"}}, false},
+		{"empty bearer", "/v1/echo/a", http.Header{"Authorization": {"Bearer "}}, false},
+		// The bearer token takes precedence over the other sources
+		{"wrong bearer with key header", "/v1/echo/a", http.Header{"Authorization": {"Bearer k3"}, "X-Api-Key": {"k1"}}, false},
+		{"bearer", "/v1/echo/a", http.Header{"Authorization": {"Bearer k1"}}, true},
+		{"second key", "/v1/echo/a", http.Header{"Authorization": {"Bearer k2"}}, true},
+		{"key header", "/v1/echo/a", http.Header{"X-Api-Key": {"k2"}}, true},
+		{"query", "/v1/echo/a?api_key=k1", nil, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := serve(a, http.MethodPost, test.target, body, test.header)
+			if !test.ok {
+				if w.Code != http.StatusUnauthorized || errorCode(t, w) != "unauthorized" {
+					t.Fatalf("got %d %s", w.Code, w.Body)
+				}
+				if w.Header().Get("WWW-Authenticate") == "" {
+					t.Fatal("no WWW-Authenticate header")
+				}
+				return
+			}
+			if w.Code != http.StatusOK {
+				t.Fatalf("got %d %s", w.Code, w.Body)
+			}
+		})
+	}
+
+	// Without keys configured every request is refused
+	if w := serve(newApi(nil), http.MethodGet, "/v1/info?api_key=", "", nil); w.Code != http.StatusUnauthorized {
+		t.Fatalf("got %d", w.Code)
+	}
+}
+
+func TestOpenapiIsPublic(t *testing.T) {
+	w := serve(testApi(new(echoRequest), "k1"), http.MethodGet, "/openapi.json", "", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("got %d %s", w.Code, w.Body)
+	}
+	var spec map[string]any
+	if err := json.Unmarshal(w.Body.Bytes(), &spec); err != nil {
+		t.Fatal(err)
+	}
+	if spec["openapi"] != "3.0.3" {
+		t.Fatalf("got %v", spec["openapi"])
+	}
+}
+
+func TestBind(t *testing.T) {
+	var got echoRequest
+	a := testApi(&got, "k")
+	key := http.Header{"X-Api-Key": {"k"}}
+	limit := uint64(5)
+	tests := []struct {
+		name   string
+		target string
+		body   string
+		want   echoRequest
+		code   string
+	}{
+		{
+			name:   "path and body",
+			target: "/v1/echo/q1",
+			body:   `{"memo": "hi", "amount": 3}`,
+			want:   echoRequest{Id: "q1", Memo: "hi", Amount: 3},
+		},
+		{
+			name:   "query parameters",
+			target: "/v1/echo/q%202?limit=5&offset=-2&pretty=true&mint=a&mint=b",
+			body:   `{"amount": 3}`,
+			want:   echoRequest{Id: "q 2", Limit: &limit, Offset: -2, Pretty: true, Mints: []string{"a", "b"}, Amount: 3},
+		},
+		{name: "missing required field", target: "/v1/echo/q1", body: `{"memo": "hi"}`, code: "invalid_request"},
+		{name: "no body", target: "/v1/echo/q1", code: "invalid_request"},
+		{name: "unknown field", target: "/v1/echo/q1", body: `{"amount": 3, "other": 1}`, code: "invalid_request"},
+		{name: "malformed body", target: "/v1/echo/q1", body: `{"amount": `, code: "invalid_request"},
+		{name: "negative uint", target: "/v1/echo/q1?limit=-1", body: `{"amount": 3}`, code: "invalid_request"},
+		{name: "invalid int", target: "/v1/echo/q1?offset=x", body: `{"amount": 3}`, code: "invalid_request"},
+		{name: "invalid bool", target: "/v1/echo/q1?pretty=maybe", body: `{"amount": 3}`, code: "invalid_request"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got = echoRequest{}
+			w := serve(a, http.MethodPost, test.target, test.body, key)
+			if test.code != "" {
+				if w.Code != http.StatusBadRequest || errorCode(t, w) != test.code {
+					t.Fatalf("got %d %s", w.Code, w.Body)
+				}
+				return
+			}
+			if w.Code != http.StatusOK {
+				t.Fatalf("got %d %s", w.Code, w.Body)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Fatalf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSetFieldUnsupported(t *testing.T) {
+	var value struct{ F float64 }
+	if err := setField(reflect.ValueOf(&value).Elem().Field(0), []string{"1.5"}); err == nil {
+		t.Fatal("set a float field")
+	}
+}
+
+func TestOpenapiListsRoutes(t *testing.T) {
+	a := newApi(nil)
+	(&daemon{}).routes(a)
+	if len(a.routes) == 0 {
+		t.Fatal("no routes")
+	}
+	spec := a.openapi()
+	paths := spec["paths"].(map[string]map[string]any)
+	operations := 0
+	ids := map[string]bool{}
+	for _, route := range a.routes {
+		operation, ok := paths[route.path][strings.ToLower(route.method)].(map[string]any)
+		if !ok {
+			t.Errorf("%s %s is not in the spec", route.method, route.path)
+			continue
+		}
+		id := operation["operationId"].(string)
+		if ids[id] {
+			t.Errorf("operationId %s is not unique", id)
+		}
+		ids[id] = true
+		for _, param := range operation["parameters"].([]map[string]any) {
+			if param["in"] == "path" && !strings.Contains(route.path, "{"+param["name"].(string)+"}") {
+				t.Errorf("%s %s: path parameter %s is not in the path", route.method, route.path, param["name"])
+			}
+		}
+	}
+	for _, methods := range paths {
+		operations += len(methods)
+	}
+	if operations != len(a.routes) {
+		t.Fatalf("spec has %d operations, %d routes are registered", operations, len(a.routes))
+	}
+}
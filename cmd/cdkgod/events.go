@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	cdk "github.com/lescuer97/cdkgo"
+)
+
+// Interval of the heartbeat events that keep idle streams open through
+// proxies.
+const heartbeatInterval = 15 * time.Second
+
+type EventsRequest struct {
+	MintUrl string   `json:"-" query:"mint_url" required:"true"`
+	Kind    string   `json:"-" query:"kind" required:"true" doc:"bolt11_mint_quote, bolt12_mint_quote, bolt11_melt_quote or proof_state"`
+	Filter  []string `json:"-" query:"filter" required:"true" doc:"Quote ids, or proof Ys for proof_state"`
+}
+
+// Event is the data of every event of the stream. The event name is the
+// type: mint_quote, melt_quote, proof_state, or heartbeat every 15 seconds.
+type Event struct {
+	Type      string       `json:"type"`
+	MintQuote *MintQuote   `json:"mint_quote,omitempty"`
+	MeltQuote *MeltQuote   `json:"melt_quote,omitempty"`
+	Proofs    []ProofState `json:"proofs,omitempty"`
+}
+
+type ProofState struct {
+	Y       string  `json:"y"`
+	State   string  `json:"state" doc:"UNSPENT, PENDING, SPENT or RESERVED"`
+	Witness *string `json:"witness"`
+}
+
+func proofState(state cdk.ProofState) string {
+	switch state {
+	case cdk.ProofStateUnspent:
+		return "UNSPENT"
+	case cdk.ProofStatePending:
+		return "PENDING"
+	case cdk.ProofStateSpent:
+		return "SPENT"
+	case cdk.ProofStateReserved:
+		return "RESERVED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+func subscriptionKind(kind string) (cdk.SubscriptionKind, bool) {
+	switch kind {
+	case "bolt11_mint_quote":
+		return cdk.SubscriptionKindBolt11MintQuote, true
+	case "bolt12_mint_quote":
+		return cdk.SubscriptionKindBolt12MintQuote, true
+	case "bolt11_melt_quote":
+		return cdk.SubscriptionKindBolt11MeltQuote, true
+	case "proof_state":
+		return cdk.SubscriptionKindProofState, true
+	default:
+		return 0, false
+	}
+}
+
+func notificationEvent(mintUrl string, payload cdk.NotificationPayload) (Event, bool) {
+	switch payload := payload.(type) {
+	case cdk.NotificationPayloadMintQuoteUpdate:
+		quote := MintQuote{
+			Id:      payload.Quote.Quote(),
+			MintUrl: mintUrl,
+			Request: payload.Quote.Request(),
+			State:   quoteState(payload.Quote.State()),
+		}
+		if amount := payload.Quote.Amount(); amount != nil {
+			quote.Amount = &amount.Value
+		}
+		if unit := payload.Quote.Unit(); unit != nil {
+			quote.Unit = cdk.CurrencyUnitString(*unit)
+		}
+		if expiry := payload.Quote.Expiry(); expiry != nil {
+			quote.Expiry = *expiry
+		}
+		return Event{Type: "mint_quote", MintQuote: &quote}, true
+	case cdk.NotificationPayloadMeltQuoteUpdate:
+		quote := MeltQuote{
+			Id:         payload.Quote.Quote(),
+			MintUrl:    mintUrl,
+			Amount:     payload.Quote.Amount().Value,
+			FeeReserve: payload.Quote.FeeReserve().Value,
+			State:      quoteState(payload.Quote.State()),
+			Expiry:     payload.Quote.Expiry(),
+			Preimage:   payload.Quote.PaymentPreimage(),
+		}
+		if request := payload.Quote.Request(); request != nil {
+			quote.Request = *request
+		}
+		if unit := payload.Quote.Unit(); unit != nil {
+			quote.Unit = cdk.CurrencyUnitString(*unit)
+		}
+		return Event{Type: "melt_quote", MeltQuote: &quote}, true
+	case cdk.NotificationPayloadProofState:
+		event := Event{Type: "proof_state"}
+		for _, update := range payload.ProofStates {
+			event.Proofs = append(event.Proofs, ProofState{Y: update.Y, State: proofState(update.State), Witness: update.Witness})
+		}
+		return event, true
+	default:
+		return Event{}, false
+	}
+}
+
+// events streams the NUT-17 notifications of the mint until the client
+// disconnects.
+func (d *daemon) events(ctx context.Context, req EventsRequest, send func(string, Event) error) error {
+	kind, ok := subscriptionKind(req.Kind)
+	if !ok {
+		return badRequest("unknown kind %q", req.Kind)
+	}
+	wallet, err := d.walletFor(req.MintUrl)
+	if err != nil {
+		return err
+	}
+	notifications, errs, err := wallet.SubscribeChan(ctx, cdk.SubscribeParams{Kind: kind, Filters: req.Filter})
+	if err != nil {
+		return err
+	}
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-d.stopping:
+			return nil
+		case <-heartbeat.C:
+			if err := send("heartbeat", Event{Type: "heartbeat"}); err != nil {
+				return nil
+			}
+		case payload, ok := <-notifications:
+			if !ok {
+				select {
+				case err := <-errs:
+					return err
+				default:
+					return nil
+				}
+			}
+			event, ok := notificationEvent(wallet.MintUrl().Url, payload)
+			if !ok {
+				continue
+			}
+			if err := send(event.Type, event); err != nil {
+				return nil
+			}
+		}
+	}
+}
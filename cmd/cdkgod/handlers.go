@@ -0,0 +1,549 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"sync"
+
+	cdk "github.com/lescuer97/cdkgo"
+)
+
+// daemon holds the wallet behind the API.
+type daemon struct {
+	wallet   *cdk.MultiMintWallet
+	db       cdk.WalletDatabase
+	mnemonic string
+
+	mu      sync.Mutex
+	wallets map[string]*cdk.Wallet
+	sends   map[string]*cdk.PreparedSend
+	// Closed when the server shuts down
+	stopping chan struct{}
+	stopOnce sync.Once
+}
+
+func newDaemon(wallet *cdk.MultiMintWallet, db cdk.WalletDatabase, mnemonic string) *daemon {
+	return &daemon{
+		wallet:   wallet,
+		db:       db,
+		mnemonic: mnemonic,
+		wallets:  map[string]*cdk.Wallet{},
+		sends:    map[string]*cdk.PreparedSend{},
+		stopping: make(chan struct{}),
+	}
+}
+
+func (d *daemon) stopStreams() {
+	d.stopOnce.Do(func() { close(d.stopping) })
+}
+
+// walletFor returns a single-mint wallet on the same database, for the
+// operations MultiMintWallet does not offer.
+func (d *daemon) walletFor(mintUrl string) (*cdk.Wallet, error) {
+	mintUrl = strings.TrimSuffix(mintUrl, "/")
+	if !d.wallet.HasMint(cdk.MintUrl{Url: mintUrl}) {
+		return nil, notFound("mint %s is not added", mintUrl)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if wallet, ok := d.wallets[mintUrl]; ok {
+		return wallet, nil
+	}
+	wallet, err := cdk.NewWallet(mintUrl, d.wallet.Unit(), d.mnemonic, d.db, cdk.WalletConfig{})
+	if err != nil {
+		return nil, err
+	}
+	d.wallets[mintUrl] = wallet
+	return wallet, nil
+}
+
+// close cancels the sends that were prepared but not confirmed, releasing
+// their proofs.
+func (d *daemon) close(ctx context.Context) {
+	d.mu.Lock()
+	sends := d.sends
+	d.sends = map[string]*cdk.PreparedSend{}
+	d.mu.Unlock()
+	for _, send := range sends {
+		send.CancelCtx(ctx)
+		send.Destroy()
+	}
+}
+
+func (d *daemon) routes(a *api) {
+	handle(a, http.MethodGet, "/v1/info", "wallet", "Wallet unit and mints", d.info)
+	handle(a, http.MethodGet, "/v1/balance", "wallet", "Balance in total and per mint", d.balance)
+	handle(a, http.MethodGet, "/v1/transactions", "wallet", "Transaction history", d.transactions)
+
+	handle(a, http.MethodGet, "/v1/mints", "mints", "List the mints of the wallet", d.listMints)
+	handle(a, http.MethodPost, "/v1/mints", "mints", "Add a mint", d.addMint)
+	handle(a, http.MethodDelete, "/v1/mints", "mints", "Remove a mint", d.removeMint)
+
+	handle(a, http.MethodPost, "/v1/mint/quote", "mint", "Request a Lightning invoice to mint ecash", d.mintQuote)
+	handle(a, http.MethodGet, "/v1/mint/quote/{id}", "mint", "Check the state of a mint quote", d.checkMintQuote)
+	handle(a, http.MethodPost, "/v1/mint", "mint", "Mint the ecash of a paid quote", d.mint)
+
+	handle(a, http.MethodPost, "/v1/melt/quote", "melt", "Request a quote to pay a Lightning invoice", d.meltQuote)
+	handle(a, http.MethodPost, "/v1/melt", "melt", "Pay a Lightning invoice", d.melt)
+
+	handle(a, http.MethodPost, "/v1/send", "send", "Prepare a token, reserving its proofs", d.prepareSend)
+	handle(a, http.MethodPost, "/v1/send/{id}/confirm", "send", "Confirm a prepared send and return its token", d.confirmSend)
+	handle(a, http.MethodPost, "/v1/send/{id}/cancel", "send", "Cancel a prepared send, releasing its proofs", d.cancelSend)
+	handle(a, http.MethodPost, "/v1/receive", "receive", "Receive a token", d.receive)
+
+	handleStream(a, "/v1/events", "events", "Subscribe to NUT-17 notifications of a mint", d.events)
+}
+
+type InfoResponse struct {
+	Unit  string   `json:"unit"`
+	Mints []string `json:"mints"`
+}
+
+func (d *daemon) info(ctx context.Context, _ empty) (InfoResponse, error) {
+	return InfoResponse{Unit: cdk.CurrencyUnitString(d.wallet.Unit()), Mints: d.wallet.GetMintUrls()}, nil
+}
+
+type BalanceResponse struct {
+	Unit  string            `json:"unit"`
+	Total uint64            `json:"total"`
+	Mints map[string]uint64 `json:"mints" doc:"Balance per mint URL"`
+}
+
+func (d *daemon) balance(ctx context.Context, _ empty) (BalanceResponse, error) {
+	balances, err := d.wallet.GetBalancesCtx(ctx)
+	if err != nil {
+		return BalanceResponse{}, err
+	}
+	response := BalanceResponse{Unit: cdk.CurrencyUnitString(d.wallet.Unit()), Mints: map[string]uint64{}}
+	for mint, amount := range balances {
+		response.Mints[mint] = amount.Value
+		response.Total += amount.Value
+	}
+	return response, nil
+}
+
+type TransactionsRequest struct {
+	Direction string `json:"-" query:"direction" doc:"incoming or outgoing, both if empty"`
+}
+
+type Transaction struct {
+	Id        string            `json:"id"`
+	MintUrl   string            `json:"mint_url"`
+	Direction string            `json:"direction"`
+	Amount    uint64            `json:"amount"`
+	Fee       uint64            `json:"fee"`
+	Unit      string            `json:"unit"`
+	Timestamp uint64            `json:"timestamp" doc:"Unix time"`
+	Memo      *string           `json:"memo"`
+	QuoteId   *string           `json:"quote_id" doc:"Mint or melt quote the transaction belongs to"`
+	Metadata  map[string]string `json:"metadata"`
+}
+
+type TransactionsResponse struct {
+	Transactions []Transaction `json:"transactions"`
+}
+
+func (d *daemon) transactions(ctx context.Context, req TransactionsRequest) (TransactionsResponse, error) {
+	var direction *cdk.TransactionDirection
+	switch req.Direction {
+	case "":
+	case "incoming":
+		incoming := cdk.TransactionDirectionIncoming
+		direction = &incoming
+	case "outgoing":
+		outgoing := cdk.TransactionDirectionOutgoing
+		direction = &outgoing
+	default:
+		return TransactionsResponse{}, badRequest("direction must be incoming or outgoing")
+	}
+	transactions, err := d.wallet.ListTransactionsCtx(ctx, direction)
+	if err != nil {
+		return TransactionsResponse{}, err
+	}
+	response := TransactionsResponse{Transactions: make([]Transaction, 0, len(transactions))}
+	for _, tx := range transactions {
+		direction := "incoming"
+		if tx.Direction == cdk.TransactionDirectionOutgoing {
+			direction = "outgoing"
+		}
+		response.Transactions = append(response.Transactions, Transaction{
+			Id:        tx.Id.Hex,
+			MintUrl:   tx.MintUrl.Url,
+			Direction: direction,
+			Amount:    tx.Amount.Value,
+			Fee:       tx.Fee.Value,
+			Unit:      cdk.CurrencyUnitString(tx.Unit),
+			Timestamp: tx.Timestamp,
+			Memo:      tx.Memo,
+			QuoteId:   tx.QuoteId,
+			Metadata:  tx.Metadata,
+		})
+	}
+	return response, nil
+}
+
+type Mint struct {
+	Url     string `json:"url"`
+	Balance uint64 `json:"balance"`
+}
+
+type MintsResponse struct {
+	Mints []Mint `json:"mints"`
+}
+
+func (d *daemon) listMints(ctx context.Context, _ empty) (MintsResponse, error) {
+	balances, err := d.wallet.GetBalancesCtx(ctx)
+	if err != nil {
+		return MintsResponse{}, err
+	}
+	response := MintsResponse{Mints: []Mint{}}
+	for _, url := range d.wallet.GetMintUrls() {
+		response.Mints = append(response.Mints, Mint{Url: url, Balance: balances[url].Value})
+	}
+	return response, nil
+}
+
+type AddMintRequest struct {
+	MintUrl string `json:"mint_url" required:"true"`
+}
+
+func (d *daemon) addMint(ctx context.Context, req AddMintRequest) (MintsResponse, error) {
+	if err := d.wallet.AddMintCtx(ctx, cdk.MintUrl{Url: req.MintUrl}, nil); err != nil {
+		return MintsResponse{}, err
+	}
+	return d.listMints(ctx, empty{})
+}
+
+type RemoveMintRequest struct {
+	MintUrl string `json:"-" query:"mint_url" required:"true"`
+}
+
+func (d *daemon) removeMint(ctx context.Context, req RemoveMintRequest) (MintsResponse, error) {
+	mintUrl := cdk.MintUrl{Url: strings.TrimSuffix(req.MintUrl, "/")}
+	if !d.wallet.HasMint(mintUrl) {
+		return MintsResponse{}, notFound("mint %s is not added", req.MintUrl)
+	}
+	d.wallet.RemoveMint(mintUrl)
+	d.mu.Lock()
+	if wallet, ok := d.wallets[mintUrl.Url]; ok {
+		wallet.Destroy()
+		delete(d.wallets, mintUrl.Url)
+	}
+	d.mu.Unlock()
+	return d.listMints(ctx, empty{})
+}
+
+func quoteState(state cdk.QuoteState) string {
+	switch state {
+	case cdk.QuoteStateUnpaid:
+		return "UNPAID"
+	case cdk.QuoteStatePaid:
+		return "PAID"
+	case cdk.QuoteStatePending:
+		return "PENDING"
+	case cdk.QuoteStateIssued:
+		return "ISSUED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+type MintQuoteRequest struct {
+	MintUrl     string  `json:"mint_url" required:"true"`
+	Amount      uint64  `json:"amount" required:"true"`
+	Description *string `json:"description,omitempty"`
+}
+
+type MintQuote struct {
+	Id      string  `json:"id"`
+	MintUrl string  `json:"mint_url"`
+	Request string  `json:"request" doc:"Lightning invoice to pay"`
+	Amount  *uint64 `json:"amount"`
+	Unit    string  `json:"unit"`
+	State   string  `json:"state" doc:"UNPAID, PAID or ISSUED"`
+	Expiry  uint64  `json:"expiry" doc:"Unix time"`
+}
+
+func mintQuoteResponse(quote cdk.MintQuote) MintQuote {
+	response := MintQuote{
+		Id:      quote.Id,
+		MintUrl: quote.MintUrl.Url,
+		Request: quote.Request,
+		Unit:    cdk.CurrencyUnitString(quote.Unit),
+		State:   quoteState(quote.State),
+		Expiry:  quote.Expiry,
+	}
+	if quote.Amount != nil {
+		response.Amount = &quote.Amount.Value
+	}
+	return response
+}
+
+func (d *daemon) mintQuote(ctx context.Context, req MintQuoteRequest) (MintQuote, error) {
+	quote, err := d.wallet.MintQuoteCtx(ctx, cdk.MintUrl{Url: req.MintUrl}, cdk.Amount{Value: req.Amount}, req.Description)
+	if err != nil {
+		return MintQuote{}, err
+	}
+	return mintQuoteResponse(quote), nil
+}
+
+type CheckMintQuoteRequest struct {
+	Id      string `json:"-" path:"id"`
+	MintUrl string `json:"-" query:"mint_url" required:"true"`
+}
+
+func (d *daemon) checkMintQuote(ctx context.Context, req CheckMintQuoteRequest) (MintQuote, error) {
+	quote, err := d.wallet.CheckMintQuoteCtx(ctx, cdk.MintUrl{Url: req.MintUrl}, req.Id)
+	if err != nil {
+		return MintQuote{}, err
+	}
+	return mintQuoteResponse(quote), nil
+}
+
+type MintRequest struct {
+	MintUrl string `json:"mint_url" required:"true"`
+	QuoteId string `json:"quote_id" required:"true"`
+}
+
+type MintResponse struct {
+	Amount uint64 `json:"amount" doc:"Amount minted"`
+	Proofs int    `json:"proofs" doc:"Number of proofs minted"`
+}
+
+func (d *daemon) mint(ctx context.Context, req MintRequest) (MintResponse, error) {
+	proofs, err := d.wallet.MintCtx(ctx, cdk.MintUrl{Url: req.MintUrl}, req.QuoteId, nil)
+	if err != nil {
+		return MintResponse{}, err
+	}
+	response := MintResponse{Proofs: len(proofs)}
+	for _, proof := range proofs {
+		response.Amount += proof.Amount().Value
+		proof.Destroy()
+	}
+	return response, nil
+}
+
+type MeltQuoteRequest struct {
+	MintUrl string `json:"mint_url" required:"true"`
+	Request string `json:"request" required:"true" doc:"bolt11 invoice"`
+	// Amount to pay, for invoices without an amount
+	AmountMsat *uint64 `json:"amount_msat,omitempty" doc:"Amount to pay in millisatoshis, for invoices without an amount"`
+}
+
+type MeltQuote struct {
+	Id         string  `json:"id"`
+	MintUrl    string  `json:"mint_url"`
+	Request    string  `json:"request"`
+	Amount     uint64  `json:"amount"`
+	FeeReserve uint64  `json:"fee_reserve" doc:"Most the payment may cost in fees, returned as change if unused"`
+	Unit       string  `json:"unit"`
+	State      string  `json:"state" doc:"UNPAID, PENDING or PAID"`
+	Expiry     uint64  `json:"expiry" doc:"Unix time"`
+	Preimage   *string `json:"preimage"`
+}
+
+func (d *daemon) meltQuote(ctx context.Context, req MeltQuoteRequest) (MeltQuote, error) {
+	var options *cdk.MeltOptions
+	if req.AmountMsat != nil {
+		var amountless cdk.MeltOptions = cdk.MeltOptionsAmountless{AmountMsat: cdk.Amount{Value: *req.AmountMsat}}
+		options = &amountless
+	}
+	mintUrl := cdk.MintUrl{Url: req.MintUrl}
+	quote, err := d.wallet.MeltQuoteCtx(ctx, mintUrl, req.Request, options)
+	if err != nil {
+		return MeltQuote{}, err
+	}
+	return MeltQuote{
+		Id:         quote.Id,
+		MintUrl:    mintUrl.Url,
+		Request:    quote.Request,
+		Amount:     quote.Amount.Value,
+		FeeReserve: quote.FeeReserve.Value,
+		Unit:       cdk.CurrencyUnitString(quote.Unit),
+		State:      quoteState(quote.State),
+		Expiry:     quote.Expiry,
+		Preimage:   quote.PaymentPreimage,
+	}, nil
+}
+
+type MeltRequest struct {
+	MintUrl string  `json:"mint_url,omitempty" doc:"Mint of the quote, required with quote_id"`
+	QuoteId string  `json:"quote_id,omitempty" doc:"Melt quote to pay"`
+	Request string  `json:"request,omitempty" doc:"bolt11 invoice to pay from any mint instead of a quote"`
+	MaxFee  *uint64 `json:"max_fee,omitempty" doc:"Most to pay in fees when paying request"`
+}
+
+type MeltResponse struct {
+	State        string  `json:"state" doc:"PAID or PENDING"`
+	Preimage     *string `json:"preimage"`
+	Amount       uint64  `json:"amount"`
+	FeePaid      uint64  `json:"fee_paid"`
+	ChangeAmount uint64  `json:"change_amount" doc:"Unused fee reserve returned to the wallet"`
+}
+
+func (d *daemon) melt(ctx context.Context, req MeltRequest) (MeltResponse, error) {
+	var melted cdk.Melted
+	var err error
+	switch {
+	case req.QuoteId != "":
+		if req.MintUrl == "" {
+			return MeltResponse{}, badRequest("mint_url is required with quote_id")
+		}
+		wallet, walletErr := d.walletFor(req.MintUrl)
+		if walletErr != nil {
+			return MeltResponse{}, walletErr
+		}
+		melted, err = wallet.MeltCtx(ctx, req.QuoteId)
+	case req.Request != "":
+		var maxFee *cdk.Amount
+		if req.MaxFee != nil {
+			maxFee = &cdk.Amount{Value: *req.MaxFee}
+		}
+		melted, err = d.wallet.MeltCtx(ctx, req.Request, nil, maxFee)
+	default:
+		return MeltResponse{}, badRequest("quote_id or request is required")
+	}
+	if err != nil {
+		return MeltResponse{}, err
+	}
+	response := MeltResponse{
+		State:    quoteState(melted.State),
+		Preimage: melted.Preimage,
+		Amount:   melted.Amount.Value,
+		FeePaid:  melted.FeePaid.Value,
+	}
+	if melted.Change != nil {
+		for _, proof := range *melted.Change {
+			response.ChangeAmount += proof.Amount().Value
+			proof.Destroy()
+		}
+	}
+	return response, nil
+}
+
+type SendRequest struct {
+	MintUrl       string  `json:"mint_url" required:"true"`
+	Amount        uint64  `json:"amount" required:"true"`
+	Memo          *string `json:"memo,omitempty" doc:"Memo included in the token"`
+	IncludeFee    bool    `json:"include_fee,omitempty" doc:"Add the receiver's swap fee to the token"`
+	AllowTransfer bool    `json:"allow_transfer,omitempty" doc:"Move funds from other mints if the mint has too little"`
+}
+
+type PreparedSend struct {
+	Id     string `json:"id" doc:"Id to confirm or cancel the send with"`
+	Amount uint64 `json:"amount"`
+	Fee    uint64 `json:"fee" doc:"Fee of the proofs being sent"`
+}
+
+func (d *daemon) prepareSend(ctx context.Context, req SendRequest) (PreparedSend, error) {
+	options := cdk.MultiMintSendOptions{
+		AllowTransfer: req.AllowTransfer,
+		SendOptions: cdk.SendOptions{
+			AmountSplitTarget: cdk.SplitTargetNone{},
+			SendKind:          cdk.SendKindOnlineExact{},
+			IncludeFee:        req.IncludeFee,
+		},
+	}
+	if req.Memo != nil {
+		options.SendOptions.Memo = &cdk.SendMemo{Memo: *req.Memo, IncludeMemo: true}
+	}
+	prepared, err := d.wallet.PrepareSendCtx(ctx, cdk.MintUrl{Url: req.MintUrl}, cdk.Amount{Value: req.Amount}, options)
+	if err != nil {
+		return PreparedSend{}, err
+	}
+	var id [16]byte
+	rand.Read(id[:])
+	response := PreparedSend{Id: hex.EncodeToString(id[:]), Amount: prepared.Amount().Value, Fee: prepared.Fee().Value}
+	d.mu.Lock()
+	d.sends[response.Id] = prepared
+	d.mu.Unlock()
+	return response, nil
+}
+
+// takeSend removes a prepared send while it is confirmed or cancelled, so
+// concurrent requests for it get not found.
+func (d *daemon) takeSend(id string) (*cdk.PreparedSend, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	prepared, ok := d.sends[id]
+	if !ok {
+		return nil, notFound("no prepared send %s", id)
+	}
+	delete(d.sends, id)
+	return prepared, nil
+}
+
+type ConfirmSendRequest struct {
+	Id   string  `json:"-" path:"id"`
+	Memo *string `json:"memo,omitempty" doc:"Memo recorded with the transaction"`
+}
+
+type TokenResponse struct {
+	Token  string `json:"token"`
+	Amount uint64 `json:"amount"`
+}
+
+// returnSend puts back a send whose confirmation failed, so it can be
+// confirmed again or cancelled.
+func (d *daemon) returnSend(id string, prepared *cdk.PreparedSend) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.sends[id] = prepared
+}
+
+func (d *daemon) confirmSend(ctx context.Context, req ConfirmSendRequest) (TokenResponse, error) {
+	prepared, err := d.takeSend(req.Id)
+	if err != nil {
+		return TokenResponse{}, err
+	}
+	amount := prepared.Amount()
+	token, err := prepared.ConfirmCtx(ctx, req.Memo)
+	if err != nil {
+		d.returnSend(req.Id, prepared)
+		return TokenResponse{}, err
+	}
+	defer prepared.Destroy()
+	defer token.Destroy()
+	return TokenResponse{Token: token.Encode(), Amount: amount.Value}, nil
+}
+
+type CancelSendRequest struct {
+	Id string `json:"-" path:"id"`
+}
+
+func (d *daemon) cancelSend(ctx context.Context, req CancelSendRequest) (empty, error) {
+	prepared, err := d.takeSend(req.Id)
+	if err != nil {
+		return empty{}, err
+	}
+	defer prepared.Destroy()
+	return empty{}, prepared.CancelCtx(ctx)
+}
+
+type ReceiveRequest struct {
+	Token          string `json:"token" required:"true"`
+	AllowUntrusted bool   `json:"allow_untrusted,omitempty" doc:"Receive tokens of mints not added to the wallet, adding them"`
+}
+
+type ReceiveResponse struct {
+	Amount uint64 `json:"amount" doc:"Amount received after fees"`
+}
+
+func (d *daemon) receive(ctx context.Context, req ReceiveRequest) (ReceiveResponse, error) {
+	token, err := cdk.TokenDecode(strings.TrimSpace(req.Token))
+	if err != nil {
+		return ReceiveResponse{}, err
+	}
+	defer token.Destroy()
+	amount, err := d.wallet.ReceiveCtx(ctx, token, cdk.MultiMintReceiveOptions{
+		AllowUntrusted: req.AllowUntrusted,
+		ReceiveOptions: cdk.ReceiveOptions{AmountSplitTarget: cdk.SplitTargetNone{}},
+	})
+	if err != nil {
+		return ReceiveResponse{}, err
+	}
+	return ReceiveResponse{Amount: amount.Value}, nil
+}
@@ -0,0 +1,160 @@
+// Command cdkgod serves a Cashu wallet over a JSON HTTP API.
+//
+// The wallet is a MultiMintWallet stored in a database under -data-dir. Its
+// mnemonic is read from CDKGOD_MNEMONIC or <data-dir>/mnemonic, which is
+// generated on first start. Every request except GET /openapi.json needs
+// one of the API keys given with -api-keys or CDKGOD_API_KEYS.
+//
+//	cdkgod -api-keys secret -mint https://mint.example.com
+//	curl -H 'Authorization: Bearer secret' localhost:8085/v1/balance
+//
+// GET /openapi.json describes every endpoint; -openapi prints it and exits.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	cdk "github.com/lescuer97/cdkgo"
+	"github.com/lescuer97/cdkgo/boltdb"
+	"github.com/lescuer97/cdkgo/internal/mnemonic"
+)
+
+type mintFlags []string
+
+func (m *mintFlags) String() string     { return strings.Join(*m, ",") }
+func (m *mintFlags) Set(v string) error { *m = append(*m, v); return nil }
+
+func main() {
+	listen := flag.String("listen", "127.0.0.1:8085", "address to serve the API on")
+	dataDir := flag.String("data-dir", "cdkgod-data", "directory of the wallet database and mnemonic")
+	dbType := flag.String("db", "sqlite", "wallet database: sqlite or bolt")
+	unit := flag.String("unit", "sat", "unit of the wallet")
+	apiKeys := flag.String("api-keys", os.Getenv("CDKGOD_API_KEYS"), "comma separated API keys")
+	printSpec := flag.Bool("openapi", false, "print the OpenAPI spec and exit")
+	var mints mintFlags
+	flag.Var(&mints, "mint", "mint to add on start, repeatable")
+	flag.Parse()
+
+	if *printSpec {
+		a := newApi(nil)
+		(&daemon{}).routes(a)
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(a.openapi()); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	var keys []string
+	for _, key := range strings.Split(*apiKeys, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		log.Fatal("no API keys: set -api-keys or CDKGOD_API_KEYS")
+	}
+
+	if err := run(*listen, *dataDir, *dbType, *unit, keys, mints); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(listen, dataDir, dbType, unit string, keys, mints []string) error {
+	if err := os.MkdirAll(dataDir, 0700); err != nil {
+		return err
+	}
+	mnemonic, err := loadMnemonic(filepath.Join(dataDir, "mnemonic"))
+	if err != nil {
+		return err
+	}
+
+	var db cdk.WalletDatabase
+	switch dbType {
+	case "sqlite":
+		sqlite, err := cdk.NewWalletSqliteDatabase(filepath.Join(dataDir, "wallet.sqlite"))
+		if err != nil {
+			return fmt.Errorf("open wallet database: %w", err)
+		}
+		defer sqlite.Destroy()
+		db = sqlite
+	case "bolt":
+		bolt, err := boltdb.Open(filepath.Join(dataDir, "wallet.bolt"))
+		if err != nil {
+			return err
+		}
+		defer bolt.Close()
+		db = bolt
+	default:
+		return fmt.Errorf("unknown database %q", dbType)
+	}
+
+	wallet, err := cdk.NewMultiMintWallet(cdk.ParseCurrencyUnit(unit), mnemonic, db)
+	if err != nil {
+		return fmt.Errorf("create wallet: %w", err)
+	}
+	defer wallet.Destroy()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	for _, mint := range mints {
+		if err := wallet.AddMintCtx(ctx, cdk.MintUrl{Url: mint}, nil); err != nil {
+			return fmt.Errorf("add mint %s: %w", mint, err)
+		}
+	}
+
+	d := newDaemon(wallet, db, mnemonic)
+	a := newApi(keys)
+	d.routes(a)
+	server := &http.Server{
+		Addr:              listen,
+		Handler:           a,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	// Event streams never finish on their own; end them so Shutdown only
+	// waits for the other requests.
+	server.RegisterOnShutdown(d.stopStreams)
+
+	errs := make(chan error, 1)
+	go func() {
+		log.Printf("cdkgod serving %s wallet on %s", unit, listen)
+		errs <- server.ListenAndServe()
+	}()
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdown, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	err = server.Shutdown(shutdown)
+	d.close(shutdown)
+	if errors.Is(err, http.ErrServerClosed) {
+		err = nil
+	}
+	return err
+}
+
+// loadMnemonic returns CDKGOD_MNEMONIC, or the mnemonic stored at path,
+// generating it on first use.
+func loadMnemonic(path string) (string, error) {
+	phrase, generated, err := mnemonic.Load("CDKGOD_MNEMONIC", path)
+	if generated {
+		log.Printf("generated a new mnemonic in %s, back it up", path)
+	}
+	return phrase, err
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+)
+
+// openapi builds the OpenAPI 3 document of the registered routes from their
+// request and response types. Struct fields are described by their doc tag.
+func (a *api) openapi() map[string]any {
+	schemas := map[string]any{}
+	paths := map[string]map[string]any{}
+	for _, route := range a.routes {
+		operation := map[string]any{
+			"summary":     route.summary,
+			"tags":        []string{route.tag},
+			"operationId": operationId(route),
+			"parameters":  parameters(route.request, schemas),
+		}
+		if body := bodySchema(route.request, schemas); body != nil && hasBody(route.method) {
+			operation["requestBody"] = map[string]any{
+				"required": true,
+				"content":  map[string]any{"application/json": map[string]any{"schema": body}},
+			}
+		}
+		contentType, description := "application/json", "Success"
+		if route.stream {
+			contentType, description = "text/event-stream", "Stream of server-sent events, the data of each being JSON"
+		}
+		operation["responses"] = map[string]any{
+			"200": map[string]any{
+				"description": description,
+				"content":     map[string]any{contentType: map[string]any{"schema": schemaOf(route.response, schemas)}},
+			},
+			"default": map[string]any{
+				"description": "Error",
+				"content": map[string]any{"application/json": map[string]any{"schema": map[string]any{
+					"type":       "object",
+					"properties": map[string]any{"error": schemaOf(reflect.TypeFor[apiError](), schemas)},
+				}}},
+			},
+		}
+		if paths[route.path] == nil {
+			paths[route.path] = map[string]any{}
+		}
+		paths[route.path][strings.ToLower(route.method)] = operation
+	}
+
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":       "cdkgod",
+			"description": "JSON API of a Cashu wallet. Amounts are in the unit of the wallet.",
+			"version":     "1.0.0",
+		},
+		"paths": paths,
+		"components": map[string]any{
+			"schemas": schemas,
+			"securitySchemes": map[string]any{
+				"bearer":      map[string]any{"type": "http", "scheme": "bearer"},
+				"apiKey":      map[string]any{"type": "apiKey", "in": "header", "name": "X-Api-Key"},
+				"apiKeyQuery": map[string]any{"type": "apiKey", "in": "query", "name": "api_key"},
+			},
+		},
+		"security": []map[string][]string{{"bearer": {}}, {"apiKey": {}}, {"apiKeyQuery": {}}},
+	}
+}
+
+func operationId(route route) string {
+	var id strings.Builder
+	id.WriteString(strings.ToLower(route.method))
+	for _, part := range strings.FieldsFunc(route.path, func(r rune) bool { return r == '/' || r == '{' || r == '}' || r == '_' }) {
+		if part == "v1" {
+			continue
+		}
+		id.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return id.String()
+}
+
+func parameters(request reflect.Type, schemas map[string]any) []map[string]any {
+	params := []map[string]any{}
+	if request.Kind() != reflect.Struct {
+		return params
+	}
+	for i := 0; i < request.NumField(); i++ {
+		field := request.Field(i)
+		in, name := "path", field.Tag.Get("path")
+		if name == "" {
+			in, name = "query", field.Tag.Get("query")
+		}
+		if name == "" {
+			continue
+		}
+		param := map[string]any{
+			"name":     name,
+			"in":       in,
+			"required": in == "path" || field.Tag.Get("required") == "true",
+			"schema":   schemaOf(field.Type, schemas),
+		}
+		if doc := field.Tag.Get("doc"); doc != "" {
+			param["description"] = doc
+		}
+		if field.Type.Kind() == reflect.Slice {
+			param["explode"] = true
+		}
+		params = append(params, param)
+	}
+	return params
+}
+
+// bodySchema returns the schema of the JSON fields of request, nil if it
+// has none.
+func bodySchema(request reflect.Type, schemas map[string]any) any {
+	if request.Kind() != reflect.Struct {
+		return nil
+	}
+	for i := 0; i < request.NumField(); i++ {
+		if request.Field(i).Tag.Get("json") != "-" {
+			return schemaOf(request, schemas)
+		}
+	}
+	return nil
+}
+
+// schemaOf returns the schema of t, adding named structs to schemas and
+// referring to them.
+func schemaOf(t reflect.Type, schemas map[string]any) map[string]any {
+	switch t.Kind() {
+	case reflect.Pointer:
+		schema := schemaOf(t.Elem(), schemas)
+		if _, ref := schema["$ref"]; ref {
+			return map[string]any{"allOf": []any{schema}, "nullable": true}
+		}
+		schema["nullable"] = true
+		return schema
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return map[string]any{"type": "integer", "format": "int64"}
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer", "format": "int64", "minimum": 0}
+	case reflect.Slice:
+		return map[string]any{"type": "array", "items": schemaOf(t.Elem(), schemas)}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": schemaOf(t.Elem(), schemas)}
+	case reflect.Interface:
+		return map[string]any{}
+	case reflect.Struct:
+		name := t.Name()
+		if name == "" {
+			return structSchema(t, schemas)
+		}
+		if _, ok := schemas[name]; !ok {
+			// Placeholder against recursive types
+			schemas[name] = map[string]any{}
+			schemas[name] = structSchema(t, schemas)
+		}
+		return map[string]any{"$ref": "#/components/schemas/" + name}
+	default:
+		return map[string]any{}
+	}
+}
+
+func structSchema(t reflect.Type, schemas map[string]any) map[string]any {
+	properties := map[string]any{}
+	var required []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if !field.IsExported() || tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = field.Name
+		}
+		schema := schemaOf(field.Type, schemas)
+		if doc := field.Tag.Get("doc"); doc != "" {
+			if _, ref := schema["$ref"]; ref {
+				schema = map[string]any{"allOf": []any{schema}}
+			}
+			schema["description"] = doc
+		}
+		properties[name] = schema
+		if field.Tag.Get("required") == "true" || (!strings.Contains(options, "omitempty") && field.Type.Kind() != reflect.Pointer) {
+			required = append(required, name)
+		}
+	}
+	schema := map[string]any{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
@@ -0,0 +1,222 @@
+package cdk_ffi
+
+import (
+	"context"
+	"fmt"
+	"math/bits"
+	"sort"
+	"strings"
+)
+
+// ConsolidationPolicy decides when and how far ConsolidateWithPolicy merges
+// proofs.
+type ConsolidationPolicy struct {
+	// Consolidate a denomination once the wallet holds more than this many
+	// proofs of it, 4 by default
+	MaxProofsPerDenomination int
+	// Consolidate the smallest proofs once the wallet holds more than this
+	// many proofs in total, 0 to disable
+	MaxProofs int
+	// Most input fee a consolidation may spend, no limit if zero. Proofs are
+	// left out, most expensive keysets first, until the fee fits.
+	MaxFee Amount
+	// Swap proofs of inactive keysets to the active keyset, regardless of
+	// the thresholds
+	MigrateInactive bool
+	// Split of the consolidated outputs, SplitTargetNone if nil
+	SplitTarget SplitTarget
+}
+
+// ConsolidationPlan lists the proofs a consolidation would swap.
+type ConsolidationPlan struct {
+	Proofs []*Proof
+	// Total of Proofs
+	Amount Amount
+	// Input fee of swapping Proofs
+	Fee Amount
+	// Number of proofs the swap would produce
+	OutputCount int
+	// Why proofs were picked, e.g. "inactive keyset 00ab..."
+	Reasons []string
+}
+
+// ConsolidationResult reports a consolidation.
+type ConsolidationResult struct {
+	Plan ConsolidationPlan
+	// Unspent proofs before and after
+	ProofsBefore int
+	ProofsAfter  int
+}
+
+// PlanConsolidation returns the proofs policy would consolidate, an empty
+// plan if nothing needs consolidating. Nothing is reserved.
+func (_self *Wallet) PlanConsolidation(ctx context.Context, policy ConsolidationPolicy) (ConsolidationPlan, error) {
+	candidates, _, err := _self.proofCandidates(ctx)
+	if err != nil {
+		return ConsolidationPlan{}, err
+	}
+	return planConsolidation(candidates, policy), nil
+}
+
+// ConsolidateWithPolicy swaps the proofs picked by PlanConsolidation into
+// fewer, larger proofs of the active keyset.
+func (_self *Wallet) ConsolidateWithPolicy(ctx context.Context, policy ConsolidationPolicy) (ConsolidationResult, error) {
+	candidates, _, err := _self.proofCandidates(ctx)
+	if err != nil {
+		return ConsolidationResult{}, err
+	}
+	result := ConsolidationResult{Plan: planConsolidation(candidates, policy), ProofsBefore: len(candidates)}
+	result.ProofsAfter = result.ProofsBefore
+	if len(result.Plan.Proofs) == 0 {
+		return result, nil
+	}
+
+	splitTarget := policy.SplitTarget
+	if splitTarget == nil {
+		splitTarget = SplitTargetNone{}
+	}
+	if _, err := _self.SwapCtx(ctx, nil, splitTarget, result.Plan.Proofs, nil, false); err != nil {
+		return result, err
+	}
+	after, err := _self.GetProofsByStatesCtx(ctx, []ProofState{ProofStateUnspent})
+	if err != nil {
+		return result, err
+	}
+	result.ProofsAfter = len(after)
+	return result, nil
+}
+
+func planConsolidation(candidates []ProofCandidate, policy ConsolidationPolicy) ConsolidationPlan {
+	if policy.MaxProofsPerDenomination <= 0 {
+		policy.MaxProofsPerDenomination = 4
+	}
+
+	var plan ConsolidationPlan
+	picked := make([]bool, len(candidates))
+	priority := make([]int, len(candidates))
+	pick := func(i, rank int) {
+		picked[i] = true
+		priority[i] = max(priority[i], rank)
+	}
+
+	if policy.MigrateInactive {
+		inactive := map[string]bool{}
+		for i, candidate := range candidates {
+			if !candidate.Keyset.Active {
+				pick(i, 2)
+				inactive[candidate.Keyset.Id] = true
+			}
+		}
+		for id := range inactive {
+			plan.Reasons = append(plan.Reasons, "inactive keyset "+id)
+		}
+	}
+
+	byDenomination := map[uint64][]int{}
+	for i, candidate := range candidates {
+		byDenomination[candidate.Amount] = append(byDenomination[candidate.Amount], i)
+	}
+	for amount, indexes := range byDenomination {
+		if len(indexes) > policy.MaxProofsPerDenomination {
+			for _, i := range indexes {
+				pick(i, 1)
+			}
+			plan.Reasons = append(plan.Reasons, fmt.Sprintf("%d proofs of %d", len(indexes), amount))
+		}
+	}
+
+	if policy.MaxProofs > 0 && len(candidates) > policy.MaxProofs {
+		order := make([]int, len(candidates))
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(a, b int) bool { return candidates[order[a]].Amount < candidates[order[b]].Amount })
+		var total uint64
+		remaining := len(candidates)
+		for _, i := range order {
+			pick(i, 1)
+			total += candidates[i].Amount
+			remaining--
+			if remaining+bits.OnesCount64(total) <= policy.MaxProofs {
+				break
+			}
+		}
+		plan.Reasons = append(plan.Reasons, fmt.Sprintf("%d proofs in total", len(candidates)))
+	}
+
+	// Keep the most important proofs, cheapest first, within the fee budget.
+	var order []int
+	for i := range candidates {
+		if picked[i] {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		ca, cb := candidates[order[a]], candidates[order[b]]
+		if priority[order[a]] != priority[order[b]] {
+			return priority[order[a]] > priority[order[b]]
+		}
+		return ca.Keyset.InputFeePpk < cb.Keyset.InputFeePpk
+	})
+	var selected []ProofCandidate
+	migrating := false
+	for _, i := range order {
+		next := append(selected, candidates[i])
+		if policy.MaxFee.Value > 0 && inputFee(next) > policy.MaxFee.Value {
+			break
+		}
+		selected = next
+		migrating = migrating || priority[i] == 2
+	}
+
+	var total uint64
+	for _, candidate := range selected {
+		total += candidate.Amount
+	}
+	fee := inputFee(selected)
+	if total <= fee {
+		return ConsolidationPlan{}
+	}
+	outputs := bits.OnesCount64(total - fee)
+	if !migrating && len(selected) <= outputs {
+		// Swapping would not reduce the number of proofs.
+		return ConsolidationPlan{}
+	}
+
+	for _, candidate := range selected {
+		plan.Proofs = append(plan.Proofs, candidate.Proof)
+	}
+	plan.Amount.Value = total
+	plan.Fee.Value = fee
+	plan.OutputCount = outputs
+	sort.Strings(plan.Reasons)
+	return plan
+}
+
+// ConsolidateWithPolicy applies policy to every mint of the wallet that has
+// an entry in wallets, keyed by mint URL. MultiMintWallet cannot swap chosen
+// proofs itself, so the per-mint wallets must be created with NewWallet from
+// the same mnemonic and database as the MultiMintWallet. Mints without an
+// entry are skipped.
+func (_self *MultiMintWallet) ConsolidateWithPolicy(ctx context.Context, policy ConsolidationPolicy, wallets map[string]*Wallet) (map[string]ConsolidationResult, error) {
+	mintUrls, err := _self.GetMintUrlsCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results := map[string]ConsolidationResult{}
+	for _, mintUrl := range mintUrls {
+		wallet, ok := wallets[mintUrl]
+		if !ok {
+			wallet, ok = wallets[strings.TrimSuffix(mintUrl, "/")]
+		}
+		if !ok {
+			continue
+		}
+		result, err := wallet.ConsolidateWithPolicy(ctx, policy)
+		if err != nil {
+			return results, fmt.Errorf("consolidate %s: %w", mintUrl, err)
+		}
+		results[mintUrl] = result
+	}
+	return results, nil
+}
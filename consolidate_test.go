@@ -0,0 +1,96 @@
+package cdk_ffi
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPlanConsolidation(t *testing.T) {
+	active := KeySetInfo{Id: "00active", Active: true}
+	expensive := KeySetInfo{Id: "00fees", Active: true, InputFeePpk: 400}
+	inactive := KeySetInfo{Id: "00old"}
+	proofs := func(keyset KeySetInfo, amounts ...uint64) []ProofCandidate {
+		var candidates []ProofCandidate
+		for _, amount := range amounts {
+			candidates = append(candidates, ProofCandidate{Amount: amount, Keyset: keyset})
+		}
+		return candidates
+	}
+
+	tests := []struct {
+		name       string
+		candidates []ProofCandidate
+		policy     ConsolidationPolicy
+		// Zero for an empty plan
+		proofs  int
+		amount  uint64
+		fee     uint64
+		outputs int
+		reasons []string
+	}{
+		{
+			name:       "below the thresholds",
+			candidates: proofs(active, 1, 1, 2, 4, 8),
+		},
+		{
+			name:       "denomination over the default threshold",
+			candidates: proofs(active, 1, 1, 1, 1, 1, 8),
+			proofs:     5, amount: 5, outputs: 2,
+			reasons: []string{"5 proofs of 1"},
+		},
+		{
+			name:       "denomination threshold set",
+			candidates: proofs(active, 2, 2, 2, 8),
+			policy:     ConsolidationPolicy{MaxProofsPerDenomination: 2},
+			proofs:     3, amount: 6, outputs: 2,
+			reasons: []string{"3 proofs of 2"},
+		},
+		{
+			name:       "total over MaxProofs, smallest first",
+			candidates: proofs(active, 8, 1, 2, 4, 1, 2),
+			policy:     ConsolidationPolicy{MaxProofs: 3},
+			proofs:     5, amount: 10, outputs: 2,
+			reasons: []string{"6 proofs in total"},
+		},
+		{
+			name:       "merging does not reduce the count",
+			candidates: proofs(active, 1, 2, 4),
+			policy:     ConsolidationPolicy{MaxProofs: 2},
+		},
+		{
+			name:       "inactive keyset migrated without a threshold",
+			candidates: append(proofs(inactive, 4), proofs(active, 1)...),
+			policy:     ConsolidationPolicy{MigrateInactive: true},
+			proofs:     1, amount: 4, outputs: 1,
+			reasons: []string{"inactive keyset 00old"},
+		},
+		{
+			name:       "no fee limit by default",
+			candidates: proofs(expensive, 1, 1, 1, 1, 1),
+			proofs:     5, amount: 5, fee: 2, outputs: 2,
+			reasons: []string{"5 proofs of 1"},
+		},
+		{
+			name:       "fee limit leaves proofs out",
+			candidates: proofs(expensive, 1, 1, 1, 1, 1),
+			policy:     ConsolidationPolicy{MaxFee: Amount{Value: 1}},
+			proofs:     2, amount: 2, fee: 1, outputs: 1,
+			reasons: []string{"5 proofs of 1"},
+		},
+		{
+			name:       "fee eats the amount",
+			candidates: proofs(KeySetInfo{Id: "00costly", Active: true, InputFeePpk: 1000}, 1, 1, 1, 1, 1),
+		},
+	}
+	for _, test := range tests {
+		plan := planConsolidation(test.candidates, test.policy)
+		if len(plan.Proofs) != test.proofs || plan.Amount.Value != test.amount || plan.Fee.Value != test.fee || plan.OutputCount != test.outputs {
+			t.Errorf("%s: planned %d proofs of %d with fee %d into %d, want %d of %d with fee %d into %d", test.name,
+				len(plan.Proofs), plan.Amount.Value, plan.Fee.Value, plan.OutputCount, test.proofs, test.amount, test.fee, test.outputs)
+			continue
+		}
+		if test.reasons != nil && !slices.Equal(plan.Reasons, test.reasons) {
+			t.Errorf("%s: reasons %q", test.name, plan.Reasons)
+		}
+	}
+}
@@ -0,0 +1,580 @@
+package cdk_ffi
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Prefix of every value written by EncryptedWalletDatabase. Values without
+// it are treated as plaintext, which lets the wrapper be put in front of an
+// existing database and migrated with ReencryptAll.
+const encryptedValuePrefix = "cdkenc1:"
+
+// Prefix of the ids of the companion mint quotes that hold encrypted mint
+// quote secret keys. The SQL backends parse MintQuote.SecretKey as a hex
+// secret key, so the ciphertext goes in the Request of a separate record
+// that the wrapper hides from its callers.
+const encryptedSecretKeyQuotePrefix = "cdkenc1-secret-key:"
+
+// EncryptionKey is an AEAD key together with the id stored next to every
+// value it encrypts, so the right key can be picked after a rotation.
+type EncryptionKey struct {
+	Id   string
+	AEAD cipher.AEAD
+}
+
+// NewAesGcmEncryptionKey builds an AES-GCM EncryptionKey from a 16, 24 or 32
+// byte key.
+func NewAesGcmEncryptionKey(id string, key []byte) (EncryptionKey, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return EncryptionKey{}, fmt.Errorf("aes.NewCipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return EncryptionKey{}, fmt.Errorf("cipher.NewGCM: %w", err)
+	}
+	return EncryptionKey{Id: id, AEAD: aead}, nil
+}
+
+// EncryptedWalletDatabase wraps a WalletDatabase and encrypts the data that
+// is worth money or identifies the user before it reaches the inner store:
+// proof secrets, mint quote secret keys and transaction memos and metadata
+// values.
+//
+// Everything the inner database filters or indexes on (Y values, mint urls,
+// states, units, spending conditions) stays in plaintext so GetProofs and
+// ListTransactions keep working unchanged. Every ciphertext is bound to the
+// record it belongs to, so values cannot be swapped between records.
+//
+// The secret key of a mint quote is stored in a companion mint quote, see
+// encryptedSecretKeyQuotePrefix; the quote itself is stored without one.
+type EncryptedWalletDatabase struct {
+	inner WalletDatabase
+
+	mu     sync.RWMutex
+	active EncryptionKey
+	keys   map[string]EncryptionKey
+}
+
+var _ WalletDatabase = (*EncryptedWalletDatabase)(nil)
+
+// NewEncryptedWalletDatabase wraps inner. The first key encrypts new values;
+// every key can decrypt values written with it, so keys that are still in
+// use after a rotation must be passed as well.
+func NewEncryptedWalletDatabase(inner WalletDatabase, active EncryptionKey, previous ...EncryptionKey) (*EncryptedWalletDatabase, error) {
+	db := &EncryptedWalletDatabase{
+		inner: inner,
+		keys:  map[string]EncryptionKey{},
+	}
+	for _, key := range append([]EncryptionKey{active}, previous...) {
+		if err := db.addKey(key); err != nil {
+			return nil, err
+		}
+	}
+	db.active = active
+	return db, nil
+}
+
+func (db *EncryptedWalletDatabase) addKey(key EncryptionKey) error {
+	if key.Id == "" || strings.Contains(key.Id, ":") {
+		return fmt.Errorf("invalid encryption key id %q", key.Id)
+	}
+	if key.AEAD == nil {
+		return fmt.Errorf("encryption key %q has no AEAD", key.Id)
+	}
+	if existing, ok := db.keys[key.Id]; ok && existing.AEAD != key.AEAD {
+		return fmt.Errorf("duplicate encryption key id %q", key.Id)
+	}
+	db.keys[key.Id] = key
+	return nil
+}
+
+// RotateKey makes key the one used for new writes. Older keys stay
+// available for reading; call ReencryptAll to move existing values over.
+func (db *EncryptedWalletDatabase) RotateKey(key EncryptionKey) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if err := db.addKey(key); err != nil {
+		return err
+	}
+	db.active = key
+	return nil
+}
+
+// ActiveKeyId returns the id of the key used for new writes.
+func (db *EncryptedWalletDatabase) ActiveKeyId() string {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	return db.active.Id
+}
+
+// ReencryptReport counts the records rewritten by ReencryptAll.
+type ReencryptReport struct {
+	Proofs       int
+	MintQuotes   int
+	Transactions int
+}
+
+// ReencryptAll rewrites every proof, mint quote and transaction with the
+// active key. Plaintext values left by a database that was used without the
+// wrapper are encrypted as well. Once it returns without error, keys other
+// than the active one are no longer needed.
+func (db *EncryptedWalletDatabase) ReencryptAll() (ReencryptReport, error) {
+	var report ReencryptReport
+
+	proofs, err := db.GetProofs(nil, nil, nil, nil)
+	if err != nil {
+		return report, err
+	}
+	if len(proofs) > 0 {
+		if err := db.UpdateProofs(proofs, nil); err != nil {
+			return report, err
+		}
+	}
+	report.Proofs = len(proofs)
+
+	quotes, err := db.GetMintQuotes()
+	if err != nil {
+		return report, err
+	}
+	for _, quote := range quotes {
+		if err := db.AddMintQuote(quote); err != nil {
+			return report, err
+		}
+		report.MintQuotes++
+	}
+
+	transactions, err := db.ListTransactions(nil, nil, nil)
+	if err != nil {
+		return report, err
+	}
+	for _, transaction := range transactions {
+		if err := db.AddTransaction(transaction); err != nil {
+			return report, err
+		}
+		report.Transactions++
+	}
+
+	return report, nil
+}
+
+func (db *EncryptedWalletDatabase) encrypt(plaintext string, context string) (string, error) {
+	db.mu.RLock()
+	key := db.active
+	db.mu.RUnlock()
+
+	nonce := make([]byte, key.AEAD.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("generate nonce: %w", err)
+	}
+	sealed := key.AEAD.Seal(nonce, nonce, []byte(plaintext), []byte(context))
+	return encryptedValuePrefix + key.Id + ":" + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// reencrypt decrypts value if it is encrypted and encrypts it with the
+// active key.
+func (db *EncryptedWalletDatabase) reencrypt(value string, context string) (string, error) {
+	plaintext, err := db.decrypt(value, context)
+	if err != nil {
+		return "", err
+	}
+	return db.encrypt(plaintext, context)
+}
+
+func (db *EncryptedWalletDatabase) decrypt(value string, context string) (string, error) {
+	if !strings.HasPrefix(value, encryptedValuePrefix) {
+		return value, nil
+	}
+	keyId, encoded, ok := strings.Cut(strings.TrimPrefix(value, encryptedValuePrefix), ":")
+	if !ok {
+		return "", errors.New("malformed encrypted value")
+	}
+
+	db.mu.RLock()
+	key, ok := db.keys[keyId]
+	db.mu.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("unknown encryption key %q", keyId)
+	}
+
+	sealed, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("decode encrypted value: %w", err)
+	}
+	nonceSize := key.AEAD.NonceSize()
+	if len(sealed) < nonceSize {
+		return "", errors.New("encrypted value too short")
+	}
+	plaintext, err := key.AEAD.Open(nil, sealed[:nonceSize], sealed[nonceSize:], []byte(context))
+	if err != nil {
+		return "", fmt.Errorf("decrypt value with key %q: %w", keyId, err)
+	}
+	return string(plaintext), nil
+}
+
+// Rewrites the secret of the proof inside info. Proofs are opaque Rust
+// objects, so this goes through the ProofInfo JSON encoding.
+func (db *EncryptedWalletDatabase) mapProofSecret(info ProofInfo, fn func(secret string) (string, error)) (ProofInfo, error) {
+	encoded, err := EncodeProofInfo(info)
+	if err != nil {
+		return ProofInfo{}, err
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(encoded))
+	decoder.UseNumber()
+	var fields map[string]any
+	if err := decoder.Decode(&fields); err != nil {
+		return ProofInfo{}, DatabaseError(fmt.Errorf("decode proof info: %w", err))
+	}
+	proof, ok := fields["proof"].(map[string]any)
+	if !ok {
+		return ProofInfo{}, DatabaseError(errors.New("proof info has no proof"))
+	}
+	secret, ok := proof["secret"].(string)
+	if !ok {
+		return ProofInfo{}, DatabaseError(errors.New("proof has no secret"))
+	}
+
+	secret, err = fn(secret)
+	if err != nil {
+		return ProofInfo{}, DatabaseError(err)
+	}
+	proof["secret"] = secret
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(fields); err != nil {
+		return ProofInfo{}, DatabaseError(fmt.Errorf("encode proof info: %w", err))
+	}
+	return DecodeProofInfo(buf.String())
+}
+
+func (db *EncryptedWalletDatabase) encryptProofInfo(info ProofInfo) (ProofInfo, error) {
+	context := "proof_secret:" + info.Y.Hex
+	return db.mapProofSecret(info, func(secret string) (string, error) {
+		return db.reencrypt(secret, context)
+	})
+}
+
+func (db *EncryptedWalletDatabase) decryptProofInfo(info ProofInfo) (ProofInfo, error) {
+	context := "proof_secret:" + info.Y.Hex
+	return db.mapProofSecret(info, func(secret string) (string, error) {
+		return db.decrypt(secret, context)
+	})
+}
+
+func secretKeyQuoteId(quoteId string) string {
+	return encryptedSecretKeyQuotePrefix + quoteId
+}
+
+func isSecretKeyQuote(quoteId string) bool {
+	return strings.HasPrefix(quoteId, encryptedSecretKeyQuotePrefix)
+}
+
+// secretKeyQuote returns the companion record holding the encrypted secret
+// key of quote.
+func (db *EncryptedWalletDatabase) secretKeyQuote(quote MintQuote) (MintQuote, error) {
+	encrypted, err := db.reencrypt(*quote.SecretKey, "mint_quote_secret_key:"+quote.Id)
+	if err != nil {
+		return MintQuote{}, DatabaseError(err)
+	}
+	return MintQuote{
+		Id:            secretKeyQuoteId(quote.Id),
+		Unit:          quote.Unit,
+		Request:       encrypted,
+		State:         quote.State,
+		Expiry:        quote.Expiry,
+		MintUrl:       quote.MintUrl,
+		PaymentMethod: quote.PaymentMethod,
+	}, nil
+}
+
+// decryptMintQuote restores the secret key of quote from its companion
+// record. Secret keys stored in the quote itself, in plaintext by a
+// database used without the wrapper, are returned as they are.
+func (db *EncryptedWalletDatabase) decryptMintQuote(quote MintQuote, companion *MintQuote) (MintQuote, error) {
+	var secretKey *string
+	switch {
+	case companion != nil:
+		secretKey = &companion.Request
+	case quote.SecretKey != nil:
+		secretKey = quote.SecretKey
+	default:
+		return quote, nil
+	}
+	plaintext, err := db.decrypt(*secretKey, "mint_quote_secret_key:"+quote.Id)
+	if err != nil {
+		return MintQuote{}, DatabaseError(err)
+	}
+	quote.SecretKey = &plaintext
+	return quote, nil
+}
+
+func (db *EncryptedWalletDatabase) mapTransaction(transaction Transaction, fn func(value string, context string) (string, error)) (Transaction, error) {
+	prefix := "transaction:" + transaction.Id.Hex + ":"
+	if transaction.Memo != nil {
+		memo, err := fn(*transaction.Memo, prefix+"memo")
+		if err != nil {
+			return Transaction{}, DatabaseError(err)
+		}
+		transaction.Memo = &memo
+	}
+	if transaction.Metadata != nil {
+		metadata := make(map[string]string, len(transaction.Metadata))
+		for k, v := range transaction.Metadata {
+			value, err := fn(v, prefix+"metadata:"+k)
+			if err != nil {
+				return Transaction{}, DatabaseError(err)
+			}
+			metadata[k] = value
+		}
+		transaction.Metadata = metadata
+	}
+	return transaction, nil
+}
+
+func (db *EncryptedWalletDatabase) encryptTransaction(transaction Transaction) (Transaction, error) {
+	return db.mapTransaction(transaction, db.reencrypt)
+}
+
+func (db *EncryptedWalletDatabase) decryptTransaction(transaction Transaction) (Transaction, error) {
+	return db.mapTransaction(transaction, db.decrypt)
+}
+
+// Add Mint to storage
+func (db *EncryptedWalletDatabase) AddMint(mintUrl MintUrl, mintInfo *MintInfo) error {
+	return db.inner.AddMint(mintUrl, mintInfo)
+}
+
+// Remove Mint from storage
+func (db *EncryptedWalletDatabase) RemoveMint(mintUrl MintUrl) error {
+	return db.inner.RemoveMint(mintUrl)
+}
+
+// Get mint from storage
+func (db *EncryptedWalletDatabase) GetMint(mintUrl MintUrl) (*MintInfo, error) {
+	return db.inner.GetMint(mintUrl)
+}
+
+// Get all mints from storage
+func (db *EncryptedWalletDatabase) GetMints() (map[MintUrl]*MintInfo, error) {
+	return db.inner.GetMints()
+}
+
+// Update mint url
+func (db *EncryptedWalletDatabase) UpdateMintUrl(oldMintUrl MintUrl, newMintUrl MintUrl) error {
+	return db.inner.UpdateMintUrl(oldMintUrl, newMintUrl)
+}
+
+// Add mint keyset to storage
+func (db *EncryptedWalletDatabase) AddMintKeysets(mintUrl MintUrl, keysets []KeySetInfo) error {
+	return db.inner.AddMintKeysets(mintUrl, keysets)
+}
+
+// Get mint keysets for mint url
+func (db *EncryptedWalletDatabase) GetMintKeysets(mintUrl MintUrl) (*[]KeySetInfo, error) {
+	return db.inner.GetMintKeysets(mintUrl)
+}
+
+// Get mint keyset by id
+func (db *EncryptedWalletDatabase) GetKeysetById(keysetId Id) (*KeySetInfo, error) {
+	return db.inner.GetKeysetById(keysetId)
+}
+
+// Add mint quote to storage
+func (db *EncryptedWalletDatabase) AddMintQuote(quote MintQuote) error {
+	if isSecretKeyQuote(quote.Id) {
+		return DatabaseError(fmt.Errorf("mint quote id %q is reserved", quote.Id))
+	}
+	if quote.SecretKey == nil {
+		if err := db.inner.RemoveMintQuote(secretKeyQuoteId(quote.Id)); err != nil {
+			return err
+		}
+		return db.inner.AddMintQuote(quote)
+	}
+	companion, err := db.secretKeyQuote(quote)
+	if err != nil {
+		return err
+	}
+	// The companion goes first: a failure in between leaves an unused
+	// companion rather than a quote whose key is lost.
+	if err := db.inner.AddMintQuote(companion); err != nil {
+		return err
+	}
+	quote.SecretKey = nil
+	return db.inner.AddMintQuote(quote)
+}
+
+// Get mint quote from storage
+func (db *EncryptedWalletDatabase) GetMintQuote(quoteId string) (*MintQuote, error) {
+	if isSecretKeyQuote(quoteId) {
+		return nil, nil
+	}
+	quote, err := db.inner.GetMintQuote(quoteId)
+	if err != nil || quote == nil {
+		return quote, err
+	}
+	companion, err := db.inner.GetMintQuote(secretKeyQuoteId(quoteId))
+	if err != nil {
+		return nil, err
+	}
+	decrypted, err := db.decryptMintQuote(*quote, companion)
+	if err != nil {
+		return nil, err
+	}
+	return &decrypted, nil
+}
+
+// Get mint quotes from storage
+func (db *EncryptedWalletDatabase) GetMintQuotes() ([]MintQuote, error) {
+	stored, err := db.inner.GetMintQuotes()
+	if err != nil {
+		return nil, err
+	}
+	companions := map[string]*MintQuote{}
+	for i := range stored {
+		if isSecretKeyQuote(stored[i].Id) {
+			companions[strings.TrimPrefix(stored[i].Id, encryptedSecretKeyQuotePrefix)] = &stored[i]
+		}
+	}
+	quotes := make([]MintQuote, 0, len(stored)-len(companions))
+	for _, quote := range stored {
+		if isSecretKeyQuote(quote.Id) {
+			continue
+		}
+		decrypted, err := db.decryptMintQuote(quote, companions[quote.Id])
+		if err != nil {
+			return nil, err
+		}
+		quotes = append(quotes, decrypted)
+	}
+	return quotes, nil
+}
+
+// Remove mint quote from storage
+func (db *EncryptedWalletDatabase) RemoveMintQuote(quoteId string) error {
+	if err := db.inner.RemoveMintQuote(quoteId); err != nil {
+		return err
+	}
+	return db.inner.RemoveMintQuote(secretKeyQuoteId(quoteId))
+}
+
+// Add melt quote to storage
+func (db *EncryptedWalletDatabase) AddMeltQuote(quote MeltQuote) error {
+	return db.inner.AddMeltQuote(quote)
+}
+
+// Get melt quote from storage
+func (db *EncryptedWalletDatabase) GetMeltQuote(quoteId string) (*MeltQuote, error) {
+	return db.inner.GetMeltQuote(quoteId)
+}
+
+// Get melt quotes from storage
+func (db *EncryptedWalletDatabase) GetMeltQuotes() ([]MeltQuote, error) {
+	return db.inner.GetMeltQuotes()
+}
+
+// Remove melt quote from storage
+func (db *EncryptedWalletDatabase) RemoveMeltQuote(quoteId string) error {
+	return db.inner.RemoveMeltQuote(quoteId)
+}
+
+// Add Keys to storage
+func (db *EncryptedWalletDatabase) AddKeys(keyset KeySet) error {
+	return db.inner.AddKeys(keyset)
+}
+
+// Get Keys from storage
+func (db *EncryptedWalletDatabase) GetKeys(id Id) (*Keys, error) {
+	return db.inner.GetKeys(id)
+}
+
+// Remove Keys from storage
+func (db *EncryptedWalletDatabase) RemoveKeys(id Id) error {
+	return db.inner.RemoveKeys(id)
+}
+
+// Update the proofs in storage by adding new proofs or removing proofs by their Y value
+func (db *EncryptedWalletDatabase) UpdateProofs(added []ProofInfo, removedYs []PublicKey) error {
+	encrypted := make([]ProofInfo, len(added))
+	for i, info := range added {
+		var err error
+		if encrypted[i], err = db.encryptProofInfo(info); err != nil {
+			return err
+		}
+	}
+	return db.inner.UpdateProofs(encrypted, removedYs)
+}
+
+// Get proofs from storage
+func (db *EncryptedWalletDatabase) GetProofs(mintUrl *MintUrl, unit *CurrencyUnit, state *[]ProofState, spendingConditions *[]SpendingConditions) ([]ProofInfo, error) {
+	proofs, err := db.inner.GetProofs(mintUrl, unit, state, spendingConditions)
+	if err != nil {
+		return nil, err
+	}
+	for i, info := range proofs {
+		if proofs[i], err = db.decryptProofInfo(info); err != nil {
+			return nil, err
+		}
+	}
+	return proofs, nil
+}
+
+// Update proofs state in storage
+func (db *EncryptedWalletDatabase) UpdateProofsState(ys []PublicKey, state ProofState) error {
+	return db.inner.UpdateProofsState(ys, state)
+}
+
+// Increment Keyset counter
+func (db *EncryptedWalletDatabase) IncrementKeysetCounter(keysetId Id, count uint32) (uint32, error) {
+	return db.inner.IncrementKeysetCounter(keysetId, count)
+}
+
+// Add transaction to storage
+func (db *EncryptedWalletDatabase) AddTransaction(transaction Transaction) error {
+	encrypted, err := db.encryptTransaction(transaction)
+	if err != nil {
+		return err
+	}
+	return db.inner.AddTransaction(encrypted)
+}
+
+// Get transaction from storage
+func (db *EncryptedWalletDatabase) GetTransaction(transactionId TransactionId) (*Transaction, error) {
+	transaction, err := db.inner.GetTransaction(transactionId)
+	if err != nil || transaction == nil {
+		return transaction, err
+	}
+	decrypted, err := db.decryptTransaction(*transaction)
+	if err != nil {
+		return nil, err
+	}
+	return &decrypted, nil
+}
+
+// List transactions from storage
+func (db *EncryptedWalletDatabase) ListTransactions(mintUrl *MintUrl, direction *TransactionDirection, unit *CurrencyUnit) ([]Transaction, error) {
+	transactions, err := db.inner.ListTransactions(mintUrl, direction, unit)
+	if err != nil {
+		return nil, err
+	}
+	for i, transaction := range transactions {
+		if transactions[i], err = db.decryptTransaction(transaction); err != nil {
+			return nil, err
+		}
+	}
+	return transactions, nil
+}
+
+// Remove transaction from storage
+func (db *EncryptedWalletDatabase) RemoveTransaction(transactionId TransactionId) error {
+	return db.inner.RemoveTransaction(transactionId)
+}
@@ -0,0 +1,200 @@
+package cdk_ffi_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cdk "github.com/lescuer97/cdkgo"
+	"github.com/lescuer97/cdkgo/walletdbtest"
+)
+
+const testSecretKey = "0000000000000000000000000000000000000000000000000000000000000001"
+
+func testEncryptionKey(t *testing.T, id string, seed byte) cdk.EncryptionKey {
+	t.Helper()
+	key, err := cdk.NewAesGcmEncryptionKey(id, bytes.Repeat([]byte{seed}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestEncryptedWalletDatabase(t *testing.T) {
+	walletdbtest.Run(t, func(t *testing.T) cdk.WalletDatabase {
+		db, err := cdk.NewEncryptedWalletDatabase(cdk.NewMemoryWalletDatabase(), testEncryptionKey(t, "k1", 1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return db
+	})
+}
+
+func lockedMintQuote(id string) cdk.MintQuote {
+	secretKey := testSecretKey
+	return cdk.MintQuote{
+		Id:            id,
+		Amount:        &cdk.Amount{Value: 21},
+		Unit:          cdk.CurrencyUnitSat{},
+		Request:       "lnbc210n1" + id,
+		State:         cdk.QuoteStateUnpaid,
+		Expiry:        1700000000,
+		MintUrl:       cdk.MintUrl{Url: "https://mint.example.com"},
+		PaymentMethod: cdk.PaymentMethodBolt11{},
+		SecretKey:     &secretKey,
+	}
+}
+
+func TestEncryptedWalletDatabaseMintQuoteSecretKey(t *testing.T) {
+	inner := cdk.NewMemoryWalletDatabase()
+	db, err := cdk.NewEncryptedWalletDatabase(inner, testEncryptionKey(t, "k1", 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AddMintQuote(lockedMintQuote("quote")); err != nil {
+		t.Fatal(err)
+	}
+
+	stored, err := inner.GetMintQuotes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 2 {
+		t.Fatalf("inner database holds %d mint quotes, want the quote and its companion", len(stored))
+	}
+	for _, quote := range stored {
+		if quote.SecretKey != nil {
+			t.Fatalf("inner mint quote %s has secret key %q, want none", quote.Id, *quote.SecretKey)
+		}
+		if strings.Contains(quote.Request, testSecretKey) {
+			t.Fatalf("inner mint quote %s holds the secret key in plaintext", quote.Id)
+		}
+	}
+
+	if err := db.RotateKey(testEncryptionKey(t, "k2", 2)); err != nil {
+		t.Fatal(err)
+	}
+	report, err := db.ReencryptAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.MintQuotes != 1 {
+		t.Fatalf("ReencryptAll rewrote %d mint quotes, want 1", report.MintQuotes)
+	}
+
+	rotated, err := cdk.NewEncryptedWalletDatabase(inner, testEncryptionKey(t, "k2", 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	quote, err := rotated.GetMintQuote("quote")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if quote == nil || quote.SecretKey == nil || *quote.SecretKey != testSecretKey {
+		t.Fatalf("GetMintQuote after rotation = %+v, want secret key %s", quote, testSecretKey)
+	}
+
+	if err := rotated.RemoveMintQuote("quote"); err != nil {
+		t.Fatal(err)
+	}
+	if stored, _ := inner.GetMintQuotes(); len(stored) != 0 {
+		t.Fatalf("RemoveMintQuote left %d records in the inner database", len(stored))
+	}
+}
+
+func TestEncryptedWalletDatabasePlaintextMintQuote(t *testing.T) {
+	inner := cdk.NewMemoryWalletDatabase()
+	if err := inner.AddMintQuote(lockedMintQuote("legacy")); err != nil {
+		t.Fatal(err)
+	}
+	db, err := cdk.NewEncryptedWalletDatabase(inner, testEncryptionKey(t, "k1", 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	quote, err := db.GetMintQuote("legacy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if quote == nil || quote.SecretKey == nil || *quote.SecretKey != testSecretKey {
+		t.Fatalf("GetMintQuote of a plaintext quote = %+v", quote)
+	}
+	if _, err := db.ReencryptAll(); err != nil {
+		t.Fatal(err)
+	}
+	stored, err := inner.GetMintQuote("legacy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.SecretKey != nil {
+		t.Fatal("ReencryptAll left the secret key in plaintext")
+	}
+}
+
+func TestEncryptedWalletDatabaseTransaction(t *testing.T) {
+	inner := cdk.NewMemoryWalletDatabase()
+	db, err := cdk.NewEncryptedWalletDatabase(inner, testEncryptionKey(t, "k1", 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	memo := "coffee"
+	transaction := cdk.Transaction{
+		Id:        cdk.TransactionId{Hex: strings.Repeat("ab", 32)},
+		MintUrl:   cdk.MintUrl{Url: "https://mint.example.com"},
+		Direction: cdk.TransactionDirectionOutgoing,
+		Amount:    cdk.Amount{Value: 5},
+		Unit:      cdk.CurrencyUnitSat{},
+		Memo:      &memo,
+		Metadata:  map[string]string{"note": "for alice"},
+	}
+	if err := db.AddTransaction(transaction); err != nil {
+		t.Fatal(err)
+	}
+
+	stored, err := inner.GetTransaction(transaction.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *stored.Memo == memo || stored.Metadata["note"] == "for alice" {
+		t.Fatalf("inner transaction is not encrypted: %+v", stored)
+	}
+	decrypted, err := db.GetTransaction(transaction.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *decrypted.Memo != memo || decrypted.Metadata["note"] != "for alice" {
+		t.Fatalf("GetTransaction = %+v, want the plaintext memo and metadata", decrypted)
+	}
+}
+
+// The SQL backends parse the records they store, this checks that what the
+// wrapper writes survives them.
+func TestEncryptedWalletDatabaseSqlite(t *testing.T) {
+	sqlite, err := cdk.NewWalletSqliteDatabase(filepath.Join(t.TempDir(), "wallet.sqlite"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqlite.Destroy()
+	db, err := cdk.NewEncryptedWalletDatabase(sqlite, testEncryptionKey(t, "k1", 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.AddMintQuote(lockedMintQuote("quote")); err != nil {
+		t.Fatal(err)
+	}
+	quote, err := db.GetMintQuote("quote")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if quote == nil || quote.SecretKey == nil || *quote.SecretKey != testSecretKey {
+		t.Fatalf("GetMintQuote = %+v, want secret key %s", quote, testSecretKey)
+	}
+	quotes, err := db.GetMintQuotes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(quotes) != 1 {
+		t.Fatalf("GetMintQuotes returned %d quotes, want 1", len(quotes))
+	}
+}
@@ -0,0 +1,264 @@
+package cdk_ffi
+
+import (
+	"context"
+	"fmt"
+	"math/bits"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Fee estimates are computed from the wallet's unspent proofs and keyset
+// fees. Only EstimateMeltCost requests a quote, from the mint directly so
+// the wallet does not store it. Nothing is written to the wallet database
+// or reserved, so an estimate can be off if the wallet changes before the
+// operation runs.
+
+// SendFeeEstimate itemizes the cost of sending an amount.
+type SendFeeEstimate struct {
+	Amount Amount
+	// Input fee of the swap that splits off the amount, zero when the wallet
+	// holds proofs matching it exactly
+	SwapFee Amount
+	// Fee the receiver pays to redeem the token, charged to the sender when
+	// SendOptions.IncludeFee is set
+	RedeemFee Amount
+	// Amount plus the fees charged to the sender
+	Total Amount
+	// Number of proofs that would be spent
+	InputCount int
+}
+
+// MeltCostEstimate itemizes the cost of paying a Lightning request.
+type MeltCostEstimate struct {
+	Amount Amount
+	// Lightning fee reserve required by the mint, unused reserve is returned
+	// as change
+	FeeReserve Amount
+	// Input fee of the proofs spent
+	InputFee Amount
+	// Amount plus fee reserve and input fee, the most the melt can cost
+	Total Amount
+	// Number of proofs that would be spent
+	InputCount int
+}
+
+// TransferEstimate itemizes the cost of moving funds between two mints of
+// a MultiMintWallet.
+type TransferEstimate struct {
+	// Amount the target mint would issue
+	AmountReceived Amount
+	// Lightning fee reserve the source mint is assumed to require
+	FeeReserve Amount
+	// Input fee of the proofs spent at the source mint
+	InputFee Amount
+	// Most that would be deducted from the source mint
+	AmountSent Amount
+	// Balance of the source mint before the transfer
+	SourceBalance Amount
+}
+
+// exactSelection looks for proofs adding up to exactly target, largest
+// first, which lets a send skip the swap.
+func exactSelection(candidates []ProofCandidate, target uint64) ([]ProofCandidate, bool) {
+	ordered := append([]ProofCandidate{}, candidates...)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].Amount > ordered[j].Amount })
+	var selected []ProofCandidate
+	remaining := target
+	for _, candidate := range ordered {
+		if candidate.Amount <= remaining {
+			selected = append(selected, candidate)
+			remaining -= candidate.Amount
+		}
+		if remaining == 0 {
+			return selected, true
+		}
+	}
+	return nil, false
+}
+
+// EstimateSendFee estimates the fees of PrepareSend(amount, options). An
+// offline SendKind is estimated without a swap and fails with
+// ErrSelectionNotOffline when the wallet holds no proofs that can be sent
+// as they are.
+func (_self *Wallet) EstimateSendFee(ctx context.Context, amount Amount, options SendOptions) (SendFeeEstimate, error) {
+	candidates, activePpk, err := _self.proofCandidates(ctx)
+	if err != nil {
+		return SendFeeEstimate{Amount: amount}, err
+	}
+	return estimateSendFee(candidates, activePpk, amount, options)
+}
+
+// estimateSendFee estimates sending amount out of candidates, activePpk
+// being the input fee of the active keyset.
+func estimateSendFee(candidates []ProofCandidate, activePpk uint64, amount Amount, options SendOptions) (SendFeeEstimate, error) {
+	estimate := SendFeeEstimate{Amount: amount}
+	withinMaxProofs := func(selected []ProofCandidate) bool {
+		return options.MaxProofs == nil || len(selected) <= int(*options.MaxProofs)
+	}
+
+	if offline, tolerance := sendKindOffline(options.SendKind); offline {
+		if options.Conditions != nil {
+			return estimate, fmt.Errorf("%w: spending conditions need a swap", ErrSelectionNotOffline)
+		}
+		// Offline proofs are sent as they are; with IncludeFee the sender
+		// covers their redeem fee, which depends on the proofs picked.
+		fee := func([]ProofCandidate) uint64 { return 0 }
+		if options.IncludeFee {
+			fee = inputFee
+		}
+		selected, err := SelectLargestFirst.SelectProofs(candidates, amount.Value, fee)
+		if err != nil {
+			return estimate, err
+		}
+		var total uint64
+		for _, candidate := range selected {
+			total += candidate.Amount
+		}
+		estimate.RedeemFee.Value = fee(selected)
+		if total > amount.Value+estimate.RedeemFee.Value+tolerance || !withinMaxProofs(selected) {
+			return estimate, ErrSelectionNotOffline
+		}
+		estimate.InputCount = len(selected)
+		estimate.Total.Value = total
+		return estimate, nil
+	}
+
+	target := amount.Value
+	if options.IncludeFee {
+		estimate.RedeemFee.Value = (uint64(bits.OnesCount64(amount.Value))*activePpk + 999) / 1000
+		target += estimate.RedeemFee.Value
+	}
+	if options.Conditions == nil {
+		if selected, ok := exactSelection(candidates, target); ok && withinMaxProofs(selected) {
+			estimate.InputCount = len(selected)
+			estimate.Total.Value = target
+			return estimate, nil
+		}
+	}
+
+	selected, err := SelectLargestFirst.SelectProofs(candidates, target, inputFee)
+	if err != nil {
+		return estimate, err
+	}
+	estimate.SwapFee.Value = inputFee(selected)
+	estimate.InputCount = len(selected)
+	estimate.Total.Value = target + estimate.SwapFee.Value
+	return estimate, nil
+}
+
+// EstimateMeltCost estimates the cost of MeltQuote(request, options)
+// followed by Melt, combining the fee reserve of a bolt11 melt quote with
+// the input fee of the proofs that would be spent. The quote is requested
+// from the mint directly and not stored, so it is never paid.
+func (_self *Wallet) EstimateMeltCost(ctx context.Context, request string, options *MeltOptions) (MeltCostEstimate, error) {
+	body := map[string]any{"request": request, "unit": CurrencyUnitString(_self.Unit())}
+	if options != nil {
+		switch option := (*options).(type) {
+		case MeltOptionsMpp:
+			body["options"] = map[string]any{"mpp": map[string]uint64{"amount": option.Amount.Value}}
+		case MeltOptionsAmountless:
+			body["options"] = map[string]any{"amountless": map[string]uint64{"amount_msat": option.AmountMsat.Value}}
+		}
+	}
+	var quote mintMeltQuote
+	if err := mintRequest(ctx, nil, http.MethodPost, _self.MintUrl(), "/v1/melt/quote/bolt11", body, &quote); err != nil {
+		return MeltCostEstimate{}, fmt.Errorf("melt quote: %w", err)
+	}
+	candidates, _, err := _self.proofCandidates(ctx)
+	if err != nil {
+		return MeltCostEstimate{}, err
+	}
+	return estimateMeltCost(candidates, Amount{Value: quote.Amount}, Amount{Value: quote.FeeReserve})
+}
+
+// estimateMeltCost estimates melting amount with feeReserve out of
+// candidates.
+func estimateMeltCost(candidates []ProofCandidate, amount, feeReserve Amount) (MeltCostEstimate, error) {
+	estimate := MeltCostEstimate{Amount: amount, FeeReserve: feeReserve}
+	selected, err := SelectLargestFirst.SelectProofs(candidates, amount.Value+feeReserve.Value, inputFee)
+	if err != nil {
+		return estimate, err
+	}
+	estimate.InputFee.Value = inputFee(selected)
+	estimate.InputCount = len(selected)
+	estimate.Total.Value = amount.Value + feeReserve.Value + estimate.InputFee.Value
+	return estimate, nil
+}
+
+// EstimateTransfer estimates Transfer(sourceMint, targetMint, transferMode)
+// without requesting quotes. The Lightning fee reserve of the source mint
+// is only known from a melt quote, so feeReserve returns the reserve to
+// assume for an amount; nil assumes none, as between mints sharing a node.
+func (_self *MultiMintWallet) EstimateTransfer(ctx context.Context, sourceMint MintUrl, targetMint MintUrl, transferMode TransferMode, feeReserve func(Amount) Amount) (TransferEstimate, error) {
+	allProofs, err := _self.ListProofsCtx(ctx)
+	if err != nil {
+		return TransferEstimate{}, err
+	}
+	var proofs []*Proof
+	for mintUrl, mintProofs := range allProofs {
+		if strings.TrimSuffix(mintUrl, "/") == strings.TrimSuffix(sourceMint.Url, "/") {
+			proofs = mintProofs
+		}
+	}
+	keysets, err := mintKeysetFees(ctx, nil, sourceMint)
+	if err != nil {
+		return TransferEstimate{}, fmt.Errorf("keysets of %s: %w", sourceMint.Url, err)
+	}
+	candidates := make([]ProofCandidate, 0, len(proofs))
+	for _, proof := range proofs {
+		candidates = append(candidates, ProofCandidate{Proof: proof, Amount: proof.Amount().Value, Keyset: keysets[proof.KeysetId()]})
+	}
+
+	if ok, err := _self.HasMintCtx(ctx, targetMint); err != nil {
+		return TransferEstimate{}, err
+	} else if !ok {
+		return TransferEstimate{}, fmt.Errorf("unknown target mint %s", targetMint.Url)
+	}
+	return estimateTransfer(candidates, transferMode, feeReserve)
+}
+
+// estimateTransfer estimates transferring out of the source mint proofs
+// candidates.
+func estimateTransfer(candidates []ProofCandidate, transferMode TransferMode, feeReserve func(Amount) Amount) (TransferEstimate, error) {
+	var estimate TransferEstimate
+	for _, candidate := range candidates {
+		estimate.SourceBalance.Value += candidate.Amount
+	}
+	reserve := func(amount uint64) uint64 {
+		if feeReserve == nil {
+			return 0
+		}
+		return feeReserve(Amount{Value: amount}).Value
+	}
+
+	var receive uint64
+	switch mode := transferMode.(type) {
+	case TransferModeExactReceive:
+		receive = mode.Amount.Value
+	case TransferModeFullBalance:
+		// The fee reserve depends on the amount, so take it for the whole
+		// balance first and then for what is left of it after fees.
+		allInputs := inputFee(candidates)
+		receive = estimate.SourceBalance.Value
+		for range 2 {
+			if estimate.SourceBalance.Value <= reserve(receive)+allInputs {
+				return estimate, ErrSelectionInsufficient
+			}
+			receive = estimate.SourceBalance.Value - reserve(receive) - allInputs
+		}
+	default:
+		return estimate, fmt.Errorf("unsupported transfer mode %T", transferMode)
+	}
+
+	estimate.FeeReserve.Value = reserve(receive)
+	selected, err := SelectLargestFirst.SelectProofs(candidates, receive+estimate.FeeReserve.Value, inputFee)
+	if err != nil {
+		return estimate, err
+	}
+	estimate.AmountReceived.Value = receive
+	estimate.InputFee.Value = inputFee(selected)
+	estimate.AmountSent.Value = receive + estimate.FeeReserve.Value + estimate.InputFee.Value
+	return estimate, nil
+}
@@ -0,0 +1,33 @@
+package cdk_ffi_test
+
+import (
+	"testing"
+
+	cdk "github.com/lescuer97/cdkgo"
+	"github.com/lescuer97/cdkgo/mockmint"
+)
+
+func TestEstimateMeltCostMint(t *testing.T) {
+	ctx := testContext(t)
+	mint := mockmint.New(mockmint.Options{FeeReserve: func(amount uint64) uint64 { return 2 }})
+	defer mint.Close()
+	wallet := newTestWallet(t, mint, cdk.NewMemoryWalletDatabase())
+	fund(t, ctx, mint, wallet, 64)
+
+	// An invoice of another node, so the mint asks for a fee reserve
+	invoice, _, err := mockmint.NewFakeLightning().CreateInvoice(10_000, "estimate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	estimate, err := wallet.EstimateMeltCost(ctx, invoice, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := cdk.MeltCostEstimate{Amount: cdk.Amount{Value: 10}, FeeReserve: cdk.Amount{Value: 2}, Total: cdk.Amount{Value: 12}, InputCount: 1}
+	if estimate != want {
+		t.Fatalf("got %+v, want %+v", estimate, want)
+	}
+	if got := balance(t, ctx, wallet); got != 64 {
+		t.Fatalf("balance %d after estimating", got)
+	}
+}
@@ -0,0 +1,257 @@
+package cdk_ffi
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestExactSelection(t *testing.T) {
+	candidates := func(amounts ...uint64) []ProofCandidate {
+		var c []ProofCandidate
+		for _, amount := range amounts {
+			c = append(c, ProofCandidate{Amount: amount})
+		}
+		return c
+	}
+	tests := []struct {
+		name       string
+		candidates []ProofCandidate
+		target     uint64
+		want       []uint64
+		ok         bool
+	}{
+		{"single proof", candidates(1, 8, 2), 8, []uint64{8}, true},
+		{"several proofs", candidates(1, 8, 2, 4), 13, []uint64{8, 4, 1}, true},
+		{"skips too large proofs", candidates(16, 2, 1), 3, []uint64{2, 1}, true},
+		{"duplicates", candidates(2, 2, 2), 4, []uint64{2, 2}, true},
+		{"no exact match", candidates(4, 4), 6, nil, false},
+		// Largest first misses 3+3 once it took 4
+		{"greedy miss", candidates(4, 3, 3), 6, nil, false},
+		{"insufficient", candidates(1, 2), 8, nil, false},
+		{"no proofs", nil, 1, nil, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			selected, ok := exactSelection(test.candidates, test.target)
+			if ok != test.ok || !slices.Equal(amounts(selected), test.want) {
+				t.Fatalf("got %v %v, want %v %v", amounts(selected), ok, test.want, test.ok)
+			}
+		})
+	}
+}
+
+func TestEstimateSendFee(t *testing.T) {
+	free := KeySetInfo{Id: "00free", Active: true}
+	paid := KeySetInfo{Id: "00paid", Active: true, InputFeePpk: 600}
+	proofs := func(keyset KeySetInfo, amounts ...uint64) []ProofCandidate {
+		var c []ProofCandidate
+		for _, amount := range amounts {
+			c = append(c, ProofCandidate{Amount: amount, Keyset: keyset})
+		}
+		return c
+	}
+	maxProofs := func(n uint32) *uint32 { return &n }
+	var p2pk SpendingConditions = SpendingConditionsP2pk{}
+	conditions := &p2pk
+
+	tests := []struct {
+		name       string
+		candidates []ProofCandidate
+		activePpk  uint64
+		amount     uint64
+		options    SendOptions
+		want       SendFeeEstimate
+		err        error
+	}{
+		{
+			name:       "online exact proofs",
+			candidates: proofs(paid, 8, 2, 1),
+			amount:     10,
+			options:    SendOptions{SendKind: SendKindOnlineExact{}},
+			want:       SendFeeEstimate{Total: Amount{Value: 10}, InputCount: 2},
+		},
+		{
+			name:       "online swap",
+			candidates: proofs(paid, 8, 8),
+			amount:     10,
+			options:    SendOptions{SendKind: SendKindOnlineExact{}},
+			want:       SendFeeEstimate{SwapFee: Amount{Value: 2}, Total: Amount{Value: 12}, InputCount: 2},
+		},
+		{
+			name:       "online exact proofs over MaxProofs",
+			candidates: proofs(free, 8, 1, 1, 16),
+			amount:     10,
+			options:    SendOptions{SendKind: SendKindOnlineExact{}, MaxProofs: maxProofs(2)},
+			want:       SendFeeEstimate{Total: Amount{Value: 10}, InputCount: 1},
+		},
+		{
+			name:       "online redeem fee",
+			candidates: proofs(free, 16),
+			activePpk:  300,
+			amount:     11,
+			options:    SendOptions{SendKind: SendKindOnlineExact{}, IncludeFee: true},
+			// 3 outputs at 300 ppk
+			want: SendFeeEstimate{RedeemFee: Amount{Value: 1}, Total: Amount{Value: 12}, InputCount: 1},
+		},
+		{
+			name:       "online conditions always swap",
+			candidates: proofs(free, 8, 2),
+			amount:     10,
+			options:    SendOptions{SendKind: SendKindOnlineExact{}, Conditions: conditions},
+			want:       SendFeeEstimate{Total: Amount{Value: 10}, InputCount: 2},
+		},
+		{
+			name:       "online insufficient",
+			candidates: proofs(paid, 8, 2),
+			amount:     10,
+			options:    SendOptions{SendKind: SendKindOnlineExact{}, IncludeFee: true},
+			activePpk:  600,
+			err:        ErrSelectionInsufficient,
+		},
+		{
+			name:       "offline exact",
+			candidates: proofs(paid, 8, 2, 1),
+			amount:     10,
+			options:    SendOptions{SendKind: SendKindOfflineExact{}},
+			want:       SendFeeEstimate{Total: Amount{Value: 10}, InputCount: 2},
+		},
+		{
+			name:       "offline redeem fee",
+			candidates: proofs(paid, 8, 2, 1),
+			amount:     9,
+			options:    SendOptions{SendKind: SendKindOfflineExact{}, IncludeFee: true},
+			// 8 and 2 cost 2 to redeem, so 1 is added
+			want: SendFeeEstimate{RedeemFee: Amount{Value: 2}, Total: Amount{Value: 11}, InputCount: 3},
+		},
+		{
+			name:       "offline over the amount",
+			candidates: proofs(free, 8, 4),
+			amount:     10,
+			options:    SendOptions{SendKind: SendKindOfflineExact{}},
+			err:        ErrSelectionNotOffline,
+		},
+		{
+			name:       "offline within tolerance",
+			candidates: proofs(free, 8, 4),
+			amount:     10,
+			options:    SendOptions{SendKind: SendKindOfflineTolerance{Tolerance: Amount{Value: 2}}},
+			want:       SendFeeEstimate{Total: Amount{Value: 12}, InputCount: 2},
+		},
+		{
+			name:       "offline over MaxProofs",
+			candidates: proofs(free, 8, 1, 1),
+			amount:     10,
+			options:    SendOptions{SendKind: SendKindOfflineExact{}, MaxProofs: maxProofs(2)},
+			err:        ErrSelectionNotOffline,
+		},
+		{
+			name:       "offline conditions",
+			candidates: proofs(free, 8, 2),
+			amount:     10,
+			options:    SendOptions{SendKind: SendKindOfflineExact{}, Conditions: conditions},
+			err:        ErrSelectionNotOffline,
+		},
+		{
+			name:       "offline insufficient",
+			candidates: proofs(free, 8),
+			amount:     10,
+			options:    SendOptions{SendKind: SendKindOfflineExact{}},
+			err:        ErrSelectionInsufficient,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			estimate, err := estimateSendFee(test.candidates, test.activePpk, Amount{Value: test.amount}, test.options)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("got error %v, want %v", err, test.err)
+			}
+			if test.err != nil {
+				return
+			}
+			test.want.Amount = Amount{Value: test.amount}
+			if estimate != test.want {
+				t.Fatalf("got %+v, want %+v", estimate, test.want)
+			}
+		})
+	}
+}
+
+func TestEstimateMeltCost(t *testing.T) {
+	candidates := []ProofCandidate{
+		{Amount: 64, Keyset: KeySetInfo{InputFeePpk: 100}},
+		{Amount: 32, Keyset: KeySetInfo{InputFeePpk: 100}},
+	}
+	estimate, err := estimateMeltCost(candidates, Amount{Value: 70}, Amount{Value: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := MeltCostEstimate{Amount: Amount{Value: 70}, FeeReserve: Amount{Value: 2}, InputFee: Amount{Value: 1}, Total: Amount{Value: 73}, InputCount: 2}
+	if estimate != want {
+		t.Fatalf("got %+v, want %+v", estimate, want)
+	}
+	if _, err := estimateMeltCost(candidates, Amount{Value: 94}, Amount{Value: 2}); !errors.Is(err, ErrSelectionInsufficient) {
+		t.Fatalf("got %v", err)
+	}
+}
+
+func TestEstimateTransfer(t *testing.T) {
+	keyset := KeySetInfo{InputFeePpk: 500}
+	candidates := []ProofCandidate{{Amount: 512, Keyset: keyset}, {Amount: 256, Keyset: keyset}, {Amount: 232, Keyset: keyset}}
+	percent := func(amount Amount) Amount { return Amount{Value: amount.Value / 100} }
+
+	tests := []struct {
+		name    string
+		mode    TransferMode
+		reserve func(Amount) Amount
+		want    TransferEstimate
+		err     error
+	}{
+		{
+			name: "exact receive",
+			mode: TransferModeExactReceive{Amount: Amount{Value: 600}},
+			// 512 and 256 cover 600 plus the 6 reserve and their fee of 1
+			reserve: percent,
+			want:    TransferEstimate{AmountReceived: Amount{Value: 600}, FeeReserve: Amount{Value: 6}, InputFee: Amount{Value: 1}, AmountSent: Amount{Value: 607}},
+		},
+		{
+			name: "full balance",
+			mode: TransferModeFullBalance{},
+			// The first pass takes the reserve of the balance, 10, leaving
+			// 988 after the input fee of 2; the second the reserve of 988, 9.
+			reserve: percent,
+			want:    TransferEstimate{AmountReceived: Amount{Value: 989}, FeeReserve: Amount{Value: 9}, InputFee: Amount{Value: 2}, AmountSent: Amount{Value: 1000}},
+		},
+		{
+			name: "full balance without reserve",
+			mode: TransferModeFullBalance{},
+			want: TransferEstimate{AmountReceived: Amount{Value: 998}, InputFee: Amount{Value: 2}, AmountSent: Amount{Value: 1000}},
+		},
+		{
+			name:    "full balance eaten by fees",
+			mode:    TransferModeFullBalance{},
+			reserve: func(Amount) Amount { return Amount{Value: 998} },
+			err:     ErrSelectionInsufficient,
+		},
+		{
+			name: "exact receive over the balance",
+			mode: TransferModeExactReceive{Amount: Amount{Value: 999}},
+			err:  ErrSelectionInsufficient,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			estimate, err := estimateTransfer(candidates, test.mode, test.reserve)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("got error %v, want %v", err, test.err)
+			}
+			if test.err != nil {
+				return
+			}
+			test.want.SourceBalance = Amount{Value: 1000}
+			if estimate != test.want {
+				t.Fatalf("got %+v, want %+v", estimate, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package cdk_ffi
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// FfiError variants only carry the message CDK produced on the Rust side.
+// The types below recover the structured parts of those messages so callers
+// can use errors.As instead of matching strings:
+//
+//	var mintErr *MintErrorResponse
+//	if errors.As(err, &mintErr) && mintErr.Code == MintErrorCodeTokenAlreadySpent { ... }
+//
+// Fields that cannot be found in the message are left at their zero value.
+
+// Error codes defined by NUT-00 that mints return in error responses.
+const (
+	MintErrorCodeBlindedMessageAlreadySigned = 10002
+	MintErrorCodeTokenNotVerified            = 10003
+	MintErrorCodeTokenAlreadySpent           = 11001
+	MintErrorCodeTransactionNotBalanced      = 11002
+	MintErrorCodeUnitNotSupported            = 11005
+	MintErrorCodeAmountOutsideLimit          = 11006
+	MintErrorCodeKeysetNotKnown              = 12001
+	MintErrorCodeKeysetInactive              = 12002
+	MintErrorCodeQuoteNotPaid                = 20001
+	MintErrorCodeTokensAlreadyIssued         = 20002
+	MintErrorCodeMintingDisabled             = 20003
+	MintErrorCodeQuotePending                = 20005
+	MintErrorCodeInvoiceAlreadyPaid          = 20006
+	MintErrorCodeQuoteExpired                = 20007
+)
+
+// InsufficientFundsError is found in an FfiErrorInsufficientFunds. CDK does
+// not put the amounts involved in the message.
+type InsufficientFundsError struct {
+	Message string
+}
+
+func (err *InsufficientFundsError) Error() string {
+	return fmt.Sprintf("insufficient funds: %s", err.Message)
+}
+
+// NetworkError is found in an FfiErrorNetwork.
+type NetworkError struct {
+	Message string
+	// HTTP status code of the response, 0 if the request got no response
+	HttpStatus int
+}
+
+func (err *NetworkError) Error() string {
+	return fmt.Sprintf("network: %s", err.Message)
+}
+
+// Retryable reports whether repeating the request may succeed: the mint
+// could not be reached, is overloaded or failed temporarily. Errors without
+// a status that don't say the connection failed, such as an undecodable
+// response, are not retryable.
+func (err *NetworkError) Retryable() bool {
+	switch {
+	case err.HttpStatus == 0:
+		return connectionFailureRegexp.MatchString(err.Message)
+	case err.HttpStatus == 408, err.HttpStatus == 429:
+		return true
+	case err.HttpStatus >= 500 && err.HttpStatus != 501:
+		return true
+	default:
+		return false
+	}
+}
+
+// MintErrorResponse is a NUT-00 error response returned by the mint. It can
+// be found in any FfiError variant whose message contains one.
+type MintErrorResponse struct {
+	Code   int
+	Detail string
+}
+
+func (err *MintErrorResponse) Error() string {
+	return fmt.Sprintf("mint error %d: %s", err.Code, err.Detail)
+}
+
+// Message returns the message CDK attached to the error.
+func (err FfiError) Message() string {
+	if err.err == nil {
+		return ""
+	}
+	_, message, _ := strings.Cut(err.err.Error(), ": ")
+	return message
+}
+
+// As lets errors.As find InsufficientFundsError, NetworkError and
+// MintErrorResponse in an FfiError.
+func (err FfiError) As(target any) bool {
+	message := err.Message()
+	switch target := target.(type) {
+	case **InsufficientFundsError:
+		if _, ok := err.err.(*FfiErrorInsufficientFunds); !ok {
+			return false
+		}
+		*target = &InsufficientFundsError{Message: message}
+		return true
+	case **NetworkError:
+		if _, ok := err.err.(*FfiErrorNetwork); !ok {
+			return false
+		}
+		*target = &NetworkError{Message: message, HttpStatus: parseHttpStatus(message)}
+		return true
+	case **MintErrorResponse:
+		response, ok := parseMintErrorResponse(message)
+		if !ok {
+			return false
+		}
+		*target = response
+		return true
+	}
+	return false
+}
+
+// IsRetryable reports whether err is a transient failure that may succeed
+// when the operation is repeated. Only network errors qualify; whether it is
+// safe to repeat a given operation is up to the caller.
+func IsRetryable(err error) bool {
+	var networkErr *NetworkError
+	if errors.As(err, &networkErr) {
+		return networkErr.Retryable()
+	}
+	return false
+}
+
+var (
+	httpStatusRegexp        = regexp.MustCompile(`(?i)\b(?:http|status)(?: code)?\D{0,3}([1-5]\d{2})\b`)
+	connectionFailureRegexp = regexp.MustCompile(`(?i)error sending request|\bconnect(?:ion)? (?:error|refused|reset|closed)|timed out|\btimeout\b|\bdns\b|unreachable`)
+	jsonCodeRegexp          = regexp.MustCompile(`"code"\s*:\s*(\d+)`)
+	jsonDetailRegexp        = regexp.MustCompile(`"detail"\s*:\s*("(?:[^"\\]|\\.)*")`)
+	textCodeRegexp          = regexp.MustCompile(`(?i)\bcode\b\D{0,3}(\d{5})\b`)
+	textDetailRegexp        = regexp.MustCompile(`(?i)\bdetail\b:?\s*(.+)$`)
+)
+
+func parseHttpStatus(message string) int {
+	match := httpStatusRegexp.FindStringSubmatch(message)
+	if match == nil {
+		return 0
+	}
+	status, _ := strconv.Atoi(match[1])
+	return status
+}
+
+func parseMintErrorResponse(message string) (*MintErrorResponse, bool) {
+	if match := jsonCodeRegexp.FindStringSubmatch(message); match != nil {
+		response := &MintErrorResponse{}
+		response.Code, _ = strconv.Atoi(match[1])
+		if detail := jsonDetailRegexp.FindStringSubmatch(message); detail != nil {
+			if unquoted, err := strconv.Unquote(detail[1]); err == nil {
+				response.Detail = unquoted
+			}
+		}
+		return response, true
+	}
+	if match := textCodeRegexp.FindStringSubmatch(message); match != nil {
+		response := &MintErrorResponse{}
+		response.Code, _ = strconv.Atoi(match[1])
+		if detail := textDetailRegexp.FindStringSubmatch(message); detail != nil {
+			response.Detail = strings.TrimSpace(detail[1])
+		}
+		return response, true
+	}
+	return nil, false
+}
@@ -0,0 +1,107 @@
+package cdk_ffi
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestParseMintErrorResponse(t *testing.T) {
+	tests := []struct {
+		message string
+		ok      bool
+		code    int
+		detail  string
+	}{
+		{`HTTP error: {"detail":"Token already spent","code":11001}`, true, 11001, "Token already spent"},
+		{`{"code": 20001, "detail": "quote \"abc\" not paid"}`, true, 20001, `quote "abc" not paid`},
+		{`Mint error code 12002, detail: keyset is inactive`, true, 12002, "keyset is inactive"},
+		{`unknown error code: 11005`, true, 11005, ""},
+		{`connection refused`, false, 0, ""},
+		{`status 500`, false, 0, ""},
+	}
+	for _, test := range tests {
+		response, ok := parseMintErrorResponse(test.message)
+		if ok != test.ok {
+			t.Errorf("%q: ok %v, want %v", test.message, ok, test.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if response.Code != test.code || response.Detail != test.detail {
+			t.Errorf("%q: got %d %q, want %d %q", test.message, response.Code, response.Detail, test.code, test.detail)
+		}
+	}
+}
+
+func TestParseHttpStatus(t *testing.T) {
+	tests := map[string]int{
+		"HTTP status 503 Service Unavailable": 503,
+		"http 429 too many requests":          429,
+		"status code: 404":                    404,
+		"received 200 proofs":                 0,
+		"error sending request for url":       0,
+		"status 999":                          0,
+	}
+	for message, want := range tests {
+		if got := parseHttpStatus(message); got != want {
+			t.Errorf("%q: got %d, want %d", message, got, want)
+		}
+	}
+}
+
+func TestNetworkErrorRetryable(t *testing.T) {
+	tests := []struct {
+		err  NetworkError
+		want bool
+	}{
+		{NetworkError{Message: "error sending request for url (https://mint.example/v1/swap)"}, true},
+		{NetworkError{Message: "tcp connect error: Connection refused (os error 111)"}, true},
+		{NetworkError{Message: "operation timed out"}, true},
+		{NetworkError{Message: "dns error: failed to lookup address"}, true},
+		{NetworkError{Message: "error decoding response body: expected value at line 1"}, false},
+		{NetworkError{Message: "HTTP status 429", HttpStatus: 429}, true},
+		{NetworkError{Message: "HTTP status 503", HttpStatus: 503}, true},
+		{NetworkError{Message: "HTTP status 501", HttpStatus: 501}, false},
+		{NetworkError{Message: "HTTP status 400", HttpStatus: 400}, false},
+	}
+	for _, test := range tests {
+		if got := test.err.Retryable(); got != test.want {
+			t.Errorf("%q (%d): got %v, want %v", test.err.Message, test.err.HttpStatus, got, test.want)
+		}
+	}
+}
+
+func TestFfiErrorAs(t *testing.T) {
+	err := fmt.Errorf("send: %w", &FfiError{err: &FfiErrorNetwork{message: "HTTP status 502 Bad Gateway"}})
+
+	var networkErr *NetworkError
+	if !errors.As(err, &networkErr) {
+		t.Fatal("NetworkError not found")
+	}
+	if networkErr.HttpStatus != 502 || networkErr.Message != "HTTP status 502 Bad Gateway" {
+		t.Fatalf("got %+v", networkErr)
+	}
+	if !IsRetryable(err) {
+		t.Fatal("502 is not retryable")
+	}
+	var funds *InsufficientFundsError
+	if errors.As(err, &funds) {
+		t.Fatal("network error matched InsufficientFundsError")
+	}
+
+	err = &FfiError{err: &FfiErrorInsufficientFunds{message: "Insufficient funds"}}
+	if !errors.As(err, &funds) || funds.Message != "Insufficient funds" {
+		t.Fatalf("got %+v", funds)
+	}
+	if IsRetryable(err) {
+		t.Fatal("insufficient funds is retryable")
+	}
+
+	err = &FfiError{err: &FfiErrorWallet{message: `{"detail":"Token already spent","code":11001}`}}
+	var mintErr *MintErrorResponse
+	if !errors.As(err, &mintErr) || mintErr.Code != MintErrorCodeTokenAlreadySpent {
+		t.Fatalf("got %+v", mintErr)
+	}
+}
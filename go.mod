@@ -1,3 +1,19 @@
 module github.com/lescuer97/cdkgo
 
-go 1.25
+go 1.25.0
+
+require (
+	github.com/coder/websocket v1.8.14
+	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.4.1
+	go.etcd.io/bbolt v1.5.0
+	golang.org/x/crypto v0.50.0
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20260414002931-afd174a4e478
+	google.golang.org/grpc v1.82.1
+	google.golang.org/protobuf v1.36.11
+)
+
+require (
+	golang.org/x/net v0.53.0 // indirect
+	golang.org/x/sys v0.45.0 // indirect
+	golang.org/x/text v0.36.0 // indirect
+)
@@ -0,0 +1,106 @@
+// Package bech32 implements the BIP-173 encoding shared by BOLT11 invoices,
+// LNURLs and NIP-19 keys. Lengths are not limited to 90 characters, as
+// invoices and LNURLs are longer.
+package bech32
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// ConvertBits regroups data from words of from bits to words of to bits.
+// With pad the last word is filled with zero bits; without, leftover bits
+// must be fewer than from and zero.
+func ConvertBits(data []byte, from, to uint, pad bool) ([]byte, error) {
+	var (
+		acc    uint
+		bits   uint
+		result []byte
+	)
+	maxValue := uint(1)<<to - 1
+	for _, value := range data {
+		if value>>from != 0 {
+			return nil, fmt.Errorf("invalid %d bit word %d", from, value)
+		}
+		acc = acc<<from | uint(value)
+		bits += from
+		for bits >= to {
+			bits -= to
+			result = append(result, byte(acc>>bits&maxValue))
+		}
+	}
+	if pad && bits > 0 {
+		result = append(result, byte(acc<<(to-bits)&maxValue))
+	} else if !pad && (bits >= from || acc<<(to-bits)&maxValue != 0) {
+		return nil, errors.New("invalid bech32 padding")
+	}
+	return result, nil
+}
+
+func polymod(values []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, value := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(value)
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+func hrpExpand(hrp string) []byte {
+	expanded := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]>>5)
+	}
+	expanded = append(expanded, 0)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]&31)
+	}
+	return expanded
+}
+
+// Encode returns the bech32 string of hrp and 5 bit data.
+func Encode(hrp string, data []byte) string {
+	values := append(hrpExpand(hrp), data...)
+	checksum := polymod(append(values, 0, 0, 0, 0, 0, 0)) ^ 1
+	var sb strings.Builder
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, word := range data {
+		sb.WriteByte(charset[word])
+	}
+	for i := 0; i < 6; i++ {
+		sb.WriteByte(charset[(checksum>>(5*(5-i)))&31])
+	}
+	return sb.String()
+}
+
+// Decode checks a lowercase bech32 string and returns its hrp and 5 bit
+// data without the checksum.
+func Decode(s string) (string, []byte, error) {
+	separator := strings.LastIndexByte(s, '1')
+	if separator < 1 || separator+7 > len(s) {
+		return "", nil, errors.New("invalid bech32 string")
+	}
+	hrp := s[:separator]
+	data := make([]byte, 0, len(s)-separator-1)
+	for _, c := range s[separator+1:] {
+		index := strings.IndexRune(charset, c)
+		if index < 0 {
+			return "", nil, fmt.Errorf("invalid bech32 character %q", c)
+		}
+		data = append(data, byte(index))
+	}
+	if polymod(append(hrpExpand(hrp), data...)) != 1 {
+		return "", nil, errors.New("invalid bech32 checksum")
+	}
+	return hrp, data[:len(data)-6], nil
+}
@@ -0,0 +1,64 @@
+package bech32
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	// BIP-173 test vectors, lowercased
+	valid := []string{
+		"a12uel5l",
+		"an83characterlonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1tt5tgs",
+		"abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw",
+		"11qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqc8247j",
+		"split1checkupstagehandshakeupstreamerranterredcaperred2y9e3w",
+	}
+	for _, s := range valid {
+		hrp, data, err := Decode(s)
+		if err != nil {
+			t.Errorf("%s: %v", s, err)
+			continue
+		}
+		if encoded := Encode(hrp, data); encoded != s {
+			t.Errorf("%s encoded back to %s", s, encoded)
+		}
+	}
+	invalid := []string{
+		"pzry9x8gf2tvdw0s3jn54khce6mua7l",  // no separator
+		"1pzry9x8gf2tvdw0s3jn54khce6mua7l", // empty hrp
+		"x1b4n0q5v",                        // invalid character
+		"li1dgmt3",                         // checksum too short
+		"a12uel5m",                         // wrong checksum
+	}
+	for _, s := range invalid {
+		if _, _, err := Decode(s); err == nil {
+			t.Errorf("%s decoded", s)
+		}
+	}
+}
+
+func TestConvertBits(t *testing.T) {
+	raw := []byte{0xff, 0x00, 0xa5}
+	words, err := ConvertBits(raw, 8, 5, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	back, err := ConvertBits(words, 5, 8, false)
+	if err != nil || !bytes.Equal(back, raw) {
+		t.Fatalf("round trip gave %x (%v)", back, err)
+	}
+
+	// 24 bits are 5 words with one spare bit, which must be zero.
+	words[len(words)-1] |= 1
+	if _, err := ConvertBits(words, 5, 8, false); err == nil {
+		t.Error("accepted non-zero padding")
+	}
+	// A whole spare word is never padding.
+	if _, err := ConvertBits(append(words[:len(words)-1:len(words)-1], 0, 0), 5, 8, false); err == nil {
+		t.Error("accepted a spare word")
+	}
+	if _, err := ConvertBits([]byte{32}, 5, 8, true); err == nil {
+		t.Error("accepted a 6 bit value as a 5 bit word")
+	}
+}
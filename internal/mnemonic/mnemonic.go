@@ -0,0 +1,44 @@
+// Package mnemonic loads the wallet mnemonic of the cdkgo and cdkgod
+// commands.
+package mnemonic
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+
+	cdk "github.com/lescuer97/cdkgo"
+)
+
+// Load returns the mnemonic in the environment variable env, or the one
+// stored at path. Without either it generates one and stores it at path,
+// reporting generated so the caller can ask for a backup. A variable or
+// file holding only whitespace is an error rather than an empty mnemonic.
+func Load(env, path string) (mnemonic string, generated bool, err error) {
+	if value := os.Getenv(env); value != "" {
+		if mnemonic = strings.TrimSpace(value); mnemonic == "" {
+			return "", false, fmt.Errorf("%s is blank", env)
+		}
+		return mnemonic, false, nil
+	}
+	raw, err := os.ReadFile(path)
+	if err == nil {
+		if mnemonic = strings.TrimSpace(string(raw)); mnemonic == "" {
+			return "", false, fmt.Errorf("%s is empty", path)
+		}
+		return mnemonic, false, nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return "", false, err
+	}
+	mnemonic, err = cdk.GenerateMnemonic()
+	if err != nil {
+		return "", false, err
+	}
+	if err := os.WriteFile(path, []byte(mnemonic+"\n"), 0600); err != nil {
+		return "", false, err
+	}
+	return mnemonic, true, nil
+}
@@ -0,0 +1,77 @@
+package mnemonic
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mnemonic")
+	if err := os.WriteFile(path, []byte("stored words\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	got, generated, err := Load("CDKGO_TEST_MNEMONIC", path)
+	if err != nil || generated || got != "stored words" {
+		t.Fatalf("got %q, generated %v (%v)", got, generated, err)
+	}
+
+	t.Setenv("CDKGO_TEST_MNEMONIC", "env words")
+	got, generated, err = Load("CDKGO_TEST_MNEMONIC", path)
+	if err != nil || generated || got != "env words" {
+		t.Fatalf("got %q, generated %v (%v)", got, generated, err)
+	}
+}
+
+func TestLoadGenerates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mnemonic")
+	generatedMnemonic, generated, err := Load("CDKGO_TEST_MNEMONIC", path)
+	if err != nil || !generated || generatedMnemonic == "" {
+		t.Fatalf("got %q, generated %v (%v)", generatedMnemonic, generated, err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Fatalf("stored with mode %v", info.Mode().Perm())
+	}
+	loaded, generated, err := Load("CDKGO_TEST_MNEMONIC", path)
+	if err != nil || generated || loaded != generatedMnemonic {
+		t.Fatalf("reloaded %q, generated %v (%v)", loaded, generated, err)
+	}
+}
+
+func TestLoadBlank(t *testing.T) {
+	dir := t.TempDir()
+	for _, content := range []string{"", " \n\t\n"} {
+		path := filepath.Join(dir, "mnemonic")
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if got, _, err := Load("CDKGO_TEST_MNEMONIC", path); err == nil {
+			t.Fatalf("file %q: got %q", content, got)
+		}
+	}
+
+	stored := filepath.Join(dir, "stored")
+	if err := os.WriteFile(stored, []byte("stored words\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	for _, env := range []string{"CDKGO_MNEMONIC", "CDKGOD_MNEMONIC"} {
+		// A blank variable does not fall back to the file
+		t.Setenv(env, " \t\n")
+		if got, _, err := Load(env, stored); err == nil {
+			t.Fatalf("%s blank: got %q", env, got)
+		}
+		// An empty one is unset
+		t.Setenv(env, "")
+		if got, _, err := Load(env, stored); err != nil || got != "stored words" {
+			t.Fatalf("%s empty: got %q (%v)", env, got, err)
+		}
+		t.Setenv(env, "  env words\n")
+		if got, _, err := Load(env, stored); err != nil || got != "env words" {
+			t.Fatalf("%s: got %q (%v)", env, got, err)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package cdk_ffi
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// SweepResult reports a SweepInactiveKeysets call.
+type SweepResult struct {
+	// Inactive keysets proofs were found on
+	KeysetIds []string
+	// Proofs swapped into the active keyset and their total
+	Swept  int
+	Amount Amount
+	// Input fee paid for the swap
+	Fee Amount
+	// Proofs left on inactive keysets because their value would not cover
+	// their share of the swap fee
+	Dust int
+}
+
+// SweepInactiveKeysets refreshes the keysets of the mint and swaps the
+// unspent proofs of inactive keysets into the active keyset. Proofs worth
+// no more than their own input fee are left where they are and counted as
+// dust; the rest go into a single swap, so the fee is rounded up once.
+func (_self *Wallet) SweepInactiveKeysets(ctx context.Context) (SweepResult, error) {
+	var result SweepResult
+	keysets, err := _self.RefreshKeysetsCtx(ctx)
+	if err != nil {
+		return result, err
+	}
+	var activeIds []string
+	fees := make(map[string]KeySetInfo, len(keysets))
+	for _, keyset := range keysets {
+		fees[keyset.Id] = keyset
+		if keyset.Active {
+			activeIds = append(activeIds, keyset.Id)
+		}
+	}
+
+	proofs, err := _self.GetProofsByStatesCtx(ctx, []ProofState{ProofStateUnspent})
+	if err != nil {
+		return result, err
+	}
+	var inactive []ProofCandidate
+	seen := map[string]bool{}
+	for _, proof := range proofs {
+		if proof.IsActive(activeIds) {
+			continue
+		}
+		id := proof.KeysetId()
+		inactive = append(inactive, ProofCandidate{Proof: proof, Amount: proof.Amount().Value, Keyset: fees[id]})
+		if !seen[id] {
+			seen[id] = true
+			result.KeysetIds = append(result.KeysetIds, id)
+		}
+	}
+
+	worth, dust := sweepable(inactive)
+	result.Dust = dust
+	if len(worth) == 0 {
+		return result, nil
+	}
+	sweep := make([]*Proof, 0, len(worth))
+	var total uint64
+	for _, candidate := range worth {
+		sweep = append(sweep, candidate.Proof)
+		total += candidate.Amount
+	}
+	if _, err := _self.SwapCtx(ctx, nil, SplitTargetNone{}, sweep, nil, false); err != nil {
+		return result, err
+	}
+	result.Swept = len(sweep)
+	result.Amount.Value = total
+	result.Fee.Value = inputFee(worth)
+	return result, nil
+}
+
+// sweepable returns the proofs of inactive keysets worth swapping and the
+// number of the others. Nothing is worth swapping if the proofs do not
+// cover the fee of the swap together.
+func sweepable(inactive []ProofCandidate) ([]ProofCandidate, int) {
+	var worth []ProofCandidate
+	var total uint64
+	for _, candidate := range inactive {
+		if candidate.Amount*1000 > candidate.Keyset.InputFeePpk {
+			worth = append(worth, candidate)
+			total += candidate.Amount
+		}
+	}
+	if len(worth) == 0 || total <= inputFee(worth) {
+		return nil, len(inactive)
+	}
+	return worth, len(inactive) - len(worth)
+}
+
+// KeysetEventKind tells what changed about a keyset.
+type KeysetEventKind int
+
+const (
+	// The mint added a keyset
+	KeysetAdded KeysetEventKind = iota
+	// The mint deactivated a keyset
+	KeysetDeactivated
+	// The final expiry of a keyset is within KeysetWatcherOptions.ExpiryWarning
+	KeysetExpiring
+)
+
+func (k KeysetEventKind) String() string {
+	switch k {
+	case KeysetAdded:
+		return "added"
+	case KeysetDeactivated:
+		return "deactivated"
+	case KeysetExpiring:
+		return "expiring"
+	default:
+		return "unknown"
+	}
+}
+
+// KeysetEvent is emitted by a KeysetWatcher.
+type KeysetEvent struct {
+	Kind     KeysetEventKind
+	MintUrl  MintUrl
+	KeysetId string
+	// Unix time after which the keyset's proofs can no longer be spent, if
+	// the mint set one
+	FinalExpiry *uint64
+}
+
+// KeysetWatcherOptions configures a KeysetWatcher.
+type KeysetWatcherOptions struct {
+	// Time between checks, 1 hour by default
+	Interval time.Duration
+	// How long before its final expiry a keyset is reported expiring, 7
+	// days by default
+	ExpiryWarning time.Duration
+	// Called for every event, from the watcher's goroutine
+	OnEvent func(KeysetEvent)
+	// Run SweepInactiveKeysets whenever a keyset is deactivated
+	AutoSweep bool
+	// Called with the result of automatic sweeps
+	OnSweep func(SweepResult, error)
+	// Called with the error of every failed check run by Start, from the
+	// watcher's goroutine
+	OnError func(error)
+}
+
+// KeysetWatcher notices when the mint of a wallet rotates its keysets or
+// sets a final expiry close to now. The first check records the current
+// keysets; later checks report what changed since.
+type KeysetWatcher struct {
+	wallet *Wallet
+	opts   KeysetWatcherOptions
+
+	mu       sync.Mutex
+	known    map[string]mintKeyset
+	warned   map[string]bool
+	baseline bool
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+// NewKeysetWatcher returns a watcher for the mint of wallet.
+func NewKeysetWatcher(wallet *Wallet, opts KeysetWatcherOptions) *KeysetWatcher {
+	if opts.Interval <= 0 {
+		opts.Interval = time.Hour
+	}
+	if opts.ExpiryWarning <= 0 {
+		opts.ExpiryWarning = 7 * 24 * time.Hour
+	}
+	return &KeysetWatcher{wallet: wallet, opts: opts, known: map[string]mintKeyset{}, warned: map[string]bool{}}
+}
+
+// Check refreshes the wallet's keysets, compares them with the previous
+// check and returns the events, which are also passed to OnEvent.
+func (w *KeysetWatcher) Check(ctx context.Context) ([]KeysetEvent, error) {
+	if _, err := w.wallet.RefreshKeysetsCtx(ctx); err != nil {
+		return nil, err
+	}
+	mintUrl := w.wallet.MintUrl()
+	keysets, err := mintKeysets(ctx, nil, mintUrl)
+	if err != nil {
+		return nil, err
+	}
+	events, deactivated := w.compare(mintUrl, CurrencyUnitString(w.wallet.Unit()), keysets, time.Now())
+
+	if w.opts.OnEvent != nil {
+		for _, event := range events {
+			w.opts.OnEvent(event)
+		}
+	}
+	if deactivated && w.opts.AutoSweep {
+		result, err := w.wallet.SweepInactiveKeysets(ctx)
+		if w.opts.OnSweep != nil {
+			w.opts.OnSweep(result, err)
+		}
+	}
+	return events, nil
+}
+
+// compare records the keysets of unit the mint serves at now and returns
+// the events since the previous call, and whether a keyset was deactivated.
+func (w *KeysetWatcher) compare(mintUrl MintUrl, unit string, keysets []mintKeyset, now time.Time) ([]KeysetEvent, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var events []KeysetEvent
+	deactivated := false
+	for _, keyset := range keysets {
+		if keyset.Unit != "" && keyset.Unit != unit {
+			continue
+		}
+		event := KeysetEvent{MintUrl: mintUrl, KeysetId: keyset.Id, FinalExpiry: keyset.FinalExpiry}
+		previous, ok := w.known[keyset.Id]
+		switch {
+		case !ok && w.baseline:
+			event.Kind = KeysetAdded
+			events = append(events, event)
+		case ok && previous.Active && !keyset.Active:
+			event.Kind = KeysetDeactivated
+			events = append(events, event)
+			deactivated = true
+		}
+		if keyset.FinalExpiry != nil && !w.warned[keyset.Id] {
+			expiry := time.Unix(int64(*keyset.FinalExpiry), 0)
+			if expiry.Sub(now) <= w.opts.ExpiryWarning {
+				w.warned[keyset.Id] = true
+				event.Kind = KeysetExpiring
+				events = append(events, event)
+			}
+		}
+		w.known[keyset.Id] = keyset
+	}
+	w.baseline = true
+	return events, deactivated
+}
+
+// Start checks the keysets every Interval in the background until ctx is
+// done or Stop is called.
+func (w *KeysetWatcher) Start(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.cancel != nil {
+		return errors.New("keyset watcher already started")
+	}
+	ctx, w.cancel = context.WithCancel(ctx)
+	w.done = make(chan struct{})
+	go func(done chan struct{}) {
+		defer close(done)
+		ticker := time.NewTicker(w.opts.Interval)
+		defer ticker.Stop()
+		for {
+			if _, err := w.Check(ctx); err != nil && ctx.Err() == nil && w.opts.OnError != nil {
+				w.opts.OnError(err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}(w.done)
+	return nil
+}
+
+// Stop stops a started watcher and waits for a check in progress.
+func (w *KeysetWatcher) Stop() {
+	w.mu.Lock()
+	cancel, done := w.cancel, w.done
+	w.cancel, w.done = nil, nil
+	w.mu.Unlock()
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
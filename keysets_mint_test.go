@@ -0,0 +1,71 @@
+package cdk_ffi_test
+
+import (
+	"testing"
+
+	cdk "github.com/lescuer97/cdkgo"
+	"github.com/lescuer97/cdkgo/mockmint"
+)
+
+func TestKeysetRotation(t *testing.T) {
+	ctx := testContext(t)
+	mint := mockmint.New(mockmint.Options{})
+	defer mint.Close()
+	wallet := newTestWallet(t, mint, cdk.NewMemoryWalletDatabase())
+	fund(t, ctx, mint, wallet, 100)
+	oldId := mint.ActiveKeysetId()
+
+	var sweeps []cdk.SweepResult
+	watcher := cdk.NewKeysetWatcher(wallet, cdk.KeysetWatcherOptions{
+		AutoSweep: true,
+		OnSweep: func(result cdk.SweepResult, err error) {
+			if err != nil {
+				t.Errorf("sweep: %v", err)
+			}
+			sweeps = append(sweeps, result)
+		},
+	})
+	if events, err := watcher.Check(ctx); err != nil || len(events) != 0 {
+		t.Fatalf("baseline events %+v, %v", events, err)
+	}
+
+	newId := mint.RotateKeyset(0)
+	events, err := watcher.Check(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kinds := map[string]cdk.KeysetEventKind{}
+	for _, event := range events {
+		kinds[event.KeysetId] = event.Kind
+	}
+	if len(events) != 2 || kinds[oldId] != cdk.KeysetDeactivated || kinds[newId] != cdk.KeysetAdded {
+		t.Fatalf("got %+v", events)
+	}
+
+	// The 100 minted on the old keyset, 64, 32 and 4, moved to the new one
+	if len(sweeps) != 1 {
+		t.Fatalf("%d sweeps", len(sweeps))
+	}
+	sweep := sweeps[0]
+	if sweep.Swept != 3 || sweep.Amount.Value != 100 || sweep.Fee.Value != 0 || sweep.Dust != 0 ||
+		len(sweep.KeysetIds) != 1 || sweep.KeysetIds[0] != oldId {
+		t.Fatalf("got %+v", sweep)
+	}
+	proofs, err := wallet.GetProofsByStatesCtx(ctx, []cdk.ProofState{cdk.ProofStateUnspent})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, proof := range proofs {
+		if proof.KeysetId() != newId {
+			t.Fatalf("proof left on keyset %s", proof.KeysetId())
+		}
+	}
+	if got := balance(t, ctx, wallet); got != 100 {
+		t.Fatalf("balance %d", got)
+	}
+
+	// Nothing is left to sweep
+	if result, err := wallet.SweepInactiveKeysets(ctx); err != nil || result.Swept != 0 || len(result.KeysetIds) != 0 {
+		t.Fatalf("got %+v, %v", result, err)
+	}
+}
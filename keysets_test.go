@@ -0,0 +1,127 @@
+package cdk_ffi
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSweepable(t *testing.T) {
+	free := KeySetInfo{Id: "00free"}
+	paid := KeySetInfo{Id: "00paid", InputFeePpk: 1000}
+	pricey := KeySetInfo{Id: "00pricey", InputFeePpk: 3000}
+	odd := KeySetInfo{Id: "00odd", InputFeePpk: 1500}
+	proofs := func(keyset KeySetInfo, amounts ...uint64) []ProofCandidate {
+		var c []ProofCandidate
+		for _, amount := range amounts {
+			c = append(c, ProofCandidate{Amount: amount, Keyset: keyset})
+		}
+		return c
+	}
+
+	tests := []struct {
+		name     string
+		inactive []ProofCandidate
+		worth    []uint64
+		dust     int
+	}{
+		{name: "nothing inactive"},
+		{name: "no fees", inactive: proofs(free, 1, 2, 4), worth: []uint64{1, 2, 4}},
+		// A proof of 1 at 1000 ppk pays exactly its own fee
+		{name: "dust at the fee", inactive: proofs(paid, 1, 8), worth: []uint64{8}, dust: 1},
+		{name: "dust under the fee", inactive: append(proofs(pricey, 2, 4), proofs(paid, 2)...), worth: []uint64{4, 2}, dust: 1},
+		// 2 covers its 1500 ppk but not the fee rounded up to 2
+		{name: "not worth the swap", inactive: append(proofs(odd, 2), proofs(paid, 1)...), dust: 2},
+		{name: "all dust", inactive: proofs(paid, 1, 1), dust: 2},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			worth, dust := sweepable(test.inactive)
+			if !slices.Equal(amounts(worth), test.worth) || dust != test.dust {
+				t.Fatalf("got %v and %d dust, want %v and %d", amounts(worth), dust, test.worth, test.dust)
+			}
+		})
+	}
+}
+
+func TestKeysetWatcherCompare(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	mintUrl := MintUrl{Url: "https://mint.example.com"}
+	expiry := func(d time.Duration) *uint64 {
+		at := uint64(now.Add(d).Unix())
+		return &at
+	}
+	w := NewKeysetWatcher(nil, KeysetWatcherOptions{ExpiryWarning: 24 * time.Hour})
+
+	type event struct {
+		kind KeysetEventKind
+		id   string
+	}
+	steps := []struct {
+		name        string
+		keysets     []mintKeyset
+		events      []event
+		deactivated bool
+	}{
+		{
+			// The first call only records the keysets, but warns about
+			// those expiring already
+			name: "baseline",
+			keysets: []mintKeyset{
+				{Id: "a", Unit: "sat", Active: true},
+				{Id: "old", Unit: "sat", FinalExpiry: expiry(time.Hour)},
+				{Id: "usd", Unit: "usd", Active: true, FinalExpiry: expiry(time.Hour)},
+			},
+			events: []event{{KeysetExpiring, "old"}},
+		},
+		{
+			name: "unchanged",
+			keysets: []mintKeyset{
+				{Id: "a", Unit: "sat", Active: true},
+				{Id: "old", Unit: "sat", FinalExpiry: expiry(time.Hour)},
+			},
+		},
+		{
+			name: "rotated",
+			keysets: []mintKeyset{
+				{Id: "a", Unit: "sat", FinalExpiry: expiry(30 * 24 * time.Hour)},
+				{Id: "b", Unit: "sat", Active: true},
+				{Id: "other", Unit: "usd", Active: true},
+			},
+			events:      []event{{KeysetDeactivated, "a"}, {KeysetAdded, "b"}},
+			deactivated: true,
+		},
+		{
+			// A keyset without unit is taken as the wallet's
+			name: "added expiring",
+			keysets: []mintKeyset{
+				{Id: "a", Unit: "sat", FinalExpiry: expiry(12 * time.Hour)},
+				{Id: "b", Unit: "sat", Active: true},
+				{Id: "c", FinalExpiry: expiry(-time.Hour)},
+			},
+			events: []event{{KeysetExpiring, "a"}, {KeysetAdded, "c"}, {KeysetExpiring, "c"}},
+		},
+		{
+			// Every keyset is warned about once
+			name: "warned",
+			keysets: []mintKeyset{
+				{Id: "a", Unit: "sat", FinalExpiry: expiry(12 * time.Hour)},
+				{Id: "b", Unit: "sat", Active: true},
+				{Id: "c", FinalExpiry: expiry(-time.Hour)},
+			},
+		},
+	}
+	for _, step := range steps {
+		events, deactivated := w.compare(mintUrl, "sat", step.keysets, now)
+		var got []event
+		for _, e := range events {
+			if e.MintUrl != mintUrl {
+				t.Fatalf("%s: event of %s", step.name, e.MintUrl.Url)
+			}
+			got = append(got, event{e.Kind, e.KeysetId})
+		}
+		if !slices.Equal(got, step.events) || deactivated != step.deactivated {
+			t.Fatalf("%s: got %v %v, want %v %v", step.name, got, deactivated, step.events, step.deactivated)
+		}
+	}
+}
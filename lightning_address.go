@@ -0,0 +1,483 @@
+package cdk_ffi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrUnknownLightningUser is returned by a LightningAddressResolver for
+// users without a wallet.
+var ErrUnknownLightningUser = errors.New("unknown lightning address user")
+
+// LightningAddressResolver returns the wallet that receives the payments to
+// a user of the Lightning address server.
+type LightningAddressResolver func(ctx context.Context, user string) (*Wallet, error)
+
+// StaticLightningAddresses resolves the users of a fixed map.
+func StaticLightningAddresses(wallets map[string]*Wallet) LightningAddressResolver {
+	return func(_ context.Context, user string) (*Wallet, error) {
+		if wallet, ok := wallets[user]; ok {
+			return wallet, nil
+		}
+		return nil, ErrUnknownLightningUser
+	}
+}
+
+// MintQuoteWatcher mints quotes handed to it once they are paid.
+type MintQuoteWatcher interface {
+	WatchMintQuote(wallet *Wallet, quote MintQuote)
+}
+
+// WatchMintQuote triggers a sync, so the quote is minted as soon as it is
+// paid. The manager mints through its MultiMintWallet, so it only works for
+// wallets sharing its mnemonic and database; use a WalletQuoteWatcher for
+// resolvers returning other wallets.
+func (m *QuoteManager) WatchMintQuote(_ *Wallet, _ MintQuote) {
+	m.Trigger()
+}
+
+// WalletQuoteWatcherOptions configures a WalletQuoteWatcher.
+type WalletQuoteWatcherOptions struct {
+	// Time between checks of the watched quotes, 5 seconds by default
+	PollInterval time.Duration
+	// Spending conditions of the minted proofs
+	SpendingConditions *SpendingConditions
+	// Client for the quote state lookups, http.DefaultClient if nil
+	HttpClient *http.Client
+	// Called after proofs were minted for a quote
+	OnMinted func(wallet *Wallet, quote MintQuote, proofs []*Proof)
+	// Called when checking or minting a quote failed; it is retried on the
+	// next check
+	OnError func(quote MintQuote, err error)
+}
+
+// WalletQuoteWatcher mints every quote handed to it through the wallet it
+// was handed with, once paid. Quotes expiring unpaid are dropped. The
+// wallets must stay alive while their quotes are watched.
+type WalletQuoteWatcher struct {
+	opts    WalletQuoteWatcherOptions
+	trigger chan struct{}
+
+	mu      sync.Mutex
+	watched map[string]watchedQuote
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+type watchedQuote struct {
+	wallet *Wallet
+	quote  MintQuote
+}
+
+var _ MintQuoteWatcher = (*WalletQuoteWatcher)(nil)
+
+// NewWalletQuoteWatcher returns a watcher that mints once started.
+func NewWalletQuoteWatcher(opts WalletQuoteWatcherOptions) *WalletQuoteWatcher {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = 5 * time.Second
+	}
+	return &WalletQuoteWatcher{opts: opts, trigger: make(chan struct{}, 1), watched: map[string]watchedQuote{}}
+}
+
+// WatchMintQuote adds quote of wallet to the watched quotes.
+func (w *WalletQuoteWatcher) WatchMintQuote(wallet *Wallet, quote MintQuote) {
+	w.mu.Lock()
+	w.watched[quote.Id] = watchedQuote{wallet: wallet, quote: quote}
+	w.mu.Unlock()
+	select {
+	case w.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// Start checks the watched quotes in the background until ctx is done or
+// Stop is called.
+func (w *WalletQuoteWatcher) Start(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.cancel != nil {
+		return errors.New("quote watcher already started")
+	}
+	ctx, w.cancel = context.WithCancel(ctx)
+	w.done = make(chan struct{})
+	go func(done chan struct{}) {
+		defer close(done)
+		ticker := time.NewTicker(w.opts.PollInterval)
+		defer ticker.Stop()
+		for {
+			w.Check(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-w.trigger:
+			}
+		}
+	}(w.done)
+	return nil
+}
+
+// Stop stops a started watcher and waits for a check in progress.
+func (w *WalletQuoteWatcher) Stop() {
+	w.mu.Lock()
+	cancel, done := w.cancel, w.done
+	w.cancel, w.done = nil, nil
+	w.mu.Unlock()
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+// Check checks every watched quote once and mints the paid ones. It returns
+// the number still watched.
+func (w *WalletQuoteWatcher) Check(ctx context.Context) int {
+	w.mu.Lock()
+	watched := make([]watchedQuote, 0, len(w.watched))
+	for _, entry := range w.watched {
+		watched = append(watched, entry)
+	}
+	w.mu.Unlock()
+
+	for _, entry := range watched {
+		if ctx.Err() != nil {
+			break
+		}
+		open, err := w.process(ctx, entry)
+		if err != nil && w.opts.OnError != nil {
+			w.opts.OnError(entry.quote, err)
+		}
+		if !open {
+			w.mu.Lock()
+			delete(w.watched, entry.quote.Id)
+			w.mu.Unlock()
+		}
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.watched)
+}
+
+// process checks one quote and mints it if paid. It reports whether the
+// quote is still outstanding.
+func (w *WalletQuoteWatcher) process(ctx context.Context, entry watchedQuote) (bool, error) {
+	var state struct {
+		State string `json:"state"`
+	}
+	path := "/v1/mint/quote/bolt11/" + entry.quote.Id
+	if err := mintRequest(ctx, w.opts.HttpClient, http.MethodGet, entry.wallet.MintUrl(), path, nil, &state); err != nil {
+		return true, err
+	}
+	switch state.State {
+	case "PAID":
+		proofs, err := entry.wallet.MintCtx(ctx, entry.quote.Id, SplitTargetNone{}, w.opts.SpendingConditions)
+		if err != nil {
+			return true, err
+		}
+		if w.opts.OnMinted != nil {
+			w.opts.OnMinted(entry.wallet, entry.quote, proofs)
+		}
+		return false, nil
+	case "ISSUED":
+		return false, nil
+	}
+	return !quoteExpired(entry.quote), nil
+}
+
+// LightningAddressQuote describes a mint quote created for a payment to a
+// Lightning address.
+type LightningAddressQuote struct {
+	User string
+	// LUD-12 comment of the payer, if any
+	Comment string
+	Quote   MintQuote
+}
+
+// LightningAddressOptions configures a LightningAddressHandler.
+type LightningAddressOptions struct {
+	// Wallet of each user, required
+	Resolve LightningAddressResolver
+	// URL the handler is served at, e.g. "https://example.com", used for
+	// the callback. By default it is taken from the request, over https
+	// unless the host is a loopback or onion address.
+	BaseUrl string
+	// Domain of the addresses, the host of the request by default
+	Domain string
+	// Created quotes are handed to it, e.g. a WalletQuoteWatcher, or a
+	// QuoteManager when Resolve returns wallets of its MultiMintWallet
+	Watcher MintQuoteWatcher
+	// Called for every quote created
+	OnQuote func(LightningAddressQuote)
+	// Longest LUD-12 comment accepted, comments are refused if 0
+	CommentAllowed int
+	// How long the NUT-04 limits of a mint are cached, 5 minutes by default
+	MintInfoTTL time.Duration
+	// Most mint quotes created per user and minute, 10 by default; callbacks
+	// beyond it get 429 Too Many Requests. Negative disables the limit.
+	QuotesPerMinute int
+}
+
+// LightningAddressHandler serves LUD-16 Lightning addresses and their
+// LUD-06 pay requests. Every payment request creates a mint quote on the
+// user's wallet whose invoice is returned to the payer, so payments land
+// straight in ecash once the quote is minted.
+//
+// It handles GET /.well-known/lnurlp/{user} and its callback
+// /.well-known/lnurlp/{user}/callback. Wallets must be in sat or msat.
+// Mints cannot set the description hash LUD-06 asks for, so invoices commit
+// to the metadata with their description instead, if the mint supports
+// descriptions. Payers checking the commitment must accept that, like
+// LnurlInvoice with AcceptDescription.
+type LightningAddressHandler struct {
+	opts LightningAddressOptions
+	mux  *http.ServeMux
+
+	mu      sync.Mutex
+	limits  map[string]lightningAddressLimits
+	buckets map[string]*quoteBucket
+}
+
+// quoteBucket is a token bucket of the quotes a user may still create.
+type quoteBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+type lightningAddressLimits struct {
+	minMsat, maxMsat uint64
+	description      bool
+	fetched          time.Time
+}
+
+// NewLightningAddressHandler returns a handler for the users opts.Resolve
+// knows.
+func NewLightningAddressHandler(opts LightningAddressOptions) *LightningAddressHandler {
+	if opts.MintInfoTTL <= 0 {
+		opts.MintInfoTTL = 5 * time.Minute
+	}
+	if opts.QuotesPerMinute == 0 {
+		opts.QuotesPerMinute = 10
+	}
+	h := &LightningAddressHandler{opts: opts, limits: map[string]lightningAddressLimits{}, buckets: map[string]*quoteBucket{}}
+	h.mux = http.NewServeMux()
+	h.mux.HandleFunc("GET /.well-known/lnurlp/{user}", h.handlePay)
+	h.mux.HandleFunc("GET /.well-known/lnurlp/{user}/callback", h.handleCallback)
+	return h
+}
+
+func (h *LightningAddressHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func writeLnurlJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
+
+func writeLnurlError(w http.ResponseWriter, status int, reason string) {
+	writeLnurlJSON(w, status, map[string]string{"status": "ERROR", "reason": reason})
+}
+
+// wallet resolves the user of the request and the NUT-04 limits of their
+// mint, writing an error response if either fails.
+func (h *LightningAddressHandler) wallet(w http.ResponseWriter, r *http.Request) (string, *Wallet, lightningAddressLimits, bool) {
+	user := strings.ToLower(r.PathValue("user"))
+	wallet, err := h.opts.Resolve(r.Context(), user)
+	if errors.Is(err, ErrUnknownLightningUser) || (err == nil && wallet == nil) {
+		writeLnurlError(w, http.StatusNotFound, "unknown user")
+		return user, nil, lightningAddressLimits{}, false
+	}
+	if err != nil {
+		writeLnurlError(w, http.StatusInternalServerError, err.Error())
+		return user, nil, lightningAddressLimits{}, false
+	}
+	limits, err := h.mintLimits(r.Context(), wallet)
+	if err != nil {
+		writeLnurlError(w, http.StatusServiceUnavailable, err.Error())
+		return user, nil, lightningAddressLimits{}, false
+	}
+	return user, wallet, limits, true
+}
+
+// allowQuote takes a token of the user's bucket, refilled at
+// QuotesPerMinute. Only resolved users get a bucket.
+func (h *LightningAddressHandler) allowQuote(user string) bool {
+	if h.opts.QuotesPerMinute < 0 {
+		return true
+	}
+	burst := float64(h.opts.QuotesPerMinute)
+	now := time.Now()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	bucket, ok := h.buckets[user]
+	if !ok {
+		bucket = &quoteBucket{tokens: burst, updated: now}
+		h.buckets[user] = bucket
+	}
+	bucket.tokens = min(burst, bucket.tokens+now.Sub(bucket.updated).Minutes()*burst)
+	bucket.updated = now
+	if bucket.tokens < 1 {
+		return false
+	}
+	bucket.tokens--
+	return true
+}
+
+func msatPerUnit(unit CurrencyUnit) (uint64, bool) {
+	switch unit.(type) {
+	case CurrencyUnitSat:
+		return 1000, true
+	case CurrencyUnitMsat:
+		return 1, true
+	default:
+		return 0, false
+	}
+}
+
+// mintLimits returns the bolt11 minting limits of the wallet's mint in
+// msat, cached for MintInfoTTL.
+func (h *LightningAddressHandler) mintLimits(ctx context.Context, wallet *Wallet) (lightningAddressLimits, error) {
+	unit := wallet.Unit()
+	key := wallet.MintUrl().Url + "/" + CurrencyUnitString(unit)
+	h.mu.Lock()
+	limits, ok := h.limits[key]
+	h.mu.Unlock()
+	if ok && time.Since(limits.fetched) < h.opts.MintInfoTTL {
+		return limits, nil
+	}
+
+	perUnit, ok := msatPerUnit(unit)
+	if !ok {
+		return limits, errors.New("wallet unit cannot receive lightning payments")
+	}
+	info, err := wallet.GetMintInfoCtx(ctx)
+	if err != nil {
+		return limits, err
+	}
+	limits = lightningAddressLimits{minMsat: perUnit, maxMsat: 100_000_000_000, fetched: time.Now()}
+	if info != nil {
+		if info.Nuts.Nut04.Disabled {
+			return limits, errors.New("mint does not accept deposits")
+		}
+		found := false
+		for _, method := range info.Nuts.Nut04.Methods {
+			if _, ok := method.Method.(PaymentMethodBolt11); !ok || CurrencyUnitString(method.Unit) != CurrencyUnitString(unit) {
+				continue
+			}
+			found = true
+			if method.MinAmount != nil && method.MinAmount.Value*perUnit > limits.minMsat {
+				limits.minMsat = method.MinAmount.Value * perUnit
+			}
+			if method.MaxAmount != nil && method.MaxAmount.Value > 0 {
+				limits.maxMsat = method.MaxAmount.Value * perUnit
+			}
+			limits.description = method.Description != nil && *method.Description
+		}
+		if !found {
+			return limits, errors.New("mint does not accept lightning deposits in the wallet unit")
+		}
+	}
+	h.mu.Lock()
+	h.limits[key] = limits
+	h.mu.Unlock()
+	return limits, nil
+}
+
+func (h *LightningAddressHandler) baseUrl(r *http.Request) string {
+	if h.opts.BaseUrl != "" {
+		return strings.TrimSuffix(h.opts.BaseUrl, "/")
+	}
+	scheme := lnurlScheme(r.Host)
+	if r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
+func (h *LightningAddressHandler) metadata(r *http.Request, user string) string {
+	domain := h.opts.Domain
+	if domain == "" {
+		domain = r.Host
+	}
+	metadata, _ := json.Marshal([][]string{
+		{"text/plain", "Payment to " + user + "@" + domain},
+		{"text/identifier", user + "@" + domain},
+	})
+	return string(metadata)
+}
+
+func (h *LightningAddressHandler) handlePay(w http.ResponseWriter, r *http.Request) {
+	user, _, limits, ok := h.wallet(w, r)
+	if !ok {
+		return
+	}
+	response := map[string]any{
+		"tag":         "payRequest",
+		"callback":    h.baseUrl(r) + "/.well-known/lnurlp/" + user + "/callback",
+		"minSendable": limits.minMsat,
+		"maxSendable": limits.maxMsat,
+		"metadata":    h.metadata(r, user),
+	}
+	if h.opts.CommentAllowed > 0 {
+		response["commentAllowed"] = h.opts.CommentAllowed
+	}
+	writeLnurlJSON(w, http.StatusOK, response)
+}
+
+func (h *LightningAddressHandler) handleCallback(w http.ResponseWriter, r *http.Request) {
+	user, wallet, limits, ok := h.wallet(w, r)
+	if !ok {
+		return
+	}
+	query := r.URL.Query()
+	amountMsat, err := strconv.ParseUint(query.Get("amount"), 10, 64)
+	if err != nil {
+		writeLnurlError(w, http.StatusBadRequest, "invalid amount")
+		return
+	}
+	if amountMsat < limits.minMsat || amountMsat > limits.maxMsat {
+		writeLnurlError(w, http.StatusBadRequest, "amount out of range")
+		return
+	}
+	perUnit, _ := msatPerUnit(wallet.Unit())
+	if amountMsat%perUnit != 0 {
+		writeLnurlError(w, http.StatusBadRequest, "amount must be whole "+CurrencyUnitString(wallet.Unit()))
+		return
+	}
+	comment := query.Get("comment")
+	if len(comment) > h.opts.CommentAllowed {
+		writeLnurlError(w, http.StatusBadRequest, "comment too long")
+		return
+	}
+
+	if !h.allowQuote(user) {
+		writeLnurlError(w, http.StatusTooManyRequests, "too many payment requests, try again later")
+		return
+	}
+
+	var description *string
+	if limits.description {
+		metadata := h.metadata(r, user)
+		description = &metadata
+	}
+	quote, err := wallet.MintQuoteCtx(r.Context(), Amount{Value: amountMsat / perUnit}, description)
+	if err != nil {
+		writeLnurlError(w, http.StatusBadGateway, err.Error())
+		return
+	}
+	if h.opts.Watcher != nil {
+		h.opts.Watcher.WatchMintQuote(wallet, quote)
+	}
+	if h.opts.OnQuote != nil {
+		h.opts.OnQuote(LightningAddressQuote{User: user, Comment: comment, Quote: quote})
+	}
+	writeLnurlJSON(w, http.StatusOK, map[string]any{"pr": quote.Request, "routes": []any{}})
+}
@@ -0,0 +1,219 @@
+package cdk_ffi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	cdk "github.com/lescuer97/cdkgo"
+	"github.com/lescuer97/cdkgo/mockmint"
+)
+
+// serveLightningAddresses serves a LightningAddressHandler for the users
+// of wallets and returns the address of user.
+func serveLightningAddresses(t *testing.T, opts cdk.LightningAddressOptions, wallets map[string]*cdk.Wallet) func(user string) string {
+	t.Helper()
+	server := lightningAddressServer(t, opts, wallets)
+	return func(user string) string {
+		return user + "@" + strings.TrimPrefix(server.URL, "http://")
+	}
+}
+
+func lightningAddressServer(t *testing.T, opts cdk.LightningAddressOptions, wallets map[string]*cdk.Wallet) *httptest.Server {
+	t.Helper()
+	opts.Resolve = cdk.StaticLightningAddresses(wallets)
+	server := httptest.NewServer(cdk.NewLightningAddressHandler(opts))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// getLnurl fetches an LNURL endpoint and decodes its JSON response into out.
+func getLnurl(t *testing.T, endpoint string, query url.Values, out any) int {
+	t.Helper()
+	if query != nil {
+		endpoint += "?" + query.Encode()
+	}
+	resp, err := http.Get(endpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode
+}
+
+func TestMeltLnurlQuoteLightningAddress(t *testing.T) {
+	ctx := testContext(t)
+	lightning := mockmint.NewFakeLightning()
+	receiverMint := mockmint.New(mockmint.Options{Lightning: lightning})
+	defer receiverMint.Close()
+	payerMint := mockmint.New(mockmint.Options{Lightning: lightning})
+	defer payerMint.Close()
+	receiver := newTestWallet(t, receiverMint, cdk.NewMemoryWalletDatabase())
+	payer := newTestWallet(t, payerMint, cdk.NewMemoryWalletDatabase())
+	fund(t, ctx, payerMint, payer, 100)
+
+	minted := make(chan uint64, 1)
+	watcher := cdk.NewWalletQuoteWatcher(cdk.WalletQuoteWatcherOptions{
+		PollInterval: 50 * time.Millisecond,
+		OnMinted: func(_ *cdk.Wallet, quote cdk.MintQuote, _ []*cdk.Proof) {
+			minted <- quote.Amount.Value
+		},
+	})
+	if err := watcher.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	defer watcher.Stop()
+	address := serveLightningAddresses(t, cdk.LightningAddressOptions{Watcher: watcher}, map[string]*cdk.Wallet{"alice": receiver})
+
+	// Mint invoices commit to the metadata with their description only.
+	if _, err := payer.MeltLnurlQuote(ctx, address("alice"), 21_000, cdk.LnurlInvoiceOptions{}); err == nil {
+		t.Fatal("accepted an invoice without a description hash")
+	}
+	quote, err := payer.MeltLnurlQuote(ctx, address("alice"), 21_000, cdk.LnurlInvoiceOptions{AcceptDescription: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	melted, err := payer.MeltCtx(ctx, quote.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if melted.State != cdk.QuoteStatePaid {
+		t.Fatalf("melt ended %v", melted.State)
+	}
+	select {
+	case amount := <-minted:
+		if amount != 21 {
+			t.Fatalf("minted a quote of %d", amount)
+		}
+	case <-ctx.Done():
+		t.Fatal("paid quote not minted")
+	}
+	if got := balance(t, ctx, receiver); got != 21 {
+		t.Fatalf("receiver balance %d, want 21", got)
+	}
+}
+
+func TestLightningAddressHandler(t *testing.T) {
+	mint := mockmint.New(mockmint.Options{MinAmount: 10, MaxAmount: 500})
+	defer mint.Close()
+	wallet := newTestWallet(t, mint, cdk.NewMemoryWalletDatabase())
+	quotes := make(chan cdk.LightningAddressQuote, 10)
+	server := lightningAddressServer(t, cdk.LightningAddressOptions{
+		CommentAllowed: 8,
+		OnQuote:        func(quote cdk.LightningAddressQuote) { quotes <- quote },
+	}, map[string]*cdk.Wallet{"alice": wallet})
+
+	var lnurlErr struct {
+		Status string `json:"status"`
+		Reason string `json:"reason"`
+	}
+	if status := getLnurl(t, server.URL+"/.well-known/lnurlp/bob", nil, &lnurlErr); status != http.StatusNotFound || lnurlErr.Status != "ERROR" {
+		t.Fatalf("unknown user got status %d, %+v", status, lnurlErr)
+	}
+
+	// The limits of NUT-04 are converted to msat.
+	var params cdk.LnurlPayParams
+	if status := getLnurl(t, server.URL+"/.well-known/lnurlp/alice", nil, &params); status != http.StatusOK {
+		t.Fatalf("got status %d", status)
+	}
+	if params.Tag != "payRequest" || params.MinSendable != 10_000 || params.MaxSendable != 500_000 || params.CommentAllowed != 8 {
+		t.Fatalf("params %+v", params)
+	}
+	if params.Callback != server.URL+"/.well-known/lnurlp/alice/callback" {
+		t.Fatalf("callback %s", params.Callback)
+	}
+
+	refused := []url.Values{
+		{"amount": {"9000"}},
+		{"amount": {"501000"}},
+		{"amount": {"10500"}},
+		{"amount": {"ten"}},
+		{"amount": {"21000"}, "comment": {"too long a comment"}},
+	}
+	for _, query := range refused {
+		lnurlErr.Status = ""
+		if status := getLnurl(t, params.Callback, query, &lnurlErr); status != http.StatusBadRequest || lnurlErr.Status != "ERROR" {
+			t.Errorf("%v got status %d, %+v", query, status, lnurlErr)
+		}
+	}
+
+	var response struct {
+		Pr string `json:"pr"`
+	}
+	if status := getLnurl(t, params.Callback, url.Values{"amount": {"21000"}, "comment": {"thanks"}}, &response); status != http.StatusOK {
+		t.Fatalf("got status %d", status)
+	}
+	invoice, err := cdk.DecodeBolt11(response.Pr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *invoice.AmountMsat != 21_000 || invoice.Description == nil || *invoice.Description != params.Metadata {
+		t.Fatalf("invoice %+v", invoice)
+	}
+	// Only the accepted callback created a quote.
+	if quote := <-quotes; quote.User != "alice" || quote.Comment != "thanks" || quote.Quote.Request != response.Pr {
+		t.Fatalf("quote %+v", quote)
+	}
+	if len(quotes) != 0 {
+		t.Fatalf("%d more quotes created", len(quotes))
+	}
+}
+
+func TestWalletQuoteWatcher(t *testing.T) {
+	ctx := testContext(t)
+	mint := mockmint.New(mockmint.Options{})
+	defer mint.Close()
+	wallet := newTestWallet(t, mint, cdk.NewMemoryWalletDatabase())
+	var minted []string
+	watcher := cdk.NewWalletQuoteWatcher(cdk.WalletQuoteWatcherOptions{
+		OnMinted: func(_ *cdk.Wallet, quote cdk.MintQuote, _ []*cdk.Proof) { minted = append(minted, quote.Id) },
+		OnError:  func(quote cdk.MintQuote, err error) { t.Errorf("quote %s: %v", quote.Id, err) },
+	})
+
+	paid, err := wallet.MintQuoteCtx(ctx, cdk.Amount{Value: 40}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	watcher.WatchMintQuote(wallet, paid)
+	if open := watcher.Check(ctx); open != 1 {
+		t.Fatalf("%d quotes watched, want the unpaid one", open)
+	}
+	if err := mint.PayMintQuote(paid.Id); err != nil {
+		t.Fatal(err)
+	}
+	if open := watcher.Check(ctx); open != 0 || len(minted) != 1 || minted[0] != paid.Id {
+		t.Fatalf("%d quotes watched, minted %v", open, minted)
+	}
+	if got := balance(t, ctx, wallet); got != 40 {
+		t.Fatalf("balance %d, want 40", got)
+	}
+
+	// A quote minted elsewhere and one that expired unpaid are dropped.
+	issued, err := wallet.MintQuoteCtx(ctx, cdk.Amount{Value: 8}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mint.PayMintQuote(issued.Id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := wallet.MintCtx(ctx, issued.Id, cdk.SplitTargetNone{}, nil); err != nil {
+		t.Fatal(err)
+	}
+	expired, err := wallet.MintQuoteCtx(ctx, cdk.Amount{Value: 8}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired.Expiry = uint64(time.Now().Add(-time.Minute).Unix())
+	watcher.WatchMintQuote(wallet, issued)
+	watcher.WatchMintQuote(wallet, expired)
+	if open := watcher.Check(ctx); open != 0 || len(minted) != 1 {
+		t.Fatalf("%d quotes watched, minted %v", open, minted)
+	}
+}
@@ -0,0 +1,35 @@
+package cdk_ffi
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLightningAddressQuoteLimit(t *testing.T) {
+	h := NewLightningAddressHandler(LightningAddressOptions{QuotesPerMinute: 2})
+	if !h.allowQuote("alice") || !h.allowQuote("alice") {
+		t.Fatal("quotes within the burst refused")
+	}
+	if h.allowQuote("alice") {
+		t.Fatal("third quote of the minute allowed")
+	}
+	if !h.allowQuote("bob") {
+		t.Fatal("quote of another user refused")
+	}
+
+	// Half a minute refills one of two tokens.
+	h.buckets["alice"].updated = time.Now().Add(-30 * time.Second)
+	if !h.allowQuote("alice") {
+		t.Fatal("quote refused after the refill")
+	}
+	if h.allowQuote("alice") {
+		t.Fatal("refill granted more than one quote")
+	}
+
+	unlimited := NewLightningAddressHandler(LightningAddressOptions{QuotesPerMinute: -1})
+	for i := 0; i < 100; i++ {
+		if !unlimited.allowQuote("alice") {
+			t.Fatal("quote refused without a limit")
+		}
+	}
+}
@@ -0,0 +1,309 @@
+package cdk_ffi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/lescuer97/cdkgo/internal/bech32"
+)
+
+// LnurlError is an {"status": "ERROR"} response of an LNURL service.
+type LnurlError struct {
+	Reason string
+}
+
+func (err *LnurlError) Error() string {
+	return "lnurl: " + err.Reason
+}
+
+// LnurlPayParams is the first response of an LNURL-pay service (LUD-06).
+type LnurlPayParams struct {
+	Callback       string `json:"callback"`
+	MinSendable    uint64 `json:"minSendable"`
+	MaxSendable    uint64 `json:"maxSendable"`
+	Metadata       string `json:"metadata"`
+	CommentAllowed int    `json:"commentAllowed"`
+	Tag            string `json:"tag"`
+}
+
+// LnurlWithdrawParams is the first response of an LNURL-withdraw service
+// (LUD-03).
+type LnurlWithdrawParams struct {
+	Callback           string `json:"callback"`
+	K1                 string `json:"k1"`
+	DefaultDescription string `json:"defaultDescription"`
+	MinWithdrawable    uint64 `json:"minWithdrawable"`
+	MaxWithdrawable    uint64 `json:"maxWithdrawable"`
+	Tag                string `json:"tag"`
+}
+
+// EncodeLnurl returns the bech32 LNURL of a URL (LUD-01).
+func EncodeLnurl(rawUrl string) string {
+	data, _ := bech32.ConvertBits([]byte(rawUrl), 8, 5, true)
+	return strings.ToUpper(bech32.Encode("lnurl", data))
+}
+
+// ResolveLnurl returns the URL an LNURL points to. It accepts bech32
+// LNURLs, lnurlp:// and lnurlw:// URLs (LUD-17), Lightning addresses
+// (LUD-16) and plain URLs, with or without a "lightning:" prefix. Loopback
+// and onion hosts are reached over plain http.
+func ResolveLnurl(lnurl string) (string, error) {
+	lnurl = strings.TrimSpace(lnurl)
+	if len(lnurl) > 10 && strings.EqualFold(lnurl[:10], "lightning:") {
+		lnurl = lnurl[10:]
+	}
+
+	if strings.HasPrefix(strings.ToLower(lnurl), "lnurl1") {
+		hrp, data, err := bech32.Decode(strings.ToLower(lnurl))
+		if err != nil {
+			return "", fmt.Errorf("lnurl: %w", err)
+		}
+		if hrp != "lnurl" {
+			return "", errors.New("lnurl: not an lnurl")
+		}
+		raw, err := bech32.ConvertBits(data, 5, 8, false)
+		if err != nil {
+			return "", fmt.Errorf("lnurl: %w", err)
+		}
+		return string(raw), nil
+	}
+
+	if user, domain, ok := strings.Cut(lnurl, "@"); ok && !strings.Contains(lnurl, "/") {
+		if user == "" || domain == "" {
+			return "", fmt.Errorf("lnurl: invalid lightning address %q", lnurl)
+		}
+		return lnurlScheme(domain) + "://" + domain + "/.well-known/lnurlp/" + url.PathEscape(strings.ToLower(user)), nil
+	}
+
+	parsed, err := url.Parse(lnurl)
+	if err != nil {
+		return "", fmt.Errorf("lnurl: %w", err)
+	}
+	switch strings.ToLower(parsed.Scheme) {
+	case "lnurlp", "lnurlw", "lnurlc", "keyauth":
+		parsed.Scheme = lnurlScheme(parsed.Host)
+	case "https", "http":
+	default:
+		return "", fmt.Errorf("lnurl: unsupported %q", lnurl)
+	}
+	return parsed.String(), nil
+}
+
+func lnurlScheme(host string) string {
+	hostname := host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		hostname = h
+	}
+	if hostname == "localhost" || strings.HasSuffix(hostname, ".onion") {
+		return "http"
+	}
+	if ip := net.ParseIP(hostname); ip != nil && ip.IsLoopback() {
+		return "http"
+	}
+	return "https"
+}
+
+// lnurlGet calls an LNURL endpoint and decodes its JSON response, turning
+// error responses into *LnurlError.
+func lnurlGet(ctx context.Context, client *http.Client, endpoint string, query url.Values, out any) error {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("lnurl: %w", err)
+	}
+	if len(query) > 0 {
+		values := parsed.Query()
+		for key, value := range query {
+			values[key] = value
+		}
+		parsed.RawQuery = values.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, parsed.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+
+	var status struct {
+		Status string `json:"status"`
+		Reason string `json:"reason"`
+	}
+	if json.Unmarshal(body, &status) == nil && strings.EqualFold(status.Status, "ERROR") {
+		return &LnurlError{Reason: status.Reason}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("lnurl %s: unexpected status %s", parsed.Host, resp.Status)
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("decode lnurl response: %w", err)
+	}
+	return nil
+}
+
+// FetchLnurlPay resolves lnurl and returns the parameters of its LNURL-pay
+// service. client defaults to http.DefaultClient.
+func FetchLnurlPay(ctx context.Context, client *http.Client, lnurl string) (LnurlPayParams, error) {
+	var params LnurlPayParams
+	endpoint, err := ResolveLnurl(lnurl)
+	if err != nil {
+		return params, err
+	}
+	if err := lnurlGet(ctx, client, endpoint, nil, &params); err != nil {
+		return params, err
+	}
+	if params.Tag != "payRequest" {
+		return params, fmt.Errorf("lnurl: expected payRequest, got %q", params.Tag)
+	}
+	return params, nil
+}
+
+// FetchLnurlWithdraw resolves lnurl and returns the parameters of its
+// LNURL-withdraw service. client defaults to http.DefaultClient.
+func FetchLnurlWithdraw(ctx context.Context, client *http.Client, lnurl string) (LnurlWithdrawParams, error) {
+	var params LnurlWithdrawParams
+	endpoint, err := ResolveLnurl(lnurl)
+	if err != nil {
+		return params, err
+	}
+	if err := lnurlGet(ctx, client, endpoint, nil, &params); err != nil {
+		return params, err
+	}
+	if params.Tag != "withdrawRequest" {
+		return params, fmt.Errorf("lnurl: expected withdrawRequest, got %q", params.Tag)
+	}
+	return params, nil
+}
+
+// LnurlInvoiceOptions configures LnurlInvoice.
+type LnurlInvoiceOptions struct {
+	// LUD-12 comment, only sent if not empty
+	Comment string
+	// Also accept an invoice whose description, rather than its description
+	// hash, is the metadata. Mints can only set descriptions (NUT-04), so
+	// services handing out mint invoices, like LightningAddressHandler,
+	// commit to the metadata that way.
+	AcceptDescription bool
+}
+
+// LnurlInvoice asks an LNURL-pay service for an invoice of amountMsat and
+// checks it is for that amount and commits to the service's metadata with
+// its description hash, as LUD-06 requires.
+func LnurlInvoice(ctx context.Context, client *http.Client, params LnurlPayParams, amountMsat uint64, opts LnurlInvoiceOptions) (string, error) {
+	if amountMsat < params.MinSendable || amountMsat > params.MaxSendable {
+		return "", fmt.Errorf("lnurl: amount %d msat outside of %d-%d msat", amountMsat, params.MinSendable, params.MaxSendable)
+	}
+	query := url.Values{"amount": {strconv.FormatUint(amountMsat, 10)}}
+	if opts.Comment != "" {
+		if len(opts.Comment) > params.CommentAllowed {
+			return "", fmt.Errorf("lnurl: comment longer than the %d characters allowed", params.CommentAllowed)
+		}
+		query.Set("comment", opts.Comment)
+	}
+	var response struct {
+		Pr string `json:"pr"`
+	}
+	if err := lnurlGet(ctx, client, params.Callback, query, &response); err != nil {
+		return "", err
+	}
+
+	invoice, err := DecodeBolt11(response.Pr)
+	if err != nil {
+		return "", err
+	}
+	if invoice.AmountMsat == nil || *invoice.AmountMsat != amountMsat {
+		return "", errors.New("lnurl: invoice amount does not match the requested amount")
+	}
+	if opts.AcceptDescription && invoice.Description != nil {
+		if *invoice.Description != params.Metadata {
+			return "", errors.New("lnurl: invoice description does not match the metadata")
+		}
+		return response.Pr, nil
+	}
+	if invoice.DescriptionHash == nil {
+		return "", errors.New("lnurl: invoice has no description hash")
+	}
+	hash := sha256.Sum256([]byte(params.Metadata))
+	if *invoice.DescriptionHash != hex.EncodeToString(hash[:]) {
+		return "", errors.New("lnurl: invoice description hash does not match the metadata")
+	}
+	return response.Pr, nil
+}
+
+// MeltLnurlQuote resolves an LNURL-pay service or Lightning address, fetches
+// an invoice of amountMsat from it, see LnurlInvoice, and returns a melt
+// quote paying it.
+func (_self *Wallet) MeltLnurlQuote(ctx context.Context, lnurlOrAddress string, amountMsat uint64, opts LnurlInvoiceOptions) (MeltQuote, error) {
+	params, err := FetchLnurlPay(ctx, nil, lnurlOrAddress)
+	if err != nil {
+		return MeltQuote{}, err
+	}
+	invoice, err := LnurlInvoice(ctx, nil, params, amountMsat, opts)
+	if err != nil {
+		return MeltQuote{}, err
+	}
+	return _self.MeltQuoteCtx(ctx, invoice, nil)
+}
+
+// RedeemLnurlWithdraw creates a mint quote for amountMsat and has the
+// LNURL-withdraw service pay its invoice. The wallet must be in sat or msat,
+// and amountMsat whole satoshis for a sat wallet; nil withdraws the most the
+// service allows. The returned quote is minted once paid, by Mint or a
+// QuoteManager.
+func (_self *Wallet) RedeemLnurlWithdraw(ctx context.Context, lnurl string, amountMsat *uint64) (MintQuote, error) {
+	perUnit, ok := msatPerUnit(_self.Unit())
+	if !ok {
+		return MintQuote{}, errors.New("lnurl withdraw needs a sat or msat wallet")
+	}
+
+	params, err := FetchLnurlWithdraw(ctx, nil, lnurl)
+	if err != nil {
+		return MintQuote{}, err
+	}
+	value := params.MaxWithdrawable / perUnit
+	if amountMsat != nil {
+		if *amountMsat%perUnit != 0 {
+			return MintQuote{}, fmt.Errorf("lnurl: withdraw of %d msat is not whole units of the wallet", *amountMsat)
+		}
+		value = *amountMsat / perUnit
+	}
+	if value == 0 || value*perUnit < params.MinWithdrawable || value*perUnit > params.MaxWithdrawable {
+		return MintQuote{}, fmt.Errorf("lnurl: withdraw of %d msat outside of %d-%d msat", value*perUnit, params.MinWithdrawable, params.MaxWithdrawable)
+	}
+
+	var description *string
+	if params.DefaultDescription != "" {
+		description = &params.DefaultDescription
+	}
+	quote, err := _self.MintQuoteCtx(ctx, Amount{Value: value}, description)
+	if err != nil {
+		return MintQuote{}, err
+	}
+	var response struct {
+		Status string `json:"status"`
+	}
+	query := url.Values{"k1": {params.K1}, "pr": {quote.Request}}
+	if err := lnurlGet(ctx, nil, params.Callback, query, &response); err != nil {
+		return quote, err
+	}
+	return quote, nil
+}
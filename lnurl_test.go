@@ -0,0 +1,81 @@
+package cdk_ffi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	cdk "github.com/lescuer97/cdkgo"
+	"github.com/lescuer97/cdkgo/mockmint"
+)
+
+func TestLnurlInvoice(t *testing.T) {
+	ctx := context.Background()
+	server := mockmint.NewLnurlServer(mockmint.NewFakeLightning())
+	defer server.Close()
+
+	params, err := cdk.FetchLnurlPay(ctx, nil, server.Address("alice"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	invoice, err := cdk.LnurlInvoice(ctx, nil, params, 21_000, cdk.LnurlInvoiceOptions{Comment: "thanks"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := cdk.DecodeBolt11(invoice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *decoded.AmountMsat != 21_000 || decoded.DescriptionHash == nil {
+		t.Fatalf("invoice %+v", decoded)
+	}
+	if comments := server.Comments("alice"); len(comments) != 1 || comments[0] != "thanks" {
+		t.Fatalf("comments %v", comments)
+	}
+	if _, err := cdk.LnurlInvoice(ctx, nil, params, 500, cdk.LnurlInvoiceOptions{}); err == nil {
+		t.Fatal("requested an invoice below minSendable")
+	}
+}
+
+func TestLnurlInvoiceDescriptionHash(t *testing.T) {
+	ctx := context.Background()
+	lightning := mockmint.NewFakeLightning()
+	var invoice string
+	service := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{"pr": invoice})
+	}))
+	defer service.Close()
+	params := cdk.LnurlPayParams{Callback: service.URL, MinSendable: 1_000, MaxSendable: 100_000, Metadata: `[["text/plain","hi"]]`, Tag: "payRequest"}
+
+	// LUD-06 invoices commit to the metadata with the h tag.
+	var err error
+	if invoice, _, err = lightning.CreateInvoice(10_000, params.Metadata); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cdk.LnurlInvoice(ctx, nil, params, 10_000, cdk.LnurlInvoiceOptions{}); err == nil {
+		t.Fatal("accepted an invoice without a description hash")
+	}
+	if _, err := cdk.LnurlInvoice(ctx, nil, params, 10_000, cdk.LnurlInvoiceOptions{AcceptDescription: true}); err != nil {
+		t.Fatal(err)
+	}
+	if invoice, _, err = lightning.CreateInvoice(10_000, "hi"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cdk.LnurlInvoice(ctx, nil, params, 10_000, cdk.LnurlInvoiceOptions{AcceptDescription: true}); err == nil {
+		t.Fatal("accepted an invoice described with other text")
+	}
+	if invoice, _, err = lightning.CreateInvoiceHashed(10_000, `[["text/plain","other"]]`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cdk.LnurlInvoice(ctx, nil, params, 10_000, cdk.LnurlInvoiceOptions{}); err == nil {
+		t.Fatal("accepted an invoice for other metadata")
+	}
+	if invoice, _, err = lightning.CreateInvoiceHashed(10_000, params.Metadata); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cdk.LnurlInvoice(ctx, nil, params, 10_000, cdk.LnurlInvoiceOptions{}); err != nil {
+		t.Fatal(err)
+	}
+}
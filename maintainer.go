@@ -0,0 +1,364 @@
+package cdk_ffi
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// MaintainerOptions configures a WalletMaintainer.
+type MaintainerOptions struct {
+	// Time between runs, 5 minutes by default
+	Interval time.Duration
+	// Reserved proofs not belonging to a tracked PreparedSend are reclaimed
+	// once they have been seen reserved for this long. Zero never reclaims
+	// them: other code using the wallet may hold sends the maintainer does
+	// not know of, so only set it when every send is tracked.
+	StaleReservation time.Duration
+	// Tracked PreparedSends are cancelled after this long, 15 minutes by
+	// default
+	PreparedSendExpiry time.Duration
+	// Proofs of sent tokens the receiver has not claimed after this long are
+	// swapped back into the wallet. Zero never reclaims them.
+	ReclaimUnclaimedAfter time.Duration
+	// Every notification received on this channel triggers a run, e.g. the
+	// proof state notifications of Wallet.SubscribeChan
+	Notifications <-chan NotificationPayload
+	// Called with the report of every run, from the maintainer's goroutine
+	OnReport func(MaintenanceReport)
+}
+
+// MaintenanceReport describes one run of a WalletMaintainer.
+type MaintenanceReport struct {
+	Started  time.Time
+	Finished time.Time
+	// Amount returned by CheckAllPendingProofs
+	PendingChecked Amount
+	// Reserved and pending-spent proofs checked with the mint
+	Checked int
+	// Proofs the mint reported spent, now marked spent
+	MarkedSpent int
+	// Unspent proofs swapped back into the wallet and their amount
+	Reclaimed       int
+	ReclaimedAmount Amount
+	// Tracked PreparedSends cancelled because they expired
+	ExpiredSends int
+	// Errors of the steps that failed; the other steps still ran
+	Errors []error
+}
+
+// Err joins the errors of the run, nil if every step succeeded.
+func (r MaintenanceReport) Err() error {
+	return errors.Join(r.Errors...)
+}
+
+type trackedSend struct {
+	send    *PreparedSend
+	created time.Time
+	ys      []string
+}
+
+// WalletMaintainer reconciles the proofs a wallet left pending or reserved,
+// e.g. after the app crashed in the middle of a send. Each run:
+//
+//   - calls CheckAllPendingProofs,
+//   - cancels tracked PreparedSends older than PreparedSendExpiry,
+//   - if enabled, checks reserved proofs that stayed reserved for
+//     StaleReservation and pending-spent proofs that stayed so for
+//     ReclaimUnclaimedAfter with the mint (NUT-07), marking spent ones spent
+//     and reclaiming unspent ones.
+//
+// Runs happen every Interval, on Trigger and on Notifications, never
+// concurrently.
+type WalletMaintainer struct {
+	wallet *Wallet
+	opts   MaintainerOptions
+	now    func() time.Time
+
+	runMu   sync.Mutex
+	trigger chan struct{}
+
+	mu        sync.Mutex
+	firstSeen map[string]time.Time
+	sends     map[*PreparedSend]*trackedSend
+	last      *MaintenanceReport
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+// NewWalletMaintainer returns a maintainer for wallet. Call Start to run it
+// on a schedule or RunOnce to run it directly.
+func NewWalletMaintainer(wallet *Wallet, opts MaintainerOptions) *WalletMaintainer {
+	if opts.Interval <= 0 {
+		opts.Interval = 5 * time.Minute
+	}
+	if opts.PreparedSendExpiry <= 0 {
+		opts.PreparedSendExpiry = 15 * time.Minute
+	}
+	return &WalletMaintainer{
+		wallet:    wallet,
+		opts:      opts,
+		now:       time.Now,
+		trigger:   make(chan struct{}, 1),
+		firstSeen: map[string]time.Time{},
+		sends:     map[*PreparedSend]*trackedSend{},
+	}
+}
+
+// TrackPreparedSend registers a send so its reserved proofs are left alone
+// until it expires, and it is cancelled then. Call ForgetPreparedSend once
+// it is confirmed or cancelled.
+func (m *WalletMaintainer) TrackPreparedSend(send *PreparedSend) {
+	tracked := &trackedSend{send: send, created: m.now()}
+	for _, proof := range send.Proofs() {
+		if y, err := proof.Y(); err == nil {
+			tracked.ys = append(tracked.ys, y)
+		}
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sends[send] = tracked
+}
+
+// ForgetPreparedSend stops tracking send.
+func (m *WalletMaintainer) ForgetPreparedSend(send *PreparedSend) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.sends, send)
+}
+
+// Start runs the maintainer in the background until ctx is done or Stop is
+// called. The first run starts immediately.
+func (m *WalletMaintainer) Start(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.cancel != nil {
+		return errors.New("wallet maintainer already started")
+	}
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.done = make(chan struct{})
+	go m.loop(ctx, m.done)
+	return nil
+}
+
+// Stop stops a started maintainer and waits for a run in progress.
+func (m *WalletMaintainer) Stop() {
+	m.mu.Lock()
+	cancel, done := m.cancel, m.done
+	m.cancel, m.done = nil, nil
+	m.mu.Unlock()
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+// Trigger asks a started maintainer to run as soon as possible.
+func (m *WalletMaintainer) Trigger() {
+	select {
+	case m.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// LastReport returns the report of the latest run, nil before the first.
+func (m *WalletMaintainer) LastReport() *MaintenanceReport {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.last
+}
+
+func (m *WalletMaintainer) loop(ctx context.Context, done chan struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(m.opts.Interval)
+	defer ticker.Stop()
+	notifications := m.opts.Notifications
+	for {
+		report := m.RunOnce(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if m.opts.OnReport != nil {
+			m.opts.OnReport(report)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-m.trigger:
+		case _, ok := <-notifications:
+			if !ok {
+				notifications = nil
+			}
+		}
+	}
+}
+
+// RunOnce runs the maintenance steps once and returns the report.
+func (m *WalletMaintainer) RunOnce(ctx context.Context) MaintenanceReport {
+	m.runMu.Lock()
+	defer m.runMu.Unlock()
+
+	report := MaintenanceReport{Started: time.Now()}
+	fail := func(err error) {
+		if err != nil {
+			report.Errors = append(report.Errors, err)
+		}
+	}
+
+	pending, err := m.wallet.CheckAllPendingProofsCtx(ctx)
+	fail(err)
+	report.PendingChecked = pending
+
+	fail(m.expireSends(ctx, &report))
+
+	candidates, err := m.staleProofs(ctx)
+	fail(err)
+	if len(candidates) > 0 {
+		fail(m.reconcile(ctx, candidates, &report))
+	}
+
+	report.Finished = time.Now()
+	m.mu.Lock()
+	m.last = &report
+	m.mu.Unlock()
+	return report
+}
+
+func (m *WalletMaintainer) expireSends(ctx context.Context, report *MaintenanceReport) error {
+	var errs []error
+	for _, tracked := range m.expiredSends() {
+		if err := tracked.send.CancelCtx(ctx); err != nil {
+			errs = append(errs, err)
+		} else {
+			report.ExpiredSends++
+		}
+		// A send that cannot be cancelled was confirmed or already cancelled;
+		// either way it is not tracked any longer.
+		m.ForgetPreparedSend(tracked.send)
+	}
+	return errors.Join(errs...)
+}
+
+// expiredSends returns the tracked sends older than PreparedSendExpiry.
+func (m *WalletMaintainer) expiredSends() []*trackedSend {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	var expired []*trackedSend
+	for _, tracked := range m.sends {
+		if now.Sub(tracked.created) >= m.opts.PreparedSendExpiry {
+			expired = append(expired, tracked)
+		}
+	}
+	return expired
+}
+
+// staleProofs returns, if StaleReservation is set, the reserved proofs
+// that outlived it and, if ReclaimUnclaimedAfter is set, the pending-spent
+// proofs that outlived it. Proofs of tracked sends are skipped.
+func (m *WalletMaintainer) staleProofs(ctx context.Context) ([]*Proof, error) {
+	var reserved, pendingSpent []*Proof
+	var err error
+	if m.opts.StaleReservation > 0 {
+		if reserved, err = m.wallet.GetProofsByStatesCtx(ctx, []ProofState{ProofStateReserved}); err != nil {
+			return nil, err
+		}
+	}
+	if m.opts.ReclaimUnclaimedAfter > 0 {
+		if pendingSpent, err = m.wallet.GetProofsByStatesCtx(ctx, []ProofState{ProofStatePendingSpent}); err != nil {
+			return nil, err
+		}
+	}
+
+	byY := map[string]*Proof{}
+	ys := func(proofs []*Proof) []string {
+		var ys []string
+		for _, proof := range proofs {
+			if y, err := proof.Y(); err == nil {
+				byY[y] = proof
+				ys = append(ys, y)
+			}
+		}
+		return ys
+	}
+	var stale []*Proof
+	for _, y := range m.staleYs(ys(reserved), ys(pendingSpent)) {
+		stale = append(stale, byY[y])
+	}
+	return stale, nil
+}
+
+// staleYs records when the reserved and pending-spent proofs with the
+// given Ys were first seen, forgetting proofs no longer in either state,
+// and returns those that outlived their threshold. Proofs of tracked sends
+// are skipped.
+func (m *WalletMaintainer) staleYs(reserved, pendingSpent []string) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	tracked := map[string]bool{}
+	for _, send := range m.sends {
+		for _, y := range send.ys {
+			tracked[y] = true
+		}
+	}
+
+	now := m.now()
+	seen := map[string]bool{}
+	var stale []string
+	collect := func(ys []string, after time.Duration) {
+		for _, y := range ys {
+			if tracked[y] {
+				continue
+			}
+			seen[y] = true
+			first, ok := m.firstSeen[y]
+			if !ok {
+				m.firstSeen[y] = now
+				continue
+			}
+			if now.Sub(first) >= after {
+				stale = append(stale, y)
+			}
+		}
+	}
+	collect(reserved, m.opts.StaleReservation)
+	collect(pendingSpent, m.opts.ReclaimUnclaimedAfter)
+	for y := range m.firstSeen {
+		if !seen[y] {
+			delete(m.firstSeen, y)
+		}
+	}
+	return stale
+}
+
+// reconcile checks proofs with the mint, which marks the spent ones spent
+// in the wallet, and swaps the unspent ones back into the wallet.
+func (m *WalletMaintainer) reconcile(ctx context.Context, proofs []*Proof, report *MaintenanceReport) error {
+	spent, err := m.wallet.CheckProofsSpentCtx(ctx, proofs)
+	if err != nil {
+		return err
+	}
+	report.Checked += len(proofs)
+
+	var unspent []*Proof
+	var unspentAmount uint64
+	for i, proof := range proofs {
+		if i < len(spent) && spent[i] {
+			report.MarkedSpent++
+			continue
+		}
+		unspent = append(unspent, proof)
+		unspentAmount += proof.Amount().Value
+	}
+	if len(unspent) == 0 {
+		return nil
+	}
+	if err := m.wallet.ReclaimUnspentCtx(ctx, unspent); err != nil {
+		return err
+	}
+	report.Reclaimed += len(unspent)
+	report.ReclaimedAmount.Value += unspentAmount
+	return nil
+}
@@ -0,0 +1,106 @@
+package cdk_ffi
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// fakeClock returns a maintainer clock and a function advancing it.
+func fakeClock() (func() time.Time, func(time.Duration)) {
+	now := time.Unix(1_700_000_000, 0)
+	return func() time.Time { return now }, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestMaintainerStaleYs(t *testing.T) {
+	m := NewWalletMaintainer(nil, MaintainerOptions{StaleReservation: 10 * time.Minute, ReclaimUnclaimedAfter: time.Hour})
+	var advance func(time.Duration)
+	m.now, advance = fakeClock()
+	m.sends[&PreparedSend{}] = &trackedSend{created: m.now(), ys: []string{"tracked"}}
+
+	steps := []struct {
+		name         string
+		after        time.Duration
+		reserved     []string
+		pendingSpent []string
+		stale        []string
+		seen         []string
+	}{
+		{
+			name:         "first seen",
+			reserved:     []string{"r1", "r2", "tracked"},
+			pendingSpent: []string{"p1"},
+			seen:         []string{"p1", "r1", "r2"},
+		},
+		{
+			// r2 was released, so it is forgotten
+			name:         "reserved threshold",
+			after:        10 * time.Minute,
+			reserved:     []string{"r1", "tracked"},
+			pendingSpent: []string{"p1"},
+			stale:        []string{"r1"},
+			seen:         []string{"p1", "r1"},
+		},
+		{
+			// Reserved again, r2 starts over
+			name:         "seen again",
+			after:        10 * time.Minute,
+			reserved:     []string{"r1", "r2"},
+			pendingSpent: []string{"p1"},
+			stale:        []string{"r1"},
+			seen:         []string{"p1", "r1", "r2"},
+		},
+		{
+			name:         "under the pending-spent threshold",
+			after:        39 * time.Minute,
+			pendingSpent: []string{"p1"},
+			seen:         []string{"p1"},
+		},
+		{
+			name:         "pending-spent threshold",
+			after:        time.Minute,
+			pendingSpent: []string{"p1"},
+			stale:        []string{"p1"},
+			seen:         []string{"p1"},
+		},
+		{
+			name: "nothing left",
+		},
+	}
+	for _, step := range steps {
+		advance(step.after)
+		stale := m.staleYs(step.reserved, step.pendingSpent)
+		var seen []string
+		for y := range m.firstSeen {
+			seen = append(seen, y)
+		}
+		slices.Sort(seen)
+		if !slices.Equal(stale, step.stale) || !slices.Equal(seen, step.seen) {
+			t.Fatalf("%s: stale %v, seen %v; want %v, %v", step.name, stale, seen, step.stale, step.seen)
+		}
+	}
+}
+
+func TestMaintainerExpiredSends(t *testing.T) {
+	m := NewWalletMaintainer(nil, MaintainerOptions{})
+	var advance func(time.Duration)
+	m.now, advance = fakeClock()
+	first := &trackedSend{send: &PreparedSend{}, created: m.now()}
+	m.sends[first.send] = first
+	advance(10 * time.Minute)
+	second := &trackedSend{send: &PreparedSend{}, created: m.now()}
+	m.sends[second.send] = second
+
+	if expired := m.expiredSends(); len(expired) != 0 {
+		t.Fatalf("expired %d sends", len(expired))
+	}
+	// The default expiry is 15 minutes
+	advance(5 * time.Minute)
+	if expired := m.expiredSends(); len(expired) != 1 || expired[0] != first {
+		t.Fatalf("expired %v", expired)
+	}
+	advance(10 * time.Minute)
+	if expired := m.expiredSends(); len(expired) != 2 {
+		t.Fatalf("expired %d sends", len(expired))
+	}
+}
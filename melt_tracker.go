@@ -0,0 +1,426 @@
+package cdk_ffi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MeltRecord is a melt a MeltTracker started and has not resolved yet.
+type MeltRecord struct {
+	QuoteId string `json:"quote_id"`
+	MintUrl string `json:"mint_url"`
+	// NUT-05 payment method of the quote, as in PaymentMethodString; bolt11
+	// if empty
+	Method  string `json:"method,omitempty"`
+	Started int64  `json:"started"`
+	// Ys of the unspent proofs when the melt started, the only proofs the
+	// wallet can pass to it
+	Unspent []string `json:"unspent,omitempty"`
+	// Ys of the melt's inputs, once known
+	Inputs []string `json:"inputs,omitempty"`
+}
+
+// MeltStore persists the melts of a MeltTracker across restarts. The bolt
+// database of the boltdb package implements it.
+type MeltStore interface {
+	// Add or replace the record of a melt
+	PutMelt(record MeltRecord) error
+	// Get the recorded melts, oldest first
+	GetMelts() ([]MeltRecord, error)
+	// Remove the record of a melt
+	RemoveMelt(quoteId string) error
+}
+
+// MemoryMeltStore is a MeltStore that forgets everything with the process.
+type MemoryMeltStore struct {
+	mu    sync.Mutex
+	melts map[string]MeltRecord
+}
+
+var _ MeltStore = (*MemoryMeltStore)(nil)
+
+// NewMemoryMeltStore returns an empty in-memory melt store.
+func NewMemoryMeltStore() *MemoryMeltStore {
+	return &MemoryMeltStore{melts: map[string]MeltRecord{}}
+}
+
+// Add or replace the record of a melt
+func (s *MemoryMeltStore) PutMelt(record MeltRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.melts[record.QuoteId] = record
+	return nil
+}
+
+// Get the recorded melts, oldest first
+func (s *MemoryMeltStore) GetMelts() ([]MeltRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records := make([]MeltRecord, 0, len(s.melts))
+	for _, record := range s.melts {
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].Started < records[j].Started })
+	return records, nil
+}
+
+// Remove the record of a melt
+func (s *MemoryMeltStore) RemoveMelt(quoteId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.melts, quoteId)
+	return nil
+}
+
+// MeltEventKind tells how a tracked melt ended.
+type MeltEventKind int
+
+const (
+	// The mint paid the request
+	MeltPaid MeltEventKind = iota
+	// The payment failed and the inputs were reclaimed
+	MeltFailed
+)
+
+func (k MeltEventKind) String() string {
+	switch k {
+	case MeltPaid:
+		return "paid"
+	case MeltFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// MeltEvent is emitted by a MeltTracker when a melt resolves.
+type MeltEvent struct {
+	Kind    MeltEventKind
+	QuoteId string
+	MintUrl MintUrl
+	// Payment preimage, if the mint returned one
+	Preimage *string
+	// Change proofs, when the melt completed in this process. A melt
+	// resumed after a restart recovers its change with Restore, whose total
+	// is in ChangeAmount.
+	Change       []*Proof
+	ChangeAmount Amount
+	// Inputs swapped back into the wallet after a failed payment
+	Reclaimed Amount
+	// Error finalizing the melt, e.g. restoring the change failed
+	Err error
+}
+
+// MeltTrackerOptions configures a MeltTracker.
+type MeltTrackerOptions struct {
+	// Time between checks of pending melts, 30 seconds by default
+	Interval time.Duration
+	// Called when a melt resolves, from the goroutine that resolved it
+	OnEvent func(MeltEvent)
+	// Client for the quote and proof state lookups, http.DefaultClient if
+	// nil
+	HttpClient *http.Client
+	// Called with the error of every failed Resume run by Start, from the
+	// tracker's goroutine
+	OnError func(error)
+}
+
+// MeltTracker runs melts of a wallet so that a payment left pending, or
+// interrupted by the process dying, is finished later: each melt is
+// recorded in the store before it starts, and resumed melts are resolved
+// by the quote state at the mint. Paid melts recover their change and
+// failed ones reclaim their inputs.
+//
+// A melt's inputs are the proofs that were unspent when it started and
+// that it left pending, at the mint as well as in the wallet. Melts of the
+// wallet should not run outside the tracker, or their inputs may be taken
+// for a tracked melt's.
+type MeltTracker struct {
+	wallet *Wallet
+	store  MeltStore
+	opts   MeltTrackerOptions
+
+	meltMu sync.Mutex
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewMeltTracker returns a tracker for the melts of wallet, persisted in
+// store.
+func NewMeltTracker(wallet *Wallet, store MeltStore, opts MeltTrackerOptions) *MeltTracker {
+	if opts.Interval <= 0 {
+		opts.Interval = 30 * time.Second
+	}
+	return &MeltTracker{wallet: wallet, store: store, opts: opts}
+}
+
+// Melt melts quote, a melt quote of the wallet of any payment method, like
+// Wallet.Melt. If the mint reports the payment pending, or the call fails
+// without the mint having failed the payment, the melt stays tracked and
+// is resolved by Resume.
+func (t *MeltTracker) Melt(ctx context.Context, quote MeltQuote) (Melted, error) {
+	t.meltMu.Lock()
+	defer t.meltMu.Unlock()
+
+	unspent, err := t.stateYs(ctx, ProofStateUnspent)
+	if err != nil {
+		return Melted{}, err
+	}
+	quoteId := quote.Id
+	record := MeltRecord{
+		QuoteId: quoteId,
+		MintUrl: t.wallet.MintUrl().Url,
+		Method:  PaymentMethodString(quote.PaymentMethod),
+		Started: time.Now().Unix(),
+		Unspent: unspent,
+	}
+	if err := t.store.PutMelt(record); err != nil {
+		return Melted{}, err
+	}
+
+	melted, err := t.wallet.MeltCtx(ctx, quoteId)
+	if err != nil {
+		// The mint may still have the payment in flight.
+		if _, resolveErr := t.resolve(ctx, record); resolveErr != nil {
+			return melted, errors.Join(err, resolveErr)
+		}
+		return melted, err
+	}
+
+	switch melted.State {
+	case QuoteStatePaid:
+		event := MeltEvent{Kind: MeltPaid, QuoteId: quoteId, MintUrl: t.wallet.MintUrl(), Preimage: melted.Preimage}
+		if melted.Change != nil {
+			event.Change = *melted.Change
+			for _, proof := range event.Change {
+				event.ChangeAmount.Value += proof.Amount().Value
+			}
+		}
+		t.finish(record, event)
+	case QuoteStateUnpaid:
+		_, err = t.resolve(ctx, record)
+	default:
+		// The mint holds the inputs of a payment in flight as pending.
+		var inputs []*Proof
+		inputs, err = t.candidates(ctx, record, func(state ProofState) bool { return state == ProofStatePending })
+		if err == nil {
+			record.Inputs = proofYs(inputs)
+			err = t.store.PutMelt(record)
+		}
+	}
+	return melted, err
+}
+
+// Resume resolves every tracked melt of the wallet's mint the mint has
+// finished, e.g. after a restart. It returns the number still pending.
+func (t *MeltTracker) Resume(ctx context.Context) (int, error) {
+	t.meltMu.Lock()
+	defer t.meltMu.Unlock()
+
+	records, err := t.store.GetMelts()
+	if err != nil {
+		return 0, err
+	}
+	mintUrl := t.wallet.MintUrl().Url
+	pending := 0
+	var errs []error
+	for _, record := range records {
+		if record.MintUrl != mintUrl {
+			continue
+		}
+		resolved, err := t.resolve(ctx, record)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		if !resolved {
+			pending++
+		}
+	}
+	return pending, errors.Join(errs...)
+}
+
+// Start resumes tracked melts now and then every Interval in the
+// background until ctx is done or Stop is called.
+func (t *MeltTracker) Start(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.cancel != nil {
+		return errors.New("melt tracker already started")
+	}
+	ctx, t.cancel = context.WithCancel(ctx)
+	t.done = make(chan struct{})
+	go func(done chan struct{}) {
+		defer close(done)
+		ticker := time.NewTicker(t.opts.Interval)
+		defer ticker.Stop()
+		for {
+			if _, err := t.Resume(ctx); err != nil && ctx.Err() == nil && t.opts.OnError != nil {
+				t.opts.OnError(err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}(t.done)
+	return nil
+}
+
+// Stop stops a started tracker and waits for a check in progress.
+func (t *MeltTracker) Stop() {
+	t.mu.Lock()
+	cancel, done := t.cancel, t.done
+	t.cancel, t.done = nil, nil
+	t.mu.Unlock()
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+// resolve looks the melt up at the mint and finishes it if the mint has.
+// It reports whether the record was resolved.
+func (t *MeltTracker) resolve(ctx context.Context, record MeltRecord) (bool, error) {
+	var quote struct {
+		State    string            `json:"state"`
+		Preimage *string           `json:"payment_preimage"`
+		Change   []json.RawMessage `json:"change"`
+	}
+	method := record.Method
+	if method == "" {
+		method = "bolt11"
+	}
+	path := "/v1/melt/quote/" + method + "/" + record.QuoteId
+	if err := mintRequest(ctx, t.opts.HttpClient, http.MethodGet, t.wallet.MintUrl(), path, nil, &quote); err != nil {
+		return false, err
+	}
+
+	event := MeltEvent{QuoteId: record.QuoteId, MintUrl: t.wallet.MintUrl(), Preimage: quote.Preimage}
+	switch quote.State {
+	case "PAID":
+		// The change outputs are derived from the seed, so Restore finds
+		// the mint's signatures on them. It scans every keyset, so it only
+		// runs when the mint reports change for the quote.
+		event.Kind = MeltPaid
+		if len(quote.Change) > 0 {
+			event.ChangeAmount, event.Err = t.wallet.RestoreCtx(ctx)
+		}
+		if _, err := t.wallet.CheckAllPendingProofsCtx(ctx); err != nil && event.Err == nil {
+			event.Err = err
+		}
+	case "UNPAID":
+		event.Kind = MeltFailed
+		inputs, err := t.inputs(ctx, record)
+		if err != nil {
+			return false, err
+		}
+		if len(inputs) > 0 {
+			if err := t.wallet.ReclaimUnspentCtx(ctx, inputs); err != nil {
+				return false, err
+			}
+		}
+		for _, proof := range inputs {
+			event.Reclaimed.Value += proof.Amount().Value
+		}
+	default:
+		return false, nil
+	}
+	t.finish(record, event)
+	return true, event.Err
+}
+
+func (t *MeltTracker) finish(record MeltRecord, event MeltEvent) {
+	// A record that cannot be removed is resolved again on the next Resume;
+	// Restore and reclaiming spent proofs are harmless to repeat.
+	t.store.RemoveMelt(record.QuoteId)
+	if t.opts.OnEvent != nil {
+		t.opts.OnEvent(event)
+	}
+}
+
+// inputs returns the pending proofs the melt used as inputs. When the
+// melt did not record them, its candidates the mint has not spent are
+// taken: the mint releases the inputs of a failed payment.
+func (t *MeltTracker) inputs(ctx context.Context, record MeltRecord) ([]*Proof, error) {
+	if len(record.Inputs) == 0 {
+		return t.candidates(ctx, record, func(state ProofState) bool { return state != ProofStateSpent })
+	}
+	wanted := make(map[string]bool, len(record.Inputs))
+	for _, y := range record.Inputs {
+		wanted[y] = true
+	}
+	return t.pendingProofs(ctx, wanted)
+}
+
+// candidates returns the proofs that were unspent when the melt started,
+// are pending in the wallet now, and whose state at the mint passes keep.
+func (t *MeltTracker) candidates(ctx context.Context, record MeltRecord, keep func(ProofState) bool) ([]*Proof, error) {
+	unspent := make(map[string]bool, len(record.Unspent))
+	for _, y := range record.Unspent {
+		unspent[y] = true
+	}
+	proofs, err := t.pendingProofs(ctx, unspent)
+	if err != nil || len(proofs) == 0 {
+		return nil, err
+	}
+	states, err := mintProofStates(ctx, t.opts.HttpClient, t.wallet.MintUrl(), proofYs(proofs))
+	if err != nil {
+		return nil, err
+	}
+	var inputs []*Proof
+	for _, proof := range proofs {
+		if y, err := proof.Y(); err == nil && keep(states[y]) {
+			inputs = append(inputs, proof)
+		}
+	}
+	return inputs, nil
+}
+
+// pendingProofs returns the pending proofs of the wallet whose Y is wanted.
+func (t *MeltTracker) pendingProofs(ctx context.Context, wanted map[string]bool) ([]*Proof, error) {
+	proofs, err := t.wallet.GetProofsByStatesCtx(ctx, []ProofState{ProofStatePending})
+	if err != nil {
+		return nil, err
+	}
+	var matched []*Proof
+	for _, proof := range proofs {
+		if y, err := proof.Y(); err == nil && wanted[y] {
+			matched = append(matched, proof)
+		}
+	}
+	return matched, nil
+}
+
+func (t *MeltTracker) stateYs(ctx context.Context, state ProofState) ([]string, error) {
+	proofs, err := t.wallet.GetProofsByStatesCtx(ctx, []ProofState{state})
+	if err != nil {
+		return nil, err
+	}
+	ys := make([]string, 0, len(proofs))
+	for _, proof := range proofs {
+		y, err := proof.Y()
+		if err != nil {
+			return nil, err
+		}
+		ys = append(ys, y)
+	}
+	return ys, nil
+}
+
+func proofYs(proofs []*Proof) []string {
+	ys := make([]string, 0, len(proofs))
+	for _, proof := range proofs {
+		if y, err := proof.Y(); err == nil {
+			ys = append(ys, y)
+		}
+	}
+	return ys
+}
@@ -0,0 +1,96 @@
+package cdk_ffi_test
+
+import (
+	"context"
+	"testing"
+
+	cdk "github.com/lescuer97/cdkgo"
+	"github.com/lescuer97/cdkgo/mockmint"
+)
+
+// pendingMelt starts a melt of amountMsat through a tracker on store, with
+// the payment left in flight, and returns the invoice.
+func pendingMelt(t *testing.T, ctx context.Context, wallet *cdk.Wallet, store cdk.MeltStore, amountMsat uint64) string {
+	t.Helper()
+	invoice, _, err := mockmint.NewFakeLightning().CreateInvoice(amountMsat, "tracked")
+	if err != nil {
+		t.Fatal(err)
+	}
+	quote, err := wallet.MeltQuoteCtx(ctx, invoice, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Failing here is fine as long as the melt stays tracked.
+	cdk.NewMeltTracker(wallet, store, cdk.MeltTrackerOptions{}).Melt(ctx, quote)
+	records, err := store.GetMelts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].QuoteId != quote.Id || records[0].Method != "bolt11" {
+		t.Fatalf("tracked %+v", records)
+	}
+	return invoice
+}
+
+// resume resolves the melts of store with a new tracker, as after a
+// restart, and returns its events.
+func resume(t *testing.T, ctx context.Context, wallet *cdk.Wallet, store cdk.MeltStore, wantPending int) []cdk.MeltEvent {
+	t.Helper()
+	var events []cdk.MeltEvent
+	tracker := cdk.NewMeltTracker(wallet, store, cdk.MeltTrackerOptions{OnEvent: func(event cdk.MeltEvent) { events = append(events, event) }})
+	pending, err := tracker.Resume(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pending != wantPending || len(events)+pending != 1 {
+		t.Fatalf("%d pending, events %+v", pending, events)
+	}
+	return events
+}
+
+func TestMeltTrackerResume(t *testing.T) {
+	ctx := testContext(t)
+	mint := mockmint.New(mockmint.Options{FeeReserve: func(uint64) uint64 { return 2 }})
+	defer mint.Close()
+	mint.Lightning().SetOutgoing(mockmint.PaymentPending, 1000)
+	wallet := newTestWallet(t, mint, cdk.NewMemoryWalletDatabase())
+	fund(t, ctx, mint, wallet, 100)
+	store := cdk.NewMemoryMeltStore()
+
+	t.Run("Paid", func(t *testing.T) {
+		invoice := pendingMelt(t, ctx, wallet, store, 10_000)
+		resume(t, ctx, wallet, store, 1)
+		if err := mint.Lightning().SettlePayment(invoice, true); err != nil {
+			t.Fatal(err)
+		}
+		events := resume(t, ctx, wallet, store, 0)
+		if events[0].Kind != cdk.MeltPaid || events[0].Err != nil || events[0].Preimage == nil {
+			t.Fatalf("got %+v", events[0])
+		}
+		// 10 paid and 1 of routing fee, the rest of the reserve restored
+		if got := balance(t, ctx, wallet); got != 89 {
+			t.Fatalf("balance %d", got)
+		}
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		invoice := pendingMelt(t, ctx, wallet, store, 20_000)
+		if err := mint.Lightning().SettlePayment(invoice, false); err != nil {
+			t.Fatal(err)
+		}
+		events := resume(t, ctx, wallet, store, 0)
+		if events[0].Kind != cdk.MeltFailed || events[0].Reclaimed.Value < 22 {
+			t.Fatalf("got %+v", events[0])
+		}
+		if got := balance(t, ctx, wallet); got != 89 {
+			t.Fatalf("balance %d", got)
+		}
+		pending, err := wallet.TotalPendingBalanceCtx(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pending.Value != 0 {
+			t.Fatalf("%d left pending", pending.Value)
+		}
+	})
+}
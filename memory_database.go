@@ -0,0 +1,384 @@
+package cdk_ffi
+
+import (
+	"sort"
+	"sync"
+)
+
+// MemoryWalletDatabase is a pure Go WalletDatabase that keeps everything in
+// memory. It is safe for concurrent use and is meant for tests and short
+// lived wallets; nothing survives the process.
+type MemoryWalletDatabase struct {
+	mu sync.RWMutex
+
+	mints          map[MintUrl]*MintInfo
+	mintKeysets    map[MintUrl][]KeySetInfo
+	keysets        map[string]KeySetInfo
+	keys           map[string]Keys
+	keysetCounters map[string]uint32
+	mintQuotes     map[string]MintQuote
+	meltQuotes     map[string]MeltQuote
+	proofs         map[string]ProofInfo
+	transactions   map[string]Transaction
+}
+
+var (
+	_ WalletDatabase      = (*MemoryWalletDatabase)(nil)
+	_ KeysetCounterReader = (*MemoryWalletDatabase)(nil)
+)
+
+// NewMemoryWalletDatabase returns an empty in-memory wallet database.
+func NewMemoryWalletDatabase() *MemoryWalletDatabase {
+	return &MemoryWalletDatabase{
+		mints:          map[MintUrl]*MintInfo{},
+		mintKeysets:    map[MintUrl][]KeySetInfo{},
+		keysets:        map[string]KeySetInfo{},
+		keys:           map[string]Keys{},
+		keysetCounters: map[string]uint32{},
+		mintQuotes:     map[string]MintQuote{},
+		meltQuotes:     map[string]MeltQuote{},
+		proofs:         map[string]ProofInfo{},
+		transactions:   map[string]Transaction{},
+	}
+}
+
+// Add Mint to storage
+func (db *MemoryWalletDatabase) AddMint(mintUrl MintUrl, mintInfo *MintInfo) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if mintInfo != nil {
+		info := *mintInfo
+		mintInfo = &info
+	}
+	db.mints[mintUrl] = mintInfo
+	return nil
+}
+
+// Remove Mint from storage
+//
+// The keysets of the mint are removed with it, as the SQL backends do.
+func (db *MemoryWalletDatabase) RemoveMint(mintUrl MintUrl) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	delete(db.mints, mintUrl)
+	for _, keyset := range db.mintKeysets[mintUrl] {
+		delete(db.keysets, keyset.Id)
+	}
+	delete(db.mintKeysets, mintUrl)
+	return nil
+}
+
+// Get mint from storage
+func (db *MemoryWalletDatabase) GetMint(mintUrl MintUrl) (*MintInfo, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	info, ok := db.mints[mintUrl]
+	if !ok || info == nil {
+		return nil, nil
+	}
+	copied := *info
+	return &copied, nil
+}
+
+// Get all mints from storage
+func (db *MemoryWalletDatabase) GetMints() (map[MintUrl]*MintInfo, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	mints := make(map[MintUrl]*MintInfo, len(db.mints))
+	for url, info := range db.mints {
+		if info != nil {
+			copied := *info
+			info = &copied
+		}
+		mints[url] = info
+	}
+	return mints, nil
+}
+
+// Update mint url
+//
+// Like the SQL backends this moves the proofs and mint quotes of the old
+// url over to the new one.
+func (db *MemoryWalletDatabase) UpdateMintUrl(oldMintUrl MintUrl, newMintUrl MintUrl) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for y, info := range db.proofs {
+		if info.MintUrl == oldMintUrl {
+			info.MintUrl = newMintUrl
+			db.proofs[y] = info
+		}
+	}
+	for id, quote := range db.mintQuotes {
+		if quote.MintUrl == oldMintUrl {
+			quote.MintUrl = newMintUrl
+			db.mintQuotes[id] = quote
+		}
+	}
+	return nil
+}
+
+// Add mint keyset to storage
+func (db *MemoryWalletDatabase) AddMintKeysets(mintUrl MintUrl, keysets []KeySetInfo) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	stored := db.mintKeysets[mintUrl]
+	for _, keyset := range keysets {
+		replaced := false
+		for i := range stored {
+			if stored[i].Id == keyset.Id {
+				stored[i] = keyset
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			stored = append(stored, keyset)
+		}
+		db.keysets[keyset.Id] = keyset
+	}
+	db.mintKeysets[mintUrl] = stored
+	return nil
+}
+
+// Get mint keysets for mint url
+func (db *MemoryWalletDatabase) GetMintKeysets(mintUrl MintUrl) (*[]KeySetInfo, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	stored, ok := db.mintKeysets[mintUrl]
+	if !ok {
+		return nil, nil
+	}
+	keysets := append([]KeySetInfo(nil), stored...)
+	return &keysets, nil
+}
+
+// Get mint keyset by id
+func (db *MemoryWalletDatabase) GetKeysetById(keysetId Id) (*KeySetInfo, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	keyset, ok := db.keysets[keysetId.Hex]
+	if !ok {
+		return nil, nil
+	}
+	return &keyset, nil
+}
+
+// Add mint quote to storage
+func (db *MemoryWalletDatabase) AddMintQuote(quote MintQuote) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.mintQuotes[quote.Id] = quote
+	return nil
+}
+
+// Get mint quote from storage
+func (db *MemoryWalletDatabase) GetMintQuote(quoteId string) (*MintQuote, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	quote, ok := db.mintQuotes[quoteId]
+	if !ok {
+		return nil, nil
+	}
+	return &quote, nil
+}
+
+// Get mint quotes from storage
+func (db *MemoryWalletDatabase) GetMintQuotes() ([]MintQuote, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	quotes := make([]MintQuote, 0, len(db.mintQuotes))
+	for _, quote := range db.mintQuotes {
+		quotes = append(quotes, quote)
+	}
+	sort.Slice(quotes, func(i, j int) bool { return quotes[i].Id < quotes[j].Id })
+	return quotes, nil
+}
+
+// Remove mint quote from storage
+func (db *MemoryWalletDatabase) RemoveMintQuote(quoteId string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	delete(db.mintQuotes, quoteId)
+	return nil
+}
+
+// Add melt quote to storage
+func (db *MemoryWalletDatabase) AddMeltQuote(quote MeltQuote) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.meltQuotes[quote.Id] = quote
+	return nil
+}
+
+// Get melt quote from storage
+func (db *MemoryWalletDatabase) GetMeltQuote(quoteId string) (*MeltQuote, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	quote, ok := db.meltQuotes[quoteId]
+	if !ok {
+		return nil, nil
+	}
+	return &quote, nil
+}
+
+// Get melt quotes from storage
+func (db *MemoryWalletDatabase) GetMeltQuotes() ([]MeltQuote, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	quotes := make([]MeltQuote, 0, len(db.meltQuotes))
+	for _, quote := range db.meltQuotes {
+		quotes = append(quotes, quote)
+	}
+	sort.Slice(quotes, func(i, j int) bool { return quotes[i].Id < quotes[j].Id })
+	return quotes, nil
+}
+
+// Remove melt quote from storage
+func (db *MemoryWalletDatabase) RemoveMeltQuote(quoteId string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	delete(db.meltQuotes, quoteId)
+	return nil
+}
+
+// Add Keys to storage
+func (db *MemoryWalletDatabase) AddKeys(keyset KeySet) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	keys := make(map[uint64]string, len(keyset.Keys))
+	for amount, pubkey := range keyset.Keys {
+		keys[amount] = pubkey
+	}
+	db.keys[keyset.Id] = Keys{Id: keyset.Id, Unit: keyset.Unit, Keys: keys}
+	return nil
+}
+
+// Get Keys from storage
+func (db *MemoryWalletDatabase) GetKeys(id Id) (*Keys, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	keys, ok := db.keys[id.Hex]
+	if !ok {
+		return nil, nil
+	}
+	return &keys, nil
+}
+
+// Remove Keys from storage
+func (db *MemoryWalletDatabase) RemoveKeys(id Id) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	delete(db.keys, id.Hex)
+	return nil
+}
+
+// Update the proofs in storage by adding new proofs or removing proofs by their Y value
+//
+// Both changes are applied under a single lock, so readers never observe
+// the added proofs without the removals or the other way around.
+func (db *MemoryWalletDatabase) UpdateProofs(added []ProofInfo, removedYs []PublicKey) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for _, info := range added {
+		db.proofs[info.Y.Hex] = info
+	}
+	for _, y := range removedYs {
+		delete(db.proofs, y.Hex)
+	}
+	return nil
+}
+
+// Get proofs from storage
+//
+// Filtering follows ProofInfoMatches.
+func (db *MemoryWalletDatabase) GetProofs(mintUrl *MintUrl, unit *CurrencyUnit, state *[]ProofState, spendingConditions *[]SpendingConditions) ([]ProofInfo, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	proofs := []ProofInfo{}
+	for _, info := range db.proofs {
+		if ProofInfoMatches(info, mintUrl, unit, state, spendingConditions) {
+			proofs = append(proofs, info)
+		}
+	}
+	sort.Slice(proofs, func(i, j int) bool { return proofs[i].Y.Hex < proofs[j].Y.Hex })
+	return proofs, nil
+}
+
+// Update proofs state in storage
+func (db *MemoryWalletDatabase) UpdateProofsState(ys []PublicKey, state ProofState) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for _, y := range ys {
+		if info, ok := db.proofs[y.Hex]; ok {
+			info.State = state
+			db.proofs[y.Hex] = info
+		}
+	}
+	return nil
+}
+
+// Increment Keyset counter
+//
+// Returns the counter value after the increment.
+func (db *MemoryWalletDatabase) IncrementKeysetCounter(keysetId Id, count uint32) (uint32, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.keysetCounters[keysetId.Hex] += count
+	return db.keysetCounters[keysetId.Hex], nil
+}
+
+// KeysetCounter returns the current counter of a keyset, zero if it was
+// never incremented.
+func (db *MemoryWalletDatabase) KeysetCounter(keysetId Id) uint32 {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	return db.keysetCounters[keysetId.Hex]
+}
+
+// GetKeysetCounter implements KeysetCounterReader.
+func (db *MemoryWalletDatabase) GetKeysetCounter(keysetId Id) (uint32, error) {
+	return db.KeysetCounter(keysetId), nil
+}
+
+// Add transaction to storage
+func (db *MemoryWalletDatabase) AddTransaction(transaction Transaction) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.transactions[transaction.Id.Hex] = transaction
+	return nil
+}
+
+// Get transaction from storage
+func (db *MemoryWalletDatabase) GetTransaction(transactionId TransactionId) (*Transaction, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	transaction, ok := db.transactions[transactionId.Hex]
+	if !ok {
+		return nil, nil
+	}
+	return &transaction, nil
+}
+
+// List transactions from storage
+//
+// Transactions are returned newest first.
+func (db *MemoryWalletDatabase) ListTransactions(mintUrl *MintUrl, direction *TransactionDirection, unit *CurrencyUnit) ([]Transaction, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	transactions := []Transaction{}
+	for _, transaction := range db.transactions {
+		if TransactionMatches(transaction, mintUrl, direction, unit) {
+			transactions = append(transactions, transaction)
+		}
+	}
+	SortTransactions(transactions)
+	return transactions, nil
+}
+
+// Remove transaction from storage
+func (db *MemoryWalletDatabase) RemoveTransaction(transactionId TransactionId) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	delete(db.transactions, transactionId.Hex)
+	return nil
+}
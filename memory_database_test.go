@@ -0,0 +1,20 @@
+package cdk_ffi_test
+
+import (
+	"testing"
+
+	cdk "github.com/lescuer97/cdkgo"
+	"github.com/lescuer97/cdkgo/walletdbtest"
+)
+
+func TestMemoryWalletDatabase(t *testing.T) {
+	walletdbtest.Run(t, func(t *testing.T) cdk.WalletDatabase {
+		return cdk.NewMemoryWalletDatabase()
+	})
+}
+
+func TestMemoryMeltStore(t *testing.T) {
+	walletdbtest.RunMeltStore(t, func(t *testing.T) cdk.MeltStore {
+		return cdk.NewMemoryMeltStore()
+	})
+}
@@ -0,0 +1,340 @@
+package cdk_ffi
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrDestinationNotEmpty is returned by MigrateWalletDatabase when the
+// destination already holds wallet data.
+var ErrDestinationNotEmpty = errors.New("destination wallet database is not empty")
+
+// MigrationStage names the step MigrateWalletDatabase is working on.
+type MigrationStage string
+
+const (
+	MigrationStageMints          MigrationStage = "mints"
+	MigrationStageKeysets        MigrationStage = "keysets"
+	MigrationStageKeysetCounters MigrationStage = "keyset_counters"
+	MigrationStageMintQuotes     MigrationStage = "mint_quotes"
+	MigrationStageMeltQuotes     MigrationStage = "melt_quotes"
+	MigrationStageProofs         MigrationStage = "proofs"
+	MigrationStageTransactions   MigrationStage = "transactions"
+	MigrationStageVerify         MigrationStage = "verify"
+)
+
+// MigrationProgress is reported after every copied record.
+type MigrationProgress struct {
+	Stage MigrationStage
+	Done  int
+	Total int
+}
+
+// MigrationOptions configures MigrateWalletDatabase.
+type MigrationOptions struct {
+	// Read the source and check the destination without writing anything.
+	// Nothing is written to the source either, so keyset counters are only
+	// read from sources that implement KeysetCounterReader.
+	DryRun bool
+	// Continue a migration from the same source that failed part way: dst
+	// may already hold some of the records, which are overwritten.
+	Resume bool
+	// Called as records are copied, may be nil
+	Progress func(MigrationProgress)
+	// Number of proofs written per UpdateProofs call, defaults to 100
+	ProofBatchSize int
+}
+
+// BalanceKey groups proof amounts in a MigrationReport.
+type BalanceKey struct {
+	MintUrl MintUrl
+	Unit    CurrencyUnit
+	State   ProofState
+}
+
+// MigrationReport describes what MigrateWalletDatabase copied, or would
+// copy in a dry run.
+type MigrationReport struct {
+	Mints          int
+	Keysets        int
+	Keys           int
+	KeysetCounters int
+	MintQuotes     int
+	MeltQuotes     int
+	Proofs         int
+	Transactions   int
+	// Sum of proof amounts in the source, per mint, unit and state
+	Balances map[BalanceKey]uint64
+}
+
+// MigrateWalletDatabase copies every mint, keyset, key, keyset counter,
+// quote, proof and transaction from src to dst, e.g. from a
+// WalletSqliteDatabase to a WalletPostgresDatabase.
+//
+// dst must be empty, otherwise ErrDestinationNotEmpty is returned before
+// anything is written, unless opts.Resume is set. Every write overwrites
+// the record of the same id and keyset counters are raised to the source's
+// value rather than incremented, so a migration that failed part way can be
+// run again with Resume. After copying, the proof balances of dst are
+// compared with the ones of src and any difference is reported as an error.
+//
+// Keysets are copied for every mint that is registered, holds proofs or
+// appears in a quote or transaction, as well as the keysets of the proofs
+// themselves. Keyset counters are read through ReadKeysetCounter. Keys are
+// copied without their final expiry, which WalletDatabase.GetKeys does not
+// return.
+func MigrateWalletDatabase(src, dst WalletDatabase, opts MigrationOptions) (MigrationReport, error) {
+	report := MigrationReport{Balances: map[BalanceKey]uint64{}}
+	if opts.ProofBatchSize <= 0 {
+		opts.ProofBatchSize = 100
+	}
+	progress := func(stage MigrationStage, done, total int) {
+		if opts.Progress != nil {
+			opts.Progress(MigrationProgress{Stage: stage, Done: done, Total: total})
+		}
+	}
+
+	if !opts.Resume {
+		empty, err := walletDatabaseIsEmpty(dst)
+		if err != nil {
+			return report, fmt.Errorf("check destination: %w", err)
+		}
+		if !empty {
+			return report, ErrDestinationNotEmpty
+		}
+	}
+
+	mints, err := src.GetMints()
+	if err != nil {
+		return report, fmt.Errorf("read mints: %w", err)
+	}
+	mintQuotes, err := src.GetMintQuotes()
+	if err != nil {
+		return report, fmt.Errorf("read mint quotes: %w", err)
+	}
+	meltQuotes, err := src.GetMeltQuotes()
+	if err != nil {
+		return report, fmt.Errorf("read melt quotes: %w", err)
+	}
+	proofs, err := src.GetProofs(nil, nil, nil, nil)
+	if err != nil {
+		return report, fmt.Errorf("read proofs: %w", err)
+	}
+	transactions, err := src.ListTransactions(nil, nil, nil)
+	if err != nil {
+		return report, fmt.Errorf("read transactions: %w", err)
+	}
+
+	done := 0
+	for mintUrl, info := range mints {
+		if !opts.DryRun {
+			if err := dst.AddMint(mintUrl, info); err != nil {
+				return report, fmt.Errorf("write mint %s: %w", mintUrl.Url, err)
+			}
+		}
+		done++
+		report.Mints++
+		progress(MigrationStageMints, done, len(mints))
+	}
+
+	// Mints whose keysets are copied, and the keysets proofs refer to that
+	// may not be listed under any of them.
+	mintUrls := map[MintUrl]bool{}
+	for mintUrl := range mints {
+		mintUrls[mintUrl] = true
+	}
+	for _, quote := range mintQuotes {
+		mintUrls[quote.MintUrl] = true
+	}
+	for _, transaction := range transactions {
+		mintUrls[transaction.MintUrl] = true
+	}
+	proofKeysets := map[string]MintUrl{}
+	for _, info := range proofs {
+		mintUrls[info.MintUrl] = true
+		proofKeysets[info.Proof.KeysetId()] = info.MintUrl
+	}
+
+	var keysetIds []Id
+	copyKeyset := func(mintUrl MintUrl, keysets []KeySetInfo) error {
+		if !opts.DryRun {
+			if err := dst.AddMintKeysets(mintUrl, keysets); err != nil {
+				return fmt.Errorf("write keysets of %s: %w", mintUrl.Url, err)
+			}
+		}
+		for _, keyset := range keysets {
+			id := Id{Hex: keyset.Id}
+			keysetIds = append(keysetIds, id)
+			delete(proofKeysets, keyset.Id)
+			report.Keysets++
+
+			keys, err := src.GetKeys(id)
+			if err != nil {
+				return fmt.Errorf("read keys of keyset %s: %w", keyset.Id, err)
+			}
+			if keys != nil {
+				if !opts.DryRun {
+					if err := dst.AddKeys(KeySet{Id: keys.Id, Unit: keys.Unit, Keys: keys.Keys}); err != nil {
+						return fmt.Errorf("write keys of keyset %s: %w", keyset.Id, err)
+					}
+				}
+				report.Keys++
+			}
+		}
+		return nil
+	}
+	done = 0
+	for mintUrl := range mintUrls {
+		keysets, err := src.GetMintKeysets(mintUrl)
+		if err != nil {
+			return report, fmt.Errorf("read keysets of %s: %w", mintUrl.Url, err)
+		}
+		if keysets != nil && len(*keysets) > 0 {
+			if err := copyKeyset(mintUrl, *keysets); err != nil {
+				return report, err
+			}
+		}
+		done++
+		progress(MigrationStageKeysets, done, len(mintUrls))
+	}
+	for keysetId, mintUrl := range proofKeysets {
+		keyset, err := src.GetKeysetById(Id{Hex: keysetId})
+		if err != nil {
+			return report, fmt.Errorf("read keyset %s: %w", keysetId, err)
+		}
+		if keyset == nil {
+			continue
+		}
+		if err := copyKeyset(mintUrl, []KeySetInfo{*keyset}); err != nil {
+			return report, err
+		}
+	}
+
+	for i, id := range keysetIds {
+		counter, ok, err := ReadKeysetCounter(src, id, opts.DryRun)
+		if err != nil {
+			return report, fmt.Errorf("read counter of keyset %s: %w", id.Hex, err)
+		}
+		if ok && counter > 0 {
+			if !opts.DryRun {
+				if err := raiseKeysetCounter(dst, id, counter); err != nil {
+					return report, fmt.Errorf("write counter of keyset %s: %w", id.Hex, err)
+				}
+			}
+			report.KeysetCounters++
+		}
+		progress(MigrationStageKeysetCounters, i+1, len(keysetIds))
+	}
+
+	for i, quote := range mintQuotes {
+		if !opts.DryRun {
+			if err := dst.AddMintQuote(quote); err != nil {
+				return report, fmt.Errorf("write mint quote %s: %w", quote.Id, err)
+			}
+		}
+		report.MintQuotes++
+		progress(MigrationStageMintQuotes, i+1, len(mintQuotes))
+	}
+
+	for i, quote := range meltQuotes {
+		if !opts.DryRun {
+			if err := dst.AddMeltQuote(quote); err != nil {
+				return report, fmt.Errorf("write melt quote %s: %w", quote.Id, err)
+			}
+		}
+		report.MeltQuotes++
+		progress(MigrationStageMeltQuotes, i+1, len(meltQuotes))
+	}
+
+	report.Balances = proofBalances(proofs)
+	for start := 0; start < len(proofs); start += opts.ProofBatchSize {
+		end := min(start+opts.ProofBatchSize, len(proofs))
+		if !opts.DryRun {
+			if err := dst.UpdateProofs(proofs[start:end], nil); err != nil {
+				return report, fmt.Errorf("write proofs: %w", err)
+			}
+		}
+		report.Proofs = end
+		progress(MigrationStageProofs, end, len(proofs))
+	}
+
+	for i, transaction := range transactions {
+		if !opts.DryRun {
+			if err := dst.AddTransaction(transaction); err != nil {
+				return report, fmt.Errorf("write transaction %s: %w", transaction.Id.Hex, err)
+			}
+		}
+		report.Transactions++
+		progress(MigrationStageTransactions, i+1, len(transactions))
+	}
+
+	if opts.DryRun {
+		return report, nil
+	}
+
+	copied, err := dst.GetProofs(nil, nil, nil, nil)
+	if err != nil {
+		return report, fmt.Errorf("read back proofs: %w", err)
+	}
+	if after := proofBalances(copied); !reflect.DeepEqual(report.Balances, after) {
+		return report, fmt.Errorf("balance mismatch after migration: source %v, destination %v", report.Balances, after)
+	}
+	copiedTransactions, err := dst.ListTransactions(nil, nil, nil)
+	if err != nil {
+		return report, fmt.Errorf("read back transactions: %w", err)
+	}
+	if len(copiedTransactions) != len(transactions) {
+		return report, fmt.Errorf("transaction count mismatch after migration: source %d, destination %d", len(transactions), len(copiedTransactions))
+	}
+	progress(MigrationStageVerify, 1, 1)
+
+	return report, nil
+}
+
+// raiseKeysetCounter increments the counter of a keyset of db up to
+// counter, so copying a counter twice does not count it twice.
+func raiseKeysetCounter(db WalletDatabase, id Id, counter uint32) error {
+	current, _, err := ReadKeysetCounter(db, id, false)
+	if err != nil {
+		return err
+	}
+	if current >= counter {
+		return nil
+	}
+	_, err = db.IncrementKeysetCounter(id, counter-current)
+	return err
+}
+
+func walletDatabaseIsEmpty(db WalletDatabase) (bool, error) {
+	mints, err := db.GetMints()
+	if err != nil || len(mints) > 0 {
+		return false, err
+	}
+	proofs, err := db.GetProofs(nil, nil, nil, nil)
+	if err != nil || len(proofs) > 0 {
+		return false, err
+	}
+	mintQuotes, err := db.GetMintQuotes()
+	if err != nil || len(mintQuotes) > 0 {
+		return false, err
+	}
+	meltQuotes, err := db.GetMeltQuotes()
+	if err != nil || len(meltQuotes) > 0 {
+		return false, err
+	}
+	transactions, err := db.ListTransactions(nil, nil, nil)
+	if err != nil || len(transactions) > 0 {
+		return false, err
+	}
+	return true, nil
+}
+
+func proofBalances(proofs []ProofInfo) map[BalanceKey]uint64 {
+	balances := map[BalanceKey]uint64{}
+	for _, info := range proofs {
+		key := BalanceKey{MintUrl: info.MintUrl, Unit: info.Unit, State: info.State}
+		balances[key] += info.Proof.Amount().Value
+	}
+	return balances
+}
@@ -0,0 +1,101 @@
+package cdk_ffi_test
+
+import (
+	"errors"
+	"testing"
+
+	cdk "github.com/lescuer97/cdkgo"
+)
+
+// countingDatabase hides the KeysetCounterReader of the database it wraps
+// and counts the counter increments it receives.
+type countingDatabase struct {
+	cdk.WalletDatabase
+	increments int
+}
+
+func (db *countingDatabase) IncrementKeysetCounter(keysetId cdk.Id, count uint32) (uint32, error) {
+	db.increments++
+	return db.WalletDatabase.IncrementKeysetCounter(keysetId, count)
+}
+
+func migrationSource(t *testing.T) *cdk.MemoryWalletDatabase {
+	t.Helper()
+	src := cdk.NewMemoryWalletDatabase()
+	mintUrl := cdk.MintUrl{Url: "https://mint.example.com"}
+	orphanUrl := cdk.MintUrl{Url: "https://removed.example.com"}
+	steps := []error{
+		src.AddMint(mintUrl, nil),
+		src.AddMintKeysets(mintUrl, []cdk.KeySetInfo{{Id: "009a1f293253e41e", Unit: cdk.CurrencyUnitSat{}, Active: true}}),
+		// Keysets of a mint that is no longer registered but still has a
+		// quote.
+		src.AddMintKeysets(orphanUrl, []cdk.KeySetInfo{{Id: "00ad268c4d1f5826", Unit: cdk.CurrencyUnitSat{}}}),
+		src.AddMintQuote(cdk.MintQuote{Id: "quote", Unit: cdk.CurrencyUnitSat{}, MintUrl: orphanUrl, PaymentMethod: cdk.PaymentMethodBolt11{}}),
+	}
+	for _, err := range steps {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := src.IncrementKeysetCounter(cdk.Id{Hex: "009a1f293253e41e"}, 7); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := src.IncrementKeysetCounter(cdk.Id{Hex: "00ad268c4d1f5826"}, 3); err != nil {
+		t.Fatal(err)
+	}
+	return src
+}
+
+func TestMigrateWalletDatabase(t *testing.T) {
+	src := migrationSource(t)
+	dst := cdk.NewMemoryWalletDatabase()
+	report, err := cdk.MigrateWalletDatabase(src, dst, cdk.MigrationOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Keysets != 2 || report.KeysetCounters != 2 || report.MintQuotes != 1 {
+		t.Fatalf("report = %+v, want 2 keysets, 2 counters and 1 mint quote", report)
+	}
+	if keyset, _ := dst.GetKeysetById(cdk.Id{Hex: "00ad268c4d1f5826"}); keyset == nil {
+		t.Fatal("the keyset of an unregistered mint was not copied")
+	}
+	if counter := dst.KeysetCounter(cdk.Id{Hex: "009a1f293253e41e"}); counter != 7 {
+		t.Fatalf("copied counter = %d, want 7", counter)
+	}
+
+	if _, err := cdk.MigrateWalletDatabase(src, dst, cdk.MigrationOptions{}); !errors.Is(err, cdk.ErrDestinationNotEmpty) {
+		t.Fatalf("second migration err = %v, want ErrDestinationNotEmpty", err)
+	}
+	if _, err := cdk.MigrateWalletDatabase(src, dst, cdk.MigrationOptions{Resume: true}); err != nil {
+		t.Fatal(err)
+	}
+	if counter := dst.KeysetCounter(cdk.Id{Hex: "009a1f293253e41e"}); counter != 7 {
+		t.Fatalf("counter after resuming = %d, want 7", counter)
+	}
+}
+
+func TestMigrateWalletDatabaseDryRun(t *testing.T) {
+	src := &countingDatabase{WalletDatabase: migrationSource(t)}
+	dst := cdk.NewMemoryWalletDatabase()
+	report, err := cdk.MigrateWalletDatabase(src, dst, cdk.MigrationOptions{DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if src.increments != 0 {
+		t.Fatalf("dry run incremented source counters %d times", src.increments)
+	}
+	if report.Keysets != 2 || report.KeysetCounters != 0 {
+		t.Fatalf("report = %+v, want 2 keysets and no counters read", report)
+	}
+	if mints, _ := dst.GetMints(); len(mints) != 0 {
+		t.Fatal("dry run wrote to the destination")
+	}
+
+	report, err = cdk.MigrateWalletDatabase(migrationSource(t), dst, cdk.MigrationOptions{DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.KeysetCounters != 2 {
+		t.Fatalf("dry run of a KeysetCounterReader source counted %d counters, want 2", report.KeysetCounters)
+	}
+}
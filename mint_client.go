@@ -0,0 +1,125 @@
+package cdk_ffi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// mintRequest calls a mint endpoint directly, for lookups the wallet would
+// otherwise persist or cannot make. NUT-00 error responses are returned as
+// *MintErrorResponse. client defaults to http.DefaultClient.
+func mintRequest(ctx context.Context, client *http.Client, method string, mintUrl MintUrl, path string, body, out any) error {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	var reader io.Reader
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(raw)
+	}
+	url := strings.TrimSuffix(mintUrl.Url, "/") + path
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var response struct {
+			Code   int    `json:"code"`
+			Detail string `json:"detail"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&response); err == nil && response.Code != 0 {
+			return &MintErrorResponse{Code: response.Code, Detail: response.Detail}
+		}
+		return fmt.Errorf("%s %s: unexpected status %s", method, path, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode %s response: %w", path, err)
+	}
+	return nil
+}
+
+type mintKeyset struct {
+	Id          string  `json:"id"`
+	Unit        string  `json:"unit"`
+	Active      bool    `json:"active"`
+	InputFeePpk uint64  `json:"input_fee_ppk"`
+	FinalExpiry *uint64 `json:"final_expiry"`
+}
+
+// mintKeysets lists the keysets of the mint as served by NUT-02, including
+// the final expiry KeySetInfo does not carry.
+func mintKeysets(ctx context.Context, client *http.Client, mintUrl MintUrl) ([]mintKeyset, error) {
+	var response struct {
+		Keysets []mintKeyset `json:"keysets"`
+	}
+	if err := mintRequest(ctx, client, http.MethodGet, mintUrl, "/v1/keysets", nil, &response); err != nil {
+		return nil, err
+	}
+	return response.Keysets, nil
+}
+
+// mintKeysetFees returns the input fee of every keyset of the mint, keyed
+// by keyset id.
+func mintKeysetFees(ctx context.Context, client *http.Client, mintUrl MintUrl) (map[string]KeySetInfo, error) {
+	keysets, err := mintKeysets(ctx, client, mintUrl)
+	if err != nil {
+		return nil, err
+	}
+	infos := make(map[string]KeySetInfo, len(keysets))
+	for _, keyset := range keysets {
+		infos[keyset.Id] = KeySetInfo{Id: keyset.Id, Active: keyset.Active, InputFeePpk: keyset.InputFeePpk}
+	}
+	return infos, nil
+}
+
+type mintMeltQuote struct {
+	Quote      string `json:"quote"`
+	Amount     uint64 `json:"amount"`
+	FeeReserve uint64 `json:"fee_reserve"`
+	State      string `json:"state"`
+	Expiry     uint64 `json:"expiry"`
+}
+
+// mintProofStates returns the NUT-07 state of the proofs with the given Ys,
+// keyed by Y. Ys the mint leaves out are missing from the map.
+func mintProofStates(ctx context.Context, client *http.Client, mintUrl MintUrl, ys []string) (map[string]ProofState, error) {
+	var response struct {
+		States []struct {
+			Y     string `json:"Y"`
+			State string `json:"state"`
+		} `json:"states"`
+	}
+	body := map[string][]string{"Ys": ys}
+	if err := mintRequest(ctx, client, http.MethodPost, mintUrl, "/v1/checkstate", body, &response); err != nil {
+		return nil, err
+	}
+	states := make(map[string]ProofState, len(response.States))
+	for _, state := range response.States {
+		switch state.State {
+		case "UNSPENT":
+			states[state.Y] = ProofStateUnspent
+		case "PENDING":
+			states[state.Y] = ProofStatePending
+		case "SPENT":
+			states[state.Y] = ProofStateSpent
+		}
+	}
+	return states, nil
+}
@@ -0,0 +1,179 @@
+package mockmint
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+var hashToCurveDomain = []byte("Secp256k1_HashToCurve_Cashu_")
+
+// hashToCurve maps a secret to a point as defined in NUT-00.
+func hashToCurve(message []byte) (*secp256k1.PublicKey, error) {
+	msgHash := sha256.Sum256(append(append([]byte{}, hashToCurveDomain...), message...))
+	var counter [4]byte
+	for i := uint32(0); i < 1<<16; i++ {
+		binary.LittleEndian.PutUint32(counter[:], i)
+		hash := sha256.Sum256(append(msgHash[:], counter[:]...))
+		if point, err := secp256k1.ParsePubKey(append([]byte{0x02}, hash[:]...)); err == nil {
+			return point, nil
+		}
+	}
+	return nil, errors.New("no valid point found")
+}
+
+func multiply(point *secp256k1.PublicKey, scalar *secp256k1.ModNScalar) *secp256k1.PublicKey {
+	var p, result secp256k1.JacobianPoint
+	point.AsJacobian(&p)
+	secp256k1.ScalarMultNonConst(scalar, &p, &result)
+	result.ToAffine()
+	return secp256k1.NewPublicKey(&result.X, &result.Y)
+}
+
+func parsePoint(hexPoint string) (*secp256k1.PublicKey, error) {
+	raw, err := hex.DecodeString(hexPoint)
+	if err != nil {
+		return nil, err
+	}
+	return secp256k1.ParsePubKey(raw)
+}
+
+func pointHex(point *secp256k1.PublicKey) string {
+	return hex.EncodeToString(point.SerializeCompressed())
+}
+
+// yHex returns the Y of a proof secret, the key proofs are tracked by.
+func yHex(secret string) (string, error) {
+	y, err := hashToCurve([]byte(secret))
+	if err != nil {
+		return "", err
+	}
+	return pointHex(y), nil
+}
+
+// dleq proves that C_ = a*B_ for the public key A = a*G, as in NUT-12.
+func dleq(a *secp256k1.PrivateKey, blinded, signed *secp256k1.PublicKey) (e, s string, err error) {
+	r, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return "", "", err
+	}
+	r1 := r.PubKey()
+	r2 := multiply(blinded, &r.Key)
+
+	var preimage string
+	for _, point := range []*secp256k1.PublicKey{r1, r2, a.PubKey(), signed} {
+		preimage += hex.EncodeToString(point.SerializeUncompressed())
+	}
+	hash := sha256.Sum256([]byte(preimage))
+
+	var eScalar, sScalar secp256k1.ModNScalar
+	eScalar.SetByteSlice(hash[:])
+	sScalar.Mul2(&eScalar, &a.Key).Add(&r.Key)
+	eBytes, sBytes := eScalar.Bytes(), sScalar.Bytes()
+	return hex.EncodeToString(eBytes[:]), hex.EncodeToString(sBytes[:]), nil
+}
+
+type keyset struct {
+	id          string
+	unit        string
+	active      bool
+	inputFeePpk uint64
+	keys        map[uint64]*secp256k1.PrivateKey
+}
+
+// newKeyset derives the keys of amounts 2^0 to 2^(maxOrder-1) from seed and
+// index, so a mint created with the same seed has the same keysets.
+func newKeyset(seed []byte, index uint32, unit string, maxOrder int, inputFeePpk uint64) *keyset {
+	ks := &keyset{unit: unit, active: true, inputFeePpk: inputFeePpk, keys: map[uint64]*secp256k1.PrivateKey{}}
+	for order := 0; order < maxOrder; order++ {
+		material := fmt.Sprintf("%x/%s/%d/%d", seed, unit, index, order)
+		hash := sha256.Sum256([]byte(material))
+		ks.keys[1<<order] = secp256k1.PrivKeyFromBytes(hash[:])
+	}
+	ks.id = keysetId(ks.publicKeys())
+	return ks
+}
+
+// keysetId derives a version 00 keyset id as defined in NUT-02.
+func keysetId(keys map[uint64]*secp256k1.PublicKey) string {
+	amounts := make([]uint64, 0, len(keys))
+	for amount := range keys {
+		amounts = append(amounts, amount)
+	}
+	sort.Slice(amounts, func(i, j int) bool { return amounts[i] < amounts[j] })
+	hash := sha256.New()
+	for _, amount := range amounts {
+		hash.Write(keys[amount].SerializeCompressed())
+	}
+	return "00" + hex.EncodeToString(hash.Sum(nil)[:7])
+}
+
+func (ks *keyset) publicKeys() map[uint64]*secp256k1.PublicKey {
+	keys := make(map[uint64]*secp256k1.PublicKey, len(ks.keys))
+	for amount, key := range ks.keys {
+		keys[amount] = key.PubKey()
+	}
+	return keys
+}
+
+func (ks *keyset) publicKeysHex() map[string]string {
+	keys := make(map[string]string, len(ks.keys))
+	for amount, key := range ks.keys {
+		keys[strconv.FormatUint(amount, 10)] = pointHex(key.PubKey())
+	}
+	return keys
+}
+
+// sign blind-signs an output with the key of its amount.
+func (ks *keyset) sign(output BlindedMessage) (BlindSignature, error) {
+	key, ok := ks.keys[output.Amount]
+	if !ok {
+		return BlindSignature{}, fmt.Errorf("amount %d not supported by keyset %s", output.Amount, ks.id)
+	}
+	blinded, err := parsePoint(output.B)
+	if err != nil {
+		return BlindSignature{}, fmt.Errorf("invalid blinded message: %w", err)
+	}
+	signed := multiply(blinded, &key.Key)
+	e, s, err := dleq(key, blinded, signed)
+	if err != nil {
+		return BlindSignature{}, err
+	}
+	return BlindSignature{Amount: output.Amount, Id: ks.id, C: pointHex(signed), Dleq: &Dleq{E: e, S: s}}, nil
+}
+
+// verify checks that proof carries the signature of this keyset on its
+// secret.
+func (ks *keyset) verify(proof Proof) error {
+	key, ok := ks.keys[proof.Amount]
+	if !ok {
+		return fmt.Errorf("amount %d not supported by keyset %s", proof.Amount, ks.id)
+	}
+	c, err := parsePoint(proof.C)
+	if err != nil {
+		return fmt.Errorf("invalid proof signature: %w", err)
+	}
+	y, err := hashToCurve([]byte(proof.Secret))
+	if err != nil {
+		return err
+	}
+	if !multiply(y, &key.Key).IsEqual(c) {
+		return errors.New("proof signature does not match its secret")
+	}
+	return nil
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
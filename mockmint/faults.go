@@ -0,0 +1,119 @@
+package mockmint
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+)
+
+// Fault describes a failure injected into requests to the mint. A fault
+// that sets nothing but Delay (and Times) only adds latency: the request is
+// handled normally once the delay has passed.
+type Fault struct {
+	// Number of requests the fault applies to, 0 for every request
+	Times int
+	// Wait this long before handling the request
+	Delay time.Duration
+	// Handle the request normally and then replace the response, as if the
+	// response was lost after the mint committed the operation
+	AfterHandling bool
+	// Close the connection without a response
+	Disconnect bool
+	// HTTP status of the response, 500 by default
+	Status int
+	// NUT-00 error code and detail of the response, CodeUnknown and
+	// "injected fault" by default
+	Code   int
+	Detail string
+}
+
+func (fault Fault) latencyOnly() bool {
+	return fault.Delay > 0 && !fault.AfterHandling && !fault.Disconnect &&
+		fault.Status == 0 && fault.Code == 0 && fault.Detail == ""
+}
+
+type activeFault struct {
+	path      string
+	fault     Fault
+	remaining int
+}
+
+// InjectFault makes requests whose path starts with path, e.g. "/v1/swap"
+// or "/v1/melt", fail as described by fault. Faults are matched in the
+// order they were injected.
+func (m *Mint) InjectFault(path string, fault Fault) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.faults = append(m.faults, &activeFault{path: path, fault: fault, remaining: fault.Times})
+}
+
+// ClearFaults removes all injected faults.
+func (m *Mint) ClearFaults() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.faults = nil
+}
+
+// takeFault returns the first fault matching path and uses one of its
+// applications up.
+func (m *Mint) takeFault(path string) (Fault, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, active := range m.faults {
+		if !isPathPrefix(path, active.path) {
+			continue
+		}
+		if active.fault.Times > 0 {
+			active.remaining--
+			if active.remaining <= 0 {
+				m.faults = append(m.faults[:i], m.faults[i+1:]...)
+			}
+		}
+		return active.fault, true
+	}
+	return Fault{}, false
+}
+
+func (m *Mint) withFaults(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fault, ok := m.takeFault(r.URL.Path)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if fault.Delay > 0 {
+			select {
+			case <-time.After(fault.Delay):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		if fault.latencyOnly() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if fault.AfterHandling {
+			next.ServeHTTP(httptest.NewRecorder(), r)
+		}
+		if fault.Disconnect {
+			if hijacker, ok := w.(http.Hijacker); ok {
+				if conn, _, err := hijacker.Hijack(); err == nil {
+					conn.Close()
+					return
+				}
+			}
+		}
+		status := fault.Status
+		if status == 0 {
+			status = http.StatusInternalServerError
+		}
+		response := &mintError{Code: fault.Code, Detail: fault.Detail}
+		if response.Code == 0 {
+			response.Code = CodeUnknown
+		}
+		if response.Detail == "" {
+			response.Detail = "injected fault"
+		}
+		writeJSON(w, status, response)
+	})
+}
@@ -0,0 +1,363 @@
+package mockmint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/lescuer97/cdkgo/internal/bech32"
+)
+
+// PaymentOutcome is what FakeLightning does with an outgoing payment to an
+// invoice it did not create.
+type PaymentOutcome int
+
+const (
+	// The payment succeeds immediately
+	PaymentSucceed PaymentOutcome = iota
+	// The payment fails and the melt quote goes back to unpaid
+	PaymentFail
+	// The payment stays in flight until SettlePayment is called
+	PaymentPending
+)
+
+// ErrUnknownInvoice is returned for invoices FakeLightning has no record of.
+var ErrUnknownInvoice = errors.New("unknown invoice")
+
+type invoice struct {
+	bolt11     string
+	amountMsat uint64
+	preimage   string
+	paid       bool
+}
+
+type payment struct {
+	amountMsat uint64
+	preimage   string
+	feeMsat    uint64
+	state      string
+}
+
+// FakeLightning stands in for the Lightning node of one or more mock mints.
+// It issues real, signed bolt11 invoices so CDK can parse them, but no
+// payment ever leaves the process. Invoices created by one mint and paid by
+// another mint sharing the same FakeLightning settle against each other,
+// which is what MultiMintWallet.Transfer needs.
+type FakeLightning struct {
+	mu       sync.Mutex
+	nodeKey  *secp256k1.PrivateKey
+	invoices map[string]*invoice
+	payments map[string]*payment
+	outcome  PaymentOutcome
+	feeMsat  uint64
+}
+
+// NewFakeLightning returns a FakeLightning whose outgoing payments succeed
+// without routing fees.
+func NewFakeLightning() *FakeLightning {
+	nodeKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		panic(err)
+	}
+	return &FakeLightning{
+		nodeKey:  nodeKey,
+		invoices: map[string]*invoice{},
+		payments: map[string]*payment{},
+	}
+}
+
+// SetOutgoing sets what happens to payments of invoices this FakeLightning
+// did not create, and the routing fee they are charged.
+func (ln *FakeLightning) SetOutgoing(outcome PaymentOutcome, feeMsat uint64) {
+	ln.mu.Lock()
+	defer ln.mu.Unlock()
+	ln.outcome = outcome
+	ln.feeMsat = feeMsat
+}
+
+// CreateInvoice returns a new bolt11 invoice and its payment hash.
+func (ln *FakeLightning) CreateInvoice(amountMsat uint64, description string) (string, string, error) {
+	return ln.createInvoice(amountMsat, description, false)
+}
+
+// CreateInvoiceHashed is CreateInvoice with the SHA-256 of description
+// in the invoice instead of description, as LNURL-pay services do.
+func (ln *FakeLightning) CreateInvoiceHashed(amountMsat uint64, description string) (string, string, error) {
+	return ln.createInvoice(amountMsat, description, true)
+}
+
+func (ln *FakeLightning) createInvoice(amountMsat uint64, description string, hashed bool) (string, string, error) {
+	preimage := randomHex(32)
+	rawPreimage, _ := hex.DecodeString(preimage)
+	hash := sha256.Sum256(rawPreimage)
+	paymentHash := hex.EncodeToString(hash[:])
+
+	bolt11, err := encodeInvoice(ln.nodeKey, amountMsat, hash[:], description, hashed)
+	if err != nil {
+		return "", "", err
+	}
+
+	ln.mu.Lock()
+	defer ln.mu.Unlock()
+	ln.invoices[paymentHash] = &invoice{bolt11: bolt11, amountMsat: amountMsat, preimage: preimage}
+	return bolt11, paymentHash, nil
+}
+
+// MarkPaid marks an invoice created by this FakeLightning as paid, as if
+// someone outside had paid it.
+func (ln *FakeLightning) MarkPaid(bolt11 string) error {
+	decoded, err := decodeInvoice(bolt11)
+	if err != nil {
+		return err
+	}
+	ln.mu.Lock()
+	defer ln.mu.Unlock()
+	inv, ok := ln.invoices[decoded.paymentHash]
+	if !ok {
+		return ErrUnknownInvoice
+	}
+	inv.paid = true
+	return nil
+}
+
+func (ln *FakeLightning) invoicePaid(paymentHash string) bool {
+	ln.mu.Lock()
+	defer ln.mu.Unlock()
+	inv, ok := ln.invoices[paymentHash]
+	return ok && inv.paid
+}
+
+// isOwnInvoice reports whether paymentHash belongs to an invoice created by
+// this FakeLightning.
+func (ln *FakeLightning) isOwnInvoice(paymentHash string) bool {
+	ln.mu.Lock()
+	defer ln.mu.Unlock()
+	_, ok := ln.invoices[paymentHash]
+	return ok
+}
+
+// pay pays a decoded invoice and returns the payment state (PAID, PENDING
+// or FAILED), the preimage and the routing fee.
+func (ln *FakeLightning) pay(decoded decodedInvoice, amountMsat uint64) (state, preimage string, feeMsat uint64) {
+	ln.mu.Lock()
+	defer ln.mu.Unlock()
+
+	if p, ok := ln.payments[decoded.paymentHash]; ok && p.state != "FAILED" {
+		return p.state, p.preimage, p.feeMsat
+	}
+
+	if inv, ok := ln.invoices[decoded.paymentHash]; ok {
+		if inv.paid {
+			return "FAILED", "", 0
+		}
+		inv.paid = true
+		ln.payments[decoded.paymentHash] = &payment{amountMsat: amountMsat, preimage: inv.preimage, state: "PAID"}
+		return "PAID", inv.preimage, 0
+	}
+
+	p := &payment{amountMsat: amountMsat, feeMsat: ln.feeMsat}
+	switch ln.outcome {
+	case PaymentFail:
+		p.state = "FAILED"
+	case PaymentPending:
+		p.state = "PENDING"
+	default:
+		p.state = "PAID"
+		p.preimage = randomHex(32)
+	}
+	ln.payments[decoded.paymentHash] = p
+	return p.state, p.preimage, p.feeMsat
+}
+
+// SettlePayment completes an outgoing payment that was left pending.
+// Mints pick up the result the next time the melt quote is looked up.
+func (ln *FakeLightning) SettlePayment(bolt11 string, succeeded bool) error {
+	decoded, err := decodeInvoice(bolt11)
+	if err != nil {
+		return err
+	}
+	ln.mu.Lock()
+	defer ln.mu.Unlock()
+	p, ok := ln.payments[decoded.paymentHash]
+	if !ok || p.state != "PENDING" {
+		return fmt.Errorf("no pending payment for invoice %s", decoded.paymentHash)
+	}
+	if succeeded {
+		p.state = "PAID"
+		p.preimage = randomHex(32)
+	} else {
+		p.state = "FAILED"
+	}
+	return nil
+}
+
+func (ln *FakeLightning) paymentState(paymentHash string) (state, preimage string, feeMsat uint64) {
+	ln.mu.Lock()
+	defer ln.mu.Unlock()
+	p, ok := ln.payments[paymentHash]
+	if !ok {
+		return "", "", 0
+	}
+	return p.state, p.preimage, p.feeMsat
+}
+
+const (
+	tagPaymentHash     = 1
+	tagDescription     = 13
+	tagDescriptionHash = 23
+	tagPaymentSecret   = 16
+	tagExpiry          = 6
+)
+
+type decodedInvoice struct {
+	amountMsat  uint64
+	paymentHash string
+}
+
+// encodeInvoice builds a regtest bolt11 invoice signed by nodeKey, with the
+// description or, if hashed, its SHA-256.
+func encodeInvoice(nodeKey *secp256k1.PrivateKey, amountMsat uint64, paymentHash []byte, description string, hashed bool) (string, error) {
+	hrp := "lnbcrt"
+	if amountMsat > 0 {
+		if amountMsat%100 == 0 {
+			hrp += strconv.FormatUint(amountMsat/100, 10) + "n"
+		} else {
+			hrp += strconv.FormatUint(amountMsat*10, 10) + "p"
+		}
+	}
+
+	data := uintToWords(uint64(time.Now().Unix()), 7)
+	data = appendTag(data, tagPaymentHash, toWords(paymentHash))
+	data = appendTag(data, tagPaymentSecret, toWords(mustDecodeHex(randomHex(32))))
+	if hashed {
+		descriptionHash := sha256.Sum256([]byte(description))
+		data = appendTag(data, tagDescriptionHash, toWords(descriptionHash[:]))
+	} else {
+		data = appendTag(data, tagDescription, toWords([]byte(description)))
+	}
+	data = appendTag(data, tagExpiry, trimLeadingZeroWords(uintToWords(3600, 7)))
+
+	signed, err := bech32.ConvertBits(data, 5, 8, true)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(append([]byte(hrp), signed...))
+	compact := ecdsa.SignCompact(nodeKey, hash[:], true)
+	signature := append(append([]byte{}, compact[1:]...), compact[0]-27-4)
+	data = append(data, toWords(signature)...)
+
+	return bech32.Encode(hrp, data), nil
+}
+
+// decodeInvoice reads the amount and payment hash of a bolt11 invoice.
+func decodeInvoice(bolt11 string) (decodedInvoice, error) {
+	var decoded decodedInvoice
+	hrp, data, err := bech32.Decode(strings.ToLower(bolt11))
+	if err != nil {
+		return decoded, err
+	}
+	if !strings.HasPrefix(hrp, "ln") {
+		return decoded, errors.New("not a lightning invoice")
+	}
+	if decoded.amountMsat, err = hrpAmountMsat(hrp[2:]); err != nil {
+		return decoded, err
+	}
+	if len(data) < 7+104 {
+		return decoded, errors.New("invoice too short")
+	}
+	fields := data[7 : len(data)-104]
+	for len(fields) >= 3 {
+		tag := fields[0]
+		length := int(fields[1])<<5 | int(fields[2])
+		if len(fields) < 3+length {
+			return decoded, errors.New("truncated invoice field")
+		}
+		if tag == tagPaymentHash && length == 52 {
+			hash, err := bech32.ConvertBits(fields[3:3+length], 5, 8, false)
+			if err != nil {
+				return decoded, err
+			}
+			decoded.paymentHash = hex.EncodeToString(hash)
+		}
+		fields = fields[3+length:]
+	}
+	if decoded.paymentHash == "" {
+		return decoded, errors.New("invoice has no payment hash")
+	}
+	return decoded, nil
+}
+
+func hrpAmountMsat(hrp string) (uint64, error) {
+	for _, currency := range []string{"bcrt", "bc", "tbs", "tb", "sb"} {
+		if strings.HasPrefix(hrp, currency) {
+			hrp = hrp[len(currency):]
+			break
+		}
+	}
+	if hrp == "" {
+		return 0, nil
+	}
+	multiplier := map[byte]uint64{'m': 100_000_000, 'u': 100_000, 'n': 100}
+	last := hrp[len(hrp)-1]
+	if last == 'p' {
+		value, err := strconv.ParseUint(hrp[:len(hrp)-1], 10, 64)
+		if err != nil || value%10 != 0 {
+			return 0, fmt.Errorf("invalid invoice amount %q", hrp)
+		}
+		return value / 10, nil
+	}
+	if factor, ok := multiplier[last]; ok {
+		value, err := strconv.ParseUint(hrp[:len(hrp)-1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid invoice amount %q", hrp)
+		}
+		return value * factor, nil
+	}
+	value, err := strconv.ParseUint(hrp, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid invoice amount %q", hrp)
+	}
+	return value * 100_000_000_000, nil
+}
+
+func appendTag(data []byte, tag byte, value []byte) []byte {
+	data = append(data, tag, byte(len(value)>>5), byte(len(value)&31))
+	return append(data, value...)
+}
+
+func uintToWords(value uint64, count int) []byte {
+	words := make([]byte, count)
+	for i := count - 1; i >= 0; i-- {
+		words[i] = byte(value & 31)
+		value >>= 5
+	}
+	return words
+}
+
+// toWords regroups bytes into 5 bit words, which cannot fail.
+func toWords(data []byte) []byte {
+	words, _ := bech32.ConvertBits(data, 8, 5, true)
+	return words
+}
+
+func trimLeadingZeroWords(words []byte) []byte {
+	for len(words) > 1 && words[0] == 0 {
+		words = words[1:]
+	}
+	return words
+}
+
+func mustDecodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
@@ -0,0 +1,190 @@
+package mockmint
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// LnurlServer stands in for LNURL-pay (Lightning address) and
+// LNURL-withdraw services. Invoices are issued and paid through a
+// FakeLightning, so a mint sharing it settles them.
+type LnurlServer struct {
+	server    *httptest.Server
+	lightning *FakeLightning
+
+	mu          sync.Mutex
+	comments    map[string][]string
+	withdrawals map[string]*withdrawal
+}
+
+type withdrawal struct {
+	minMsat, maxMsat uint64
+	invoice          string
+}
+
+// NewLnurlServer starts an LNURL server paying and issuing invoices on
+// lightning. Close it when done.
+func NewLnurlServer(lightning *FakeLightning) *LnurlServer {
+	s := &LnurlServer{
+		lightning:   lightning,
+		comments:    map[string][]string{},
+		withdrawals: map[string]*withdrawal{},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/lnurlp/{user}", s.handlePay)
+	mux.HandleFunc("GET /lnurlp/{user}/callback", s.handlePayCallback)
+	mux.HandleFunc("GET /lnurlw/{k1}", s.handleWithdraw)
+	mux.HandleFunc("GET /lnurlw/{k1}/callback", s.handleWithdrawCallback)
+	s.server = httptest.NewServer(mux)
+	return s
+}
+
+// Close shuts the server down.
+func (s *LnurlServer) Close() {
+	s.server.Close()
+}
+
+// Address returns the Lightning address of user on this server. Every user
+// exists and accepts 1 to 1,000,000 sat.
+func (s *LnurlServer) Address(user string) string {
+	return user + "@" + strings.TrimPrefix(s.server.URL, "http://")
+}
+
+// Comments returns the comments sent with payments to user.
+func (s *LnurlServer) Comments(user string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.comments[user]...)
+}
+
+// NewWithdraw returns the URL of a single use LNURL-withdraw of minMsat to
+// maxMsat.
+func (s *LnurlServer) NewWithdraw(minMsat, maxMsat uint64) string {
+	k1 := randomHex(32)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.withdrawals[k1] = &withdrawal{minMsat: minMsat, maxMsat: maxMsat}
+	return s.server.URL + "/lnurlw/" + k1
+}
+
+// Withdrawn returns the invoice a withdraw paid, empty if it was not used.
+func (s *LnurlServer) Withdrawn(withdrawUrl string) string {
+	k1 := withdrawUrl[strings.LastIndexByte(withdrawUrl, '/')+1:]
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if w, ok := s.withdrawals[k1]; ok {
+		return w.invoice
+	}
+	return ""
+}
+
+func lnurlError(w http.ResponseWriter, format string, args ...any) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ERROR", "reason": fmt.Sprintf(format, args...)})
+}
+
+func payMetadata(user string) string {
+	metadata, _ := json.Marshal([][]string{{"text/plain", "Payment to " + user}})
+	return string(metadata)
+}
+
+func (s *LnurlServer) handlePay(w http.ResponseWriter, r *http.Request) {
+	user := r.PathValue("user")
+	writeJSON(w, http.StatusOK, map[string]any{
+		"tag":            "payRequest",
+		"callback":       s.server.URL + "/lnurlp/" + user + "/callback",
+		"minSendable":    1000,
+		"maxSendable":    1_000_000_000,
+		"metadata":       payMetadata(user),
+		"commentAllowed": 140,
+	})
+}
+
+func (s *LnurlServer) handlePayCallback(w http.ResponseWriter, r *http.Request) {
+	user := r.PathValue("user")
+	amount, err := strconv.ParseUint(r.URL.Query().Get("amount"), 10, 64)
+	if err != nil || amount < 1000 || amount > 1_000_000_000 {
+		lnurlError(w, "invalid amount")
+		return
+	}
+	if comment := r.URL.Query().Get("comment"); comment != "" {
+		if len(comment) > 140 {
+			lnurlError(w, "comment too long")
+			return
+		}
+		s.mu.Lock()
+		s.comments[user] = append(s.comments[user], comment)
+		s.mu.Unlock()
+	}
+	invoice, _, err := s.lightning.CreateInvoiceHashed(amount, payMetadata(user))
+	if err != nil {
+		lnurlError(w, "%v", err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"pr": invoice, "routes": []any{}})
+}
+
+func (s *LnurlServer) handleWithdraw(w http.ResponseWriter, r *http.Request) {
+	k1 := r.PathValue("k1")
+	s.mu.Lock()
+	wd, ok := s.withdrawals[k1]
+	s.mu.Unlock()
+	if !ok {
+		lnurlError(w, "unknown withdraw")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"tag":                "withdrawRequest",
+		"callback":           s.server.URL + "/lnurlw/" + k1 + "/callback",
+		"k1":                 k1,
+		"defaultDescription": "Withdraw",
+		"minWithdrawable":    wd.minMsat,
+		"maxWithdrawable":    wd.maxMsat,
+	})
+}
+
+func (s *LnurlServer) handleWithdrawCallback(w http.ResponseWriter, r *http.Request) {
+	k1 := r.PathValue("k1")
+	if r.URL.Query().Get("k1") != k1 {
+		lnurlError(w, "k1 mismatch")
+		return
+	}
+	invoice := r.URL.Query().Get("pr")
+	decoded, err := decodeInvoice(invoice)
+	if err != nil {
+		lnurlError(w, "invalid invoice: %v", err)
+		return
+	}
+
+	s.mu.Lock()
+	wd, ok := s.withdrawals[k1]
+	switch {
+	case !ok:
+		s.mu.Unlock()
+		lnurlError(w, "unknown withdraw")
+		return
+	case wd.invoice != "":
+		s.mu.Unlock()
+		lnurlError(w, "withdraw already used")
+		return
+	case decoded.amountMsat < wd.minMsat || decoded.amountMsat > wd.maxMsat:
+		s.mu.Unlock()
+		lnurlError(w, "amount outside of %d-%d msat", wd.minMsat, wd.maxMsat)
+		return
+	}
+	wd.invoice = invoice
+	s.mu.Unlock()
+
+	if state, _, _ := s.lightning.pay(decoded, decoded.amountMsat); state != "PAID" {
+		s.mu.Lock()
+		wd.invoice = ""
+		s.mu.Unlock()
+		lnurlError(w, "payment %s", strings.ToLower(state))
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"status": "OK"})
+}
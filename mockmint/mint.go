@@ -0,0 +1,897 @@
+// Package mockmint is an in-process Cashu mint for exercising wallets
+// without a network. It serves NUT-01, 02, 03, 04, 05, 06, 07 and 09 over an
+// httptest server, signs outputs with real BDHKE blind signatures (including
+// NUT-12 DLEQ proofs) and settles Lightning payments against a FakeLightning.
+//
+// Spending conditions (NUT-10/11/14) and mint quote signatures (NUT-20) are
+// accepted but not enforced.
+package mockmint
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+)
+
+// NUT-00 error codes returned by the mint.
+const (
+	CodeBlindedMessageAlreadySigned = 10002
+	CodeTokenNotVerified            = 10003
+	CodeTokenAlreadySpent           = 11001
+	CodeTransactionNotBalanced      = 11002
+	CodeUnitNotSupported            = 11005
+	CodeAmountOutsideLimit          = 11006
+	CodeDuplicateInputs             = 11007
+	CodeDuplicateOutputs            = 11008
+	CodeKeysetNotKnown              = 12001
+	CodeKeysetInactive              = 12002
+	CodeQuoteNotPaid                = 20001
+	CodeTokensAlreadyIssued         = 20002
+	CodeLightningError              = 20004
+	CodeQuotePending                = 20005
+	CodeInvoiceAlreadyPaid          = 20006
+	CodeQuoteExpired                = 20007
+	CodeUnknown                     = 10000
+)
+
+// Options configures a Mint. The zero value is a usable sat mint.
+type Options struct {
+	// Unit of the keysets, "sat" by default
+	Unit string
+	// Millisatoshis one unit is worth when converting to and from invoice
+	// amounts. Defaults to 1 for "msat" and 1000 otherwise, so other units
+	// trade one to one with sat.
+	MsatPerUnit uint64
+	// Seed the keysets are derived from, random if empty
+	Seed []byte
+	// Number of keys per keyset, amounts 2^0 to 2^(MaxOrder-1), 32 by default
+	MaxOrder int
+	// Input fee of the keysets in parts per thousand
+	InputFeePpk uint64
+	// Fee reserve of a melt quote for amount, 1% with a minimum of 1 by
+	// default. Invoices of the mint's own FakeLightning get no reserve.
+	FeeReserve func(amount uint64) uint64
+	// Lightning backend, possibly shared with other mints, a new
+	// FakeLightning if nil
+	Lightning *FakeLightning
+	// Mark mint quotes paid as soon as they are created
+	AutoPay bool
+	// Time until mint and melt quotes expire, an hour by default. Quotes
+	// are created already expired if it is negative.
+	QuoteExpiry time.Duration
+	// Smallest and largest amount accepted by mint and melt quotes, 1 and
+	// 1,000,000 by default
+	MinAmount, MaxAmount uint64
+	// Name reported by /v1/info
+	Name string
+}
+
+type mintQuote struct {
+	id          string
+	amount      uint64
+	unit        string
+	request     string
+	paymentHash string
+	expiry      int64
+	pubkey      *string
+	issued      bool
+}
+
+type meltQuote struct {
+	id          string
+	amount      uint64
+	unit        string
+	request     string
+	paymentHash string
+	feeReserve  uint64
+	expiry      int64
+	state       string
+	preimage    string
+	inputs      []string
+	inputTotal  uint64
+	inputFee    uint64
+	outputs     []BlindedMessage
+	change      []BlindSignature
+}
+
+// Mint is a mock Cashu mint served by an httptest server.
+type Mint struct {
+	opts      Options
+	server    *httptest.Server
+	lightning *FakeLightning
+	pubkey    string
+
+	mu         sync.Mutex
+	keysets    []*keyset
+	spent      map[string]bool
+	pending    map[string]string
+	signed     map[string]BlindSignature
+	signedB    map[string]BlindedMessage
+	mintQuotes map[string]*mintQuote
+	meltQuotes map[string]*meltQuote
+	faults     []*activeFault
+}
+
+// New starts a mock mint. Close it when done.
+func New(opts Options) *Mint {
+	m := NewUnstarted(opts)
+	m.server = httptest.NewServer(m.Handler())
+	return m
+}
+
+// NewUnstarted returns a mock mint without starting a server, to be served
+// through Handler.
+func NewUnstarted(opts Options) *Mint {
+	if opts.Unit == "" {
+		opts.Unit = "sat"
+	}
+	if len(opts.Seed) == 0 {
+		opts.Seed = make([]byte, 32)
+		rand.Read(opts.Seed)
+	}
+	if opts.MsatPerUnit == 0 {
+		opts.MsatPerUnit = 1000
+		if opts.Unit == "msat" {
+			opts.MsatPerUnit = 1
+		}
+	}
+	if opts.MaxOrder <= 0 {
+		opts.MaxOrder = 32
+	}
+	if opts.FeeReserve == nil {
+		opts.FeeReserve = func(amount uint64) uint64 { return max(amount/100, 1) }
+	}
+	if opts.QuoteExpiry == 0 {
+		opts.QuoteExpiry = time.Hour
+	}
+	if opts.MinAmount == 0 {
+		opts.MinAmount = 1
+	}
+	if opts.MaxAmount == 0 {
+		opts.MaxAmount = 1_000_000
+	}
+	if opts.Name == "" {
+		opts.Name = "mockmint"
+	}
+	lightning := opts.Lightning
+	if lightning == nil {
+		lightning = NewFakeLightning()
+	}
+	m := &Mint{
+		opts:       opts,
+		lightning:  lightning,
+		keysets:    []*keyset{newKeyset(opts.Seed, 0, opts.Unit, opts.MaxOrder, opts.InputFeePpk)},
+		spent:      map[string]bool{},
+		pending:    map[string]string{},
+		signed:     map[string]BlindSignature{},
+		signedB:    map[string]BlindedMessage{},
+		mintQuotes: map[string]*mintQuote{},
+		meltQuotes: map[string]*meltQuote{},
+	}
+	m.pubkey = pointHex(m.keysets[0].keys[1].PubKey())
+	return m
+}
+
+// URL returns the base URL of the mint, to be passed as MintUrl.
+func (m *Mint) URL() string {
+	if m.server == nil {
+		return ""
+	}
+	return m.server.URL
+}
+
+// Close shuts the server down.
+func (m *Mint) Close() {
+	if m.server != nil {
+		m.server.Close()
+	}
+}
+
+// Lightning returns the Lightning backend of the mint.
+func (m *Mint) Lightning() *FakeLightning {
+	return m.lightning
+}
+
+// ActiveKeysetId returns the id of the keyset new outputs are signed with.
+func (m *Mint) ActiveKeysetId() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.keysets[len(m.keysets)-1].id
+}
+
+// RotateKeyset deactivates the current keyset and activates a new one with
+// the given input fee. Proofs of inactive keysets can still be spent.
+func (m *Mint) RotateKeyset(inputFeePpk uint64) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, ks := range m.keysets {
+		ks.active = false
+	}
+	ks := newKeyset(m.opts.Seed, uint32(len(m.keysets)), m.opts.Unit, m.opts.MaxOrder, inputFeePpk)
+	m.keysets = append(m.keysets, ks)
+	return ks.id
+}
+
+// PayMintQuote marks the invoice of a mint quote as paid.
+func (m *Mint) PayMintQuote(quoteId string) error {
+	m.mu.Lock()
+	quote, ok := m.mintQuotes[quoteId]
+	m.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("unknown mint quote %s", quoteId)
+	}
+	return m.lightning.MarkPaid(quote.request)
+}
+
+// MeltQuoteState returns the NUT-05 state of a melt quote.
+func (m *Mint) MeltQuoteState(quoteId string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	quote, ok := m.meltQuotes[quoteId]
+	if !ok {
+		return "", fmt.Errorf("unknown melt quote %s", quoteId)
+	}
+	m.syncMeltQuote(quote)
+	return quote.state, nil
+}
+
+// Handler returns the HTTP handler of the mint.
+func (m *Mint) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/info", m.handleInfo)
+	mux.HandleFunc("GET /v1/keys", m.handleKeys)
+	mux.HandleFunc("GET /v1/keys/{id}", m.handleKeys)
+	mux.HandleFunc("GET /v1/keysets", m.handleKeysets)
+	mux.HandleFunc("POST /v1/swap", m.handleSwap)
+	mux.HandleFunc("POST /v1/mint/quote/bolt11", m.handleMintQuote)
+	mux.HandleFunc("GET /v1/mint/quote/bolt11/{id}", m.handleGetMintQuote)
+	mux.HandleFunc("POST /v1/mint/bolt11", m.handleMint)
+	mux.HandleFunc("POST /v1/melt/quote/bolt11", m.handleMeltQuote)
+	mux.HandleFunc("GET /v1/melt/quote/bolt11/{id}", m.handleGetMeltQuote)
+	mux.HandleFunc("POST /v1/melt/bolt11", m.handleMelt)
+	mux.HandleFunc("POST /v1/checkstate", m.handleCheckState)
+	mux.HandleFunc("POST /v1/restore", m.handleRestore)
+	return m.withFaults(mux)
+}
+
+// mintError is a NUT-00 error response.
+type mintError struct {
+	Code   int    `json:"code"`
+	Detail string `json:"detail"`
+}
+
+func (err *mintError) Error() string {
+	return fmt.Sprintf("%d: %s", err.Code, err.Detail)
+}
+
+func errorf(code int, format string, args ...any) *mintError {
+	return &mintError{Code: code, Detail: fmt.Sprintf(format, args...)}
+}
+
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	if mErr, ok := err.(*mintError); ok {
+		writeJSON(w, http.StatusBadRequest, mErr)
+		return
+	}
+	writeJSON(w, http.StatusBadRequest, &mintError{Code: CodeUnknown, Detail: err.Error()})
+}
+
+func readJSON(r *http.Request, value any) error {
+	if err := json.NewDecoder(r.Body).Decode(value); err != nil {
+		return errorf(CodeUnknown, "invalid request body: %v", err)
+	}
+	return nil
+}
+
+func (m *Mint) keyset(id string) *keyset {
+	for _, ks := range m.keysets {
+		if ks.id == id {
+			return ks
+		}
+	}
+	return nil
+}
+
+func (m *Mint) handleInfo(w http.ResponseWriter, r *http.Request) {
+	method := map[string]any{
+		"method":     "bolt11",
+		"unit":       m.opts.Unit,
+		"min_amount": m.opts.MinAmount,
+		"max_amount": m.opts.MaxAmount,
+	}
+	// Mint quotes take a description, melt quotes have no options.
+	mintMethod := map[string]any{"options": map[string]any{"description": true}}
+	for key, value := range method {
+		mintMethod[key] = value
+	}
+	supported := map[string]bool{"supported": true}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"name":    m.opts.Name,
+		"pubkey":  m.pubkey,
+		"version": "mockmint/0.1.0",
+		"time":    time.Now().Unix(),
+		"nuts": map[string]any{
+			"4":  map[string]any{"methods": []any{mintMethod}, "disabled": false},
+			"5":  map[string]any{"methods": []any{method}, "disabled": false},
+			"7":  supported,
+			"8":  supported,
+			"9":  supported,
+			"12": supported,
+		},
+	})
+}
+
+func (m *Mint) handleKeys(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	id := r.PathValue("id")
+	var keysets []any
+	for _, ks := range m.keysets {
+		if (id == "" && ks.active) || ks.id == id {
+			keysets = append(keysets, map[string]any{"id": ks.id, "unit": ks.unit, "keys": ks.publicKeysHex()})
+		}
+	}
+	if id != "" && len(keysets) == 0 {
+		writeError(w, errorf(CodeKeysetNotKnown, "keyset %s is not known", id))
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"keysets": keysets})
+}
+
+func (m *Mint) handleKeysets(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	keysets := make([]any, 0, len(m.keysets))
+	for _, ks := range m.keysets {
+		keysets = append(keysets, map[string]any{
+			"id":            ks.id,
+			"unit":          ks.unit,
+			"active":        ks.active,
+			"input_fee_ppk": ks.inputFeePpk,
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"keysets": keysets})
+}
+
+// checkInputs verifies the signatures of inputs and that none of them is
+// spent or pending. It returns their Ys, total amount and input fee.
+func (m *Mint) checkInputs(inputs []Proof) ([]string, uint64, uint64, error) {
+	if len(inputs) == 0 {
+		return nil, 0, 0, errorf(CodeTransactionNotBalanced, "no inputs")
+	}
+	var (
+		ys     []string
+		total  uint64
+		feePpk uint64
+		seen   = map[string]bool{}
+	)
+	for _, proof := range inputs {
+		ks := m.keyset(proof.Id)
+		if ks == nil {
+			return nil, 0, 0, errorf(CodeKeysetNotKnown, "keyset %s is not known", proof.Id)
+		}
+		if err := ks.verify(proof); err != nil {
+			return nil, 0, 0, errorf(CodeTokenNotVerified, "%v", err)
+		}
+		y, err := yHex(proof.Secret)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		if seen[y] {
+			return nil, 0, 0, errorf(CodeDuplicateInputs, "duplicate inputs")
+		}
+		seen[y] = true
+		if m.spent[y] {
+			return nil, 0, 0, errorf(CodeTokenAlreadySpent, "token already spent")
+		}
+		if _, ok := m.pending[y]; ok {
+			return nil, 0, 0, errorf(CodeQuotePending, "token is pending")
+		}
+		ys = append(ys, y)
+		total += proof.Amount
+		feePpk += ks.inputFeePpk
+	}
+	return ys, total, (feePpk + 999) / 1000, nil
+}
+
+// checkOutputs verifies that outputs are new and target an active keyset
+// and returns their total amount.
+func (m *Mint) checkOutputs(outputs []BlindedMessage) (uint64, error) {
+	var (
+		total uint64
+		seen  = map[string]bool{}
+	)
+	for _, output := range outputs {
+		ks := m.keyset(output.Id)
+		if ks == nil {
+			return 0, errorf(CodeKeysetNotKnown, "keyset %s is not known", output.Id)
+		}
+		if !ks.active {
+			return 0, errorf(CodeKeysetInactive, "keyset %s is inactive", output.Id)
+		}
+		if seen[output.B] {
+			return 0, errorf(CodeDuplicateOutputs, "duplicate outputs")
+		}
+		seen[output.B] = true
+		if _, ok := m.signed[output.B]; ok {
+			return 0, errorf(CodeBlindedMessageAlreadySigned, "blinded message already signed")
+		}
+		if _, ok := ks.keys[output.Amount]; !ok {
+			return 0, errorf(CodeTransactionNotBalanced, "amount %d not supported", output.Amount)
+		}
+		total += output.Amount
+	}
+	return total, nil
+}
+
+func (m *Mint) signOutputs(outputs []BlindedMessage) ([]BlindSignature, error) {
+	signatures := make([]BlindSignature, 0, len(outputs))
+	for _, output := range outputs {
+		signature, err := m.keyset(output.Id).sign(output)
+		if err != nil {
+			return nil, errorf(CodeUnknown, "%v", err)
+		}
+		signatures = append(signatures, signature)
+	}
+	for i, output := range outputs {
+		m.signed[output.B] = signatures[i]
+		m.signedB[output.B] = output
+	}
+	return signatures, nil
+}
+
+func (m *Mint) handleSwap(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Inputs  []Proof          `json:"inputs"`
+		Outputs []BlindedMessage `json:"outputs"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ys, inputTotal, fee, err := m.checkInputs(req.Inputs)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	outputTotal, err := m.checkOutputs(req.Outputs)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if inputTotal != outputTotal+fee {
+		writeError(w, errorf(CodeTransactionNotBalanced, "inputs %d do not match outputs %d plus fee %d", inputTotal, outputTotal, fee))
+		return
+	}
+	signatures, err := m.signOutputs(req.Outputs)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	for _, y := range ys {
+		m.spent[y] = true
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"signatures": signatures})
+}
+
+func (q *mintQuote) state(lightning *FakeLightning) string {
+	switch {
+	case q.issued:
+		return "ISSUED"
+	case lightning.invoicePaid(q.paymentHash):
+		return "PAID"
+	default:
+		return "UNPAID"
+	}
+}
+
+func (m *Mint) mintQuoteResponse(q *mintQuote) map[string]any {
+	state := q.state(m.lightning)
+	return map[string]any{
+		"quote":   q.id,
+		"request": q.request,
+		"amount":  q.amount,
+		"unit":    q.unit,
+		"state":   state,
+		"paid":    state != "UNPAID",
+		"expiry":  q.expiry,
+		"pubkey":  q.pubkey,
+	}
+}
+
+func (m *Mint) handleMintQuote(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Amount      uint64  `json:"amount"`
+		Unit        string  `json:"unit"`
+		Description *string `json:"description"`
+		Pubkey      *string `json:"pubkey"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	if req.Unit != m.opts.Unit {
+		writeError(w, errorf(CodeUnitNotSupported, "unit %s is not supported", req.Unit))
+		return
+	}
+	if req.Amount < m.opts.MinAmount || req.Amount > m.opts.MaxAmount {
+		writeError(w, errorf(CodeAmountOutsideLimit, "amount %d outside limit", req.Amount))
+		return
+	}
+	description := ""
+	if req.Description != nil {
+		description = *req.Description
+	}
+	request, paymentHash, err := m.lightning.CreateInvoice(req.Amount*m.opts.MsatPerUnit, description)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	quote := &mintQuote{
+		id:          randomHex(16),
+		amount:      req.Amount,
+		unit:        req.Unit,
+		request:     request,
+		paymentHash: paymentHash,
+		expiry:      time.Now().Add(m.opts.QuoteExpiry).Unix(),
+		pubkey:      req.Pubkey,
+	}
+	if m.opts.AutoPay {
+		m.lightning.MarkPaid(request)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.mintQuotes[quote.id] = quote
+	writeJSON(w, http.StatusOK, m.mintQuoteResponse(quote))
+}
+
+func (m *Mint) handleGetMintQuote(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	quote, ok := m.mintQuotes[r.PathValue("id")]
+	if !ok {
+		writeError(w, errorf(CodeUnknown, "unknown quote"))
+		return
+	}
+	writeJSON(w, http.StatusOK, m.mintQuoteResponse(quote))
+}
+
+func (m *Mint) handleMint(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Quote   string           `json:"quote"`
+		Outputs []BlindedMessage `json:"outputs"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	quote, ok := m.mintQuotes[req.Quote]
+	if !ok {
+		writeError(w, errorf(CodeUnknown, "unknown quote"))
+		return
+	}
+	switch quote.state(m.lightning) {
+	case "UNPAID":
+		writeError(w, errorf(CodeQuoteNotPaid, "quote not paid"))
+		return
+	case "ISSUED":
+		writeError(w, errorf(CodeTokensAlreadyIssued, "tokens already issued"))
+		return
+	}
+	if time.Now().Unix() > quote.expiry {
+		writeError(w, errorf(CodeQuoteExpired, "quote expired"))
+		return
+	}
+	total, err := m.checkOutputs(req.Outputs)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if total != quote.amount {
+		writeError(w, errorf(CodeTransactionNotBalanced, "outputs %d do not match quote amount %d", total, quote.amount))
+		return
+	}
+	signatures, err := m.signOutputs(req.Outputs)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	quote.issued = true
+	writeJSON(w, http.StatusOK, map[string]any{"signatures": signatures})
+}
+
+func (m *Mint) meltQuoteResponse(q *meltQuote) map[string]any {
+	response := map[string]any{
+		"quote":            q.id,
+		"request":          q.request,
+		"amount":           q.amount,
+		"unit":             q.unit,
+		"fee_reserve":      q.feeReserve,
+		"state":            q.state,
+		"paid":             q.state == "PAID",
+		"expiry":           q.expiry,
+		"payment_preimage": nil,
+	}
+	if q.preimage != "" {
+		response["payment_preimage"] = q.preimage
+	}
+	if q.change != nil {
+		response["change"] = q.change
+	}
+	return response
+}
+
+func (m *Mint) handleMeltQuote(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Request string `json:"request"`
+		Unit    string `json:"unit"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	if req.Unit != m.opts.Unit {
+		writeError(w, errorf(CodeUnitNotSupported, "unit %s is not supported", req.Unit))
+		return
+	}
+	decoded, err := decodeInvoice(req.Request)
+	if err != nil {
+		writeError(w, errorf(CodeUnknown, "invalid invoice: %v", err))
+		return
+	}
+	amount := m.fromMsat(decoded.amountMsat)
+	if amount < m.opts.MinAmount || amount > m.opts.MaxAmount {
+		writeError(w, errorf(CodeAmountOutsideLimit, "amount %d outside limit", amount))
+		return
+	}
+	feeReserve := uint64(0)
+	if !m.lightning.isOwnInvoice(decoded.paymentHash) {
+		feeReserve = m.opts.FeeReserve(amount)
+	}
+	quote := &meltQuote{
+		id:          randomHex(16),
+		amount:      amount,
+		unit:        req.Unit,
+		request:     req.Request,
+		paymentHash: decoded.paymentHash,
+		feeReserve:  feeReserve,
+		expiry:      time.Now().Add(m.opts.QuoteExpiry).Unix(),
+		state:       "UNPAID",
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.meltQuotes[quote.id] = quote
+	writeJSON(w, http.StatusOK, m.meltQuoteResponse(quote))
+}
+
+func (m *Mint) handleGetMeltQuote(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	quote, ok := m.meltQuotes[r.PathValue("id")]
+	if !ok {
+		writeError(w, errorf(CodeUnknown, "unknown quote"))
+		return
+	}
+	m.syncMeltQuote(quote)
+	writeJSON(w, http.StatusOK, m.meltQuoteResponse(quote))
+}
+
+func (m *Mint) handleMelt(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Quote   string           `json:"quote"`
+		Inputs  []Proof          `json:"inputs"`
+		Outputs []BlindedMessage `json:"outputs"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	quote, ok := m.meltQuotes[req.Quote]
+	if !ok {
+		writeError(w, errorf(CodeUnknown, "unknown quote"))
+		return
+	}
+	m.syncMeltQuote(quote)
+	switch quote.state {
+	case "PENDING":
+		writeError(w, errorf(CodeQuotePending, "quote is pending"))
+		return
+	case "PAID":
+		writeError(w, errorf(CodeInvoiceAlreadyPaid, "invoice already paid"))
+		return
+	}
+	ys, inputTotal, inputFee, err := m.checkInputs(req.Inputs)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if inputTotal < quote.amount+quote.feeReserve+inputFee {
+		writeError(w, errorf(CodeTransactionNotBalanced, "inputs %d do not cover amount %d, fee reserve %d and input fee %d", inputTotal, quote.amount, quote.feeReserve, inputFee))
+		return
+	}
+	if _, err := m.checkOutputs(req.Outputs); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	for _, y := range ys {
+		m.pending[y] = quote.id
+	}
+	quote.inputs = ys
+	quote.inputTotal = inputTotal
+	quote.inputFee = inputFee
+	quote.outputs = req.Outputs
+	quote.state = "PENDING"
+
+	decoded, _ := decodeInvoice(quote.request)
+	m.lightning.pay(decoded, quote.amount*m.opts.MsatPerUnit)
+	m.syncMeltQuote(quote)
+	if quote.state == "UNPAID" {
+		writeError(w, errorf(CodeLightningError, "lightning payment failed"))
+		return
+	}
+	writeJSON(w, http.StatusOK, m.meltQuoteResponse(quote))
+}
+
+// fromMsat converts msat to the unit of the mint, rounding up.
+func (m *Mint) fromMsat(msat uint64) uint64 {
+	return (msat + m.opts.MsatPerUnit - 1) / m.opts.MsatPerUnit
+}
+
+// syncMeltQuote applies the outcome of a pending melt payment: inputs are
+// spent and change is signed once it is paid, released once it failed.
+func (m *Mint) syncMeltQuote(quote *meltQuote) {
+	if quote.state != "PENDING" {
+		return
+	}
+	state, preimage, feeMsat := m.lightning.paymentState(quote.paymentHash)
+	switch state {
+	case "PAID":
+		for _, y := range quote.inputs {
+			delete(m.pending, y)
+			m.spent[y] = true
+		}
+		quote.state = "PAID"
+		quote.preimage = preimage
+		available := quote.inputTotal - quote.amount - quote.inputFee
+		fee := min(m.fromMsat(feeMsat), available)
+		quote.change = m.signChange(quote.outputs, available-fee)
+	case "FAILED":
+		for _, y := range quote.inputs {
+			delete(m.pending, y)
+		}
+		quote.inputs = nil
+		quote.state = "UNPAID"
+	}
+}
+
+// signChange returns overpaid fees as in NUT-08, setting the amounts of the
+// blank outputs to the powers of two overpaid splits into.
+func (m *Mint) signChange(outputs []BlindedMessage, overpaid uint64) []BlindSignature {
+	change := []BlindSignature{}
+	for bit := 0; bit < 64 && len(change) < len(outputs); bit++ {
+		amount := uint64(1) << bit
+		if overpaid&amount == 0 {
+			continue
+		}
+		output := outputs[len(change)]
+		output.Amount = amount
+		ks := m.keyset(output.Id)
+		if ks == nil {
+			break
+		}
+		signature, err := ks.sign(output)
+		if err != nil {
+			break
+		}
+		m.signed[output.B] = signature
+		m.signedB[output.B] = output
+		change = append(change, signature)
+	}
+	return change
+}
+
+func (m *Mint) handleCheckState(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Ys []string `json:"Ys"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, quote := range m.meltQuotes {
+		m.syncMeltQuote(quote)
+	}
+	states := make([]any, 0, len(req.Ys))
+	for _, y := range req.Ys {
+		state := "UNSPENT"
+		if m.spent[y] {
+			state = "SPENT"
+		} else if _, ok := m.pending[y]; ok {
+			state = "PENDING"
+		}
+		states = append(states, map[string]any{"Y": y, "state": state, "witness": nil})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"states": states})
+}
+
+func (m *Mint) handleRestore(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Outputs []BlindedMessage `json:"outputs"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	outputs := []BlindedMessage{}
+	signatures := []BlindSignature{}
+	for _, output := range req.Outputs {
+		if signature, ok := m.signed[output.B]; ok {
+			outputs = append(outputs, m.signedB[output.B])
+			signatures = append(signatures, signature)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"outputs": outputs, "signatures": signatures, "promises": signatures})
+}
+
+// BlindedMessage is an output sent to the mint to be signed.
+type BlindedMessage struct {
+	Amount uint64 `json:"amount"`
+	Id     string `json:"id"`
+	B      string `json:"B_"`
+}
+
+// BlindSignature is the mint's signature on a BlindedMessage.
+type BlindSignature struct {
+	Amount uint64 `json:"amount"`
+	Id     string `json:"id"`
+	C      string `json:"C_"`
+	Dleq   *Dleq  `json:"dleq,omitempty"`
+}
+
+// Dleq is a NUT-12 proof that a signature was made with the keyset's key.
+type Dleq struct {
+	E string `json:"e"`
+	S string `json:"s"`
+}
+
+// Proof is an input spent at the mint.
+type Proof struct {
+	Amount  uint64          `json:"amount"`
+	Id      string          `json:"id"`
+	Secret  string          `json:"secret"`
+	C       string          `json:"C"`
+	Witness json.RawMessage `json:"witness,omitempty"`
+}
+
+func isPathPrefix(path, prefix string) bool {
+	return path == prefix || strings.HasPrefix(path, strings.TrimSuffix(prefix, "/")+"/")
+}
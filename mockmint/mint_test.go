@@ -0,0 +1,109 @@
+package mockmint
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func postJSON(t *testing.T, url string, body any, out any) int {
+	t.Helper()
+	raw, err := json.Marshal(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.Post(url, "application/json", bytes.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode
+}
+
+func TestFaultDelayOnly(t *testing.T) {
+	m := New(Options{})
+	defer m.Close()
+	m.InjectFault("/v1/mint/quote", Fault{Times: 1, Delay: 50 * time.Millisecond})
+
+	var quote struct {
+		Quote   string `json:"quote"`
+		Request string `json:"request"`
+	}
+	start := time.Now()
+	status := postJSON(t, m.URL()+"/v1/mint/quote/bolt11", map[string]any{"amount": 10, "unit": "sat"}, &quote)
+	if status != http.StatusOK || quote.Quote == "" {
+		t.Fatalf("delayed request failed with status %d", status)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Fatalf("request was not delayed, took %v", elapsed)
+	}
+}
+
+func TestFaultStatus(t *testing.T) {
+	m := New(Options{})
+	defer m.Close()
+	m.InjectFault("/v1/mint/quote", Fault{Times: 1, Delay: time.Millisecond, Status: http.StatusServiceUnavailable})
+
+	var response mintError
+	if status := postJSON(t, m.URL()+"/v1/mint/quote/bolt11", map[string]any{"amount": 10, "unit": "sat"}, &response); status != http.StatusServiceUnavailable {
+		t.Fatalf("got status %d", status)
+	}
+	if response.Code != CodeUnknown || response.Detail != "injected fault" {
+		t.Fatalf("got %+v", response)
+	}
+
+	// The fault is used up.
+	var quote struct {
+		Quote string `json:"quote"`
+	}
+	if status := postJSON(t, m.URL()+"/v1/mint/quote/bolt11", map[string]any{"amount": 10, "unit": "sat"}, &quote); status != http.StatusOK {
+		t.Fatalf("got status %d after the fault was used up", status)
+	}
+}
+
+func TestUnitConversion(t *testing.T) {
+	tests := []struct {
+		opts        Options
+		amount      uint64
+		invoiceMsat uint64
+	}{
+		{Options{}, 21, 21_000},
+		{Options{Unit: "msat"}, 21_500, 21_500},
+		{Options{Unit: "usd", MsatPerUnit: 20_000}, 3, 60_000},
+	}
+	for _, test := range tests {
+		m := New(test.opts)
+		unit := m.opts.Unit
+
+		var mintQuote struct {
+			Request string `json:"request"`
+			Amount  uint64 `json:"amount"`
+		}
+		if status := postJSON(t, m.URL()+"/v1/mint/quote/bolt11", map[string]any{"amount": test.amount, "unit": unit}, &mintQuote); status != http.StatusOK {
+			t.Fatalf("%s: mint quote failed with status %d", unit, status)
+		}
+		decoded, err := decodeInvoice(mintQuote.Request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if decoded.amountMsat != test.invoiceMsat {
+			t.Errorf("%s: invoice of %d msat, want %d", unit, decoded.amountMsat, test.invoiceMsat)
+		}
+
+		var meltQuote struct {
+			Amount uint64 `json:"amount"`
+		}
+		if status := postJSON(t, m.URL()+"/v1/melt/quote/bolt11", map[string]any{"request": mintQuote.Request, "unit": unit}, &meltQuote); status != http.StatusOK {
+			t.Fatalf("%s: melt quote failed with status %d", unit, status)
+		}
+		if meltQuote.Amount != test.amount {
+			t.Errorf("%s: melt quote of %d, want %d", unit, meltQuote.Amount, test.amount)
+		}
+		m.Close()
+	}
+}
@@ -0,0 +1,213 @@
+package mockmint_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cdk "github.com/lescuer97/cdkgo"
+	"github.com/lescuer97/cdkgo/mockmint"
+)
+
+func newWallet(t *testing.T, mint *mockmint.Mint, mnemonic string) *cdk.Wallet {
+	t.Helper()
+	wallet, err := cdk.NewWallet(mint.URL(), cdk.CurrencyUnitSat{}, mnemonic, cdk.NewMemoryWalletDatabase(), cdk.WalletConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(wallet.Destroy)
+	return wallet
+}
+
+func newMnemonic(t *testing.T) string {
+	t.Helper()
+	mnemonic, err := cdk.GenerateMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mnemonic
+}
+
+func testContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+// fund mints amount into wallet through a paid mint quote.
+func fund(t *testing.T, ctx context.Context, mint *mockmint.Mint, wallet *cdk.Wallet, amount uint64) {
+	t.Helper()
+	quote, err := wallet.MintQuoteCtx(ctx, cdk.Amount{Value: amount}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mint.PayMintQuote(quote.Id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := wallet.MintCtx(ctx, quote.Id, cdk.SplitTargetNone{}, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func balance(t *testing.T, ctx context.Context, wallet *cdk.Wallet) uint64 {
+	t.Helper()
+	total, err := wallet.TotalBalanceCtx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return total.Value
+}
+
+func TestWalletMint(t *testing.T) {
+	ctx := testContext(t)
+	mint := mockmint.New(mockmint.Options{})
+	defer mint.Close()
+	wallet := newWallet(t, mint, newMnemonic(t))
+
+	quote, err := wallet.MintQuoteCtx(ctx, cdk.Amount{Value: 100}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := wallet.MintCtx(ctx, quote.Id, cdk.SplitTargetNone{}, nil); err == nil {
+		t.Fatal("minted an unpaid quote")
+	}
+	if err := mint.PayMintQuote(quote.Id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := wallet.MintCtx(ctx, quote.Id, cdk.SplitTargetNone{}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := balance(t, ctx, wallet); got != 100 {
+		t.Fatalf("balance %d, want 100", got)
+	}
+}
+
+func TestWalletMelt(t *testing.T) {
+	ctx := testContext(t)
+	mint := mockmint.New(mockmint.Options{})
+	defer mint.Close()
+	wallet := newWallet(t, mint, newMnemonic(t))
+	fund(t, ctx, mint, wallet, 100)
+
+	// An invoice of another node, so the mint pays it over FakeLightning.
+	invoice, _, err := mockmint.NewFakeLightning().CreateInvoice(50_000, "melt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	quote, err := wallet.MeltQuoteCtx(ctx, invoice, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if quote.Amount.Value != 50 || quote.FeeReserve.Value != 1 {
+		t.Fatalf("quote of %d with fee reserve %d", quote.Amount.Value, quote.FeeReserve.Value)
+	}
+	melted, err := wallet.MeltCtx(ctx, quote.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if melted.State != cdk.QuoteStatePaid || melted.Preimage == nil {
+		t.Fatalf("melt ended %v", melted.State)
+	}
+	if state, err := mint.MeltQuoteState(quote.Id); err != nil || state != "PAID" {
+		t.Fatalf("mint reports quote %q (%v)", state, err)
+	}
+	if got, want := balance(t, ctx, wallet), 50-melted.FeePaid.Value; got != want {
+		t.Fatalf("balance %d, want %d", got, want)
+	}
+}
+
+func TestWalletSwap(t *testing.T) {
+	ctx := testContext(t)
+	mint := mockmint.New(mockmint.Options{})
+	defer mint.Close()
+	wallet := newWallet(t, mint, newMnemonic(t))
+	fund(t, ctx, mint, wallet, 64)
+
+	inputs, err := wallet.GetProofsByStatesCtx(ctx, []cdk.ProofState{cdk.ProofStateUnspent})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := wallet.SwapCtx(ctx, nil, cdk.SplitTargetValue{Amount: cdk.Amount{Value: 8}}, inputs, nil, false); err != nil {
+		t.Fatal(err)
+	}
+	spent, err := wallet.CheckProofsSpentCtx(ctx, inputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, isSpent := range spent {
+		if !isSpent {
+			t.Fatalf("input %d not spent by the swap", i)
+		}
+	}
+	if got := balance(t, ctx, wallet); got != 64 {
+		t.Fatalf("balance %d, want 64", got)
+	}
+}
+
+func TestWalletRestore(t *testing.T) {
+	ctx := testContext(t)
+	mint := mockmint.New(mockmint.Options{})
+	defer mint.Close()
+	mnemonic := newMnemonic(t)
+	fund(t, ctx, mint, newWallet(t, mint, mnemonic), 42)
+
+	restored := newWallet(t, mint, mnemonic)
+	amount, err := restored.RestoreCtx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if amount.Value != 42 {
+		t.Fatalf("restored %d, want 42", amount.Value)
+	}
+	if got := balance(t, ctx, restored); got != 42 {
+		t.Fatalf("balance %d, want 42", got)
+	}
+}
+
+func TestMultiMintWalletTransfer(t *testing.T) {
+	ctx := testContext(t)
+	lightning := mockmint.NewFakeLightning()
+	source := mockmint.New(mockmint.Options{Lightning: lightning})
+	defer source.Close()
+	target := mockmint.New(mockmint.Options{Lightning: lightning})
+	defer target.Close()
+
+	wallet, err := cdk.NewMultiMintWallet(cdk.CurrencyUnitSat{}, newMnemonic(t), cdk.NewMemoryWalletDatabase())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wallet.Destroy()
+	sourceUrl, targetUrl := cdk.MintUrl{Url: source.URL()}, cdk.MintUrl{Url: target.URL()}
+	for _, mintUrl := range []cdk.MintUrl{sourceUrl, targetUrl} {
+		if err := wallet.AddMintCtx(ctx, mintUrl, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	quote, err := wallet.MintQuoteCtx(ctx, sourceUrl, cdk.Amount{Value: 100}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := source.PayMintQuote(quote.Id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := wallet.MintCtx(ctx, sourceUrl, quote.Id, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := wallet.TransferCtx(ctx, sourceUrl, targetUrl, cdk.TransferModeExactReceive{Amount: cdk.Amount{Value: 40}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.AmountReceived.Value != 40 {
+		t.Fatalf("received %d, want 40", result.AmountReceived.Value)
+	}
+	balances, err := wallet.GetBalancesCtx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Invoices of a shared FakeLightning are paid without a fee reserve.
+	if balances[target.URL()].Value != 40 || balances[source.URL()].Value != 60 {
+		t.Fatalf("balances %v", balances)
+	}
+}
@@ -0,0 +1,213 @@
+package nostr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/coder/websocket"
+)
+
+const maxMessageSize = 1 << 20
+
+var errInvalidMessage = errors.New("invalid relay message")
+
+func dialRelay(ctx context.Context, relay string) (*websocket.Conn, error) {
+	conn, _, err := websocket.Dial(ctx, relay, nil)
+	if err != nil {
+		return nil, fmt.Errorf("connect %s: %w", relay, err)
+	}
+	conn.SetReadLimit(maxMessageSize)
+	return conn, nil
+}
+
+func writeMessage(ctx context.Context, conn *websocket.Conn, message ...any) error {
+	raw, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	return conn.Write(ctx, websocket.MessageText, raw)
+}
+
+// readMessage reads a relay message: its type and the raw elements after
+// it.
+func readMessage(ctx context.Context, conn *websocket.Conn) (string, []json.RawMessage, error) {
+	_, raw, err := conn.Read(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	var message []json.RawMessage
+	if err := json.Unmarshal(raw, &message); err != nil || len(message) == 0 {
+		return "", nil, fmt.Errorf("%w %.100s", errInvalidMessage, raw)
+	}
+	var kind string
+	if err := json.Unmarshal(message[0], &kind); err != nil {
+		return "", nil, fmt.Errorf("%w %.100s", errInvalidMessage, raw)
+	}
+	return kind, message[1:], nil
+}
+
+// Publish sends event to the relays and returns once each has answered.
+// It succeeds if at least one relay accepted the event.
+func Publish(ctx context.Context, relays []string, event Event) error {
+	if len(relays) == 0 {
+		return errors.New("no relays to publish to")
+	}
+	errs := make([]error, len(relays))
+	var wg sync.WaitGroup
+	for i, relay := range relays {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = publishTo(ctx, relay, event)
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err == nil {
+			return nil
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func publishTo(ctx context.Context, relay string, event Event) error {
+	ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+	conn, err := dialRelay(ctx, relay)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(websocket.StatusNormalClosure, "")
+
+	if err := writeMessage(ctx, conn, "EVENT", event); err != nil {
+		return fmt.Errorf("publish to %s: %w", relay, err)
+	}
+	for {
+		kind, message, err := readMessage(ctx, conn)
+		if err != nil {
+			return fmt.Errorf("publish to %s: %w", relay, err)
+		}
+		if kind != "OK" || len(message) < 2 {
+			continue
+		}
+		var id, reason string
+		var accepted bool
+		json.Unmarshal(message[0], &id)
+		json.Unmarshal(message[1], &accepted)
+		if len(message) > 2 {
+			json.Unmarshal(message[2], &reason)
+		}
+		if id != event.ID {
+			continue
+		}
+		if !accepted {
+			return fmt.Errorf("%s rejected event: %s", relay, reason)
+		}
+		return nil
+	}
+}
+
+// Subscribe streams the events matching filters from the relays until ctx
+// is done, then closes the channel. Connections that drop are reopened
+// with backoff; events are verified and delivered once even if several
+// relays send them.
+func Subscribe(ctx context.Context, relays []string, filters ...Filter) <-chan Event {
+	events := make(chan Event)
+	var (
+		mu   sync.Mutex
+		seen = map[string]bool{}
+		wg   sync.WaitGroup
+	)
+	deliver := func(event Event) {
+		mu.Lock()
+		if seen[event.ID] {
+			mu.Unlock()
+			return
+		}
+		if len(seen) > 10000 {
+			seen = map[string]bool{}
+		}
+		seen[event.ID] = true
+		mu.Unlock()
+		select {
+		case events <- event:
+		case <-ctx.Done():
+		}
+	}
+	for _, relay := range relays {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			backoff := time.Second
+			for ctx.Err() == nil {
+				started := time.Now()
+				subscribeTo(ctx, relay, filters, deliver)
+				if time.Since(started) > time.Minute {
+					backoff = time.Second
+				}
+				select {
+				case <-ctx.Done():
+				case <-time.After(backoff):
+				}
+				backoff = min(backoff*2, 30*time.Second)
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(events)
+	}()
+	return events
+}
+
+func subscribeTo(ctx context.Context, relay string, filters []Filter, deliver func(Event)) error {
+	conn, err := dialRelay(ctx, relay)
+	if err != nil {
+		return err
+	}
+	defer conn.CloseNow()
+
+	const subscriptionId = "cdkgo"
+	request := []any{"REQ", subscriptionId}
+	for _, filter := range filters {
+		request = append(request, filter)
+	}
+	if err := writeMessage(ctx, conn, request...); err != nil {
+		return err
+	}
+	for {
+		kind, message, err := readMessage(ctx, conn)
+		if errors.Is(err, errInvalidMessage) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		switch kind {
+		case "EVENT":
+			if len(message) < 2 {
+				continue
+			}
+			var event Event
+			if err := json.Unmarshal(message[1], &event); err != nil || event.Verify() != nil {
+				continue
+			}
+			for _, filter := range filters {
+				if filter.Matches(event) {
+					deliver(event)
+					break
+				}
+			}
+		case "CLOSED":
+			var reason string
+			if len(message) > 1 {
+				json.Unmarshal(message[1], &reason)
+			}
+			return fmt.Errorf("%s closed the subscription: %s", relay, reason)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package nostr
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// Gift wraps and seals are backdated by up to this much so their time does
+// not reveal when the message was sent (NIP-59).
+const giftWrapJitter = 2 * 24 * time.Hour
+
+func randomPastTime() int64 {
+	jitter, err := rand.Int(rand.Reader, big.NewInt(int64(giftWrapJitter/time.Second)))
+	if err != nil {
+		return time.Now().Unix()
+	}
+	return time.Now().Unix() - jitter.Int64()
+}
+
+// giftWrap wraps content for recipient as a NIP-17 direct message: an
+// unsigned kind 14 rumor, sealed by the sender, wrapped with a throwaway
+// key.
+func giftWrap(senderSecret, recipient, content string) (Event, error) {
+	sender, err := PublicKey(senderSecret)
+	if err != nil {
+		return Event{}, err
+	}
+	rumor := Event{
+		PubKey:    sender,
+		CreatedAt: time.Now().Unix(),
+		Kind:      KindPrivateDirectMessage,
+		Tags:      [][]string{{"p", recipient}},
+		Content:   content,
+	}
+	if rumor.ID, err = rumor.Hash(); err != nil {
+		return Event{}, err
+	}
+	rumorJSON, err := json.Marshal(rumor)
+	if err != nil {
+		return Event{}, err
+	}
+
+	key, err := conversationKey(senderSecret, recipient)
+	if err != nil {
+		return Event{}, err
+	}
+	sealed, err := encryptNip44(key, string(rumorJSON))
+	if err != nil {
+		return Event{}, err
+	}
+	seal := Event{CreatedAt: randomPastTime(), Kind: KindSeal, Content: sealed}
+	if err := seal.Sign(senderSecret); err != nil {
+		return Event{}, err
+	}
+	sealJSON, err := json.Marshal(seal)
+	if err != nil {
+		return Event{}, err
+	}
+
+	ephemeral, err := GenerateSecretKey()
+	if err != nil {
+		return Event{}, err
+	}
+	if key, err = conversationKey(ephemeral, recipient); err != nil {
+		return Event{}, err
+	}
+	wrapped, err := encryptNip44(key, string(sealJSON))
+	if err != nil {
+		return Event{}, err
+	}
+	wrap := Event{
+		CreatedAt: randomPastTime(),
+		Kind:      KindGiftWrap,
+		Tags:      [][]string{{"p", recipient}},
+		Content:   wrapped,
+	}
+	return wrap, wrap.Sign(ephemeral)
+}
+
+// unwrapGift opens a gift wrap addressed to recipientSecret and returns the
+// rumor inside, whose PubKey is the verified sender.
+func unwrapGift(recipientSecret string, wrap Event) (Event, error) {
+	key, err := conversationKey(recipientSecret, wrap.PubKey)
+	if err != nil {
+		return Event{}, err
+	}
+	sealJSON, err := decryptNip44(key, wrap.Content)
+	if err != nil {
+		return Event{}, fmt.Errorf("open gift wrap: %w", err)
+	}
+	var seal Event
+	if err := json.Unmarshal([]byte(sealJSON), &seal); err != nil {
+		return Event{}, fmt.Errorf("decode seal: %w", err)
+	}
+	if seal.Kind != KindSeal {
+		return Event{}, fmt.Errorf("gift wrap holds kind %d, not a seal", seal.Kind)
+	}
+	if err := seal.Verify(); err != nil {
+		return Event{}, err
+	}
+
+	if key, err = conversationKey(recipientSecret, seal.PubKey); err != nil {
+		return Event{}, err
+	}
+	rumorJSON, err := decryptNip44(key, seal.Content)
+	if err != nil {
+		return Event{}, fmt.Errorf("open seal: %w", err)
+	}
+	var rumor Event
+	if err := json.Unmarshal([]byte(rumorJSON), &rumor); err != nil {
+		return Event{}, fmt.Errorf("decode rumor: %w", err)
+	}
+	if rumor.PubKey != seal.PubKey {
+		return Event{}, errors.New("rumor author does not match seal")
+	}
+	return rumor, nil
+}
+
+// encryptedDirectMessage builds a NIP-04 kind 4 message.
+func encryptedDirectMessage(senderSecret, recipient, content string) (Event, error) {
+	encrypted, err := encryptNip04(senderSecret, recipient, content)
+	if err != nil {
+		return Event{}, err
+	}
+	event := Event{
+		CreatedAt: time.Now().Unix(),
+		Kind:      KindEncryptedDirectMessage,
+		Tags:      [][]string{{"p", recipient}},
+		Content:   encrypted,
+	}
+	return event, event.Sign(senderSecret)
+}
@@ -0,0 +1,84 @@
+package nostr
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestGiftWrap(t *testing.T) {
+	sender, _ := GenerateSecretKey()
+	recipient, _ := GenerateSecretKey()
+	senderPublic, _ := PublicKey(sender)
+	recipientPublic, _ := PublicKey(recipient)
+
+	wrap, err := giftWrap(sender, recipientPublic, "hello")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := wrap.Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if wrap.Kind != KindGiftWrap || wrap.Tag("p") != recipientPublic {
+		t.Fatalf("wrap of kind %d to %q", wrap.Kind, wrap.Tag("p"))
+	}
+	if wrap.PubKey == senderPublic {
+		t.Fatal("wrap signed by the sender, not a throwaway key")
+	}
+	if wrap.CreatedAt > time.Now().Unix() || wrap.CreatedAt < time.Now().Add(-giftWrapJitter).Unix()-1 {
+		t.Fatalf("wrap created at %d", wrap.CreatedAt)
+	}
+
+	rumor, err := unwrapGift(recipient, wrap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rumor.Kind != KindPrivateDirectMessage || rumor.PubKey != senderPublic || rumor.Content != "hello" || rumor.Sig != "" {
+		t.Fatalf("rumor %+v", rumor)
+	}
+	if id, _ := rumor.Hash(); rumor.ID != id {
+		t.Fatal("rumor id does not match its hash")
+	}
+
+	other, _ := GenerateSecretKey()
+	if _, err := unwrapGift(other, wrap); err == nil {
+		t.Fatal("unwrapped a gift for another key")
+	}
+}
+
+func TestGiftWrapForgedAuthor(t *testing.T) {
+	sender, _ := GenerateSecretKey()
+	recipient, _ := GenerateSecretKey()
+	recipientPublic, _ := PublicKey(recipient)
+	impersonated, _ := GenerateSecretKey()
+	impersonatedPublic, _ := PublicKey(impersonated)
+
+	// A seal signed by the sender around a rumor claiming another author.
+	rumor := Event{PubKey: impersonatedPublic, CreatedAt: time.Now().Unix(), Kind: KindPrivateDirectMessage, Tags: [][]string{{"p", recipientPublic}}, Content: "hello"}
+	rumor.ID, _ = rumor.Hash()
+	rumorJSON, _ := json.Marshal(rumor)
+	key, _ := conversationKey(sender, recipientPublic)
+	sealed, err := encryptNip44(key, string(rumorJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	seal := Event{CreatedAt: randomPastTime(), Kind: KindSeal, Content: sealed}
+	if err := seal.Sign(sender); err != nil {
+		t.Fatal(err)
+	}
+	sealJSON, _ := json.Marshal(seal)
+	ephemeral, _ := GenerateSecretKey()
+	key, _ = conversationKey(ephemeral, recipientPublic)
+	wrapped, err := encryptNip44(key, string(sealJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrap := Event{CreatedAt: randomPastTime(), Kind: KindGiftWrap, Tags: [][]string{{"p", recipientPublic}}, Content: wrapped}
+	if err := wrap.Sign(ephemeral); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := unwrapGift(recipient, wrap); err == nil {
+		t.Fatal("accepted a rumor whose author is not the seal's")
+	}
+}
@@ -0,0 +1,187 @@
+package nostr
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"math/bits"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"golang.org/x/crypto/chacha20"
+)
+
+// sharedX returns the x coordinate of the ECDH point of a secret and a
+// public key, the input of both NIP-04 and NIP-44.
+func sharedX(secretKey, publicKey string) ([]byte, error) {
+	key, err := parseSecretKey(secretKey)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := parsePublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return secp256k1.GenerateSharedSecret(key, pub), nil
+}
+
+// encryptNip04 encrypts a kind 4 message: AES-256-CBC keyed with the
+// shared x coordinate, written as "<ciphertext>?iv=<iv>".
+func encryptNip04(secretKey, publicKey, plaintext string) (string, error) {
+	shared, err := sharedX(secretKey, publicKey)
+	if err != nil {
+		return "", err
+	}
+	block, err := aes.NewCipher(shared)
+	if err != nil {
+		return "", err
+	}
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return "", err
+	}
+	padding := aes.BlockSize - len(plaintext)%aes.BlockSize
+	padded := append([]byte(plaintext), bytes.Repeat([]byte{byte(padding)}, padding)...)
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(padded, padded)
+	return base64.StdEncoding.EncodeToString(padded) + "?iv=" + base64.StdEncoding.EncodeToString(iv), nil
+}
+
+func decryptNip04(secretKey, publicKey, content string) (string, error) {
+	ciphertext, ivText, ok := strings.Cut(content, "?iv=")
+	if !ok {
+		return "", errors.New("nip04: missing iv")
+	}
+	data, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return "", err
+	}
+	iv, err := base64.StdEncoding.DecodeString(ivText)
+	if err != nil || len(iv) != aes.BlockSize {
+		return "", errors.New("nip04: invalid iv")
+	}
+	if len(data) == 0 || len(data)%aes.BlockSize != 0 {
+		return "", errors.New("nip04: invalid ciphertext length")
+	}
+	shared, err := sharedX(secretKey, publicKey)
+	if err != nil {
+		return "", err
+	}
+	block, err := aes.NewCipher(shared)
+	if err != nil {
+		return "", err
+	}
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(data, data)
+	padding := int(data[len(data)-1])
+	if padding == 0 || padding > aes.BlockSize || padding > len(data) {
+		return "", errors.New("nip04: invalid padding")
+	}
+	return string(data[:len(data)-padding]), nil
+}
+
+// conversationKey is the NIP-44 v2 key shared by two parties.
+func conversationKey(secretKey, publicKey string) ([]byte, error) {
+	shared, err := sharedX(secretKey, publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return hkdf.Extract(sha256.New, shared, []byte("nip44-v2"))
+}
+
+func nip44MessageKeys(conversation, nonce []byte) (key, chachaNonce, hmacKey []byte, err error) {
+	keys, err := hkdf.Expand(sha256.New, conversation, string(nonce), 76)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return keys[:32], keys[32:44], keys[44:], nil
+}
+
+func nip44PaddedLen(length int) int {
+	if length <= 32 {
+		return 32
+	}
+	nextPower := 1 << bits.Len(uint(length-1))
+	chunk := 32
+	if nextPower > 256 {
+		chunk = nextPower / 8
+	}
+	return chunk * ((length-1)/chunk + 1)
+}
+
+// encryptNip44 encrypts plaintext with NIP-44 version 2.
+func encryptNip44(conversation []byte, plaintext string) (string, error) {
+	if len(plaintext) == 0 || len(plaintext) > 65535 {
+		return "", errors.New("nip44: plaintext must be 1 to 65535 bytes")
+	}
+	nonce := make([]byte, 32)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return encryptNip44Nonce(conversation, plaintext, nonce)
+}
+
+func encryptNip44Nonce(conversation []byte, plaintext string, nonce []byte) (string, error) {
+	key, chachaNonce, hmacKey, err := nip44MessageKeys(conversation, nonce)
+	if err != nil {
+		return "", err
+	}
+	padded := make([]byte, 2+nip44PaddedLen(len(plaintext)))
+	binary.BigEndian.PutUint16(padded, uint16(len(plaintext)))
+	copy(padded[2:], plaintext)
+
+	stream, err := chacha20.NewUnauthenticatedCipher(key, chachaNonce)
+	if err != nil {
+		return "", err
+	}
+	stream.XORKeyStream(padded, padded)
+	mac := hmac.New(sha256.New, hmacKey)
+	mac.Write(nonce)
+	mac.Write(padded)
+
+	payload := append([]byte{2}, nonce...)
+	payload = append(payload, padded...)
+	payload = mac.Sum(payload)
+	return base64.StdEncoding.EncodeToString(payload), nil
+}
+
+func decryptNip44(conversation []byte, content string) (string, error) {
+	if strings.HasPrefix(content, "#") {
+		return "", errors.New("nip44: unsupported version")
+	}
+	payload, err := base64.StdEncoding.DecodeString(content)
+	if err != nil {
+		return "", err
+	}
+	if len(payload) < 99 || payload[0] != 2 {
+		return "", errors.New("nip44: invalid payload")
+	}
+	nonce, ciphertext, tag := payload[1:33], payload[33:len(payload)-32], payload[len(payload)-32:]
+	key, chachaNonce, hmacKey, err := nip44MessageKeys(conversation, nonce)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, hmacKey)
+	mac.Write(nonce)
+	mac.Write(ciphertext)
+	if !hmac.Equal(mac.Sum(nil), tag) {
+		return "", errors.New("nip44: invalid mac")
+	}
+
+	stream, err := chacha20.NewUnauthenticatedCipher(key, chachaNonce)
+	if err != nil {
+		return "", err
+	}
+	padded := make([]byte, len(ciphertext))
+	stream.XORKeyStream(padded, ciphertext)
+	length := int(binary.BigEndian.Uint16(padded))
+	if length == 0 || len(padded) != 2+nip44PaddedLen(length) {
+		return "", errors.New("nip44: invalid padding")
+	}
+	return string(padded[2 : 2+length]), nil
+}
@@ -0,0 +1,121 @@
+package nostr
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestNip44ConversationKey(t *testing.T) {
+	// NIP-44 v2 vectors, get_conversation_key
+	tests := []struct{ secretKey, publicKey, conversation string }{
+		{"315e59ff51cb9209768cf7da80791ddcaae56ac9775eb25b6dee1234bc5d2268", "c2f9d9948dc8c7c38321e4b85c8558872eafa0641cd269db76848a6073e69133", "3dfef0ce2a4d80a25e7a328accf73448ef67096f65f79588e358d9a0eb9013f1"},
+	}
+	for _, test := range tests {
+		got, err := conversationKey(test.secretKey, test.publicKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(got) != test.conversation {
+			t.Errorf("conversation key %x, want %s", got, test.conversation)
+		}
+	}
+}
+
+func TestNip44PaddedLen(t *testing.T) {
+	// NIP-44 v2 vectors, calc_padded_len
+	tests := [][2]int{
+		{16, 32}, {32, 32}, {33, 64}, {37, 64}, {45, 64}, {49, 64}, {64, 64},
+		{65, 96}, {100, 128}, {111, 128}, {200, 224}, {250, 256}, {320, 320},
+		{383, 384}, {384, 384}, {400, 448}, {500, 512}, {512, 512}, {515, 640},
+		{700, 768}, {800, 896}, {900, 1024}, {1020, 1024}, {65536, 65536},
+	}
+	for _, test := range tests {
+		if got := nip44PaddedLen(test[0]); got != test[1] {
+			t.Errorf("padded length of %d is %d, want %d", test[0], got, test[1])
+		}
+	}
+}
+
+func TestNip44Vectors(t *testing.T) {
+	// NIP-44 v2 vectors, encrypt_decrypt
+	tests := []struct{ secretKey1, secretKey2, conversation, nonce, plaintext, payload string }{
+		{
+			"0000000000000000000000000000000000000000000000000000000000000001",
+			"0000000000000000000000000000000000000000000000000000000000000002",
+			"c41c775356fd92eadc63ff5a0dc1da211b268cbea22316767095b2871ea1412d",
+			"0000000000000000000000000000000000000000000000000000000000000001",
+			"a",
+			"AgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABee0G5VSK0/9YypIObAtDKfYEAjD35uVkHyB0F4DwrcNaCXlCWZKaArsGrY6M9wnuTMxWfp1RTN9Xga8no+kF5Vsb",
+		},
+		{
+			"0000000000000000000000000000000000000000000000000000000000000002",
+			"0000000000000000000000000000000000000000000000000000000000000001",
+			"c41c775356fd92eadc63ff5a0dc1da211b268cbea22316767095b2871ea1412d",
+			"f00000000000000000000000000000f00000000000000000000000000000000f",
+			"🍕🫃",
+			"AvAAAAAAAAAAAAAAAAAAAPAAAAAAAAAAAAAAAAAAAAAPSKSK6is9ngkX2+cSq85Th16oRTISAOfhStnixqZziKMDvB0QQzgFZdjLTPicCJaV8nDITO+QfaQ61+KbWQIOO2Yj",
+		},
+	}
+	for _, test := range tests {
+		publicKey2, err := PublicKey(test.secretKey2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		conversation, err := conversationKey(test.secretKey1, publicKey2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(conversation) != test.conversation {
+			t.Errorf("%q: conversation key %x", test.plaintext, conversation)
+		}
+		payload, err := encryptNip44Nonce(conversation, test.plaintext, mustHex(t, test.nonce))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if payload != test.payload {
+			t.Errorf("%q: payload %s", test.plaintext, payload)
+		}
+		plaintext, err := decryptNip44(conversation, test.payload)
+		if err != nil || plaintext != test.plaintext {
+			t.Errorf("%q: decrypted %q (%v)", test.plaintext, plaintext, err)
+		}
+	}
+}
+
+func TestNip44Invalid(t *testing.T) {
+	conversation := mustHex(t, "c41c775356fd92eadc63ff5a0dc1da211b268cbea22316767095b2871ea1412d")
+	if _, err := encryptNip44(conversation, ""); err == nil {
+		t.Error("encrypted an empty message")
+	}
+	if _, err := encryptNip44(conversation, strings.Repeat("a", 65536)); err == nil {
+		t.Error("encrypted a message over 65535 bytes")
+	}
+	payload, err := encryptNip44(conversation, "hello")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Flip a bit of the ciphertext, the MAC no longer matches.
+	raw := []byte(payload)
+	raw[60] ^= 1
+	if _, err := decryptNip44(conversation, string(raw)); err == nil {
+		t.Error("decrypted a tampered payload")
+	}
+	if _, err := decryptNip44(conversation, "#"+payload); err == nil {
+		t.Error("decrypted an unknown version")
+	}
+}
+
+func TestNip04(t *testing.T) {
+	sender, recipient := "0000000000000000000000000000000000000000000000000000000000000001", "0000000000000000000000000000000000000000000000000000000000000002"
+	recipientPublic, _ := PublicKey(recipient)
+	senderPublic, _ := PublicKey(sender)
+	content, err := encryptNip04(sender, recipientPublic, "cashuBexample")
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext, err := decryptNip04(recipient, senderPublic, content)
+	if err != nil || plaintext != "cashuBexample" {
+		t.Fatalf("decrypted %q (%v)", plaintext, err)
+	}
+}
@@ -0,0 +1,203 @@
+package nostr
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Event kinds used by this package.
+const (
+	KindEncryptedDirectMessage = 4
+	KindSeal                   = 13
+	KindPrivateDirectMessage   = 14
+	KindGiftWrap               = 1059
+)
+
+// Event is a NIP-01 event.
+type Event struct {
+	ID        string     `json:"id"`
+	PubKey    string     `json:"pubkey"`
+	CreatedAt int64      `json:"created_at"`
+	Kind      int        `json:"kind"`
+	Tags      [][]string `json:"tags"`
+	Content   string     `json:"content"`
+	Sig       string     `json:"sig,omitempty"`
+}
+
+// Hash returns the event id computed from the other fields.
+func (e *Event) Hash() (string, error) {
+	tags := e.Tags
+	if tags == nil {
+		tags = [][]string{}
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode([]any{0, e.PubKey, e.CreatedAt, e.Kind, tags, e.Content}); err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Sign sets the public key, id and signature of the event.
+func (e *Event) Sign(secretKey string) error {
+	key, err := parseSecretKey(secretKey)
+	if err != nil {
+		return err
+	}
+	e.PubKey = xOnlyHex(key.PubKey())
+	if e.Tags == nil {
+		e.Tags = [][]string{}
+	}
+	if e.ID, err = e.Hash(); err != nil {
+		return err
+	}
+	id, _ := hex.DecodeString(e.ID)
+	sig, err := signSchnorr(key, id)
+	if err != nil {
+		return err
+	}
+	e.Sig = hex.EncodeToString(sig)
+	return nil
+}
+
+// Verify checks the id and signature of the event.
+func (e *Event) Verify() error {
+	hash, err := e.Hash()
+	if err != nil {
+		return err
+	}
+	if hash != e.ID {
+		return errors.New("event id does not match its content")
+	}
+	id, _ := hex.DecodeString(e.ID)
+	sig, err := hex.DecodeString(e.Sig)
+	if err != nil || !verifySchnorr(e.PubKey, id, sig) {
+		return fmt.Errorf("invalid signature on event %s", e.ID)
+	}
+	return nil
+}
+
+// Tag returns the first value of the first tag named name.
+func (e *Event) Tag(name string) string {
+	for _, tag := range e.Tags {
+		if len(tag) >= 2 && tag[0] == name {
+			return tag[1]
+		}
+	}
+	return ""
+}
+
+// Filter is a NIP-01 subscription filter. Tags maps a single letter tag
+// name, without the "#", to the accepted values.
+type Filter struct {
+	IDs     []string
+	Authors []string
+	Kinds   []int
+	Tags    map[string][]string
+	Since   *int64
+	Until   *int64
+	Limit   int
+}
+
+func (f Filter) MarshalJSON() ([]byte, error) {
+	out := map[string]any{}
+	if len(f.IDs) > 0 {
+		out["ids"] = f.IDs
+	}
+	if len(f.Authors) > 0 {
+		out["authors"] = f.Authors
+	}
+	if len(f.Kinds) > 0 {
+		out["kinds"] = f.Kinds
+	}
+	for name, values := range f.Tags {
+		out["#"+name] = values
+	}
+	if f.Since != nil {
+		out["since"] = *f.Since
+	}
+	if f.Until != nil {
+		out["until"] = *f.Until
+	}
+	if f.Limit > 0 {
+		out["limit"] = f.Limit
+	}
+	return json.Marshal(out)
+}
+
+func (f *Filter) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*f = Filter{}
+	for key, value := range raw {
+		var err error
+		switch {
+		case key == "ids":
+			err = json.Unmarshal(value, &f.IDs)
+		case key == "authors":
+			err = json.Unmarshal(value, &f.Authors)
+		case key == "kinds":
+			err = json.Unmarshal(value, &f.Kinds)
+		case key == "since":
+			err = json.Unmarshal(value, &f.Since)
+		case key == "until":
+			err = json.Unmarshal(value, &f.Until)
+		case key == "limit":
+			err = json.Unmarshal(value, &f.Limit)
+		case strings.HasPrefix(key, "#") && len(key) == 2:
+			var values []string
+			if err = json.Unmarshal(value, &values); err == nil {
+				if f.Tags == nil {
+					f.Tags = map[string][]string{}
+				}
+				f.Tags[key[1:]] = values
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("filter %s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// Matches reports whether event passes the filter. Limit is not applied.
+func (f Filter) Matches(event Event) bool {
+	if len(f.IDs) > 0 && !slices.Contains(f.IDs, event.ID) {
+		return false
+	}
+	if len(f.Authors) > 0 && !slices.Contains(f.Authors, event.PubKey) {
+		return false
+	}
+	if len(f.Kinds) > 0 && !slices.Contains(f.Kinds, event.Kind) {
+		return false
+	}
+	if f.Since != nil && event.CreatedAt < *f.Since {
+		return false
+	}
+	if f.Until != nil && event.CreatedAt > *f.Until {
+		return false
+	}
+	for name, values := range f.Tags {
+		found := false
+		for _, tag := range event.Tags {
+			if len(tag) >= 2 && tag[0] == name && slices.Contains(values, tag[1]) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,267 @@
+// Package nostr delivers cashu tokens and NUT-18 payment request payloads
+// as Nostr direct messages, and receives them into a wallet.
+//
+// Messages are sent as NIP-17 gift-wrapped DMs (NIP-44 encryption, NIP-59
+// wrapping) or, for older clients, as NIP-04 kind 4 DMs. The Relay type is
+// an in-process relay for tests.
+package nostr
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/lescuer97/cdkgo/internal/bech32"
+)
+
+// GenerateSecretKey returns a new random secret key as hex.
+func GenerateSecretKey() (string, error) {
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(key.Serialize()), nil
+}
+
+// PublicKey returns the x-only public key, as hex, of a secret key given as
+// hex or nsec.
+func PublicKey(secretKey string) (string, error) {
+	key, err := parseSecretKey(secretKey)
+	if err != nil {
+		return "", err
+	}
+	return xOnlyHex(key.PubKey()), nil
+}
+
+func parseSecretKey(secretKey string) (*secp256k1.PrivateKey, error) {
+	raw, err := decodeKey(secretKey, "nsec")
+	if err != nil {
+		return nil, fmt.Errorf("secret key: %w", err)
+	}
+	var scalar secp256k1.ModNScalar
+	if overflow := scalar.SetByteSlice(raw); overflow || scalar.IsZero() {
+		return nil, errors.New("secret key out of range")
+	}
+	return secp256k1.NewPrivateKey(&scalar), nil
+}
+
+// parsePublicKey lifts an x-only public key to the point with even y.
+func parsePublicKey(publicKey string) (*secp256k1.PublicKey, error) {
+	raw, err := hex.DecodeString(publicKey)
+	if err != nil || len(raw) != 32 {
+		return nil, fmt.Errorf("invalid public key %q", publicKey)
+	}
+	return secp256k1.ParsePubKey(append([]byte{0x02}, raw...))
+}
+
+func xOnlyHex(key *secp256k1.PublicKey) string {
+	return hex.EncodeToString(key.SerializeCompressed()[1:])
+}
+
+// decodeKey reads a 32 byte key given as hex or as bech32 with hrp.
+func decodeKey(key, hrp string) ([]byte, error) {
+	key = strings.TrimSpace(key)
+	if strings.HasPrefix(strings.ToLower(key), hrp+"1") {
+		prefix, data, err := bech32.Decode(strings.ToLower(key))
+		if err != nil {
+			return nil, err
+		}
+		if prefix != hrp {
+			return nil, fmt.Errorf("expected %s, got %s", hrp, prefix)
+		}
+		data, err = bech32.ConvertBits(data, 5, 8, false)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) != 32 {
+			return nil, fmt.Errorf("%s has %d bytes", hrp, len(data))
+		}
+		return data, nil
+	}
+	raw, err := hex.DecodeString(key)
+	if err != nil || len(raw) != 32 {
+		return nil, fmt.Errorf("invalid key %q", key)
+	}
+	return raw, nil
+}
+
+// EncodeNpub returns the NIP-19 npub of a hex public key.
+func EncodeNpub(publicKey string) (string, error) {
+	raw, err := hex.DecodeString(publicKey)
+	if err != nil || len(raw) != 32 {
+		return "", fmt.Errorf("invalid public key %q", publicKey)
+	}
+	data, _ := bech32.ConvertBits(raw, 8, 5, true)
+	return bech32.Encode("npub", data), nil
+}
+
+// EncodeNsec returns the NIP-19 nsec of a hex secret key.
+func EncodeNsec(secretKey string) (string, error) {
+	raw, err := hex.DecodeString(secretKey)
+	if err != nil || len(raw) != 32 {
+		return "", errors.New("invalid secret key")
+	}
+	data, _ := bech32.ConvertBits(raw, 8, 5, true)
+	return bech32.Encode("nsec", data), nil
+}
+
+// EncodeNprofile returns the NIP-19 nprofile of a hex public key and the
+// relays it can be reached on.
+func EncodeNprofile(publicKey string, relays []string) (string, error) {
+	raw, err := hex.DecodeString(publicKey)
+	if err != nil || len(raw) != 32 {
+		return "", fmt.Errorf("invalid public key %q", publicKey)
+	}
+	tlv := append([]byte{0, 32}, raw...)
+	for _, relay := range relays {
+		if len(relay) > 255 {
+			return "", fmt.Errorf("relay url too long: %s", relay)
+		}
+		tlv = append(append(tlv, 1, byte(len(relay))), relay...)
+	}
+	data, _ := bech32.ConvertBits(tlv, 8, 5, true)
+	return bech32.Encode("nprofile", data), nil
+}
+
+// ParseRecipient reads a public key given as hex, npub or nprofile. The
+// relays are those of an nprofile.
+func ParseRecipient(recipient string) (publicKey string, relays []string, err error) {
+	recipient = strings.TrimPrefix(strings.TrimSpace(recipient), "nostr:")
+	if !strings.HasPrefix(strings.ToLower(recipient), "nprofile1") {
+		raw, err := decodeKey(recipient, "npub")
+		if err != nil {
+			return "", nil, fmt.Errorf("recipient: %w", err)
+		}
+		return hex.EncodeToString(raw), nil, nil
+	}
+
+	_, data, err := bech32.Decode(strings.ToLower(recipient))
+	if err != nil {
+		return "", nil, fmt.Errorf("recipient: %w", err)
+	}
+	tlv, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return "", nil, fmt.Errorf("recipient: %w", err)
+	}
+	for len(tlv) >= 2 {
+		kind, length := tlv[0], int(tlv[1])
+		if len(tlv) < 2+length {
+			return "", nil, errors.New("recipient: truncated nprofile")
+		}
+		value := tlv[2 : 2+length]
+		switch kind {
+		case 0:
+			if length != 32 {
+				return "", nil, errors.New("recipient: invalid nprofile public key")
+			}
+			publicKey = hex.EncodeToString(value)
+		case 1:
+			relays = append(relays, string(value))
+		}
+		tlv = tlv[2+length:]
+	}
+	if publicKey == "" {
+		return "", nil, errors.New("recipient: nprofile without public key")
+	}
+	return publicKey, relays, nil
+}
+
+func taggedHash(tag string, parts ...[]byte) [32]byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	for _, part := range parts {
+		h.Write(part)
+	}
+	var out [32]byte
+	h.Sum(out[:0])
+	return out
+}
+
+// signSchnorr signs a 32 byte message as specified by BIP-340, with fresh
+// auxiliary randomness.
+func signSchnorr(key *secp256k1.PrivateKey, message []byte) ([]byte, error) {
+	var aux [32]byte
+	if _, err := rand.Read(aux[:]); err != nil {
+		return nil, err
+	}
+	return signSchnorrAux(key, message, aux[:])
+}
+
+func signSchnorrAux(key *secp256k1.PrivateKey, message, aux []byte) ([]byte, error) {
+	d := key.Key
+	var p secp256k1.JacobianPoint
+	secp256k1.ScalarBaseMultNonConst(&d, &p)
+	p.ToAffine()
+	if p.Y.IsOdd() {
+		d.Negate()
+	}
+	px := p.X.Bytes()
+
+	auxHash := taggedHash("BIP0340/aux", aux)
+	dBytes := d.Bytes()
+	var t [32]byte
+	for i := range t {
+		t[i] = dBytes[i] ^ auxHash[i]
+	}
+	nonce := taggedHash("BIP0340/nonce", t[:], px[:], message)
+	var k secp256k1.ModNScalar
+	k.SetBytes(&nonce)
+	if k.IsZero() {
+		return nil, errors.New("schnorr: zero nonce")
+	}
+	var r secp256k1.JacobianPoint
+	secp256k1.ScalarBaseMultNonConst(&k, &r)
+	r.ToAffine()
+	if r.Y.IsOdd() {
+		k.Negate()
+	}
+	rx := r.X.Bytes()
+
+	challenge := taggedHash("BIP0340/challenge", rx[:], px[:], message)
+	var e secp256k1.ModNScalar
+	e.SetBytes(&challenge)
+	s := new(secp256k1.ModNScalar).Mul2(&e, &d).Add(&k)
+	sBytes := s.Bytes()
+	return append(rx[:], sBytes[:]...), nil
+}
+
+// verifySchnorr checks a BIP-340 signature of a 32 byte message.
+func verifySchnorr(publicKey string, message, signature []byte) bool {
+	if len(signature) != 64 {
+		return false
+	}
+	pub, err := parsePublicKey(publicKey)
+	if err != nil {
+		return false
+	}
+	var rx secp256k1.FieldVal
+	if overflow := rx.SetByteSlice(signature[:32]); overflow {
+		return false
+	}
+	var s secp256k1.ModNScalar
+	if overflow := s.SetByteSlice(signature[32:]); overflow {
+		return false
+	}
+	px := pub.SerializeCompressed()[1:]
+	challenge := taggedHash("BIP0340/challenge", signature[:32], px, message)
+	var e secp256k1.ModNScalar
+	e.SetBytes(&challenge)
+	e.Negate()
+
+	var p, sG, eP, r secp256k1.JacobianPoint
+	pub.AsJacobian(&p)
+	secp256k1.ScalarBaseMultNonConst(&s, &sG)
+	secp256k1.ScalarMultNonConst(&e, &p, &eP)
+	secp256k1.AddNonConst(&sG, &eP, &r)
+	if (r.X.IsZero() && r.Y.IsZero()) || r.Z.IsZero() {
+		return false
+	}
+	r.ToAffine()
+	return !r.Y.IsOdd() && r.X.Equals(&rx)
+}
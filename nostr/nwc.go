@@ -0,0 +1,802 @@
+package nostr
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	cdk "github.com/lescuer97/cdkgo"
+)
+
+// Event kinds of NIP-47 Nostr Wallet Connect.
+const (
+	KindWalletConnectInfo     = 13194
+	KindWalletConnectRequest  = 23194
+	KindWalletConnectResponse = 23195
+)
+
+// Wallet Connect methods served by WalletConnectService.
+const (
+	MethodPayInvoice       = "pay_invoice"
+	MethodMakeInvoice      = "make_invoice"
+	MethodGetBalance       = "get_balance"
+	MethodListTransactions = "list_transactions"
+	MethodLookupInvoice    = "lookup_invoice"
+	MethodGetInfo          = "get_info"
+)
+
+var walletConnectMethods = []string{
+	MethodPayInvoice,
+	MethodMakeInvoice,
+	MethodGetBalance,
+	MethodListTransactions,
+	MethodLookupInvoice,
+	MethodGetInfo,
+}
+
+// Wallet Connect error codes.
+const (
+	NwcRateLimited         = "RATE_LIMITED"
+	NwcNotImplemented      = "NOT_IMPLEMENTED"
+	NwcInsufficientBalance = "INSUFFICIENT_BALANCE"
+	NwcQuotaExceeded       = "QUOTA_EXCEEDED"
+	NwcRestricted          = "RESTRICTED"
+	NwcUnauthorized        = "UNAUTHORIZED"
+	NwcInternal            = "INTERNAL"
+	NwcPaymentFailed       = "PAYMENT_FAILED"
+	NwcNotFound            = "NOT_FOUND"
+	NwcOther               = "OTHER"
+)
+
+// NwcError is the error of a Wallet Connect response.
+type NwcError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *NwcError) Error() string {
+	return e.Code + ": " + e.Message
+}
+
+func nwcError(code, format string, args ...any) *NwcError {
+	return &NwcError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// BudgetPeriod is the period a connection's budget renews after.
+type BudgetPeriod string
+
+const (
+	BudgetDaily   BudgetPeriod = "daily"
+	BudgetWeekly  BudgetPeriod = "weekly"
+	BudgetMonthly BudgetPeriod = "monthly"
+	BudgetYearly  BudgetPeriod = "yearly"
+	// The budget never renews
+	BudgetNever BudgetPeriod = "never"
+)
+
+func (p BudgetPeriod) next(start time.Time) time.Time {
+	switch p {
+	case BudgetDaily:
+		return start.AddDate(0, 0, 1)
+	case BudgetWeekly:
+		return start.AddDate(0, 0, 7)
+	case BudgetMonthly:
+		return start.AddDate(0, 1, 0)
+	case BudgetYearly:
+		return start.AddDate(1, 0, 0)
+	default:
+		return time.Time{}
+	}
+}
+
+// WalletConnection is an app allowed to use the wallet.
+type WalletConnection struct {
+	Name string
+	// Hex public key the app signs its requests with
+	PublicKey string
+	// Methods the app may call, all if empty
+	Methods []string
+	// Most the app may spend per BudgetPeriod, in millisatoshis, including
+	// fees. Zero is unlimited.
+	BudgetMsat   uint64
+	BudgetPeriod BudgetPeriod
+	// The connection stops working after this time, never if zero
+	ExpiresAt time.Time
+	// Spent in the current period and when the period started. Persist
+	// them with the connection to keep the budget across restarts.
+	SpentMsat   uint64
+	PeriodStart time.Time
+}
+
+func (c *WalletConnection) allows(method string) bool {
+	return len(c.Methods) == 0 || slices.Contains(c.Methods, method)
+}
+
+// remainingMsat returns what the connection may still spend, renewing the
+// budget if its period ended. ok is false for unlimited connections.
+func (c *WalletConnection) remainingMsat(now time.Time) (remaining uint64, ok bool) {
+	if c.BudgetMsat == 0 {
+		return 0, false
+	}
+	if c.PeriodStart.IsZero() {
+		c.PeriodStart = now
+	}
+	for next := c.BudgetPeriod.next(c.PeriodStart); !next.IsZero() && !now.Before(next); next = c.BudgetPeriod.next(c.PeriodStart) {
+		c.PeriodStart, c.SpentMsat = next, 0
+	}
+	if c.SpentMsat >= c.BudgetMsat {
+		return 0, true
+	}
+	return c.BudgetMsat - c.SpentMsat, true
+}
+
+// WalletConnectOptions configures a WalletConnectService.
+type WalletConnectOptions struct {
+	// Name returned by get_info
+	Alias string
+	// Mint make_invoice creates quotes on, the first mint of the wallet if
+	// empty
+	Mint string
+	// Triggered after make_invoice so the invoice is minted once paid
+	QuoteManager *cdk.QuoteManager
+	// Called with every connection after its spending changed, to persist it
+	OnConnectionUpdate func(WalletConnection)
+	// Called when a request could not be read or answered
+	OnError func(error)
+}
+
+// WalletConnectService is a NIP-47 Nostr Wallet Connect service backed by a
+// MultiMintWallet. Apps connect with a URI from NewConnection and are
+// limited to the methods and budget of their WalletConnection.
+//
+// Amounts are millisatoshis on the wire; the wallet must be in sat or msat.
+type WalletConnectService struct {
+	wallet    *cdk.MultiMintWallet
+	db        cdk.WalletDatabase
+	msat      bool
+	secretKey string
+	publicKey string
+	relays    []string
+	opts      WalletConnectOptions
+
+	mu          sync.Mutex
+	connections map[string]*WalletConnection
+	cancel      context.CancelFunc
+	done        chan struct{}
+}
+
+// NewWalletConnectService returns a service answering with secretKey, hex
+// or nsec, on relays. db is the wallet's database, read by lookup_invoice
+// and list_transactions.
+func NewWalletConnectService(wallet *cdk.MultiMintWallet, db cdk.WalletDatabase, secretKey string, relays []string, opts WalletConnectOptions) (*WalletConnectService, error) {
+	key, err := parseSecretKey(secretKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(relays) == 0 {
+		return nil, errors.New("no relays to serve on")
+	}
+	var msat bool
+	switch wallet.Unit().(type) {
+	case cdk.CurrencyUnitSat:
+	case cdk.CurrencyUnitMsat:
+		msat = true
+	default:
+		return nil, errors.New("wallet connect needs a sat or msat wallet")
+	}
+	return &WalletConnectService{
+		wallet:      wallet,
+		db:          db,
+		msat:        msat,
+		secretKey:   hex.EncodeToString(key.Serialize()),
+		publicKey:   xOnlyHex(key.PubKey()),
+		relays:      relays,
+		opts:        opts,
+		connections: map[string]*WalletConnection{},
+	}, nil
+}
+
+// PublicKey returns the hex public key of the service.
+func (s *WalletConnectService) PublicKey() string {
+	return s.publicKey
+}
+
+// AddConnection allows the app signing with connection.PublicKey, replacing
+// a connection with the same key.
+func (s *WalletConnectService) AddConnection(connection WalletConnection) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.connections[connection.PublicKey] = &connection
+}
+
+// NewConnection creates a connection with a fresh app key and returns the
+// nostr+walletconnect URI to give to the app. connection.PublicKey is
+// filled in.
+func (s *WalletConnectService) NewConnection(connection WalletConnection) (string, WalletConnection, error) {
+	secret, err := GenerateSecretKey()
+	if err != nil {
+		return "", connection, err
+	}
+	if connection.PublicKey, err = PublicKey(secret); err != nil {
+		return "", connection, err
+	}
+	s.AddConnection(connection)
+
+	query := url.Values{}
+	for _, relay := range s.relays {
+		query.Add("relay", relay)
+	}
+	query.Set("secret", secret)
+	return "nostr+walletconnect://" + s.publicKey + "?" + query.Encode(), connection, nil
+}
+
+// RemoveConnection revokes the connection of an app.
+func (s *WalletConnectService) RemoveConnection(publicKey string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.connections, publicKey)
+}
+
+// Connections returns the current connections.
+func (s *WalletConnectService) Connections() []WalletConnection {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	connections := make([]WalletConnection, 0, len(s.connections))
+	for _, connection := range s.connections {
+		connections = append(connections, *connection)
+	}
+	slices.SortFunc(connections, func(a, b WalletConnection) int { return strings.Compare(a.Name, b.Name) })
+	return connections
+}
+
+// Start publishes the info event and answers requests in the background
+// until ctx is done or Stop is called.
+func (s *WalletConnectService) Start(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cancel != nil {
+		return errors.New("wallet connect service already started")
+	}
+
+	info := Event{
+		CreatedAt: time.Now().Unix(),
+		Kind:      KindWalletConnectInfo,
+		Tags:      [][]string{{"encryption", "nip44_v2 nip04"}},
+		Content:   strings.Join(walletConnectMethods, " "),
+	}
+	if err := info.Sign(s.secretKey); err != nil {
+		return err
+	}
+	if err := Publish(ctx, s.relays, info); err != nil {
+		return fmt.Errorf("publish wallet connect info: %w", err)
+	}
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+	since := time.Now().Add(-time.Minute).Unix()
+	events := Subscribe(ctx, s.relays, Filter{
+		Kinds: []int{KindWalletConnectRequest},
+		Tags:  map[string][]string{"p": {s.publicKey}},
+		Since: &since,
+	})
+	go func(done chan struct{}) {
+		defer close(done)
+		var wg sync.WaitGroup
+		for event := range events {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				response, err := s.Handle(ctx, event)
+				if err == nil && response != nil {
+					err = Publish(ctx, s.relays, *response)
+				}
+				if err != nil && s.opts.OnError != nil {
+					s.opts.OnError(fmt.Errorf("request %s: %w", event.ID, err))
+				}
+			}()
+		}
+		wg.Wait()
+	}(s.done)
+	return nil
+}
+
+// Stop stops a started service and waits for requests in progress.
+func (s *WalletConnectService) Stop() {
+	s.mu.Lock()
+	cancel, done := s.cancel, s.done
+	s.cancel, s.done = nil, nil
+	s.mu.Unlock()
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+type nwcRequest struct {
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+type nwcResponse struct {
+	ResultType string    `json:"result_type"`
+	Error      *NwcError `json:"error,omitempty"`
+	Result     any       `json:"result,omitempty"`
+}
+
+// Handle answers a request event and returns the signed response to
+// publish. Requests from unknown apps and expired requests get no
+// response.
+func (s *WalletConnectService) Handle(ctx context.Context, event Event) (*Event, error) {
+	if event.Kind != KindWalletConnectRequest {
+		return nil, nil
+	}
+	if expiration, err := strconv.ParseInt(event.Tag("expiration"), 10, 64); err == nil && time.Now().Unix() > expiration {
+		return nil, nil
+	}
+	s.mu.Lock()
+	_, known := s.connections[event.PubKey]
+	s.mu.Unlock()
+	if !known {
+		return nil, nil
+	}
+
+	nip44 := strings.Contains(event.Tag("encryption"), "nip44_v2")
+	var key []byte
+	var plaintext string
+	var err error
+	if nip44 {
+		if key, err = conversationKey(s.secretKey, event.PubKey); err == nil {
+			plaintext, err = decryptNip44(key, event.Content)
+		}
+	} else {
+		plaintext, err = decryptNip04(s.secretKey, event.PubKey, event.Content)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var request nwcRequest
+	response := nwcResponse{}
+	if err := json.Unmarshal([]byte(plaintext), &request); err != nil {
+		response.Error = nwcError(NwcOther, "invalid request: %v", err)
+	} else {
+		response.ResultType = request.Method
+		response.Result, err = s.call(ctx, event.PubKey, request)
+		if err != nil {
+			var nwcErr *NwcError
+			if !errors.As(err, &nwcErr) {
+				nwcErr = nwcError(NwcInternal, "%v", err)
+			}
+			response.Error, response.Result = nwcErr, nil
+		}
+	}
+
+	content, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+	var encrypted string
+	if nip44 {
+		if encrypted, err = encryptNip44(key, string(content)); err != nil {
+			return nil, err
+		}
+	} else if encrypted, err = encryptNip04(s.secretKey, event.PubKey, string(content)); err != nil {
+		return nil, err
+	}
+	tags := [][]string{{"p", event.PubKey}, {"e", event.ID}}
+	if nip44 {
+		tags = append(tags, []string{"encryption", "nip44_v2"})
+	}
+	reply := Event{CreatedAt: time.Now().Unix(), Kind: KindWalletConnectResponse, Tags: tags, Content: encrypted}
+	return &reply, reply.Sign(s.secretKey)
+}
+
+func (s *WalletConnectService) call(ctx context.Context, app string, request nwcRequest) (any, error) {
+	s.mu.Lock()
+	connection, ok := s.connections[app]
+	var allowed, expired bool
+	var methods []string
+	if ok {
+		methods = connection.Methods
+		allowed = connection.allows(request.Method)
+		expired = !connection.ExpiresAt.IsZero() && time.Now().After(connection.ExpiresAt)
+	}
+	s.mu.Unlock()
+	switch {
+	case !ok || expired:
+		return nil, nwcError(NwcUnauthorized, "no active connection for this app")
+	case !slices.Contains(walletConnectMethods, request.Method):
+		return nil, nwcError(NwcNotImplemented, "method %s is not supported", request.Method)
+	case !allowed:
+		return nil, nwcError(NwcRestricted, "method %s is not allowed for this connection", request.Method)
+	}
+
+	params := request.Params
+	if len(params) == 0 {
+		params = json.RawMessage("{}")
+	}
+	switch request.Method {
+	case MethodPayInvoice:
+		return s.payInvoice(ctx, app, params)
+	case MethodMakeInvoice:
+		return s.makeInvoice(ctx, params)
+	case MethodGetBalance:
+		balance, err := s.wallet.TotalBalanceCtx(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// Per-mint balances are an extension apps may ignore.
+		balances, err := s.wallet.GetBalancesCtx(ctx)
+		if err != nil {
+			return nil, err
+		}
+		mints := make(map[string]uint64, len(balances))
+		for mint, amount := range balances {
+			mints[mint] = s.toMsat(amount)
+		}
+		return map[string]any{"balance": s.toMsat(balance), "balances": mints}, nil
+	case MethodListTransactions:
+		return s.listTransactions(ctx, params)
+	case MethodLookupInvoice:
+		return s.lookupInvoice(params)
+	default: // MethodGetInfo
+		if len(methods) == 0 {
+			methods = walletConnectMethods
+		}
+		return map[string]any{"alias": s.opts.Alias, "pubkey": s.publicKey, "methods": methods}, nil
+	}
+}
+
+func (s *WalletConnectService) toMsat(amount cdk.Amount) uint64 {
+	if s.msat {
+		return amount.Value
+	}
+	return amount.Value * 1000
+}
+
+// fromMsat converts msat to the wallet's unit, rounding down.
+func (s *WalletConnectService) fromMsat(msat uint64) cdk.Amount {
+	if s.msat {
+		return cdk.Amount{Value: msat}
+	}
+	return cdk.Amount{Value: msat / 1000}
+}
+
+func (s *WalletConnectService) payInvoice(ctx context.Context, app string, params json.RawMessage) (any, error) {
+	var request struct {
+		Invoice string  `json:"invoice"`
+		Amount  *uint64 `json:"amount"`
+	}
+	if err := json.Unmarshal(params, &request); err != nil {
+		return nil, nwcError(NwcOther, "invalid params: %v", err)
+	}
+	invoice, err := cdk.DecodeBolt11(request.Invoice)
+	if err != nil {
+		return nil, nwcError(NwcOther, "%v", err)
+	}
+	amountMsat := invoice.AmountMsat
+	var options *cdk.MeltOptions
+	if amountMsat == nil {
+		if request.Amount == nil {
+			return nil, nwcError(NwcOther, "amount required for an invoice without amount")
+		}
+		amountMsat = request.Amount
+		var amountless cdk.MeltOptions = cdk.MeltOptionsAmountless{AmountMsat: cdk.Amount{Value: *request.Amount}}
+		options = &amountless
+	}
+
+	// Reserve the amount against the budget; fees are capped by what is
+	// left of it. The connection may be revoked or replaced meanwhile, so
+	// the spending is accounted on the one looked up here.
+	s.mu.Lock()
+	connection := s.connections[app]
+	if connection == nil {
+		s.mu.Unlock()
+		return nil, nwcError(NwcUnauthorized, "no active connection for this app")
+	}
+	remaining, limited := connection.remainingMsat(time.Now())
+	if limited && remaining < *amountMsat {
+		s.mu.Unlock()
+		return nil, nwcError(NwcQuotaExceeded, "budget has %d msat left", remaining)
+	}
+	connection.SpentMsat += *amountMsat
+	s.mu.Unlock()
+
+	var maxFee *cdk.Amount
+	if limited {
+		fee := s.fromMsat(remaining - *amountMsat)
+		maxFee = &fee
+	}
+	melted, err := s.wallet.MeltCtx(ctx, request.Invoice, options, maxFee)
+	if err == nil && melted.State != cdk.QuoteStatePaid {
+		err = nwcError(NwcPaymentFailed, "payment is %s", quoteStateName(melted.State))
+	}
+
+	s.mu.Lock()
+	if err != nil && melted.State != cdk.QuoteStatePending {
+		connection.SpentMsat -= min(connection.SpentMsat, *amountMsat)
+	} else if err == nil {
+		connection.SpentMsat += s.toMsat(melted.FeePaid)
+	}
+	// Only the current connection of the app is persisted.
+	current := s.connections[app] == connection
+	updated := *connection
+	s.mu.Unlock()
+	if current && s.opts.OnConnectionUpdate != nil {
+		s.opts.OnConnectionUpdate(updated)
+	}
+
+	if err != nil {
+		var nwcErr *NwcError
+		var insufficient *cdk.InsufficientFundsError
+		switch {
+		case errors.As(err, &nwcErr):
+			return nil, nwcErr
+		case errors.As(err, &insufficient):
+			return nil, nwcError(NwcInsufficientBalance, "%v", err)
+		default:
+			return nil, nwcError(NwcPaymentFailed, "%v", err)
+		}
+	}
+	result := map[string]any{"fees_paid": s.toMsat(melted.FeePaid)}
+	if melted.Preimage != nil {
+		result["preimage"] = *melted.Preimage
+	}
+	return result, nil
+}
+
+func quoteStateName(state cdk.QuoteState) string {
+	switch state {
+	case cdk.QuoteStateUnpaid:
+		return "unpaid"
+	case cdk.QuoteStatePaid:
+		return "paid"
+	case cdk.QuoteStatePending:
+		return "pending"
+	case cdk.QuoteStateIssued:
+		return "issued"
+	default:
+		return "unknown"
+	}
+}
+
+// nwcTransaction is a transaction as returned by make_invoice,
+// lookup_invoice and list_transactions.
+type nwcTransaction struct {
+	Type            string  `json:"type"`
+	State           string  `json:"state,omitempty"`
+	Invoice         string  `json:"invoice,omitempty"`
+	Description     string  `json:"description,omitempty"`
+	DescriptionHash string  `json:"description_hash,omitempty"`
+	Preimage        string  `json:"preimage,omitempty"`
+	PaymentHash     string  `json:"payment_hash"`
+	Amount          uint64  `json:"amount"`
+	FeesPaid        uint64  `json:"fees_paid"`
+	CreatedAt       uint64  `json:"created_at"`
+	ExpiresAt       *uint64 `json:"expires_at,omitempty"`
+	SettledAt       *uint64 `json:"settled_at,omitempty"`
+}
+
+func (s *WalletConnectService) makeInvoice(ctx context.Context, params json.RawMessage) (any, error) {
+	var request struct {
+		Amount      uint64 `json:"amount"`
+		Description string `json:"description"`
+	}
+	if err := json.Unmarshal(params, &request); err != nil {
+		return nil, nwcError(NwcOther, "invalid params: %v", err)
+	}
+	amount := s.fromMsat(request.Amount)
+	if amount.Value == 0 {
+		return nil, nwcError(NwcOther, "amount must be at least one unit of the wallet")
+	}
+	if s.toMsat(amount) != request.Amount {
+		return nil, nwcError(NwcOther, "amount must be whole satoshis")
+	}
+	mint := s.opts.Mint
+	if mint == "" {
+		mints := s.wallet.GetMintUrls()
+		if len(mints) == 0 {
+			return nil, nwcError(NwcInternal, "wallet has no mints")
+		}
+		mint = mints[0]
+	}
+	var description *string
+	if request.Description != "" {
+		description = &request.Description
+	}
+	quote, err := s.wallet.MintQuoteCtx(ctx, cdk.MintUrl{Url: mint}, amount, description)
+	if err != nil {
+		return nil, err
+	}
+	if s.opts.QuoteManager != nil {
+		s.opts.QuoteManager.Trigger()
+	}
+	return s.incomingTransaction(quote), nil
+}
+
+func (s *WalletConnectService) incomingTransaction(quote cdk.MintQuote) nwcTransaction {
+	tx := nwcTransaction{Type: "incoming", Invoice: quote.Request, State: "pending"}
+	if quote.Amount != nil {
+		tx.Amount = s.toMsat(*quote.Amount)
+	}
+	if invoice, err := cdk.DecodeBolt11(quote.Request); err == nil {
+		tx.PaymentHash = invoice.PaymentHash
+		tx.CreatedAt = invoice.Timestamp
+		expires := invoice.ExpiresAt()
+		tx.ExpiresAt = &expires
+		if invoice.Description != nil {
+			tx.Description = *invoice.Description
+		}
+		if invoice.AmountMsat != nil {
+			tx.Amount = *invoice.AmountMsat
+		}
+	}
+	if quote.Expiry > 0 {
+		expires := quote.Expiry
+		tx.ExpiresAt = &expires
+	}
+	switch quote.State {
+	case cdk.QuoteStatePaid, cdk.QuoteStateIssued:
+		tx.State = "settled"
+	case cdk.QuoteStateUnpaid:
+		if tx.ExpiresAt != nil && uint64(time.Now().Unix()) > *tx.ExpiresAt {
+			tx.State = "expired"
+		}
+	}
+	return tx
+}
+
+func (s *WalletConnectService) outgoingTransaction(quote cdk.MeltQuote) nwcTransaction {
+	tx := nwcTransaction{Type: "outgoing", Invoice: quote.Request, Amount: s.toMsat(quote.Amount), State: "pending"}
+	if invoice, err := cdk.DecodeBolt11(quote.Request); err == nil {
+		tx.PaymentHash = invoice.PaymentHash
+		tx.CreatedAt = invoice.Timestamp
+		if invoice.Description != nil {
+			tx.Description = *invoice.Description
+		}
+	}
+	if quote.PaymentPreimage != nil {
+		tx.Preimage = *quote.PaymentPreimage
+	}
+	switch quote.State {
+	case cdk.QuoteStatePaid:
+		tx.State = "settled"
+	case cdk.QuoteStateUnpaid:
+		tx.State = "failed"
+	}
+	return tx
+}
+
+func (s *WalletConnectService) lookupInvoice(params json.RawMessage) (any, error) {
+	var request struct {
+		PaymentHash string `json:"payment_hash"`
+		Invoice     string `json:"invoice"`
+	}
+	if err := json.Unmarshal(params, &request); err != nil {
+		return nil, nwcError(NwcOther, "invalid params: %v", err)
+	}
+	hash := strings.ToLower(request.PaymentHash)
+	if request.Invoice != "" {
+		invoice, err := cdk.DecodeBolt11(request.Invoice)
+		if err != nil {
+			return nil, nwcError(NwcOther, "%v", err)
+		}
+		hash = invoice.PaymentHash
+	}
+	if hash == "" {
+		return nil, nwcError(NwcOther, "payment_hash or invoice required")
+	}
+	matches := func(request string) bool {
+		invoice, err := cdk.DecodeBolt11(request)
+		return err == nil && invoice.PaymentHash == hash
+	}
+
+	mintQuotes, err := s.db.GetMintQuotes()
+	if err != nil {
+		return nil, err
+	}
+	for _, quote := range mintQuotes {
+		if matches(quote.Request) {
+			return s.incomingTransaction(quote), nil
+		}
+	}
+	meltQuotes, err := s.db.GetMeltQuotes()
+	if err != nil {
+		return nil, err
+	}
+	for _, quote := range meltQuotes {
+		if matches(quote.Request) {
+			return s.outgoingTransaction(quote), nil
+		}
+	}
+	return nil, nwcError(NwcNotFound, "invoice not found")
+}
+
+func (s *WalletConnectService) listTransactions(ctx context.Context, params json.RawMessage) (any, error) {
+	var request struct {
+		From   *uint64 `json:"from"`
+		Until  *uint64 `json:"until"`
+		Limit  int     `json:"limit"`
+		Offset int     `json:"offset"`
+		Type   string  `json:"type"`
+	}
+	if err := json.Unmarshal(params, &request); err != nil {
+		return nil, nwcError(NwcOther, "invalid params: %v", err)
+	}
+	var direction *cdk.TransactionDirection
+	switch request.Type {
+	case "incoming":
+		incoming := cdk.TransactionDirectionIncoming
+		direction = &incoming
+	case "outgoing":
+		outgoing := cdk.TransactionDirectionOutgoing
+		direction = &outgoing
+	}
+	transactions, err := s.wallet.ListTransactionsCtx(ctx, direction)
+	if err != nil {
+		return nil, err
+	}
+
+	// Mint and melt quotes add the invoice details to Lightning transactions.
+	mintQuotes := map[string]cdk.MintQuote{}
+	meltQuotes := map[string]cdk.MeltQuote{}
+	if quotes, err := s.db.GetMintQuotes(); err == nil {
+		for _, quote := range quotes {
+			mintQuotes[quote.Id] = quote
+		}
+	}
+	if quotes, err := s.db.GetMeltQuotes(); err == nil {
+		for _, quote := range quotes {
+			meltQuotes[quote.Id] = quote
+		}
+	}
+
+	result := []nwcTransaction{}
+	skipped := 0
+	for _, transaction := range transactions {
+		if request.From != nil && transaction.Timestamp < *request.From {
+			continue
+		}
+		if request.Until != nil && transaction.Timestamp > *request.Until {
+			continue
+		}
+		if skipped < request.Offset {
+			skipped++
+			continue
+		}
+		tx := nwcTransaction{Type: "incoming", State: "settled", CreatedAt: transaction.Timestamp}
+		if transaction.Direction == cdk.TransactionDirectionOutgoing {
+			tx.Type = "outgoing"
+		}
+		if transaction.QuoteId != nil {
+			if quote, ok := mintQuotes[*transaction.QuoteId]; ok {
+				tx = s.incomingTransaction(quote)
+			} else if quote, ok := meltQuotes[*transaction.QuoteId]; ok {
+				tx = s.outgoingTransaction(quote)
+			}
+		}
+		tx.Amount = s.toMsat(transaction.Amount)
+		tx.FeesPaid = s.toMsat(transaction.Fee)
+		settled := transaction.Timestamp
+		tx.SettledAt = &settled
+		if tx.CreatedAt == 0 {
+			tx.CreatedAt = transaction.Timestamp
+		}
+		if transaction.Memo != nil && tx.Description == "" {
+			tx.Description = *transaction.Memo
+		}
+		result = append(result, tx)
+		if request.Limit > 0 && len(result) == request.Limit {
+			break
+		}
+	}
+	return map[string]any{"transactions": result}, nil
+}
@@ -0,0 +1,118 @@
+package nostr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// WalletConnectClient calls a Nostr Wallet Connect service, such as a
+// WalletConnectService, with the key of a connection URI.
+type WalletConnectClient struct {
+	service   string
+	relays    []string
+	secretKey string
+	publicKey string
+}
+
+// NewWalletConnectClient parses a nostr+walletconnect:// URI.
+func NewWalletConnectClient(uri string) (*WalletConnectClient, error) {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.Scheme != "nostr+walletconnect" && parsed.Scheme != "nostrwalletconnect" {
+		return nil, errors.New("not a nostr+walletconnect URI")
+	}
+	service := parsed.Host
+	if service == "" {
+		service = parsed.Opaque
+	}
+	if _, err := parsePublicKey(service); err != nil {
+		return nil, fmt.Errorf("wallet connect service key: %w", err)
+	}
+	query := parsed.Query()
+	relays := query["relay"]
+	if len(relays) == 0 {
+		return nil, errors.New("wallet connect URI has no relay")
+	}
+	publicKey, err := PublicKey(query.Get("secret"))
+	if err != nil {
+		return nil, fmt.Errorf("wallet connect secret: %w", err)
+	}
+	return &WalletConnectClient{service: service, relays: relays, secretKey: query.Get("secret"), publicKey: publicKey}, nil
+}
+
+// PublicKey returns the hex public key requests are signed with.
+func (c *WalletConnectClient) PublicKey() string {
+	return c.publicKey
+}
+
+// Call sends method with params and decodes the result into result, which
+// may be nil. Errors returned by the service are *NwcError.
+func (c *WalletConnectClient) Call(ctx context.Context, method string, params, result any) error {
+	if params == nil {
+		params = struct{}{}
+	}
+	content, err := json.Marshal(map[string]any{"method": method, "params": params})
+	if err != nil {
+		return err
+	}
+	key, err := conversationKey(c.secretKey, c.service)
+	if err != nil {
+		return err
+	}
+	encrypted, err := encryptNip44(key, string(content))
+	if err != nil {
+		return err
+	}
+	request := Event{
+		CreatedAt: time.Now().Unix(),
+		Kind:      KindWalletConnectRequest,
+		Tags:      [][]string{{"p", c.service}, {"encryption", "nip44_v2"}},
+		Content:   encrypted,
+	}
+	if err := request.Sign(c.secretKey); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	responses := Subscribe(ctx, c.relays, Filter{
+		Authors: []string{c.service},
+		Kinds:   []int{KindWalletConnectResponse},
+		Tags:    map[string][]string{"e": {request.ID}},
+	})
+	if err := Publish(ctx, c.relays, request); err != nil {
+		return err
+	}
+
+	for event := range responses {
+		plaintext, err := decryptNip44(key, event.Content)
+		if err != nil && !strings.Contains(event.Tag("encryption"), "nip44") {
+			plaintext, err = decryptNip04(c.secretKey, c.service, event.Content)
+		}
+		if err != nil {
+			return err
+		}
+		var response struct {
+			Error  *NwcError       `json:"error"`
+			Result json.RawMessage `json:"result"`
+		}
+		if err := json.Unmarshal([]byte(plaintext), &response); err != nil {
+			return fmt.Errorf("decode wallet connect response: %w", err)
+		}
+		if response.Error != nil {
+			return response.Error
+		}
+		if result == nil || len(response.Result) == 0 {
+			return nil
+		}
+		return json.Unmarshal(response.Result, result)
+	}
+	return ctx.Err()
+}
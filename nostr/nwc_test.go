@@ -0,0 +1,120 @@
+package nostr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/lescuer97/cdkgo/mockmint"
+)
+
+// newTestService returns a sat wallet connect service without a wallet,
+// for the requests answered before the wallet is used.
+func newTestService(t *testing.T, relays []string, opts WalletConnectOptions) *WalletConnectService {
+	t.Helper()
+	secretKey, err := GenerateSecretKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey, _ := PublicKey(secretKey)
+	return &WalletConnectService{
+		secretKey:   secretKey,
+		publicKey:   publicKey,
+		relays:      relays,
+		opts:        opts,
+		connections: map[string]*WalletConnection{},
+	}
+}
+
+func wantNwcError(t *testing.T, err error, code string) {
+	t.Helper()
+	var nwcErr *NwcError
+	if !errors.As(err, &nwcErr) || nwcErr.Code != code {
+		t.Fatalf("got %v, want %s", err, code)
+	}
+}
+
+func TestWalletConnectRelay(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	relay := newRelay(t)
+	service := newTestService(t, []string{relay.URL()}, WalletConnectOptions{Alias: "test"})
+	if err := service.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	defer service.Stop()
+
+	uri, connection, err := service.NewConnection(WalletConnection{Name: "app", Methods: []string{MethodGetInfo, MethodMakeInvoice}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := NewWalletConnectClient(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.PublicKey() != connection.PublicKey {
+		t.Fatal("client does not sign with the connection key")
+	}
+
+	var info struct {
+		Alias   string   `json:"alias"`
+		Pubkey  string   `json:"pubkey"`
+		Methods []string `json:"methods"`
+	}
+	if err := client.Call(ctx, MethodGetInfo, nil, &info); err != nil {
+		t.Fatal(err)
+	}
+	if info.Alias != "test" || info.Pubkey != service.PublicKey() || !slices.Equal(info.Methods, connection.Methods) {
+		t.Fatalf("get_info returned %+v", info)
+	}
+	wantNwcError(t, client.Call(ctx, MethodPayInvoice, map[string]any{"invoice": "lnbc1"}, nil), NwcRestricted)
+	wantNwcError(t, client.Call(ctx, MethodMakeInvoice, map[string]any{"amount": 1500}, nil), NwcOther)
+}
+
+func TestWalletConnectRevoked(t *testing.T) {
+	service := newTestService(t, []string{"ws://127.0.0.1:1"}, WalletConnectOptions{})
+	_, connection, err := service.NewConnection(WalletConnection{Name: "app"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	invoice, _, err := mockmint.NewFakeLightning().CreateInvoice(21_000, "revoked")
+	if err != nil {
+		t.Fatal(err)
+	}
+	params, _ := json.Marshal(map[string]any{"invoice": invoice})
+
+	// A payment that passed call's checks before the app was revoked.
+	service.RemoveConnection(connection.PublicKey)
+	_, err = service.payInvoice(context.Background(), connection.PublicKey, params)
+	wantNwcError(t, err, NwcUnauthorized)
+	_, err = service.call(context.Background(), connection.PublicKey, nwcRequest{Method: MethodGetInfo})
+	wantNwcError(t, err, NwcUnauthorized)
+}
+
+func TestWalletConnectMakeInvoiceAmount(t *testing.T) {
+	service := newTestService(t, nil, WalletConnectOptions{})
+	for _, amount := range []uint64{0, 999, 1500} {
+		params, _ := json.Marshal(map[string]any{"amount": amount})
+		_, err := service.makeInvoice(context.Background(), params)
+		wantNwcError(t, err, NwcOther)
+	}
+}
+
+func TestWalletConnectionBudget(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	connection := WalletConnection{BudgetMsat: 10_000, BudgetPeriod: BudgetDaily, SpentMsat: 4_000, PeriodStart: start}
+	if remaining, limited := connection.remainingMsat(start.Add(time.Hour)); !limited || remaining != 6_000 {
+		t.Fatalf("%d left (limited %v)", remaining, limited)
+	}
+	// Two days later the budget renewed twice.
+	if remaining, _ := connection.remainingMsat(start.Add(49 * time.Hour)); remaining != 10_000 || !connection.PeriodStart.Equal(start.AddDate(0, 0, 2)) {
+		t.Fatalf("%d left, period from %v", remaining, connection.PeriodStart)
+	}
+	unlimited := WalletConnection{}
+	if _, limited := unlimited.remainingMsat(start); limited {
+		t.Fatal("connection without budget is limited")
+	}
+}
@@ -0,0 +1,191 @@
+package nostr
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	cdk "github.com/lescuer97/cdkgo"
+)
+
+// ReceiverOptions configures a Receiver.
+type ReceiverOptions struct {
+	// Options of the Receive calls
+	ReceiveOptions cdk.ReceiveOptions
+	// Oldest messages to ask the relays for, 3 days ago by default since
+	// gift wraps are backdated by up to 2 days. Store the time of the last
+	// received message and pass it here to avoid seeing old tokens again.
+	Since time.Time
+	// Called for every token or payload received into the wallet
+	OnReceived func(Received)
+	// Called when a message could not be read or received
+	OnError func(error)
+}
+
+// Received describes a token a Receiver received.
+type Received struct {
+	// Id of the event that carried it
+	EventId string
+	// Hex public key of the sender
+	Sender    string
+	CreatedAt time.Time
+	Amount    cdk.Amount
+	// Set when the message was a NUT-18 payload
+	Payload *cdk.PaymentRequestPayload
+}
+
+// Receiver listens for direct messages to a key and receives the tokens
+// and NUT-18 payment payloads they carry into a wallet. Both NIP-17 gift
+// wraps and NIP-04 messages are read.
+type Receiver struct {
+	wallet    *cdk.Wallet
+	secretKey string
+	publicKey string
+	relays    []string
+	opts      ReceiverOptions
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewReceiver returns a receiver for the messages to secretKey, hex or
+// nsec, on relays.
+func NewReceiver(wallet *cdk.Wallet, secretKey string, relays []string, opts ReceiverOptions) (*Receiver, error) {
+	key, err := parseSecretKey(secretKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(relays) == 0 {
+		return nil, errors.New("no relays to listen on")
+	}
+	if opts.Since.IsZero() {
+		opts.Since = time.Now().Add(-giftWrapJitter - 24*time.Hour)
+	}
+	if opts.ReceiveOptions.AmountSplitTarget == nil {
+		opts.ReceiveOptions.AmountSplitTarget = cdk.SplitTargetNone{}
+	}
+	return &Receiver{
+		wallet:    wallet,
+		secretKey: hex.EncodeToString(key.Serialize()),
+		publicKey: xOnlyHex(key.PubKey()),
+		relays:    relays,
+		opts:      opts,
+	}, nil
+}
+
+// PublicKey returns the hex public key the receiver listens for.
+func (r *Receiver) PublicKey() string {
+	return r.publicKey
+}
+
+// Start listens in the background until ctx is done or Stop is called.
+func (r *Receiver) Start(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cancel != nil {
+		return errors.New("nostr receiver already started")
+	}
+	ctx, r.cancel = context.WithCancel(ctx)
+	r.done = make(chan struct{})
+	since := r.opts.Since.Unix()
+	filter := Filter{
+		Kinds: []int{KindGiftWrap, KindEncryptedDirectMessage},
+		Tags:  map[string][]string{"p": {r.publicKey}},
+		Since: &since,
+	}
+	events := Subscribe(ctx, r.relays, filter)
+	go func(done chan struct{}) {
+		defer close(done)
+		for event := range events {
+			received, err := r.Handle(ctx, event)
+			if err != nil {
+				if r.opts.OnError != nil {
+					r.opts.OnError(fmt.Errorf("event %s: %w", event.ID, err))
+				}
+				continue
+			}
+			if received != nil && r.opts.OnReceived != nil {
+				r.opts.OnReceived(*received)
+			}
+		}
+	}(r.done)
+	return nil
+}
+
+// Stop stops a started receiver and waits for a receive in progress.
+func (r *Receiver) Stop() {
+	r.mu.Lock()
+	cancel, done := r.cancel, r.done
+	r.cancel, r.done = nil, nil
+	r.mu.Unlock()
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+var tokenPattern = regexp.MustCompile(`cashu[AB][A-Za-z0-9_\-+/=]+`)
+
+// Handle decrypts a direct message event and receives what it carries. It
+// returns nil without an error for messages that hold no token.
+func (r *Receiver) Handle(ctx context.Context, event Event) (*Received, error) {
+	var sender, content string
+	var createdAt int64
+	switch event.Kind {
+	case KindGiftWrap:
+		rumor, err := unwrapGift(r.secretKey, event)
+		if err != nil {
+			return nil, err
+		}
+		if rumor.Kind != KindPrivateDirectMessage {
+			return nil, nil
+		}
+		sender, content, createdAt = rumor.PubKey, rumor.Content, rumor.CreatedAt
+	case KindEncryptedDirectMessage:
+		plaintext, err := decryptNip04(r.secretKey, event.PubKey, event.Content)
+		if err != nil {
+			return nil, err
+		}
+		sender, content, createdAt = event.PubKey, plaintext, event.CreatedAt
+	default:
+		return nil, nil
+	}
+	received := &Received{EventId: event.ID, Sender: sender, CreatedAt: time.Unix(createdAt, 0)}
+
+	content = strings.TrimSpace(content)
+	if strings.HasPrefix(content, "{") {
+		var payload cdk.PaymentRequestPayload
+		if err := json.Unmarshal([]byte(content), &payload); err != nil {
+			return nil, fmt.Errorf("decode payment payload: %w", err)
+		}
+		amount, err := r.wallet.ReceivePaymentPayload(ctx, payload, r.opts.ReceiveOptions)
+		if err != nil {
+			return nil, err
+		}
+		received.Amount, received.Payload = amount, &payload
+		return received, nil
+	}
+
+	encoded := tokenPattern.FindString(content)
+	if encoded == "" {
+		return nil, nil
+	}
+	token, err := cdk.TokenDecode(encoded)
+	if err != nil {
+		return nil, err
+	}
+	defer token.Destroy()
+	amount, err := r.wallet.ReceiveCtx(ctx, token, r.opts.ReceiveOptions)
+	if err != nil {
+		return nil, err
+	}
+	received.Amount = amount
+	return received, nil
+}
@@ -0,0 +1,155 @@
+package nostr_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cdk "github.com/lescuer97/cdkgo"
+	"github.com/lescuer97/cdkgo/mockmint"
+	"github.com/lescuer97/cdkgo/nostr"
+)
+
+func newWallet(t *testing.T, ctx context.Context, mint *mockmint.Mint, amount uint64) *cdk.Wallet {
+	t.Helper()
+	mnemonic, err := cdk.GenerateMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wallet, err := cdk.NewWallet(mint.URL(), cdk.CurrencyUnitSat{}, mnemonic, cdk.NewMemoryWalletDatabase(), cdk.WalletConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(wallet.Destroy)
+	if amount == 0 {
+		return wallet
+	}
+	quote, err := wallet.MintQuoteCtx(ctx, cdk.Amount{Value: amount}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mint.PayMintQuote(quote.Id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := wallet.MintCtx(ctx, quote.Id, cdk.SplitTargetNone{}, nil); err != nil {
+		t.Fatal(err)
+	}
+	return wallet
+}
+
+func startReceiver(t *testing.T, ctx context.Context, wallet *cdk.Wallet, secretKey, relay string) (*nostr.Receiver, <-chan nostr.Received) {
+	t.Helper()
+	received := make(chan nostr.Received, 1)
+	receiver, err := nostr.NewReceiver(wallet, secretKey, []string{relay}, nostr.ReceiverOptions{
+		OnReceived: func(r nostr.Received) { received <- r },
+		OnError:    func(err error) { t.Error(err) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := receiver.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(receiver.Stop)
+	return receiver, received
+}
+
+func TestReceiverToken(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	mint := mockmint.New(mockmint.Options{})
+	defer mint.Close()
+	relay, err := nostr.NewRelay()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer relay.Close()
+
+	sender := newWallet(t, ctx, mint, 64)
+	recipient := newWallet(t, ctx, mint, 0)
+	recipientKey, _ := nostr.GenerateSecretKey()
+	receiver, received := startReceiver(t, ctx, recipient, recipientKey, relay.URL())
+
+	senderKey, _ := nostr.GenerateSecretKey()
+	transport, err := nostr.NewTransport(senderKey, []string{relay.URL()}, nostr.TransportOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	prepared, err := sender.PrepareSendCtx(ctx, cdk.Amount{Value: 21}, cdk.SendOptions{SendKind: cdk.SendKindOnlineExact{}, AmountSplitTarget: cdk.SplitTargetNone{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer prepared.Destroy()
+	token, err := prepared.ConfirmCtx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer token.Destroy()
+	npub, _ := nostr.EncodeNpub(receiver.PublicKey())
+	if err := transport.SendToken(ctx, npub, token); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case r := <-received:
+		if r.Amount.Value != 21 || r.Sender != transport.PublicKey() || r.Payload != nil {
+			t.Fatalf("received %+v", r)
+		}
+	case <-ctx.Done():
+		t.Fatal("token not received")
+	}
+	balance, err := recipient.TotalBalanceCtx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance.Value != 21 {
+		t.Fatalf("balance %d, want 21", balance.Value)
+	}
+}
+
+func TestReceiverPaymentRequest(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	mint := mockmint.New(mockmint.Options{})
+	defer mint.Close()
+	relay, err := nostr.NewRelay()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer relay.Close()
+
+	payer := newWallet(t, ctx, mint, 64)
+	payee := newWallet(t, ctx, mint, 0)
+	payeeKey, _ := nostr.GenerateSecretKey()
+	_, received := startReceiver(t, ctx, payee, payeeKey, relay.URL())
+	payeeTransport, err := nostr.NewTransport(payeeKey, []string{relay.URL()}, nostr.TransportOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	target, err := payeeTransport.RequestTransport()
+	if err != nil {
+		t.Fatal(err)
+	}
+	request, err := payee.CreatePaymentRequest(&cdk.Amount{Value: 10}, nil, nil, []cdk.PaymentRequestTransport{target})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	payerKey, _ := nostr.GenerateSecretKey()
+	transport, err := nostr.NewTransport(payerKey, nil, nostr.TransportOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := payer.PayPaymentRequest(ctx, request, cdk.PayRequestOptions{Transports: []cdk.PaymentTransport{transport}}); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case r := <-received:
+		if r.Amount.Value != 10 || r.Payload == nil || *r.Payload.Id != *request.Id {
+			t.Fatalf("received %+v", r)
+		}
+	case <-ctx.Done():
+		t.Fatal("payment not received")
+	}
+}
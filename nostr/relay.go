@@ -0,0 +1,203 @@
+package nostr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/coder/websocket"
+)
+
+// Relay is an in-process Nostr relay for tests. It keeps every valid event
+// in memory and serves NIP-01 EVENT, REQ and CLOSE over websockets.
+type Relay struct {
+	listener net.Listener
+	server   *http.Server
+
+	mu       sync.Mutex
+	events   []Event
+	sessions map[*relaySession]bool
+}
+
+type relaySession struct {
+	conn *websocket.Conn
+	// Writes to a websocket must not be concurrent.
+	writeMu sync.Mutex
+	mu      sync.Mutex
+	subs    map[string][]Filter
+}
+
+// NewRelay starts a relay on a local port.
+func NewRelay() (*Relay, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	relay := &Relay{listener: listener, sessions: map[*relaySession]bool{}}
+	relay.server = &http.Server{Handler: http.HandlerFunc(relay.serve)}
+	go relay.server.Serve(listener)
+	return relay, nil
+}
+
+// URL returns the ws:// URL of the relay.
+func (r *Relay) URL() string {
+	return "ws://" + r.listener.Addr().String()
+}
+
+// Close disconnects every client and stops the relay.
+func (r *Relay) Close() {
+	r.mu.Lock()
+	for session := range r.sessions {
+		session.conn.CloseNow()
+	}
+	r.mu.Unlock()
+	r.server.Close()
+}
+
+// Events returns the events published so far.
+func (r *Relay) Events() []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Event(nil), r.events...)
+}
+
+func (r *Relay) serve(w http.ResponseWriter, req *http.Request) {
+	conn, err := websocket.Accept(w, req, nil)
+	if err != nil {
+		return
+	}
+	conn.SetReadLimit(maxMessageSize)
+	session := &relaySession{conn: conn, subs: map[string][]Filter{}}
+	r.mu.Lock()
+	r.sessions[session] = true
+	r.mu.Unlock()
+	defer func() {
+		r.mu.Lock()
+		delete(r.sessions, session)
+		r.mu.Unlock()
+		conn.CloseNow()
+	}()
+
+	ctx := req.Context()
+	for {
+		kind, message, err := readMessage(ctx, conn)
+		if err != nil {
+			if errors.Is(err, errInvalidMessage) {
+				session.send(ctx, "NOTICE", err.Error())
+				continue
+			}
+			return
+		}
+		switch kind {
+		case "EVENT":
+			r.handleEvent(ctx, session, message)
+		case "REQ":
+			r.handleReq(ctx, session, message)
+		case "CLOSE":
+			var id string
+			if len(message) > 0 {
+				json.Unmarshal(message[0], &id)
+			}
+			session.mu.Lock()
+			delete(session.subs, id)
+			session.mu.Unlock()
+		default:
+			session.send(ctx, "NOTICE", "unknown message "+kind)
+		}
+	}
+}
+
+func (r *Relay) handleEvent(ctx context.Context, session *relaySession, message []json.RawMessage) {
+	var event Event
+	if len(message) == 0 || json.Unmarshal(message[0], &event) != nil {
+		session.send(ctx, "NOTICE", "invalid event")
+		return
+	}
+	if err := event.Verify(); err != nil {
+		session.send(ctx, "OK", event.ID, false, "invalid: "+err.Error())
+		return
+	}
+
+	r.mu.Lock()
+	for _, stored := range r.events {
+		if stored.ID == event.ID {
+			r.mu.Unlock()
+			session.send(ctx, "OK", event.ID, true, "duplicate: already have this event")
+			return
+		}
+	}
+	r.events = append(r.events, event)
+	sessions := make([]*relaySession, 0, len(r.sessions))
+	for other := range r.sessions {
+		sessions = append(sessions, other)
+	}
+	r.mu.Unlock()
+
+	session.send(ctx, "OK", event.ID, true, "")
+	for _, other := range sessions {
+		other.mu.Lock()
+		var matched []string
+		for id, filters := range other.subs {
+			for _, filter := range filters {
+				if filter.Matches(event) {
+					matched = append(matched, id)
+					break
+				}
+			}
+		}
+		other.mu.Unlock()
+		for _, id := range matched {
+			other.send(context.Background(), "EVENT", id, event)
+		}
+	}
+}
+
+func (r *Relay) handleReq(ctx context.Context, session *relaySession, message []json.RawMessage) {
+	var id string
+	if len(message) == 0 || json.Unmarshal(message[0], &id) != nil {
+		session.send(ctx, "NOTICE", "invalid REQ")
+		return
+	}
+	var filters []Filter
+	for _, raw := range message[1:] {
+		var filter Filter
+		if err := json.Unmarshal(raw, &filter); err != nil {
+			session.send(ctx, "CLOSED", id, "invalid: "+err.Error())
+			return
+		}
+		filters = append(filters, filter)
+	}
+
+	r.mu.Lock()
+	var stored []Event
+	for _, filter := range filters {
+		var matched []Event
+		for i := len(r.events) - 1; i >= 0; i-- {
+			if filter.Matches(r.events[i]) {
+				matched = append(matched, r.events[i])
+				if filter.Limit > 0 && len(matched) == filter.Limit {
+					break
+				}
+			}
+		}
+		stored = append(stored, matched...)
+	}
+	session.mu.Lock()
+	session.subs[id] = filters
+	session.mu.Unlock()
+	r.mu.Unlock()
+
+	for _, event := range stored {
+		session.send(ctx, "EVENT", id, event)
+	}
+	session.send(ctx, "EOSE", id)
+}
+
+func (s *relaySession) send(ctx context.Context, message ...any) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	writeMessage(ctx, s.conn, message...)
+}
@@ -0,0 +1,115 @@
+package nostr
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"slices"
+
+	cdk "github.com/lescuer97/cdkgo"
+)
+
+// TransportOptions configures a Transport.
+type TransportOptions struct {
+	// Send NIP-04 kind 4 messages instead of NIP-17 gift wraps, for
+	// recipients that only read those
+	Nip04 bool
+}
+
+// Transport sends tokens and payment request payloads as direct messages
+// from one key. It implements cdk.PaymentTransport for "nostr" transports
+// of NUT-18 payment requests.
+type Transport struct {
+	secretKey string
+	publicKey string
+	relays    []string
+	opts      TransportOptions
+}
+
+var _ cdk.PaymentTransport = (*Transport)(nil)
+
+// NewTransport returns a transport sending from secretKey, hex or nsec,
+// through relays in addition to the relays of nprofile recipients.
+func NewTransport(secretKey string, relays []string, opts TransportOptions) (*Transport, error) {
+	key, err := parseSecretKey(secretKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Transport{
+		secretKey: hex.EncodeToString(key.Serialize()),
+		publicKey: xOnlyHex(key.PubKey()),
+		relays:    relays,
+		opts:      opts,
+	}, nil
+}
+
+// PublicKey returns the hex public key messages are sent from.
+func (t *Transport) PublicKey() string {
+	return t.publicKey
+}
+
+// SendToken DMs the encoded token to recipient, given as hex, npub or
+// nprofile.
+func (t *Transport) SendToken(ctx context.Context, recipient string, token *cdk.Token) error {
+	return t.SendMessage(ctx, recipient, token.Encode())
+}
+
+// SendMessage DMs content to recipient, given as hex, npub or nprofile.
+func (t *Transport) SendMessage(ctx context.Context, recipient, content string) error {
+	return t.send(ctx, recipient, content, t.opts.Nip04)
+}
+
+func (t *Transport) send(ctx context.Context, recipient, content string, nip04 bool) error {
+	publicKey, relays, err := ParseRecipient(recipient)
+	if err != nil {
+		return err
+	}
+	for _, relay := range t.relays {
+		if !slices.Contains(relays, relay) {
+			relays = append(relays, relay)
+		}
+	}
+	if len(relays) == 0 {
+		return errors.New("no relays to reach the recipient on")
+	}
+
+	var event Event
+	if nip04 {
+		event, err = encryptedDirectMessage(t.secretKey, publicKey, content)
+	} else {
+		event, err = giftWrap(t.secretKey, publicKey, content)
+	}
+	if err != nil {
+		return err
+	}
+	return Publish(ctx, relays, event)
+}
+
+func (*Transport) Type() string { return cdk.TransportTypeNostr }
+
+// Deliver sends the payload as JSON to the nprofile of target. NIP-04 is
+// used if the target only lists it in its "n" tag, NIP-17 otherwise.
+func (t *Transport) Deliver(ctx context.Context, target cdk.PaymentRequestTransport, payload cdk.PaymentRequestPayload) error {
+	content, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	nip04 := t.opts.Nip04
+	for _, tag := range target.Tags {
+		if len(tag) >= 2 && tag[0] == "n" {
+			nip04 = !slices.Contains(tag[1:], "17") && slices.Contains(tag[1:], "04")
+		}
+	}
+	return t.send(ctx, target.Target, string(content), nip04)
+}
+
+// RequestTransport returns the transport to put in payment requests paid to
+// this key, reachable on the transport's relays.
+func (t *Transport) RequestTransport() (cdk.PaymentRequestTransport, error) {
+	nprofile, err := EncodeNprofile(t.publicKey, t.relays)
+	if err != nil {
+		return cdk.PaymentRequestTransport{}, err
+	}
+	return cdk.PaymentRequestTransport{Type: cdk.TransportTypeNostr, Target: nprofile, Tags: [][]string{{"n", "17"}}}, nil
+}
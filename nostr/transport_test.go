@@ -0,0 +1,91 @@
+package nostr
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	cdk "github.com/lescuer97/cdkgo"
+)
+
+func newRelay(t *testing.T) *Relay {
+	t.Helper()
+	relay, err := NewRelay()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(relay.Close)
+	return relay
+}
+
+// nextMessage waits for the next event on events and decrypts it for
+// secretKey.
+func nextMessage(t *testing.T, ctx context.Context, secretKey string, events <-chan Event) (sender, content string) {
+	t.Helper()
+	select {
+	case event := <-events:
+		switch event.Kind {
+		case KindGiftWrap:
+			rumor, err := unwrapGift(secretKey, event)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return rumor.PubKey, rumor.Content
+		case KindEncryptedDirectMessage:
+			plaintext, err := decryptNip04(secretKey, event.PubKey, event.Content)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return event.PubKey, plaintext
+		}
+		t.Fatalf("unexpected event of kind %d", event.Kind)
+	case <-ctx.Done():
+		t.Fatal("no message arrived")
+	}
+	return "", ""
+}
+
+func TestTransportRelay(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	relay := newRelay(t)
+
+	senderSecret, _ := GenerateSecretKey()
+	recipientSecret, _ := GenerateSecretKey()
+	recipient, _ := PublicKey(recipientSecret)
+	transport, err := NewTransport(senderSecret, []string{relay.URL()}, TransportOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	since := time.Now().Add(-2 * giftWrapJitter).Unix()
+	events := Subscribe(ctx, []string{relay.URL()}, Filter{
+		Kinds: []int{KindGiftWrap, KindEncryptedDirectMessage},
+		Tags:  map[string][]string{"p": {recipient}},
+		Since: &since,
+	})
+
+	npub, _ := EncodeNpub(recipient)
+	if err := transport.SendMessage(ctx, npub, "gift wrapped"); err != nil {
+		t.Fatal(err)
+	}
+	if sender, content := nextMessage(t, ctx, recipientSecret, events); sender != transport.PublicKey() || content != "gift wrapped" {
+		t.Fatalf("got %q from %s", content, sender)
+	}
+
+	// A payment request target that only reads NIP-04.
+	nprofile, _ := EncodeNprofile(recipient, nil)
+	payload := cdk.PaymentRequestPayload{Mint: "http://mint.example", Unit: "sat"}
+	target := cdk.PaymentRequestTransport{Type: cdk.TransportTypeNostr, Target: nprofile, Tags: [][]string{{"n", "04"}}}
+	if err := transport.Deliver(ctx, target, payload); err != nil {
+		t.Fatal(err)
+	}
+	_, content := nextMessage(t, ctx, recipientSecret, events)
+	var delivered cdk.PaymentRequestPayload
+	if err := json.Unmarshal([]byte(content), &delivered); err != nil || delivered.Mint != payload.Mint {
+		t.Fatalf("delivered %q (%v)", content, err)
+	}
+	if kinds := []int{relay.Events()[0].Kind, relay.Events()[1].Kind}; kinds[0] != KindGiftWrap || kinds[1] != KindEncryptedDirectMessage {
+		t.Fatalf("relay holds kinds %v", kinds)
+	}
+}
@@ -0,0 +1,541 @@
+package cdk_ffi
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Transport types of NUT-18 payment requests.
+const (
+	TransportTypePost  = "post"
+	TransportTypeNostr = "nostr"
+	// In-process delivery through a LoopbackTransport, not part of NUT-18
+	TransportTypeLoopback = "loopback"
+)
+
+const paymentRequestPrefix = "creqA"
+
+// PaymentRequest is a NUT-18 payment request. Unset optional fields are
+// left out of the encoding.
+type PaymentRequest struct {
+	// Payment id, echoed in the payload
+	Id *string
+	// Requested amount; the payer chooses if nil
+	Amount *Amount
+	Unit   CurrencyUnit
+	// Whether the request should be paid only once
+	SingleUse *bool
+	// Mints the payee accepts, any if empty
+	Mints       []string
+	Description *string
+	// Where to deliver the payment; empty means in-band, the payer hands
+	// the payload over however the request reached them
+	Transports []PaymentRequestTransport
+	// Spending conditions the sent proofs must be locked to
+	Nut10 *Nut10SecretRequest
+}
+
+// PaymentRequestTransport is a way to deliver the payment of a request.
+type PaymentRequestTransport struct {
+	// One of the TransportType constants
+	Type string
+	// URL for post, nprofile for nostr
+	Target string
+	Tags   [][]string
+}
+
+// Nut10SecretRequest asks for proofs locked to a NUT-10 secret.
+type Nut10SecretRequest struct {
+	// "P2PK" or "HTLC"
+	Kind string
+	// Public key or hash lock
+	Data string
+	Tags [][]string
+}
+
+// PaymentRequestPayload carries the payment of a request to the payee.
+type PaymentRequestPayload struct {
+	Id     *string    `json:"id,omitempty"`
+	Memo   *string    `json:"memo,omitempty"`
+	Mint   string     `json:"mint"`
+	Unit   string     `json:"unit"`
+	Proofs []RawProof `json:"proofs"`
+}
+
+// Encode returns the request as a "creqA" string.
+func (r PaymentRequest) Encode() (string, error) {
+	var entries []cborMapEntry
+	if r.Id != nil {
+		entries = append(entries, cborMapEntry{"i", *r.Id})
+	}
+	if r.Amount != nil {
+		entries = append(entries, cborMapEntry{"a", r.Amount.Value})
+	}
+	if r.Unit != nil {
+		entries = append(entries, cborMapEntry{"u", CurrencyUnitString(r.Unit)})
+	}
+	if r.SingleUse != nil {
+		entries = append(entries, cborMapEntry{"s", *r.SingleUse})
+	}
+	if len(r.Mints) > 0 {
+		entries = append(entries, cborMapEntry{"m", r.Mints})
+	}
+	if r.Description != nil {
+		entries = append(entries, cborMapEntry{"d", *r.Description})
+	}
+	if len(r.Transports) > 0 {
+		transports := make([]any, 0, len(r.Transports))
+		for _, transport := range r.Transports {
+			entry := []cborMapEntry{{"t", transport.Type}, {"a", transport.Target}}
+			if len(transport.Tags) > 0 {
+				entry = append(entry, cborMapEntry{"g", cborTags(transport.Tags)})
+			}
+			transports = append(transports, entry)
+		}
+		entries = append(entries, cborMapEntry{"t", transports})
+	}
+	if r.Nut10 != nil {
+		nut10 := []cborMapEntry{{"k", r.Nut10.Kind}, {"d", r.Nut10.Data}}
+		if len(r.Nut10.Tags) > 0 {
+			nut10 = append(nut10, cborMapEntry{"t", cborTags(r.Nut10.Tags)})
+		}
+		entries = append(entries, cborMapEntry{"nut10", nut10})
+	}
+
+	raw, err := cborEncode(entries)
+	if err != nil {
+		return "", err
+	}
+	return paymentRequestPrefix + base64.URLEncoding.EncodeToString(raw), nil
+}
+
+func (r PaymentRequest) String() string {
+	encoded, err := r.Encode()
+	if err != nil {
+		return ""
+	}
+	return encoded
+}
+
+func cborTags(tags [][]string) []any {
+	out := make([]any, 0, len(tags))
+	for _, tag := range tags {
+		out = append(out, tag)
+	}
+	return out
+}
+
+// DecodePaymentRequest parses a "creqA" payment request.
+func DecodePaymentRequest(encoded string) (PaymentRequest, error) {
+	var request PaymentRequest
+	encoded = strings.TrimSpace(encoded)
+	if !strings.HasPrefix(encoded, paymentRequestPrefix) {
+		return request, errors.New("payment request: missing creqA prefix")
+	}
+	payload := strings.TrimRight(encoded[len(paymentRequestPrefix):], "=")
+	raw, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		if raw, err = base64.RawStdEncoding.DecodeString(payload); err != nil {
+			return request, fmt.Errorf("payment request: %w", err)
+		}
+	}
+	value, err := cborDecode(raw)
+	if err != nil {
+		return request, fmt.Errorf("payment request: %w", err)
+	}
+	fields, ok := value.(map[string]any)
+	if !ok {
+		return request, errors.New("payment request: not a map")
+	}
+
+	var errs []error
+	str := func(v any, field string) string {
+		s, ok := v.(string)
+		if !ok {
+			errs = append(errs, fmt.Errorf("payment request: %s is not text", field))
+		}
+		return s
+	}
+	for key, v := range fields {
+		switch key {
+		case "i":
+			id := str(v, "id")
+			request.Id = &id
+		case "a":
+			amount, ok := v.(uint64)
+			if !ok {
+				errs = append(errs, errors.New("payment request: amount is not an unsigned integer"))
+			}
+			request.Amount = &Amount{Value: amount}
+		case "u":
+			request.Unit = ParseCurrencyUnit(str(v, "unit"))
+		case "s":
+			single, ok := v.(bool)
+			if !ok {
+				errs = append(errs, errors.New("payment request: single use is not a boolean"))
+			}
+			request.SingleUse = &single
+		case "m":
+			items, _ := v.([]any)
+			for _, item := range items {
+				request.Mints = append(request.Mints, str(item, "mint"))
+			}
+		case "d":
+			description := str(v, "description")
+			request.Description = &description
+		case "t":
+			items, _ := v.([]any)
+			for _, item := range items {
+				entry, ok := item.(map[string]any)
+				if !ok {
+					errs = append(errs, errors.New("payment request: transport is not a map"))
+					continue
+				}
+				transport := PaymentRequestTransport{Type: str(entry["t"], "transport type"), Target: str(entry["a"], "transport target")}
+				if tags, ok := entry["g"]; ok {
+					transport.Tags = decodeCborTags(tags, &errs)
+				}
+				request.Transports = append(request.Transports, transport)
+			}
+		case "nut10":
+			entry, ok := v.(map[string]any)
+			if !ok {
+				errs = append(errs, errors.New("payment request: nut10 is not a map"))
+				continue
+			}
+			nut10 := &Nut10SecretRequest{Kind: str(entry["k"], "nut10 kind"), Data: str(entry["d"], "nut10 data")}
+			if tags, ok := entry["t"]; ok {
+				nut10.Tags = decodeCborTags(tags, &errs)
+			}
+			request.Nut10 = nut10
+		}
+	}
+	return request, errors.Join(errs...)
+}
+
+func decodeCborTags(value any, errs *[]error) [][]string {
+	items, _ := value.([]any)
+	tags := make([][]string, 0, len(items))
+	for _, item := range items {
+		parts, _ := item.([]any)
+		tag := make([]string, 0, len(parts))
+		for _, part := range parts {
+			s, ok := part.(string)
+			if !ok {
+				*errs = append(*errs, errors.New("payment request: tag is not text"))
+			}
+			tag = append(tag, s)
+		}
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+// ParseCurrencyUnit is the inverse of CurrencyUnitString.
+func ParseCurrencyUnit(unit string) CurrencyUnit {
+	switch strings.ToLower(unit) {
+	case "sat":
+		return CurrencyUnitSat{}
+	case "msat":
+		return CurrencyUnitMsat{}
+	case "usd":
+		return CurrencyUnitUsd{}
+	case "eur":
+		return CurrencyUnitEur{}
+	case "auth":
+		return CurrencyUnitAuth{}
+	default:
+		return CurrencyUnitCustom{Unit: unit}
+	}
+}
+
+// SpendingConditions converts the NUT-10 request into the conditions to
+// lock the sent proofs with.
+func (n Nut10SecretRequest) SpendingConditions() (SpendingConditions, error) {
+	var conditions *Conditions
+	for _, tag := range n.Tags {
+		if len(tag) < 2 {
+			continue
+		}
+		if conditions == nil {
+			conditions = &Conditions{}
+		}
+		var err error
+		switch tag[0] {
+		case "locktime":
+			var locktime uint64
+			locktime, err = strconv.ParseUint(tag[1], 10, 64)
+			conditions.Locktime = &locktime
+		case "pubkeys":
+			conditions.Pubkeys = append(conditions.Pubkeys, tag[1:]...)
+		case "refund":
+			conditions.RefundKeys = append(conditions.RefundKeys, tag[1:]...)
+		case "n_sigs":
+			var n uint64
+			n, err = strconv.ParseUint(tag[1], 10, 64)
+			conditions.NumSigs = &n
+		case "n_sigs_refund":
+			var n uint64
+			n, err = strconv.ParseUint(tag[1], 10, 64)
+			conditions.NumSigsRefund = &n
+		case "sigflag":
+			if tag[1] == "SIG_ALL" {
+				conditions.SigFlag = 1
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("nut10 tag %s: %w", tag[0], err)
+		}
+	}
+	switch strings.ToUpper(n.Kind) {
+	case "P2PK":
+		return SpendingConditionsP2pk{Pubkey: n.Data, Conditions: conditions}, nil
+	case "HTLC":
+		return SpendingConditionsHtlc{Hash: n.Data, Conditions: conditions}, nil
+	default:
+		return nil, fmt.Errorf("unsupported nut10 kind %q", n.Kind)
+	}
+}
+
+// PaymentTransport delivers payment request payloads of one transport type.
+type PaymentTransport interface {
+	// The PaymentRequestTransport.Type this transport delivers to
+	Type() string
+	// Deliver the payload to target
+	Deliver(ctx context.Context, target PaymentRequestTransport, payload PaymentRequestPayload) error
+}
+
+// HttpPostTransport delivers payloads as a JSON POST to the target URL.
+type HttpPostTransport struct {
+	// http.DefaultClient if nil
+	Client *http.Client
+}
+
+func (HttpPostTransport) Type() string { return TransportTypePost }
+
+func (t HttpPostTransport) Deliver(ctx context.Context, target PaymentRequestTransport, payload PaymentRequestPayload) error {
+	client := t.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.Target, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("post payment to %s: %s %s", target.Target, resp.Status, strings.TrimSpace(string(detail)))
+	}
+	return nil
+}
+
+// loopbackInboxSize is the number of payloads a LoopbackTransport queues
+// for a receiver.
+const loopbackInboxSize = 16
+
+// ErrNoLoopbackReceiver is returned when delivering to a loopback target
+// nobody called Receive for.
+var ErrNoLoopbackReceiver = errors.New("no receiver for loopback target")
+
+// LoopbackTransport delivers payloads within the process, to whoever reads
+// Receive of the target. It is meant for tests.
+type LoopbackTransport struct {
+	mu    sync.Mutex
+	inbox map[string]chan PaymentRequestPayload
+}
+
+// NewLoopbackTransport returns a loopback transport without receivers.
+func NewLoopbackTransport() *LoopbackTransport {
+	return &LoopbackTransport{inbox: map[string]chan PaymentRequestPayload{}}
+}
+
+func (*LoopbackTransport) Type() string { return TransportTypeLoopback }
+
+// Target returns the request transport that delivers to name.
+func (t *LoopbackTransport) Target(name string) PaymentRequestTransport {
+	return PaymentRequestTransport{Type: TransportTypeLoopback, Target: name}
+}
+
+// Receive returns the channel the payloads for name arrive on, creating
+// the inbox of name on the first call.
+func (t *LoopbackTransport) Receive(name string) <-chan PaymentRequestPayload {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ch, ok := t.inbox[name]
+	if !ok {
+		ch = make(chan PaymentRequestPayload, loopbackInboxSize)
+		t.inbox[name] = ch
+	}
+	return ch
+}
+
+// Deliver queues the payload, blocking while the inbox of the target is
+// full. Targets without a receiver get ErrNoLoopbackReceiver.
+func (t *LoopbackTransport) Deliver(ctx context.Context, target PaymentRequestTransport, payload PaymentRequestPayload) error {
+	t.mu.Lock()
+	ch, ok := t.inbox[target.Target]
+	t.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNoLoopbackReceiver, target.Target)
+	}
+	select {
+	case ch <- payload:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// CreatePaymentRequest returns a request for amount, nil to let the payer
+// choose, payable to mints (the wallet's mint if empty) over transports.
+// The request gets a random id; the other fields can be set on the result
+// before encoding it.
+func (_self *Wallet) CreatePaymentRequest(amount *Amount, unit CurrencyUnit, mints []string, transports []PaymentRequestTransport) (PaymentRequest, error) {
+	var id [8]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return PaymentRequest{}, err
+	}
+	idHex := hex.EncodeToString(id[:])
+	if unit == nil {
+		unit = _self.Unit()
+	}
+	if len(mints) == 0 {
+		mints = []string{_self.MintUrl().Url}
+	}
+	return PaymentRequest{Id: &idHex, Amount: amount, Unit: unit, Mints: mints, Transports: transports}, nil
+}
+
+// PayRequestOptions configures PayPaymentRequest.
+type PayRequestOptions struct {
+	// Amount to pay if the request leaves it open
+	Amount *Amount
+	Memo   *string
+	// Transports to deliver with, the first matching a transport of the
+	// request is used. HttpPostTransport if empty.
+	Transports []PaymentTransport
+}
+
+// ErrNoPaymentTransport is returned when none of the transports of a
+// payment request is supported.
+var ErrNoPaymentTransport = errors.New("no supported transport in payment request")
+
+// PayPaymentRequest sends the requested amount with PrepareSend, locked to
+// the requested conditions, and delivers it over the first transport of
+// the request that options supports. Requests without transports are paid
+// in-band: the payload is only returned.
+//
+// The payload is also returned when delivery fails, as the proofs are
+// spent from the wallet by then; deliver it again or reclaim its proofs.
+func (_self *Wallet) PayPaymentRequest(ctx context.Context, request PaymentRequest, options PayRequestOptions) (PaymentRequestPayload, error) {
+	var payload PaymentRequestPayload
+	mintUrl := _self.MintUrl().Url
+	if len(request.Mints) > 0 && !slices.ContainsFunc(request.Mints, func(mint string) bool {
+		return strings.TrimSuffix(mint, "/") == strings.TrimSuffix(mintUrl, "/")
+	}) {
+		return payload, fmt.Errorf("payment request does not accept mint %s", mintUrl)
+	}
+	unit := CurrencyUnitString(_self.Unit())
+	if request.Unit != nil && CurrencyUnitString(request.Unit) != unit {
+		return payload, fmt.Errorf("payment request is in %s, wallet in %s", CurrencyUnitString(request.Unit), unit)
+	}
+	amount := request.Amount
+	if amount == nil {
+		amount = options.Amount
+	}
+	if amount == nil || amount.Value == 0 {
+		return payload, errors.New("payment request has no amount")
+	}
+
+	transports := options.Transports
+	if len(transports) == 0 {
+		transports = []PaymentTransport{HttpPostTransport{}}
+	}
+	var transport PaymentTransport
+	var target PaymentRequestTransport
+	for _, candidate := range request.Transports {
+		i := slices.IndexFunc(transports, func(t PaymentTransport) bool { return t.Type() == candidate.Type })
+		if i >= 0 {
+			transport, target = transports[i], candidate
+			break
+		}
+	}
+	if len(request.Transports) > 0 && transport == nil {
+		return payload, ErrNoPaymentTransport
+	}
+
+	sendOptions := SendOptions{SendKind: SendKindOnlineExact{}, AmountSplitTarget: SplitTargetNone{}, IncludeFee: true}
+	if request.Nut10 != nil {
+		conditions, err := request.Nut10.SpendingConditions()
+		if err != nil {
+			return payload, err
+		}
+		sendOptions.Conditions = &conditions
+	}
+	prepared, err := _self.PrepareSendCtx(ctx, *amount, sendOptions)
+	if err != nil {
+		return payload, err
+	}
+	defer prepared.Destroy()
+	token, err := prepared.ConfirmCtx(ctx, options.Memo)
+	if err != nil {
+		if cancelErr := prepared.CancelCtx(ctx); cancelErr != nil {
+			return payload, fmt.Errorf("%w (cancel failed: %w)", err, cancelErr)
+		}
+		return payload, err
+	}
+	defer token.Destroy()
+	proofs, err := token.ProofsSimple()
+	if err != nil {
+		return payload, err
+	}
+	payload = PaymentRequestPayload{Id: request.Id, Memo: options.Memo, Mint: mintUrl, Unit: unit, Proofs: rawProofs(proofs)}
+
+	if transport != nil {
+		if err := transport.Deliver(ctx, target, payload); err != nil {
+			return payload, fmt.Errorf("deliver payment: %w", err)
+		}
+	}
+	return payload, nil
+}
+
+// ReceivePaymentPayload receives the proofs of a payload paid to this
+// wallet's mint and unit.
+func (_self *Wallet) ReceivePaymentPayload(ctx context.Context, payload PaymentRequestPayload, options ReceiveOptions) (Amount, error) {
+	mintUrl := _self.MintUrl()
+	if strings.TrimSuffix(payload.Mint, "/") != strings.TrimSuffix(mintUrl.Url, "/") {
+		return Amount{}, fmt.Errorf("payment is from mint %s, wallet uses %s", payload.Mint, mintUrl.Url)
+	}
+	if payload.Unit != "" && payload.Unit != CurrencyUnitString(_self.Unit()) {
+		return Amount{}, fmt.Errorf("payment is in %s", payload.Unit)
+	}
+	token, err := encodeTokenV3(mintUrl, _self.Unit(), payload.Proofs, payload.Memo)
+	if err != nil {
+		return Amount{}, err
+	}
+	if options.AmountSplitTarget == nil {
+		options.AmountSplitTarget = SplitTargetNone{}
+	}
+	return _self.ReceiveCtx(ctx, token, options)
+}
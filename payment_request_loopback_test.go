@@ -0,0 +1,63 @@
+package cdk_ffi_test
+
+import (
+	"errors"
+	"testing"
+
+	cdk "github.com/lescuer97/cdkgo"
+	"github.com/lescuer97/cdkgo/mockmint"
+)
+
+func TestPayPaymentRequestLoopback(t *testing.T) {
+	ctx := testContext(t)
+	mint := mockmint.New(mockmint.Options{})
+	defer mint.Close()
+	payer := newTestWallet(t, mint, cdk.NewMemoryWalletDatabase())
+	receiver := newTestWallet(t, mint, cdk.NewMemoryWalletDatabase())
+	fund(t, ctx, mint, payer, 100)
+
+	loopback := cdk.NewLoopbackTransport()
+	inbox := loopback.Receive("receiver")
+	request, err := receiver.CreatePaymentRequest(&cdk.Amount{Value: 30}, nil, nil, []cdk.PaymentRequestTransport{loopback.Target("receiver")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded, err := request.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := cdk.DecodePaymentRequest(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	memo := "invoice 7"
+	if _, err := payer.PayPaymentRequest(ctx, decoded, cdk.PayRequestOptions{Memo: &memo, Transports: []cdk.PaymentTransport{loopback}}); err != nil {
+		t.Fatal(err)
+	}
+	var payload cdk.PaymentRequestPayload
+	select {
+	case payload = <-inbox:
+	case <-ctx.Done():
+		t.Fatal("payment not delivered")
+	}
+	if payload.Id == nil || *payload.Id != *request.Id || payload.Memo == nil || *payload.Memo != memo {
+		t.Fatalf("payload %+v", payload)
+	}
+	received, err := receiver.ReceivePaymentPayload(ctx, payload, cdk.ReceiveOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if received.Value != 30 || balance(t, ctx, receiver) != 30 {
+		t.Fatalf("received %d", received.Value)
+	}
+	if got := balance(t, ctx, payer); got != 70 {
+		t.Fatalf("payer balance %d, want 70", got)
+	}
+
+	// Only targets somebody receives for get payloads.
+	request.Transports = []cdk.PaymentRequestTransport{loopback.Target("nobody")}
+	if _, err := payer.PayPaymentRequest(ctx, request, cdk.PayRequestOptions{Transports: []cdk.PaymentTransport{loopback}}); !errors.Is(err, cdk.ErrNoLoopbackReceiver) {
+		t.Fatalf("got %v, want ErrNoLoopbackReceiver", err)
+	}
+}
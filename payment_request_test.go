@@ -0,0 +1,173 @@
+package cdk_ffi
+
+import (
+	"encoding/base64"
+	"reflect"
+	"testing"
+)
+
+// Example of NUT-18.
+const specPaymentRequest = "creqApWF0gaNhdGVub3N0cmFheKlucHJvZmlsZTFxeTI4d3VtbjhnaGo3dW45ZDNzaGp0bnl2OWtoMnVld2Q5aHN6OW1od2RlbjV0ZTB3ZmprY2N0ZTljdXJ4dmVuOWVlaHFjdHJ2NWhzenJ0aHdkZW41dGUwZGVoaHh0bnZkYWtxcWd5ZGFxeTdjdXJrNDM5eWtwdGt5c3Y3dWRoZGh1NjhzdWNtMjk1YWtxZWZkZWhrZjBkNDk1Y3d1bmw1YWeBgmFuYjE3YWloYjdhOTAxNzZhYQphdWNzYXRhbYF4Imh0dHBzOi8vbm9mZWVzLnRlc3RudXQuY2FzaHUuc3BhY2U="
+
+func TestDecodePaymentRequestSpec(t *testing.T) {
+	request, err := DecodePaymentRequest(specPaymentRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if request.Id == nil || *request.Id != "b7a90176" {
+		t.Errorf("id %v", request.Id)
+	}
+	if request.Amount == nil || request.Amount.Value != 10 {
+		t.Errorf("amount %v", request.Amount)
+	}
+	if _, ok := request.Unit.(CurrencyUnitSat); !ok {
+		t.Errorf("unit %v", request.Unit)
+	}
+	if !reflect.DeepEqual(request.Mints, []string{"https://nofees.testnut.cashu.space"}) {
+		t.Errorf("mints %v", request.Mints)
+	}
+	want := []PaymentRequestTransport{{
+		Type:   TransportTypeNostr,
+		Target: "nprofile1qy28wumn8ghj7un9d3shjtnyv9kh2uewd9hsz9mhwden5te0wfjkccte9curxven9eehqctrv5hszrthwden5te0dehhxtnvdakqqgydaqy7curk439ykptkysv7udhdhu68sucm295akqefdehkf0d495cwunl5",
+		Tags:   [][]string{{"n", "17"}},
+	}}
+	if !reflect.DeepEqual(request.Transports, want) {
+		t.Errorf("transports %+v", request.Transports)
+	}
+	if request.SingleUse != nil || request.Description != nil || request.Nut10 != nil {
+		t.Errorf("unexpected fields in %+v", request)
+	}
+}
+
+func TestPaymentRequestRoundTrip(t *testing.T) {
+	id, description, single := "r1", "coffee", true
+	request := PaymentRequest{
+		Id:          &id,
+		Amount:      &Amount{Value: 2100},
+		Unit:        CurrencyUnitSat{},
+		SingleUse:   &single,
+		Mints:       []string{"https://a.example", "https://b.example"},
+		Description: &description,
+		Transports: []PaymentRequestTransport{
+			{Type: TransportTypePost, Target: "https://shop.example/pay"},
+			{Type: TransportTypeNostr, Target: "nprofile1abc", Tags: [][]string{{"n", "17"}, {"relay", "wss://r.example"}}},
+		},
+		Nut10: &Nut10SecretRequest{Kind: "P2PK", Data: "02aa", Tags: [][]string{{"locktime", "1700000000"}, {"refund", "03bb"}}},
+	}
+	encoded, err := request.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := DecodePaymentRequest(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, request) {
+		t.Fatalf("decoded %+v\nwant    %+v", decoded, request)
+	}
+
+	// Optional fields are left out.
+	encoded, err = PaymentRequest{}.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded, err := DecodePaymentRequest(encoded); err != nil || !reflect.DeepEqual(decoded, PaymentRequest{}) {
+		t.Fatalf("empty request decoded to %+v (%v)", decoded, err)
+	}
+}
+
+func TestDecodePaymentRequestMalformed(t *testing.T) {
+	encode := func(value any) string {
+		raw, err := cborEncode(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return paymentRequestPrefix + base64.URLEncoding.EncodeToString(raw)
+	}
+	valid := encode([]cborMapEntry{{"a", uint64(1)}})
+	nested := []byte{0xa1, 0x61, 't'}
+	for range 40 {
+		nested = append(nested, 0x81)
+	}
+	nested = append(nested, 0x00)
+
+	tests := map[string]string{
+		"no prefix":           "creqB" + valid[5:],
+		"invalid base64":      "creqA!!!",
+		"truncated":           valid[:len(valid)-4],
+		"not a map":           encode([]any{"a"}),
+		"text amount":         encode([]cborMapEntry{{"a", "ten"}}),
+		"negative amount":     encode([]cborMapEntry{{"a", -1}}),
+		"integer unit":        encode([]cborMapEntry{{"u", uint64(1)}}),
+		"text single use":     encode([]cborMapEntry{{"s", "yes"}}),
+		"integer mint":        encode([]cborMapEntry{{"m", []any{uint64(1)}}}),
+		"transport not a map": encode([]cborMapEntry{{"t", []any{"post"}}}),
+		"integer tag":         encode([]cborMapEntry{{"t", []any{[]cborMapEntry{{"t", "post"}, {"a", "x"}, {"g", []any{[]any{uint64(1)}}}}}}}),
+		"nut10 not a map":     encode([]cborMapEntry{{"nut10", "P2PK"}}),
+		"too deeply nested":   paymentRequestPrefix + base64.URLEncoding.EncodeToString(nested),
+	}
+	for name, encoded := range tests {
+		if _, err := DecodePaymentRequest(encoded); err == nil {
+			t.Errorf("%s: decoded %s", name, encoded)
+		}
+	}
+	if _, err := DecodePaymentRequest(valid); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNut10SpendingConditions(t *testing.T) {
+	locktime, sigs, refundSigs := uint64(1700000000), uint64(2), uint64(1)
+	tests := []struct {
+		request Nut10SecretRequest
+		want    SpendingConditions
+	}{
+		{
+			Nut10SecretRequest{Kind: "P2PK", Data: "02aa"},
+			SpendingConditionsP2pk{Pubkey: "02aa"},
+		},
+		{
+			Nut10SecretRequest{Kind: "p2pk", Data: "02aa", Tags: [][]string{
+				{"locktime", "1700000000"},
+				{"pubkeys", "02bb", "02cc"},
+				{"refund", "03dd"},
+				{"n_sigs", "2"},
+				{"n_sigs_refund", "1"},
+				{"sigflag", "SIG_ALL"},
+				{"ignored"},
+			}},
+			SpendingConditionsP2pk{Pubkey: "02aa", Conditions: &Conditions{
+				Locktime:      &locktime,
+				Pubkeys:       []string{"02bb", "02cc"},
+				RefundKeys:    []string{"03dd"},
+				NumSigs:       &sigs,
+				NumSigsRefund: &refundSigs,
+				SigFlag:       1,
+			}},
+		},
+		{
+			Nut10SecretRequest{Kind: "HTLC", Data: "ab12", Tags: [][]string{{"sigflag", "SIG_INPUTS"}}},
+			SpendingConditionsHtlc{Hash: "ab12", Conditions: &Conditions{}},
+		},
+	}
+	for _, test := range tests {
+		got, err := test.request.SpendingConditions()
+		if err != nil {
+			t.Errorf("%+v: %v", test.request, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%+v: got %+v", test.request, got)
+		}
+	}
+
+	for _, request := range []Nut10SecretRequest{
+		{Kind: "DLC", Data: "00"},
+		{Kind: "P2PK", Data: "02aa", Tags: [][]string{{"locktime", "soon"}}},
+		{Kind: "P2PK", Data: "02aa", Tags: [][]string{{"n_sigs", "-1"}}},
+	} {
+		if _, err := request.SpendingConditions(); err == nil {
+			t.Errorf("%+v: no error", request)
+		}
+	}
+}
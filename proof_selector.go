@@ -0,0 +1,414 @@
+package cdk_ffi
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/bits"
+	"math/rand/v2"
+	"sort"
+)
+
+var (
+	// ErrSelectionInsufficient is returned when the unspent proofs of a
+	// wallet cannot cover a send and its fee.
+	ErrSelectionInsufficient = errors.New("not enough unspent proofs to cover amount and fee")
+	// ErrSelectionNotOffline is returned when an offline SendKind is asked
+	// for but the selected proofs cannot be sent without a swap: they don't
+	// add up to the amount within the tolerance, or there are more of them
+	// than SendOptions.MaxProofs. It is also returned for an offline send
+	// with another selector than SelectLargestFirst.
+	ErrSelectionNotOffline = errors.New("selected proofs cannot be sent offline")
+	// ErrSelectionChanged is returned by SelectedSend.Confirm for an offline
+	// send when the wallet would send other proofs than the selected ones.
+	// Nothing is sent or reserved.
+	ErrSelectionChanged = errors.New("wallet selected other proofs than the selection")
+)
+
+// ProofCandidate is an unspent proof offered to a ProofSelector.
+type ProofCandidate struct {
+	Proof  *Proof
+	Amount uint64
+	// Keyset the proof was signed with, zero if the keyset is not known
+	Keyset KeySetInfo
+}
+
+// ProofSelector picks the proofs to spend for a send. target is the amount
+// the selected proofs must cover before input fees, and fee returns the
+// input fee of a selection.
+type ProofSelector interface {
+	SelectProofs(candidates []ProofCandidate, target uint64, fee func([]ProofCandidate) uint64) ([]ProofCandidate, error)
+}
+
+// ProofSelectorFunc adapts a function to a ProofSelector.
+type ProofSelectorFunc func(candidates []ProofCandidate, target uint64, fee func([]ProofCandidate) uint64) ([]ProofCandidate, error)
+
+func (f ProofSelectorFunc) SelectProofs(candidates []ProofCandidate, target uint64, fee func([]ProofCandidate) uint64) ([]ProofCandidate, error) {
+	return f(candidates, target, fee)
+}
+
+// orderedSelector takes candidates in the order given by sortFn until they
+// cover target and their own input fee. It is a pointer so selectors can be
+// compared.
+type orderedSelector struct {
+	sortFn func([]ProofCandidate)
+}
+
+func (s *orderedSelector) SelectProofs(candidates []ProofCandidate, target uint64, fee func([]ProofCandidate) uint64) ([]ProofCandidate, error) {
+	ordered := append([]ProofCandidate{}, candidates...)
+	s.sortFn(ordered)
+	var (
+		selected []ProofCandidate
+		total    uint64
+	)
+	for _, candidate := range ordered {
+		selected = append(selected, candidate)
+		total += candidate.Amount
+		if total >= target+fee(selected) {
+			return selected, nil
+		}
+	}
+	return nil, ErrSelectionInsufficient
+}
+
+var (
+	// SelectLargestFirst spends as few proofs as possible.
+	SelectLargestFirst ProofSelector = &orderedSelector{func(c []ProofCandidate) {
+		sort.SliceStable(c, func(i, j int) bool { return c[i].Amount > c[j].Amount })
+	}}
+	// SelectSmallestFirst spends small proofs first, consolidating a
+	// fragmented wallet as a side effect.
+	SelectSmallestFirst ProofSelector = &orderedSelector{func(c []ProofCandidate) {
+		sort.SliceStable(c, func(i, j int) bool { return c[i].Amount < c[j].Amount })
+	}}
+	// SelectRandom spends proofs in random order, so the amounts spent do
+	// not reveal anything about the rest of the wallet.
+	SelectRandom ProofSelector = &orderedSelector{func(c []ProofCandidate) {
+		rand.Shuffle(len(c), func(i, j int) { c[i], c[j] = c[j], c[i] })
+	}}
+	// SelectMinimizeFees spends proofs of the cheapest keysets first and,
+	// within a keyset, the largest proofs, so the fewest paid inputs are used.
+	SelectMinimizeFees ProofSelector = &orderedSelector{func(c []ProofCandidate) {
+		sort.SliceStable(c, func(i, j int) bool {
+			if c[i].Keyset.InputFeePpk != c[j].Keyset.InputFeePpk {
+				return c[i].Keyset.InputFeePpk < c[j].Keyset.InputFeePpk
+			}
+			return c[i].Amount > c[j].Amount
+		})
+	}}
+	// SelectInactiveFirst spends proofs of inactive keysets before active
+	// ones, largest first, moving funds off keysets the mint is retiring.
+	SelectInactiveFirst ProofSelector = &orderedSelector{func(c []ProofCandidate) {
+		sort.SliceStable(c, func(i, j int) bool {
+			if c[i].Keyset.Active != c[j].Keyset.Active {
+				return !c[i].Keyset.Active
+			}
+			return c[i].Amount > c[j].Amount
+		})
+	}}
+)
+
+// inputFee returns the input fee of spending candidates, as defined in
+// NUT-02.
+func inputFee(candidates []ProofCandidate) uint64 {
+	var ppk uint64
+	for _, candidate := range candidates {
+		ppk += candidate.Keyset.InputFeePpk
+	}
+	return (ppk + 999) / 1000
+}
+
+// SelectedSend is a send planned by SelectSend. Nothing is reserved until
+// Confirm is called, so a SelectedSend doubles as a dry run.
+type SelectedSend struct {
+	wallet    *Wallet
+	options   SendOptions
+	redeemFee uint64
+	// Amount to send
+	Amount Amount
+	// Proofs that would be spent
+	Proofs []*Proof
+	// Total of Proofs
+	Total Amount
+	// Input fee of spending Proofs, plus the fee of redeeming the sent proofs
+	// if SendOptions.IncludeFee is set. Offline sends spend Proofs as they
+	// are, so only the redeem fee applies.
+	Fee Amount
+}
+
+// SelectSend plans sending amount from the unspent proofs of the wallet
+// chosen by selector, e.g. SelectLargestFirst. The selection is not
+// reserved: call Confirm on the result to send, or discard it after
+// inspecting which proofs and fee would be used.
+//
+// With an online SendKind the selected proofs are swapped for the send.
+// With an offline one they are sent as they are, so they must add up to
+// amount (plus its redeem fee with IncludeFee) within the tolerance of the
+// SendKind and number no more than options.MaxProofs; ErrSelectionNotOffline
+// is returned otherwise. The wallet picks the proofs of an offline send
+// itself, largest first, so offline sends only take SelectLargestFirst and
+// return ErrSelectionNotOffline for any other selector. Keyset fees come
+// from the wallet's cache.
+func (_self *Wallet) SelectSend(ctx context.Context, amount Amount, options SendOptions, selector ProofSelector) (*SelectedSend, error) {
+	offline, tolerance := sendKindOffline(options.SendKind)
+	if offline && selector != SelectLargestFirst {
+		return nil, fmt.Errorf("%w: offline sends select the largest proofs first", ErrSelectionNotOffline)
+	}
+	candidates, activePpk, err := _self.proofCandidates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	target := amount.Value
+	var redeemFee uint64
+	if options.IncludeFee && !offline {
+		redeemFee = (uint64(bits.OnesCount64(amount.Value))*activePpk + 999) / 1000
+		target += redeemFee
+	}
+	fee := inputFee
+	if offline && !options.IncludeFee {
+		// The recipient pays the input fee of offline proofs.
+		fee = func([]ProofCandidate) uint64 { return 0 }
+	}
+	selected, err := selector.SelectProofs(candidates, target, fee)
+	if err != nil {
+		return nil, err
+	}
+
+	send := &SelectedSend{wallet: _self, options: options, redeemFee: redeemFee, Amount: amount}
+	for _, candidate := range selected {
+		send.Proofs = append(send.Proofs, candidate.Proof)
+		send.Total.Value += candidate.Amount
+	}
+	send.Fee.Value = fee(selected) + redeemFee
+	if send.Total.Value < amount.Value+send.Fee.Value {
+		return nil, ErrSelectionInsufficient
+	}
+	if offline {
+		if send.Total.Value > amount.Value+send.Fee.Value+tolerance {
+			return nil, fmt.Errorf("%w: selected %d for %d", ErrSelectionNotOffline, send.Total.Value, amount.Value+send.Fee.Value)
+		}
+		if options.MaxProofs != nil && len(selected) > int(*options.MaxProofs) {
+			return nil, fmt.Errorf("%w: selected %d proofs, at most %d allowed", ErrSelectionNotOffline, len(selected), *options.MaxProofs)
+		}
+	}
+	return send, nil
+}
+
+// sendKindOffline reports whether kind sends proofs without a swap, and
+// the amount the sent proofs may exceed the send by.
+func sendKindOffline(kind SendKind) (bool, uint64) {
+	switch kind := kind.(type) {
+	case SendKindOfflineExact:
+		return true, 0
+	case SendKindOfflineTolerance:
+		return true, kind.Tolerance.Value
+	default:
+		return false, 0
+	}
+}
+
+// proofCandidates returns the unspent proofs of the wallet with their
+// keysets, and the input fee of the active keyset. Keyset fees come from the
+// wallet's cache, so no keysets are refreshed.
+func (_self *Wallet) proofCandidates(ctx context.Context) ([]ProofCandidate, uint64, error) {
+	active, err := _self.GetActiveKeysetCtx(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	proofs, err := _self.GetProofsByStatesCtx(ctx, []ProofState{ProofStateUnspent})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	keysets := map[string]KeySetInfo{active.Id: active}
+	candidates := make([]ProofCandidate, 0, len(proofs))
+	for _, proof := range proofs {
+		id := proof.KeysetId()
+		keyset, ok := keysets[id]
+		if !ok {
+			fee, err := _self.GetKeysetFeesByIdCtx(ctx, id)
+			if err != nil {
+				return nil, 0, err
+			}
+			keyset = KeySetInfo{Id: id, Unit: active.Unit, InputFeePpk: fee}
+			keysets[id] = keyset
+		}
+		candidates = append(candidates, ProofCandidate{Proof: proof, Amount: proof.Amount().Value, Keyset: keyset})
+	}
+	return candidates, active.InputFeePpk, nil
+}
+
+// Confirm sends the selection through PrepareSend and PreparedSend.Confirm,
+// so the wallet reserves the sent proofs and records the transaction.
+//
+// For an online SendKind the selected proofs are first swapped, keeping
+// the result in the wallet, into proofs that make up Amount exactly, which
+// PrepareSend then sends without another swap. The sent proofs are those or
+// equal ones the wallet already held. For an offline SendKind the wallet
+// picks the proofs again; if they differ from the selection, e.g. because
+// the wallet changed since SelectSend, the send is cancelled and
+// ErrSelectionChanged is returned.
+func (s *SelectedSend) Confirm(ctx context.Context) (*Token, error) {
+	offline, _ := sendKindOffline(s.options.SendKind)
+	if !offline {
+		if err := s.isolate(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	prepared, err := s.wallet.PrepareSendCtx(ctx, s.Amount, s.options)
+	if err != nil {
+		return nil, err
+	}
+	defer prepared.Destroy()
+	if offline && !sameProofs(prepared.Proofs(), s.Proofs) {
+		if err := prepared.CancelCtx(ctx); err != nil {
+			return nil, fmt.Errorf("%w (cancel failed: %w)", ErrSelectionChanged, err)
+		}
+		return nil, ErrSelectionChanged
+	}
+
+	var memo *string
+	if s.options.Memo != nil && s.options.Memo.IncludeMemo {
+		memo = &s.options.Memo.Memo
+	}
+	token, err := prepared.ConfirmCtx(ctx, memo)
+	if err != nil {
+		if cancelErr := prepared.CancelCtx(ctx); cancelErr != nil {
+			return nil, fmt.Errorf("%w (cancel failed: %w)", err, cancelErr)
+		}
+		return nil, err
+	}
+	return token, nil
+}
+
+// isolate swaps the selected proofs for proofs of the amount to send, plus
+// its redeem fee, and change, all kept in the wallet as unspent.
+func (s *SelectedSend) isolate(ctx context.Context) error {
+	var values []Amount
+	sendTotal := s.Amount.Value + s.redeemFee
+	for bit := range 64 {
+		if value := uint64(1) << bit; sendTotal&value != 0 {
+			values = append(values, Amount{Value: value})
+		}
+	}
+	_, err := s.wallet.SwapCtx(ctx, nil, SplitTargetValues{Amounts: values}, s.Proofs, nil, false)
+	return err
+}
+
+func sameProofs(a, b []*Proof) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	secrets := make(map[string]bool, len(a))
+	for _, proof := range a {
+		secrets[proof.Secret()] = true
+	}
+	for _, proof := range b {
+		if !secrets[proof.Secret()] {
+			return false
+		}
+	}
+	return true
+}
+
+// encodeTokenV3 builds a cashuA token out of proofs, for the cases CDK only
+// hands out proofs.
+func encodeTokenV3(mintUrl MintUrl, unit CurrencyUnit, proofs []RawProof, memo *string) (*Token, error) {
+	type entryV3 struct {
+		Mint   string     `json:"mint"`
+		Proofs []RawProof `json:"proofs"`
+	}
+	type tokenV3 struct {
+		Token []entryV3 `json:"token"`
+		Unit  string    `json:"unit,omitempty"`
+		Memo  *string   `json:"memo,omitempty"`
+	}
+
+	token := tokenV3{Token: []entryV3{{Mint: mintUrl.Url, Proofs: proofs}}, Unit: CurrencyUnitString(unit), Memo: memo}
+	raw, err := json.Marshal(token)
+	if err != nil {
+		return nil, fmt.Errorf("encode token: %w", err)
+	}
+	return TokenDecode("cashuA" + base64.URLEncoding.EncodeToString(raw))
+}
+
+// RawProof is a proof as serialized in tokens and NUT-18 payloads.
+type RawProof struct {
+	Id     string        `json:"id"`
+	Amount uint64        `json:"amount"`
+	Secret string        `json:"secret"`
+	C      string        `json:"C"`
+	Dleq   *RawProofDleq `json:"dleq,omitempty"`
+}
+
+// RawProofDleq is the NUT-12 DLEQ proof of a RawProof.
+type RawProofDleq struct {
+	E string `json:"e"`
+	S string `json:"s"`
+	R string `json:"r"`
+}
+
+// rawProofs serializes proofs. Witnesses are left out: the proofs are
+// being handed to someone who will add their own.
+func rawProofs(proofs []*Proof) []RawProof {
+	raw := make([]RawProof, 0, len(proofs))
+	for _, proof := range proofs {
+		encoded := RawProof{Id: proof.KeysetId(), Amount: proof.Amount().Value, Secret: proof.Secret(), C: proof.C()}
+		if dleq := proof.Dleq(); dleq != nil {
+			encoded.Dleq = &RawProofDleq{E: dleq.E, S: dleq.S, R: dleq.R}
+		}
+		raw = append(raw, encoded)
+	}
+	return raw
+}
+
+// DecodeRawProofs turns serialized proofs of mintUrl back into wallet
+// proofs.
+func DecodeRawProofs(mintUrl MintUrl, unit CurrencyUnit, proofs []RawProof) ([]*Proof, error) {
+	token, err := encodeTokenV3(mintUrl, unit, proofs, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer token.Destroy()
+	return token.ProofsSimple()
+}
+
+// CurrencyUnitString returns the unit as written in tokens and mint
+// requests.
+func CurrencyUnitString(unit CurrencyUnit) string {
+	switch unit := unit.(type) {
+	case CurrencyUnitSat:
+		return "sat"
+	case CurrencyUnitMsat:
+		return "msat"
+	case CurrencyUnitUsd:
+		return "usd"
+	case CurrencyUnitEur:
+		return "eur"
+	case CurrencyUnitAuth:
+		return "auth"
+	case CurrencyUnitCustom:
+		return unit.Unit
+	default:
+		return ""
+	}
+}
+
+// PaymentMethodString returns the method as written in NUT-04 and NUT-05
+// endpoints.
+func PaymentMethodString(method PaymentMethod) string {
+	switch method := method.(type) {
+	case PaymentMethodBolt11:
+		return "bolt11"
+	case PaymentMethodBolt12:
+		return "bolt12"
+	case PaymentMethodCustom:
+		return method.Method
+	default:
+		return ""
+	}
+}
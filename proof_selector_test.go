@@ -0,0 +1,122 @@
+package cdk_ffi
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestProofSelectors(t *testing.T) {
+	active := KeySetInfo{Id: "00active", Active: true}
+	cheap := KeySetInfo{Id: "00cheap", Active: true, InputFeePpk: 100}
+	expensive := KeySetInfo{Id: "00fees", Active: true, InputFeePpk: 1000}
+	inactive := KeySetInfo{Id: "00old"}
+	candidates := []ProofCandidate{
+		{Amount: 2, Keyset: active},
+		{Amount: 8, Keyset: expensive},
+		{Amount: 1, Keyset: inactive},
+		{Amount: 4, Keyset: cheap},
+		{Amount: 16, Keyset: active},
+		{Amount: 2, Keyset: inactive},
+	}
+	noFee := func([]ProofCandidate) uint64 { return 0 }
+
+	tests := []struct {
+		name     string
+		selector ProofSelector
+		target   uint64
+		fee      func([]ProofCandidate) uint64
+		want     []uint64
+		err      error
+	}{
+		{"largest first", SelectLargestFirst, 20, noFee, []uint64{16, 8}, nil},
+		{"largest first exact", SelectLargestFirst, 16, noFee, []uint64{16}, nil},
+		{"largest first with fees", SelectLargestFirst, 24, inputFee, []uint64{16, 8, 4}, nil},
+		{"smallest first", SelectSmallestFirst, 4, noFee, []uint64{1, 2, 2}, nil},
+		// Equal amounts keep their order
+		{"smallest first stable", SelectSmallestFirst, 2, noFee, []uint64{1, 2}, nil},
+		{"minimize fees", SelectMinimizeFees, 19, inputFee, []uint64{16, 2, 2}, nil},
+		// The cheap keyset comes before the expensive one
+		{"minimize fees paid", SelectMinimizeFees, 25, inputFee, []uint64{16, 2, 2, 1, 4, 8}, nil},
+		{"inactive first", SelectInactiveFirst, 4, noFee, []uint64{2, 1, 16}, nil},
+		{"insufficient", SelectLargestFirst, 34, noFee, nil, ErrSelectionInsufficient},
+		// The fee of spending every proof is not covered
+		{"insufficient with fees", SelectSmallestFirst, 33, inputFee, nil, ErrSelectionInsufficient},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			input := slices.Clone(candidates)
+			selected, err := test.selector.SelectProofs(input, test.target, test.fee)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("got error %v, want %v", err, test.err)
+			}
+			if !slices.Equal(amounts(selected), test.want) {
+				t.Fatalf("selected %v, want %v", amounts(selected), test.want)
+			}
+			if !slices.Equal(input, candidates) {
+				t.Fatal("candidates were reordered")
+			}
+		})
+	}
+}
+
+func TestSelectRandom(t *testing.T) {
+	var candidates []ProofCandidate
+	for range 32 {
+		candidates = append(candidates, ProofCandidate{Amount: 1})
+	}
+	for target := range uint64(33) {
+		selected, err := SelectRandom.SelectProofs(candidates, target, func([]ProofCandidate) uint64 { return 1 })
+		if target >= 32 {
+			if !errors.Is(err, ErrSelectionInsufficient) {
+				t.Fatalf("target %d: got %v", target, err)
+			}
+			continue
+		}
+		if err != nil || len(selected) != int(target)+1 {
+			t.Fatalf("target %d: selected %d proofs, error %v", target, len(selected), err)
+		}
+	}
+}
+
+func TestProofSelectorFunc(t *testing.T) {
+	candidates := []ProofCandidate{{Amount: 1}, {Amount: 2}, {Amount: 4}}
+	var gotTarget, gotFee uint64
+	selector := ProofSelectorFunc(func(candidates []ProofCandidate, target uint64, fee func([]ProofCandidate) uint64) ([]ProofCandidate, error) {
+		gotTarget, gotFee = target, fee(candidates)
+		return candidates[1:], nil
+	})
+	selected, err := selector.SelectProofs(candidates, 5, func(c []ProofCandidate) uint64 { return uint64(len(c)) })
+	if err != nil || !slices.Equal(amounts(selected), []uint64{2, 4}) {
+		t.Fatalf("selected %v, error %v", amounts(selected), err)
+	}
+	if gotTarget != 5 || gotFee != 3 {
+		t.Fatalf("target %d, fee %d", gotTarget, gotFee)
+	}
+}
+
+func TestSelectSendOfflineSelector(t *testing.T) {
+	custom := ProofSelectorFunc(func([]ProofCandidate, uint64, func([]ProofCandidate) uint64) ([]ProofCandidate, error) {
+		t.Fatal("selector called")
+		return nil, nil
+	})
+	kinds := []SendKind{SendKindOfflineExact{}, SendKindOfflineTolerance{Tolerance: Amount{Value: 1}}}
+	for _, kind := range kinds {
+		for _, selector := range []ProofSelector{SelectSmallestFirst, SelectRandom, SelectMinimizeFees, SelectInactiveFirst, custom} {
+			// Refused before the wallet is used
+			_, err := (*Wallet)(nil).SelectSend(context.Background(), Amount{Value: 1}, SendOptions{SendKind: kind}, selector)
+			if !errors.Is(err, ErrSelectionNotOffline) {
+				t.Fatalf("%T: got %v", kind, err)
+			}
+		}
+	}
+}
+
+func amounts(candidates []ProofCandidate) []uint64 {
+	var values []uint64
+	for _, candidate := range candidates {
+		values = append(values, candidate.Amount)
+	}
+	return values
+}
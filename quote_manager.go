@@ -0,0 +1,354 @@
+package cdk_ffi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// QuoteManagerOptions configures a QuoteManager.
+type QuoteManagerOptions struct {
+	// Time between polls of outstanding quotes, 10 seconds by default
+	PollInterval time.Duration
+	// Per-mint wallets, keyed by mint URL, created with NewWallet from the
+	// same mnemonic and database as the MultiMintWallet. They are used for
+	// NUT-17 subscriptions and to mint bolt12 quotes; mints without one are
+	// only polled and their bolt12 quotes cannot be minted.
+	Wallets map[string]*Wallet
+	// Spending conditions of the minted proofs
+	SpendingConditions *SpendingConditions
+	// Keep expired unpaid quotes in the database instead of removing them
+	KeepExpired bool
+	// Called after proofs were minted for a quote
+	OnMinted func(quote MintQuote, proofs []*Proof)
+	// Called when a quote expired unpaid
+	OnExpired func(quote MintQuote)
+	// Called when checking or minting a quote failed; it is retried on the
+	// next poll
+	OnError func(quote MintQuote, err error)
+	// Called when a poll could not read the quotes from the database
+	OnSyncError func(err error)
+	// Called when the NUT-17 subscription to a mint failed; the mint is
+	// subscribed to again on the next poll
+	OnSubscriptionError func(mintUrl MintUrl, err error)
+}
+
+// QuoteManager mints outstanding mint quotes of a MultiMintWallet as soon as
+// they are paid, instead of a WaitForMintQuote call blocking per quote.
+// Quotes are read from the database on every poll, so quotes created
+// through the wallet are picked up without registering them. NUT-17
+// notifications trigger an immediate check, polling covers mints without
+// subscriptions and missed notifications.
+//
+// Bolt12 quotes can be paid several times: whatever was paid beyond what
+// was issued is minted, and the quote stays tracked until it expires.
+type QuoteManager struct {
+	wallet *MultiMintWallet
+	db     WalletDatabase
+	opts   QuoteManagerOptions
+
+	syncMu  sync.Mutex
+	trigger chan struct{}
+
+	mu            sync.Mutex
+	subscriptions map[string]*quoteSubscription
+	cancel        context.CancelFunc
+	done          chan struct{}
+}
+
+type quoteSubscription struct {
+	mintUrl MintUrl
+	filters string
+	cancel  context.CancelFunc
+}
+
+// NewQuoteManager returns a manager for the quotes of wallet stored in db,
+// the database the wallet was created with.
+func NewQuoteManager(wallet *MultiMintWallet, db WalletDatabase, opts QuoteManagerOptions) *QuoteManager {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = 10 * time.Second
+	}
+	return &QuoteManager{
+		wallet:        wallet,
+		db:            db,
+		opts:          opts,
+		trigger:       make(chan struct{}, 1),
+		subscriptions: map[string]*quoteSubscription{},
+	}
+}
+
+// Start polls and listens for quote updates in the background until ctx
+// is done or Stop is called.
+func (m *QuoteManager) Start(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.cancel != nil {
+		return errors.New("quote manager already started")
+	}
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.done = make(chan struct{})
+	go m.loop(ctx, m.done)
+	return nil
+}
+
+// Stop stops a started manager, closing its subscriptions.
+func (m *QuoteManager) Stop() {
+	m.mu.Lock()
+	cancel, done := m.cancel, m.done
+	m.cancel, m.done = nil, nil
+	m.mu.Unlock()
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+// Trigger asks a started manager to check all quotes now, e.g. right after
+// creating one.
+func (m *QuoteManager) Trigger() {
+	select {
+	case m.trigger <- struct{}{}:
+	default:
+	}
+}
+
+func (m *QuoteManager) loop(ctx context.Context, done chan struct{}) {
+	defer close(done)
+	defer m.unsubscribeAll()
+	ticker := time.NewTicker(m.opts.PollInterval)
+	defer ticker.Stop()
+	for {
+		outstanding, err := m.Sync(ctx)
+		if err == nil {
+			m.subscribe(ctx, outstanding)
+		} else if ctx.Err() == nil && m.opts.OnSyncError != nil {
+			m.opts.OnSyncError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-m.trigger:
+		}
+	}
+}
+
+// Sync checks every outstanding quote once: paid quotes are minted and
+// expired unpaid ones removed. It returns the quotes still outstanding.
+// Errors of single quotes go to OnError; the returned error is about
+// reading the database, and goes to OnSyncError when a started manager
+// polls.
+func (m *QuoteManager) Sync(ctx context.Context) ([]MintQuote, error) {
+	m.syncMu.Lock()
+	defer m.syncMu.Unlock()
+
+	quotes, err := m.db.GetMintQuotes()
+	if err != nil {
+		return nil, err
+	}
+	var outstanding []MintQuote
+	for _, quote := range quotes {
+		if ctx.Err() != nil {
+			return outstanding, ctx.Err()
+		}
+		if quote.State == QuoteStateIssued && !isBolt12(quote.PaymentMethod) {
+			continue
+		}
+		open, err := m.process(ctx, quote)
+		if err != nil && m.opts.OnError != nil {
+			m.opts.OnError(quote, err)
+		}
+		if open {
+			outstanding = append(outstanding, quote)
+		}
+	}
+	return outstanding, nil
+}
+
+func isBolt12(method PaymentMethod) bool {
+	_, ok := method.(PaymentMethodBolt12)
+	return ok
+}
+
+func quoteExpired(quote MintQuote) bool {
+	return quote.Expiry > 0 && time.Now().Unix() > int64(quote.Expiry)
+}
+
+// process checks one quote and mints what was paid. It reports whether the
+// quote is still outstanding.
+func (m *QuoteManager) process(ctx context.Context, quote MintQuote) (bool, error) {
+	if isBolt12(quote.PaymentMethod) {
+		return m.processBolt12(ctx, quote)
+	}
+
+	updated, err := m.wallet.CheckMintQuoteCtx(ctx, quote.MintUrl, quote.Id)
+	if err != nil {
+		return true, err
+	}
+	switch updated.State {
+	case QuoteStatePaid:
+		proofs, err := m.wallet.MintCtx(ctx, quote.MintUrl, quote.Id, m.opts.SpendingConditions)
+		if err != nil {
+			return true, err
+		}
+		if m.opts.OnMinted != nil {
+			m.opts.OnMinted(updated, proofs)
+		}
+		return false, nil
+	case QuoteStateIssued:
+		return false, nil
+	case QuoteStateUnpaid:
+		if quoteExpired(updated) {
+			return false, m.expire(updated)
+		}
+	}
+	return true, nil
+}
+
+func (m *QuoteManager) processBolt12(ctx context.Context, quote MintQuote) (bool, error) {
+	var state struct {
+		AmountPaid   uint64 `json:"amount_paid"`
+		AmountIssued uint64 `json:"amount_issued"`
+	}
+	if err := mintRequest(ctx, nil, http.MethodGet, quote.MintUrl, "/v1/mint/quote/bolt12/"+quote.Id, nil, &state); err != nil {
+		return true, err
+	}
+	if state.AmountPaid > state.AmountIssued {
+		wallet := m.walletFor(quote.MintUrl)
+		if wallet == nil {
+			return true, fmt.Errorf("no wallet for %s to mint bolt12 quote %s", quote.MintUrl.Url, quote.Id)
+		}
+		amount := Amount{Value: state.AmountPaid - state.AmountIssued}
+		proofs, err := wallet.MintBolt12Ctx(ctx, quote.Id, &amount, SplitTargetNone{}, m.opts.SpendingConditions)
+		if err != nil {
+			return true, err
+		}
+		quote.AmountPaid.Value = state.AmountPaid
+		quote.AmountIssued.Value = state.AmountPaid
+		if m.opts.OnMinted != nil {
+			m.opts.OnMinted(quote, proofs)
+		}
+	}
+	if quoteExpired(quote) {
+		return false, m.expire(quote)
+	}
+	return true, nil
+}
+
+func (m *QuoteManager) expire(quote MintQuote) error {
+	if m.opts.OnExpired != nil {
+		m.opts.OnExpired(quote)
+	}
+	if m.opts.KeepExpired {
+		return nil
+	}
+	return m.db.RemoveMintQuote(quote.Id)
+}
+
+func (m *QuoteManager) walletFor(mintUrl MintUrl) *Wallet {
+	if wallet, ok := m.opts.Wallets[mintUrl.Url]; ok {
+		return wallet
+	}
+	return m.opts.Wallets[strings.TrimSuffix(mintUrl.Url, "/")]
+}
+
+// subscribe keeps one NUT-17 subscription per mint and quote kind covering
+// the outstanding quotes, replacing it when the set of quotes changes.
+func (m *QuoteManager) subscribe(ctx context.Context, outstanding []MintQuote) {
+	wanted := map[string][]string{}
+	kinds := map[string]SubscriptionKind{}
+	wallets := map[string]*Wallet{}
+	mintUrls := map[string]MintUrl{}
+	for _, quote := range outstanding {
+		wallet := m.walletFor(quote.MintUrl)
+		if wallet == nil {
+			continue
+		}
+		kind := SubscriptionKindBolt11MintQuote
+		if isBolt12(quote.PaymentMethod) {
+			kind = SubscriptionKindBolt12MintQuote
+		}
+		key := fmt.Sprintf("%s|%d", quote.MintUrl.Url, kind)
+		wanted[key] = append(wanted[key], quote.Id)
+		kinds[key] = kind
+		wallets[key] = wallet
+		mintUrls[key] = quote.MintUrl
+	}
+
+	type failure struct {
+		mintUrl MintUrl
+		err     error
+	}
+	var failures []failure
+	m.mu.Lock()
+	for key, sub := range m.subscriptions {
+		if _, ok := wanted[key]; !ok {
+			sub.cancel()
+			delete(m.subscriptions, key)
+		}
+	}
+	for key, ids := range wanted {
+		sort.Strings(ids)
+		filters := strings.Join(ids, ",")
+		if sub, ok := m.subscriptions[key]; ok {
+			if sub.filters == filters {
+				continue
+			}
+			sub.cancel()
+			delete(m.subscriptions, key)
+		}
+		subCtx, cancel := context.WithCancel(ctx)
+		notifications, errs, err := wallets[key].SubscribeChan(subCtx, SubscribeParams{Kind: kinds[key], Filters: ids})
+		if err != nil {
+			// Polling covers this mint until the next attempt.
+			cancel()
+			failures = append(failures, failure{mintUrls[key], err})
+			continue
+		}
+		sub := &quoteSubscription{mintUrl: mintUrls[key], filters: filters, cancel: cancel}
+		m.subscriptions[key] = sub
+		go m.listen(subCtx, key, sub, notifications, errs)
+	}
+	m.mu.Unlock()
+
+	for _, failure := range failures {
+		if ctx.Err() == nil && m.opts.OnSubscriptionError != nil {
+			m.opts.OnSubscriptionError(failure.mintUrl, failure.err)
+		}
+	}
+}
+
+// listen triggers a check for every notification. When the subscription
+// fails it is dropped, so the next poll subscribes again.
+func (m *QuoteManager) listen(ctx context.Context, key string, sub *quoteSubscription, notifications <-chan NotificationPayload, errs <-chan error) {
+	for range notifications {
+		m.Trigger()
+	}
+	err := <-errs
+	if ctx.Err() != nil {
+		return
+	}
+	m.mu.Lock()
+	if m.subscriptions[key] == sub {
+		delete(m.subscriptions, key)
+	}
+	m.mu.Unlock()
+	sub.cancel()
+	if err != nil && m.opts.OnSubscriptionError != nil {
+		m.opts.OnSubscriptionError(sub.mintUrl, err)
+	}
+}
+
+func (m *QuoteManager) unsubscribeAll() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key, sub := range m.subscriptions {
+		sub.cancel()
+		delete(m.subscriptions, key)
+	}
+}
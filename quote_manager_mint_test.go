@@ -0,0 +1,87 @@
+package cdk_ffi_test
+
+import (
+	"testing"
+	"time"
+
+	cdk "github.com/lescuer97/cdkgo"
+	"github.com/lescuer97/cdkgo/mockmint"
+)
+
+func TestQuoteManagerMint(t *testing.T) {
+	ctx := testContext(t)
+	mint := mockmint.New(mockmint.Options{})
+	defer mint.Close()
+	expiring := mockmint.New(mockmint.Options{QuoteExpiry: -time.Minute})
+	defer expiring.Close()
+
+	mnemonic, err := cdk.GenerateMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := cdk.NewMemoryWalletDatabase()
+	wallet, err := cdk.NewMultiMintWallet(cdk.CurrencyUnitSat{}, mnemonic, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wallet.Destroy()
+	mintUrl, expiringUrl := cdk.MintUrl{Url: mint.URL()}, cdk.MintUrl{Url: expiring.URL()}
+	for _, url := range []cdk.MintUrl{mintUrl, expiringUrl} {
+		if err := wallet.AddMintCtx(ctx, url, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var minted, expired []string
+	manager := cdk.NewQuoteManager(wallet, db, cdk.QuoteManagerOptions{
+		OnMinted:  func(quote cdk.MintQuote, _ []*cdk.Proof) { minted = append(minted, quote.Id) },
+		OnExpired: func(quote cdk.MintQuote) { expired = append(expired, quote.Id) },
+		OnError:   func(quote cdk.MintQuote, err error) { t.Errorf("quote %s: %v", quote.Id, err) },
+	})
+
+	quote, err := wallet.MintQuoteCtx(ctx, mintUrl, cdk.Amount{Value: 50}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stale, err := wallet.MintQuoteCtx(ctx, expiringUrl, cdk.Amount{Value: 20}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Unpaid quotes stay outstanding, expired ones are removed
+	outstanding, err := manager.Sync(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(outstanding) != 1 || outstanding[0].Id != quote.Id || len(minted) != 0 {
+		t.Fatalf("outstanding %+v, minted %v", outstanding, minted)
+	}
+	if len(expired) != 1 || expired[0] != stale.Id {
+		t.Fatalf("expired %v", expired)
+	}
+	if removed, err := db.GetMintQuote(stale.Id); err != nil || removed != nil {
+		t.Fatalf("expired quote stored: %+v, %v", removed, err)
+	}
+
+	if err := mint.PayMintQuote(quote.Id); err != nil {
+		t.Fatal(err)
+	}
+	if outstanding, err = manager.Sync(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(outstanding) != 0 || len(minted) != 1 || minted[0] != quote.Id {
+		t.Fatalf("outstanding %+v, minted %v", outstanding, minted)
+	}
+	total, err := wallet.TotalBalanceCtx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total.Value != 50 {
+		t.Fatalf("balance %d", total.Value)
+	}
+
+	// Issued quotes are skipped
+	if outstanding, err = manager.Sync(ctx); err != nil || len(outstanding) != 0 || len(minted) != 1 {
+		t.Fatalf("outstanding %+v, minted %v, %v", outstanding, minted, err)
+	}
+}
@@ -0,0 +1,172 @@
+package cdk_ffi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+)
+
+// bolt12Mint serves the NUT-25 state of bolt12 quotes, amounts paid and
+// issued keyed by quote id. Other quotes are unknown.
+func bolt12Mint(t *testing.T, quotes map[string][2]uint64) MintUrl {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/mint/quote/bolt12/{id}", func(w http.ResponseWriter, r *http.Request) {
+		amounts, ok := quotes[r.PathValue("id")]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]any{"code": 20007, "detail": "unknown quote"})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]uint64{"amount_paid": amounts[0], "amount_issued": amounts[1]})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return MintUrl{Url: server.URL}
+}
+
+func bolt12Quote(mintUrl MintUrl, id string, expiry time.Time) MintQuote {
+	return MintQuote{Id: id, MintUrl: mintUrl, Unit: CurrencyUnitSat{}, Expiry: uint64(expiry.Unix()), PaymentMethod: PaymentMethodBolt12{}}
+}
+
+// failingQuotes fails to read the mint quotes.
+type failingQuotes struct {
+	*MemoryWalletDatabase
+}
+
+var errReadQuotes = errors.New("read quotes")
+
+func (failingQuotes) GetMintQuotes() ([]MintQuote, error) {
+	return nil, errReadQuotes
+}
+
+func TestQuoteManagerProcessBolt12(t *testing.T) {
+	ctx := context.Background()
+	mintUrl := bolt12Mint(t, map[string][2]uint64{"open": {10, 10}, "paid": {30, 10}, "unpaid": {0, 0}})
+	later, earlier := time.Now().Add(time.Hour), time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name        string
+		quote       MintQuote
+		keepExpired bool
+		open        bool
+		err         bool
+		expired     bool
+		kept        bool
+	}{
+		{name: "issued what was paid", quote: bolt12Quote(mintUrl, "open", later), open: true, kept: true},
+		{name: "no expiry", quote: bolt12Quote(mintUrl, "open", time.Unix(0, 0)), open: true, kept: true},
+		// Minting needs a wallet for the mint
+		{name: "paid without a wallet", quote: bolt12Quote(mintUrl, "paid", later), open: true, err: true, kept: true},
+		{name: "unknown to the mint", quote: bolt12Quote(mintUrl, "unknown", earlier), open: true, err: true, kept: true},
+		{name: "expired", quote: bolt12Quote(mintUrl, "unpaid", earlier), expired: true},
+		{name: "expired kept", quote: bolt12Quote(mintUrl, "unpaid", earlier), keepExpired: true, expired: true, kept: true},
+		// Expired quotes are not minted any more, so the error stands
+		{name: "expired unminted", quote: bolt12Quote(mintUrl, "paid", earlier), open: true, err: true, kept: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			db := NewMemoryWalletDatabase()
+			if err := db.AddMintQuote(test.quote); err != nil {
+				t.Fatal(err)
+			}
+			var expired []string
+			m := NewQuoteManager(nil, db, QuoteManagerOptions{
+				KeepExpired: test.keepExpired,
+				OnExpired:   func(quote MintQuote) { expired = append(expired, quote.Id) },
+				OnMinted:    func(MintQuote, []*Proof) { t.Fatal("minted") },
+			})
+			open, err := m.process(ctx, test.quote)
+			if open != test.open || (err != nil) != test.err {
+				t.Fatalf("got %v, %v", open, err)
+			}
+			if (len(expired) == 1) != test.expired {
+				t.Fatalf("expired %v", expired)
+			}
+			stored, err := db.GetMintQuote(test.quote.Id)
+			if err != nil || (stored != nil) != test.kept {
+				t.Fatalf("stored %+v, %v", stored, err)
+			}
+		})
+	}
+}
+
+func TestQuoteManagerSync(t *testing.T) {
+	ctx := context.Background()
+	mintUrl := bolt12Mint(t, map[string][2]uint64{"a": {5, 5}, "b": {0, 0}})
+	later, earlier := time.Now().Add(time.Hour), time.Now().Add(-time.Minute)
+	db := NewMemoryWalletDatabase()
+	issued := MintQuote{Id: "issued", MintUrl: mintUrl, State: QuoteStateIssued, PaymentMethod: PaymentMethodBolt11{}}
+	for _, quote := range []MintQuote{issued, bolt12Quote(mintUrl, "a", later), bolt12Quote(mintUrl, "b", earlier), bolt12Quote(mintUrl, "c", later)} {
+		if err := db.AddMintQuote(quote); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var failed []string
+	m := NewQuoteManager(nil, db, QuoteManagerOptions{OnError: func(quote MintQuote, err error) {
+		var mintErr *MintErrorResponse
+		if !errors.As(err, &mintErr) {
+			t.Errorf("quote %s: got %v", quote.Id, err)
+		}
+		failed = append(failed, quote.Id)
+	}})
+	outstanding, err := m.Sync(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, quote := range outstanding {
+		ids = append(ids, quote.Id)
+	}
+	// Issued bolt11 quotes are done and skipped, the expired one removed,
+	// and the failed one is retried on the next poll.
+	if !slices.Equal(ids, []string{"a", "c"}) || !slices.Equal(failed, []string{"c"}) {
+		t.Fatalf("outstanding %v, failed %v", ids, failed)
+	}
+	quotes, err := db.GetMintQuotes()
+	if err != nil || len(quotes) != 3 {
+		t.Fatalf("%d quotes left, %v", len(quotes), err)
+	}
+
+	// Sync stops at a cancelled context
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := m.Sync(cancelled); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v", err)
+	}
+
+	m = NewQuoteManager(nil, failingQuotes{db}, QuoteManagerOptions{})
+	if _, err := m.Sync(ctx); !errors.Is(err, errReadQuotes) {
+		t.Fatalf("got %v", err)
+	}
+}
+
+func TestQuoteManagerSyncError(t *testing.T) {
+	errs := make(chan error, 1)
+	m := NewQuoteManager(nil, failingQuotes{NewMemoryWalletDatabase()}, QuoteManagerOptions{
+		PollInterval: time.Hour,
+		OnSyncError: func(err error) {
+			select {
+			case errs <- err:
+			default:
+			}
+		},
+	})
+	if err := m.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	defer m.Stop()
+	select {
+	case err := <-errs:
+		if !errors.Is(err, errReadQuotes) {
+			t.Fatalf("got %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("no sync error")
+	}
+}
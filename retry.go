@@ -0,0 +1,329 @@
+package cdk_ffi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+var (
+	// ErrCompletedDuringRetry is returned when a retried Mint or Swap is
+	// rejected because an earlier attempt already went through on the mint.
+	// The outputs of that attempt are recovered with Restore where possible.
+	ErrCompletedDuringRetry = errors.New("operation completed on the mint during an earlier attempt")
+	// ErrMeltPending is returned when a failed Melt left the quote pending.
+	// The payment may still settle; it must not be retried.
+	ErrMeltPending = errors.New("melt quote is pending")
+	// ErrMeltPaid is returned when a failed Melt turns out to have paid the
+	// quote. The wallet's pending proofs are reconciled before returning.
+	ErrMeltPaid = errors.New("melt quote was paid during an earlier attempt")
+	// ErrMeltStateUnknown is returned when a Melt failed and the state of its
+	// quote could not be looked up, so it is not known whether it is safe to
+	// retry.
+	ErrMeltStateUnknown = errors.New("melt quote state unknown")
+)
+
+// RetryPolicy configures how RetryingWallet and RetryingMultiMintWallet
+// repeat operations that failed with a transient error.
+type RetryPolicy struct {
+	// Total number of attempts, including the first one
+	MaxAttempts int
+	// Delay before the first retry
+	InitialBackoff time.Duration
+	// Upper bound for the delay between attempts
+	MaxBackoff time.Duration
+	// Factor the delay grows by after every attempt
+	Multiplier float64
+	// Fraction of the delay that is randomised, between 0 and 1
+	Jitter float64
+	// Decides whether an error is worth retrying, IsRetryable if nil
+	Retryable func(error) bool
+}
+
+// DefaultRetryPolicy returns a policy of 4 attempts with backoff starting
+// at 200ms and capped at 5s.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    4,
+		InitialBackoff: 200 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+func (policy RetryPolicy) retryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if policy.Retryable != nil {
+		return policy.Retryable(err)
+	}
+	return IsRetryable(err)
+}
+
+// backoff returns the delay before retry number attempt, starting at 1.
+func (policy RetryPolicy) backoff(attempt int) time.Duration {
+	delay := float64(policy.InitialBackoff)
+	multiplier := policy.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	for i := 1; i < attempt; i++ {
+		delay *= multiplier
+	}
+	if policy.MaxBackoff > 0 && delay > float64(policy.MaxBackoff) {
+		delay = float64(policy.MaxBackoff)
+	}
+	if jitter := min(max(policy.Jitter, 0), 1); jitter > 0 {
+		delay += delay * jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(delay)
+}
+
+func (policy RetryPolicy) wait(ctx context.Context, attempt int) error {
+	timer := time.NewTimer(policy.backoff(attempt))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// retry calls op until it succeeds, fails with an error the policy does not
+// retry, or runs out of attempts. before, if not nil, is called ahead of
+// every retry and may stop retrying by returning an error.
+func retry[T any](ctx context.Context, policy RetryPolicy, op func(attempt int) (T, error), before func(err error) error) (T, error) {
+	attempts := max(policy.MaxAttempts, 1)
+	var (
+		result T
+		err    error
+	)
+	for attempt := 1; ; attempt++ {
+		result, err = op(attempt)
+		if err == nil || attempt >= attempts || !policy.retryable(err) {
+			return result, err
+		}
+		if waitErr := policy.wait(ctx, attempt); waitErr != nil {
+			return result, err
+		}
+		if before != nil {
+			if stopErr := before(err); stopErr != nil {
+				return result, stopErr
+			}
+		}
+	}
+}
+
+func isMintErrorCode(err error, code int) bool {
+	var response *MintErrorResponse
+	return errors.As(err, &response) && response.Code == code
+}
+
+// RetryingWallet wraps a Wallet and retries network-bound operations that
+// fail with a transient error. Each operation follows its own idempotency
+// rule:
+//
+//   - MintQuote, MeltQuote, CheckProofsSpent, GetMintInfo and RefreshKeysets
+//     have no side effects worth guarding and are retried as is.
+//   - Mint and Swap derive their outputs from the keyset counter. When a
+//     retry is rejected because an earlier attempt already went through,
+//     the outputs are recovered with Restore and ErrCompletedDuringRetry is
+//     returned.
+//   - Melt is never retried blindly: the quote state is looked up on the
+//     mint first and Melt is only repeated while the quote is unpaid.
+type RetryingWallet struct {
+	Wallet *Wallet
+	Policy RetryPolicy
+	// Client used to look up melt quote states, http.DefaultClient if nil
+	HttpClient *http.Client
+}
+
+// NewRetryingWallet wraps wallet with policy.
+func NewRetryingWallet(wallet *Wallet, policy RetryPolicy) *RetryingWallet {
+	return &RetryingWallet{Wallet: wallet, Policy: policy}
+}
+
+func (w *RetryingWallet) MintQuote(ctx context.Context, amount Amount, description *string) (MintQuote, error) {
+	return retry(ctx, w.Policy, func(int) (MintQuote, error) {
+		return w.Wallet.MintQuoteCtx(ctx, amount, description)
+	}, nil)
+}
+
+func (w *RetryingWallet) Mint(ctx context.Context, quoteId string, amountSplitTarget SplitTarget, spendingConditions *SpendingConditions) ([]*Proof, error) {
+	return retry(ctx, w.Policy, func(attempt int) ([]*Proof, error) {
+		proofs, err := w.Wallet.MintCtx(ctx, quoteId, amountSplitTarget, spendingConditions)
+		if err != nil && attempt > 1 && isMintErrorCode(err, MintErrorCodeTokensAlreadyIssued) {
+			return nil, w.restoreAfter(ctx, err)
+		}
+		return proofs, err
+	}, nil)
+}
+
+func (w *RetryingWallet) Swap(ctx context.Context, amount *Amount, amountSplitTarget SplitTarget, inputProofs []*Proof, spendingConditions *SpendingConditions, includeFees bool) (*[]*Proof, error) {
+	return retry(ctx, w.Policy, func(attempt int) (*[]*Proof, error) {
+		proofs, err := w.Wallet.SwapCtx(ctx, amount, amountSplitTarget, inputProofs, spendingConditions, includeFees)
+		if err != nil && attempt > 1 && isMintErrorCode(err, MintErrorCodeTokenAlreadySpent) {
+			return nil, w.restoreAfter(ctx, err)
+		}
+		return proofs, err
+	}, nil)
+}
+
+func (w *RetryingWallet) restoreAfter(ctx context.Context, err error) error {
+	if _, restoreErr := w.Wallet.RestoreCtx(ctx); restoreErr != nil {
+		return fmt.Errorf("%w: %w (restore failed: %w)", ErrCompletedDuringRetry, err, restoreErr)
+	}
+	return fmt.Errorf("%w: %w", ErrCompletedDuringRetry, err)
+}
+
+func (w *RetryingWallet) CheckProofsSpent(ctx context.Context, proofs []*Proof) ([]bool, error) {
+	return retry(ctx, w.Policy, func(int) ([]bool, error) {
+		return w.Wallet.CheckProofsSpentCtx(ctx, proofs)
+	}, nil)
+}
+
+func (w *RetryingWallet) GetMintInfo(ctx context.Context) (*MintInfo, error) {
+	return retry(ctx, w.Policy, func(int) (*MintInfo, error) {
+		return w.Wallet.GetMintInfoCtx(ctx)
+	}, nil)
+}
+
+func (w *RetryingWallet) RefreshKeysets(ctx context.Context) ([]KeySetInfo, error) {
+	return retry(ctx, w.Policy, func(int) ([]KeySetInfo, error) {
+		return w.Wallet.RefreshKeysetsCtx(ctx)
+	}, nil)
+}
+
+func (w *RetryingWallet) MeltQuote(ctx context.Context, request string, options *MeltOptions) (MeltQuote, error) {
+	return retry(ctx, w.Policy, func(int) (MeltQuote, error) {
+		return w.Wallet.MeltQuoteCtx(ctx, request, options)
+	}, nil)
+}
+
+// Melt pays the melt quote quoteId. After a transient failure the quote
+// state is looked up on the mint and the proofs the failed attempt left
+// pending are reconciled: Melt is retried while the quote is unpaid,
+// ErrMeltPending or ErrMeltPaid is returned otherwise, and
+// ErrMeltStateUnknown if the lookup fails. Melt is not retried when the
+// pending proofs cannot be reconciled, since they would not be available to
+// the next attempt.
+func (w *RetryingWallet) Melt(ctx context.Context, quoteId string) (Melted, error) {
+	return retry(ctx, w.Policy, func(int) (Melted, error) {
+		return w.Wallet.MeltCtx(ctx, quoteId)
+	}, meltRetryCheck(ctx, w.HttpClient, w.Wallet.MintUrl(), quoteId, w.Wallet.CheckAllPendingProofsCtx))
+}
+
+// meltRetryCheck returns the check RetryingWallet.Melt runs before every
+// retry. reconcile returns pending proofs the mint reports unspent to the
+// wallet.
+func meltRetryCheck(ctx context.Context, client *http.Client, mintUrl MintUrl, quoteId string, reconcile func(context.Context) (Amount, error)) func(error) error {
+	return func(err error) error {
+		state, stateErr := MeltQuoteStateFromMint(ctx, client, mintUrl, quoteId)
+		if stateErr != nil {
+			return fmt.Errorf("%w: %w (lookup failed: %w)", ErrMeltStateUnknown, err, stateErr)
+		}
+		switch state {
+		case QuoteStateUnpaid:
+			if _, reconcileErr := reconcile(ctx); reconcileErr != nil {
+				return fmt.Errorf("%w (reclaiming pending proofs failed: %w)", err, reconcileErr)
+			}
+			return nil
+		case QuoteStatePaid:
+			if _, reconcileErr := reconcile(ctx); reconcileErr != nil {
+				return fmt.Errorf("%w: %w (reconciling pending proofs failed: %w)", ErrMeltPaid, err, reconcileErr)
+			}
+			return fmt.Errorf("%w: %w", ErrMeltPaid, err)
+		default:
+			return fmt.Errorf("%w: %w", ErrMeltPending, err)
+		}
+	}
+}
+
+// MeltQuoteStateFromMint looks up the state of a bolt11 melt quote with the
+// NUT-05 quote state endpoint of the mint. A NUT-00 error response of the
+// mint is returned as *MintErrorResponse. client defaults to
+// http.DefaultClient.
+func MeltQuoteStateFromMint(ctx context.Context, client *http.Client, mintUrl MintUrl, quoteId string) (QuoteState, error) {
+	var quote mintMeltQuote
+	if err := mintRequest(ctx, client, http.MethodGet, mintUrl, "/v1/melt/quote/bolt11/"+quoteId, nil, &quote); err != nil {
+		return 0, fmt.Errorf("melt quote %s: %w", quoteId, err)
+	}
+	switch quote.State {
+	case "UNPAID":
+		return QuoteStateUnpaid, nil
+	case "PENDING":
+		return QuoteStatePending, nil
+	case "PAID":
+		return QuoteStatePaid, nil
+	default:
+		return 0, fmt.Errorf("melt quote %s: unknown state %q", quoteId, quote.State)
+	}
+}
+
+// RetryingMultiMintWallet wraps a MultiMintWallet with the same rules as
+// RetryingWallet. MultiMintWallet.Melt picks the quote itself, so its state
+// cannot be checked between attempts and Melt is not retried at all.
+type RetryingMultiMintWallet struct {
+	Wallet *MultiMintWallet
+	Policy RetryPolicy
+}
+
+// NewRetryingMultiMintWallet wraps wallet with policy.
+func NewRetryingMultiMintWallet(wallet *MultiMintWallet, policy RetryPolicy) *RetryingMultiMintWallet {
+	return &RetryingMultiMintWallet{Wallet: wallet, Policy: policy}
+}
+
+func (w *RetryingMultiMintWallet) MintQuote(ctx context.Context, mintUrl MintUrl, amount Amount, description *string) (MintQuote, error) {
+	return retry(ctx, w.Policy, func(int) (MintQuote, error) {
+		return w.Wallet.MintQuoteCtx(ctx, mintUrl, amount, description)
+	}, nil)
+}
+
+func (w *RetryingMultiMintWallet) CheckMintQuote(ctx context.Context, mintUrl MintUrl, quoteId string) (MintQuote, error) {
+	return retry(ctx, w.Policy, func(int) (MintQuote, error) {
+		return w.Wallet.CheckMintQuoteCtx(ctx, mintUrl, quoteId)
+	}, nil)
+}
+
+func (w *RetryingMultiMintWallet) Mint(ctx context.Context, mintUrl MintUrl, quoteId string, spendingConditions *SpendingConditions) ([]*Proof, error) {
+	return retry(ctx, w.Policy, func(attempt int) ([]*Proof, error) {
+		proofs, err := w.Wallet.MintCtx(ctx, mintUrl, quoteId, spendingConditions)
+		if err != nil && attempt > 1 && isMintErrorCode(err, MintErrorCodeTokensAlreadyIssued) {
+			if _, restoreErr := w.Wallet.RestoreCtx(ctx, mintUrl); restoreErr != nil {
+				return nil, fmt.Errorf("%w: %w (restore failed: %w)", ErrCompletedDuringRetry, err, restoreErr)
+			}
+			return nil, fmt.Errorf("%w: %w", ErrCompletedDuringRetry, err)
+		}
+		return proofs, err
+	}, nil)
+}
+
+// Swap retries like RetryingWallet.Swap, but the mint that handled an
+// earlier attempt is not known, so nothing is restored when
+// ErrCompletedDuringRetry is returned.
+func (w *RetryingMultiMintWallet) Swap(ctx context.Context, amount *Amount, spendingConditions *SpendingConditions) (*[]*Proof, error) {
+	return retry(ctx, w.Policy, func(attempt int) (*[]*Proof, error) {
+		proofs, err := w.Wallet.SwapCtx(ctx, amount, spendingConditions)
+		if err != nil && attempt > 1 && isMintErrorCode(err, MintErrorCodeTokenAlreadySpent) {
+			return nil, fmt.Errorf("%w: %w", ErrCompletedDuringRetry, err)
+		}
+		return proofs, err
+	}, nil)
+}
+
+func (w *RetryingMultiMintWallet) MeltQuote(ctx context.Context, mintUrl MintUrl, request string, options *MeltOptions) (MeltQuote, error) {
+	return retry(ctx, w.Policy, func(int) (MeltQuote, error) {
+		return w.Wallet.MeltQuoteCtx(ctx, mintUrl, request, options)
+	}, nil)
+}
+
+// Melt calls MultiMintWallet.Melt once.
+func (w *RetryingMultiMintWallet) Melt(ctx context.Context, bolt11 string, options *MeltOptions, maxFee *Amount) (Melted, error) {
+	return w.Wallet.MeltCtx(ctx, bolt11, options, maxFee)
+}
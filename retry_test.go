@@ -0,0 +1,93 @@
+package cdk_ffi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// meltQuoteServer serves a bolt11 melt quote in the given state.
+func meltQuoteServer(t *testing.T, state string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/melt/quote/bolt11/q1" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"quote":"q1","amount":100,"fee_reserve":2,"state":"` + state + `","expiry":0}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRetryMeltUnpaid(t *testing.T) {
+	server := meltQuoteServer(t, "UNPAID")
+	policy := RetryPolicy{MaxAttempts: 3}
+	transient := &FfiError{err: &FfiErrorNetwork{message: "error sending request for url"}}
+
+	var attempts, reconciled int
+	reconcile := func(context.Context) (Amount, error) {
+		reconciled++
+		return Amount{Value: 100}, nil
+	}
+	melted, err := retry(context.Background(), policy, func(attempt int) (Melted, error) {
+		attempts = attempt
+		if attempt == 1 {
+			return Melted{}, transient
+		}
+		return Melted{State: QuoteStatePaid}, nil
+	}, meltRetryCheck(context.Background(), nil, MintUrl{Url: server.URL}, "q1", reconcile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if melted.State != QuoteStatePaid || attempts != 2 {
+		t.Fatalf("got state %v after %d attempts", melted.State, attempts)
+	}
+	if reconciled != 1 {
+		t.Fatalf("pending proofs reconciled %d times before the retry", reconciled)
+	}
+
+	// No retry when the pending proofs cannot be reclaimed.
+	attempts = 0
+	reconcileErr := errors.New("mint unreachable")
+	_, err = retry(context.Background(), policy, func(attempt int) (Melted, error) {
+		attempts = attempt
+		return Melted{}, transient
+	}, meltRetryCheck(context.Background(), nil, MintUrl{Url: server.URL}, "q1", func(context.Context) (Amount, error) {
+		return Amount{}, reconcileErr
+	}))
+	if !errors.Is(err, reconcileErr) || !errors.Is(err, ErrFfiErrorNetwork) || attempts != 1 {
+		t.Fatalf("got %v after %d attempts", err, attempts)
+	}
+}
+
+func TestRetryMeltNotRepeated(t *testing.T) {
+	transient := &FfiError{err: &FfiErrorNetwork{message: "error sending request for url"}}
+	tests := []struct {
+		state string
+		want  error
+	}{
+		{"PENDING", ErrMeltPending},
+		{"PAID", ErrMeltPaid},
+		{"EXPIRED", ErrMeltStateUnknown},
+	}
+	for _, test := range tests {
+		server := meltQuoteServer(t, test.state)
+		var attempts, reconciled int
+		_, err := retry(context.Background(), RetryPolicy{MaxAttempts: 3}, func(attempt int) (Melted, error) {
+			attempts = attempt
+			return Melted{}, transient
+		}, meltRetryCheck(context.Background(), nil, MintUrl{Url: server.URL}, "q1", func(context.Context) (Amount, error) {
+			reconciled++
+			return Amount{}, nil
+		}))
+		if !errors.Is(err, test.want) || attempts != 1 {
+			t.Errorf("%s: got %v after %d attempts", test.state, err, attempts)
+		}
+		if wantReconciled := test.state == "PAID"; (reconciled == 1) != wantReconciled {
+			t.Errorf("%s: reconciled %d times", test.state, reconciled)
+		}
+	}
+}
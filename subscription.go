@@ -0,0 +1,289 @@
+package cdk_ffi
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Number of notifications buffered per listener before the stream blocks.
+const subscriptionListenerBuffer = 16
+
+// SubscriptionStream pumps notifications from an ActiveSubscription to any
+// number of Go listeners. Every listener sees every notification received
+// after it joined; a listener that stops reading blocks the others, so
+// consumers should drain their channel or cancel their context.
+//
+// Payloads are shared between listeners and must not be destroyed by them.
+// The ActiveSubscription is destroyed once the last listener leaves or the
+// stream is closed.
+type SubscriptionStream struct {
+	sub    *ActiveSubscription
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mu        sync.Mutex
+	listeners map[*subscriptionListener]struct{}
+	// closed is set once the stream stops accepting listeners, which is
+	// before run has exited when the last listener left or Close was called.
+	closed  bool
+	onClose func()
+}
+
+type subscriptionListener struct {
+	mu       sync.Mutex
+	out      chan NotificationPayload
+	errs     chan error
+	left     chan struct{}
+	leftOnce sync.Once
+	closed   bool
+}
+
+// NewSubscriptionStream takes ownership of sub and starts delivering its
+// notifications. The stream has no listeners until Listen is called.
+func NewSubscriptionStream(sub *ActiveSubscription) *SubscriptionStream {
+	return newSubscriptionStream(sub, nil)
+}
+
+func newSubscriptionStream(sub *ActiveSubscription, onClose func()) *SubscriptionStream {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &SubscriptionStream{
+		sub:       sub,
+		ctx:       ctx,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+		listeners: map[*subscriptionListener]struct{}{},
+		onClose:   onClose,
+	}
+	go stream.run()
+	return stream
+}
+
+// Id returns the id of the underlying subscription.
+func (s *SubscriptionStream) Id() string {
+	return s.sub.Id()
+}
+
+// Listen registers a new listener. The notification channel is closed when
+// ctx is done, when the stream is closed or when the subscription fails; in
+// the latter case the failure is sent on the error channel first. Listen
+// returns closed channels if the stream has already shut down.
+func (s *SubscriptionStream) Listen(ctx context.Context) (<-chan NotificationPayload, <-chan error) {
+	out, errs, _ := s.join(ctx)
+	return out, errs
+}
+
+// join is Listen that also reports whether the listener joined; it did not
+// when the stream was already shutting down.
+func (s *SubscriptionStream) join(ctx context.Context) (<-chan NotificationPayload, <-chan error, bool) {
+	l := &subscriptionListener{
+		out:  make(chan NotificationPayload, subscriptionListenerBuffer),
+		errs: make(chan error, 1),
+		left: make(chan struct{}),
+	}
+
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		l.close(nil)
+		return l.out, l.errs, false
+	}
+	s.listeners[l] = struct{}{}
+	s.mu.Unlock()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.leave(l)
+		case <-l.left:
+		}
+	}()
+
+	return l.out, l.errs, true
+}
+
+// Close stops the stream, closes every listener and destroys the
+// subscription. It waits for the delivery goroutine to exit.
+func (s *SubscriptionStream) Close() {
+	s.mu.Lock()
+	s.closed = true
+	s.mu.Unlock()
+	s.cancel()
+	<-s.done
+}
+
+// Done is closed once the stream has shut down.
+func (s *SubscriptionStream) Done() <-chan struct{} {
+	return s.done
+}
+
+func (s *SubscriptionStream) leave(l *subscriptionListener) {
+	s.mu.Lock()
+	_, ok := s.listeners[l]
+	delete(s.listeners, l)
+	last := ok && len(s.listeners) == 0
+	if last {
+		// Refuse new listeners now, the stream is about to stop.
+		s.closed = true
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		return
+	}
+	l.close(nil)
+	if last {
+		s.cancel()
+	}
+}
+
+func (s *SubscriptionStream) run() {
+	defer close(s.done)
+	defer s.sub.Destroy()
+
+	var streamErr error
+	for {
+		payload, err := s.sub.RecvCtx(s.ctx)
+		if err != nil {
+			if !errors.Is(err, context.Canceled) {
+				streamErr = err
+			}
+			break
+		}
+		s.broadcast(payload)
+	}
+
+	s.mu.Lock()
+	s.closed = true
+	listeners := s.listeners
+	s.listeners = map[*subscriptionListener]struct{}{}
+	s.mu.Unlock()
+
+	for l := range listeners {
+		l.close(streamErr)
+	}
+	if s.onClose != nil {
+		s.onClose()
+	}
+}
+
+func (s *SubscriptionStream) broadcast(payload NotificationPayload) {
+	s.mu.Lock()
+	listeners := make([]*subscriptionListener, 0, len(s.listeners))
+	for l := range s.listeners {
+		listeners = append(listeners, l)
+	}
+	s.mu.Unlock()
+
+	for _, l := range listeners {
+		l.send(s.ctx, payload)
+	}
+}
+
+func (l *subscriptionListener) send(ctx context.Context, payload NotificationPayload) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return
+	}
+	select {
+	case l.out <- payload:
+	case <-l.left:
+	case <-ctx.Done():
+	}
+}
+
+func (l *subscriptionListener) close(err error) {
+	// Unblock a pending send before taking the lock it holds.
+	l.leftOnce.Do(func() { close(l.left) })
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return
+	}
+	l.closed = true
+	if err != nil {
+		l.errs <- err
+	}
+	close(l.out)
+	close(l.errs)
+}
+
+type walletSubscriptionKey struct {
+	wallet *Wallet
+	id     string
+}
+
+var walletSubscriptions = struct {
+	sync.Mutex
+	streams map[walletSubscriptionKey]*SubscriptionStream
+}{streams: map[walletSubscriptionKey]*SubscriptionStream{}}
+
+// SubscribeChan subscribes to wallet events and delivers them on a channel
+// until ctx is done. Calls that pass the same params.Id on the same wallet
+// while the subscription is live share a single ActiveSubscription and each
+// receive every notification. See SubscriptionStream.Listen for channel
+// semantics.
+func (_self *Wallet) SubscribeChan(ctx context.Context, params SubscribeParams) (<-chan NotificationPayload, <-chan error, error) {
+	if params.Id == nil {
+		sub, err := _self.SubscribeCtx(ctx, params)
+		if err != nil {
+			return nil, nil, err
+		}
+		out, errs := NewSubscriptionStream(sub).Listen(ctx)
+		return out, errs, nil
+	}
+
+	key := walletSubscriptionKey{wallet: _self, id: *params.Id}
+	for {
+		if out, errs, ok := joinWalletSubscription(ctx, key); ok {
+			return out, errs, nil
+		}
+
+		// Subscribe without holding the registry, then register the stream
+		// unless another caller registered a live one meanwhile.
+		sub, err := _self.SubscribeCtx(ctx, params)
+		if err != nil {
+			return nil, nil, err
+		}
+		var created *SubscriptionStream
+		created = newSubscriptionStream(sub, func() {
+			walletSubscriptions.Lock()
+			defer walletSubscriptions.Unlock()
+			if walletSubscriptions.streams[key] == created {
+				delete(walletSubscriptions.streams, key)
+			}
+		})
+		out, errs, _ := created.join(ctx)
+
+		walletSubscriptions.Lock()
+		existing, ok := walletSubscriptions.streams[key]
+		if ok && existing.joinable() {
+			walletSubscriptions.Unlock()
+			created.Close()
+			continue
+		}
+		walletSubscriptions.streams[key] = created
+		walletSubscriptions.Unlock()
+		return out, errs, nil
+	}
+}
+
+// joinWalletSubscription joins the live stream registered under key.
+func joinWalletSubscription(ctx context.Context, key walletSubscriptionKey) (<-chan NotificationPayload, <-chan error, bool) {
+	walletSubscriptions.Lock()
+	stream, ok := walletSubscriptions.streams[key]
+	walletSubscriptions.Unlock()
+	if !ok {
+		return nil, nil, false
+	}
+	return stream.join(ctx)
+}
+
+func (s *SubscriptionStream) joinable() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return !s.closed
+}
@@ -0,0 +1,108 @@
+package cdk_ffi
+
+import (
+	"reflect"
+	"sort"
+)
+
+// Helpers for WalletDatabase implementations written in Go.
+
+// DatabaseError wraps err as an FfiErrorDatabase so that its message crosses
+// the FFI boundary when returned from a Go WalletDatabase. Errors that are
+// not *FfiError are otherwise reported to Rust as an unexpected callback
+// failure without any detail.
+func DatabaseError(err error) *FfiError {
+	return &FfiError{err: &FfiErrorDatabase{message: err.Error()}}
+}
+
+// KeysetCounterReader is implemented by WalletDatabases that can read a
+// keyset counter without changing it. WalletDatabase only offers
+// IncrementKeysetCounter, which is a write even with a count of zero.
+type KeysetCounterReader interface {
+	// GetKeysetCounter returns the counter of a keyset, zero if it was never
+	// incremented.
+	GetKeysetCounter(keysetId Id) (uint32, error)
+}
+
+// ReadKeysetCounter returns the counter of a keyset of db. When db does not
+// implement KeysetCounterReader the counter is read by incrementing it by
+// zero, unless readOnly is set, in which case ok is false.
+func ReadKeysetCounter(db WalletDatabase, keysetId Id, readOnly bool) (counter uint32, ok bool, err error) {
+	if reader, isReader := db.(KeysetCounterReader); isReader {
+		counter, err = reader.GetKeysetCounter(keysetId)
+		return counter, err == nil, err
+	}
+	if readOnly {
+		return 0, false, nil
+	}
+	counter, err = db.IncrementKeysetCounter(keysetId, 0)
+	return counter, err == nil, err
+}
+
+// ProofInfoMatches reports whether info passes the filters of
+// WalletDatabase.GetProofs. Every non-nil filter must match: the proof's
+// mint url, its unit, one of the given states and one of the given spending
+// conditions. Proofs without spending conditions never match a spending
+// condition filter.
+func ProofInfoMatches(info ProofInfo, mintUrl *MintUrl, unit *CurrencyUnit, state *[]ProofState, spendingConditions *[]SpendingConditions) bool {
+	if mintUrl != nil && info.MintUrl != *mintUrl {
+		return false
+	}
+	if unit != nil && !reflect.DeepEqual(info.Unit, *unit) {
+		return false
+	}
+	if state != nil {
+		found := false
+		for _, s := range *state {
+			if info.State == s {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if spendingConditions != nil {
+		if info.SpendingCondition == nil {
+			return false
+		}
+		found := false
+		for _, condition := range *spendingConditions {
+			if reflect.DeepEqual(*info.SpendingCondition, condition) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// TransactionMatches reports whether transaction passes the filters of
+// WalletDatabase.ListTransactions.
+func TransactionMatches(transaction Transaction, mintUrl *MintUrl, direction *TransactionDirection, unit *CurrencyUnit) bool {
+	if mintUrl != nil && transaction.MintUrl != *mintUrl {
+		return false
+	}
+	if direction != nil && transaction.Direction != *direction {
+		return false
+	}
+	if unit != nil && !reflect.DeepEqual(transaction.Unit, *unit) {
+		return false
+	}
+	return true
+}
+
+// SortTransactions orders transactions newest first, breaking ties by id so
+// the order is stable across backends.
+func SortTransactions(transactions []Transaction) {
+	sort.Slice(transactions, func(i, j int) bool {
+		if transactions[i].Timestamp != transactions[j].Timestamp {
+			return transactions[i].Timestamp > transactions[j].Timestamp
+		}
+		return transactions[i].Id.Hex < transactions[j].Id.Hex
+	})
+}
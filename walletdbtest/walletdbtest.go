@@ -0,0 +1,456 @@
+// Package walletdbtest checks that a cdk_ffi WalletDatabase implementation
+// behaves like the SQL backends CDK ships, so the Go databases can be tested
+// against the same expectations.
+//
+//	func TestWalletDatabase(t *testing.T) {
+//		walletdbtest.Run(t, func(t *testing.T) cdk.WalletDatabase {
+//			return mydb.New(t.TempDir())
+//		})
+//	}
+package walletdbtest
+
+import (
+	"reflect"
+	"testing"
+
+	cdk "github.com/lescuer97/cdkgo"
+)
+
+// Valid secp256k1 points, G and 2G, for the fields the bindings parse as
+// public keys.
+const (
+	pointG  = "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+	point2G = "02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5"
+)
+
+var (
+	mintA   = cdk.MintUrl{Url: "https://mint-a.example.com"}
+	mintB   = cdk.MintUrl{Url: "https://mint-b.example.com"}
+	keysetA = cdk.KeySetInfo{Id: "009a1f293253e41e", Unit: cdk.CurrencyUnitSat{}, Active: true, InputFeePpk: 100}
+	keysetB = cdk.KeySetInfo{Id: "00ad268c4d1f5826", Unit: cdk.CurrencyUnitSat{}, Active: false}
+)
+
+// Run runs the conformance tests, each on a fresh empty database returned
+// by open.
+func Run(t *testing.T, open func(t *testing.T) cdk.WalletDatabase) {
+	t.Run("Mints", func(t *testing.T) { testMints(t, open(t)) })
+	t.Run("Keysets", func(t *testing.T) { testKeysets(t, open(t)) })
+	t.Run("RemoveMint", func(t *testing.T) { testRemoveMint(t, open(t)) })
+	t.Run("Keys", func(t *testing.T) { testKeys(t, open(t)) })
+	t.Run("MintQuotes", func(t *testing.T) { testMintQuotes(t, open(t)) })
+	t.Run("MeltQuotes", func(t *testing.T) { testMeltQuotes(t, open(t)) })
+	t.Run("KeysetCounter", func(t *testing.T) { testKeysetCounter(t, open(t)) })
+	t.Run("Transactions", func(t *testing.T) { testTransactions(t, open(t)) })
+	t.Run("Proofs", func(t *testing.T) { testProofs(t, open(t)) })
+}
+
+func check(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testMints(t *testing.T, db cdk.WalletDatabase) {
+	name := "Mint A"
+	check(t, db.AddMint(mintA, &cdk.MintInfo{Name: &name}))
+	check(t, db.AddMint(mintB, nil))
+
+	info, err := db.GetMint(mintA)
+	check(t, err)
+	if info == nil || info.Name == nil || *info.Name != name {
+		t.Fatalf("GetMint(%s) = %+v, want name %q", mintA.Url, info, name)
+	}
+	info, err = db.GetMint(mintB)
+	check(t, err)
+	if info != nil {
+		t.Fatalf("GetMint(%s) = %+v, want nil info", mintB.Url, info)
+	}
+
+	mints, err := db.GetMints()
+	check(t, err)
+	if len(mints) != 2 {
+		t.Fatalf("GetMints returned %d mints, want 2", len(mints))
+	}
+	if _, ok := mints[mintB]; !ok {
+		t.Fatalf("GetMints is missing %s", mintB.Url)
+	}
+
+	check(t, db.RemoveMint(mintB))
+	mints, err = db.GetMints()
+	check(t, err)
+	if _, ok := mints[mintB]; ok || len(mints) != 1 {
+		t.Fatalf("GetMints after RemoveMint = %v", mints)
+	}
+	info, err = db.GetMint(cdk.MintUrl{Url: "https://unknown.example.com"})
+	check(t, err)
+	if info != nil {
+		t.Fatalf("GetMint of an unknown mint = %+v, want nil", info)
+	}
+}
+
+func testKeysets(t *testing.T, db cdk.WalletDatabase) {
+	check(t, db.AddMint(mintA, nil))
+	check(t, db.AddMintKeysets(mintA, []cdk.KeySetInfo{keysetA, keysetB}))
+
+	updated := keysetB
+	updated.InputFeePpk = 200
+	check(t, db.AddMintKeysets(mintA, []cdk.KeySetInfo{updated}))
+
+	keysets, err := db.GetMintKeysets(mintA)
+	check(t, err)
+	if keysets == nil || len(*keysets) != 2 {
+		t.Fatalf("GetMintKeysets = %v, want 2 keysets", keysets)
+	}
+	keyset, err := db.GetKeysetById(cdk.Id{Hex: keysetB.Id})
+	check(t, err)
+	if keyset == nil || keyset.InputFeePpk != 200 {
+		t.Fatalf("GetKeysetById(%s) = %+v, want the updated keyset", keysetB.Id, keyset)
+	}
+
+	keysets, err = db.GetMintKeysets(mintB)
+	check(t, err)
+	if keysets != nil && len(*keysets) != 0 {
+		t.Fatalf("GetMintKeysets of a mint without keysets = %v", *keysets)
+	}
+	keyset, err = db.GetKeysetById(cdk.Id{Hex: "00ffffffffffffff"})
+	check(t, err)
+	if keyset != nil {
+		t.Fatalf("GetKeysetById of an unknown keyset = %+v, want nil", keyset)
+	}
+}
+
+func testRemoveMint(t *testing.T, db cdk.WalletDatabase) {
+	check(t, db.AddMint(mintA, nil))
+	check(t, db.AddMint(mintB, nil))
+	check(t, db.AddMintKeysets(mintA, []cdk.KeySetInfo{keysetA}))
+	check(t, db.AddMintKeysets(mintB, []cdk.KeySetInfo{keysetB}))
+
+	check(t, db.RemoveMint(mintA))
+
+	keysets, err := db.GetMintKeysets(mintA)
+	check(t, err)
+	if keysets != nil && len(*keysets) != 0 {
+		t.Fatalf("GetMintKeysets after RemoveMint = %v, want none", *keysets)
+	}
+	keyset, err := db.GetKeysetById(cdk.Id{Hex: keysetA.Id})
+	check(t, err)
+	if keyset != nil {
+		t.Fatalf("GetKeysetById after RemoveMint = %+v, want nil", keyset)
+	}
+	keyset, err = db.GetKeysetById(cdk.Id{Hex: keysetB.Id})
+	check(t, err)
+	if keyset == nil {
+		t.Fatal("RemoveMint removed the keyset of another mint")
+	}
+}
+
+func testKeys(t *testing.T, db cdk.WalletDatabase) {
+	keyset := cdk.KeySet{Id: keysetA.Id, Unit: cdk.CurrencyUnitSat{}, Keys: map[uint64]string{1: pointG, 2: point2G}}
+	check(t, db.AddKeys(keyset))
+
+	keys, err := db.GetKeys(cdk.Id{Hex: keysetA.Id})
+	check(t, err)
+	if keys == nil || !reflect.DeepEqual(keys.Keys, keyset.Keys) {
+		t.Fatalf("GetKeys = %+v, want %v", keys, keyset.Keys)
+	}
+
+	check(t, db.RemoveKeys(cdk.Id{Hex: keysetA.Id}))
+	keys, err = db.GetKeys(cdk.Id{Hex: keysetA.Id})
+	check(t, err)
+	if keys != nil {
+		t.Fatalf("GetKeys after RemoveKeys = %+v, want nil", keys)
+	}
+}
+
+func mintQuote(id string) cdk.MintQuote {
+	return cdk.MintQuote{
+		Id:            id,
+		Amount:        &cdk.Amount{Value: 1000},
+		Unit:          cdk.CurrencyUnitSat{},
+		Request:       "lnbc10u1" + id,
+		State:         cdk.QuoteStateUnpaid,
+		Expiry:        1700000000,
+		MintUrl:       mintA,
+		PaymentMethod: cdk.PaymentMethodBolt11{},
+	}
+}
+
+func testMintQuotes(t *testing.T, db cdk.WalletDatabase) {
+	secretKey := "0000000000000000000000000000000000000000000000000000000000000001"
+	locked := mintQuote("quote-b")
+	locked.SecretKey = &secretKey
+	check(t, db.AddMintQuote(locked))
+	check(t, db.AddMintQuote(mintQuote("quote-a")))
+
+	paid := mintQuote("quote-a")
+	paid.State = cdk.QuoteStatePaid
+	paid.AmountPaid = cdk.Amount{Value: 1000}
+	check(t, db.AddMintQuote(paid))
+
+	quote, err := db.GetMintQuote("quote-a")
+	check(t, err)
+	if quote == nil || quote.State != cdk.QuoteStatePaid || quote.AmountPaid.Value != 1000 {
+		t.Fatalf("GetMintQuote = %+v, want the updated quote", quote)
+	}
+	quotes, err := db.GetMintQuotes()
+	check(t, err)
+	if len(quotes) != 2 {
+		t.Fatalf("GetMintQuotes returned %d quotes, want 2", len(quotes))
+	}
+	for _, quote := range quotes {
+		if quote.Id == locked.Id && (quote.SecretKey == nil || *quote.SecretKey != secretKey) {
+			t.Fatalf("GetMintQuotes lost the secret key of %s: %v", locked.Id, quote.SecretKey)
+		}
+	}
+	quote, err = db.GetMintQuote(locked.Id)
+	check(t, err)
+	if quote == nil || quote.SecretKey == nil || *quote.SecretKey != secretKey {
+		t.Fatalf("GetMintQuote(%s) = %+v, want secret key %s", locked.Id, quote, secretKey)
+	}
+
+	check(t, db.RemoveMintQuote("quote-a"))
+	quote, err = db.GetMintQuote("quote-a")
+	check(t, err)
+	if quote != nil {
+		t.Fatalf("GetMintQuote after RemoveMintQuote = %+v, want nil", quote)
+	}
+}
+
+func testMeltQuotes(t *testing.T, db cdk.WalletDatabase) {
+	preimage := "00"
+	quote := cdk.MeltQuote{
+		Id:              "melt-a",
+		Amount:          cdk.Amount{Value: 100},
+		Unit:            cdk.CurrencyUnitSat{},
+		Request:         "lnbc1u1melt",
+		FeeReserve:      cdk.Amount{Value: 2},
+		State:           cdk.QuoteStatePaid,
+		Expiry:          1700000000,
+		PaymentPreimage: &preimage,
+		PaymentMethod:   cdk.PaymentMethodBolt11{},
+	}
+	check(t, db.AddMeltQuote(quote))
+
+	stored, err := db.GetMeltQuote("melt-a")
+	check(t, err)
+	if stored == nil || stored.FeeReserve.Value != 2 || stored.PaymentPreimage == nil || *stored.PaymentPreimage != preimage {
+		t.Fatalf("GetMeltQuote = %+v, want %+v", stored, quote)
+	}
+	quotes, err := db.GetMeltQuotes()
+	check(t, err)
+	if len(quotes) != 1 {
+		t.Fatalf("GetMeltQuotes returned %d quotes, want 1", len(quotes))
+	}
+
+	check(t, db.RemoveMeltQuote("melt-a"))
+	stored, err = db.GetMeltQuote("melt-a")
+	check(t, err)
+	if stored != nil {
+		t.Fatalf("GetMeltQuote after RemoveMeltQuote = %+v, want nil", stored)
+	}
+}
+
+func testKeysetCounter(t *testing.T, db cdk.WalletDatabase) {
+	id := cdk.Id{Hex: keysetA.Id}
+	counter, err := db.IncrementKeysetCounter(id, 3)
+	check(t, err)
+	if counter != 3 {
+		t.Fatalf("IncrementKeysetCounter(3) on a new keyset = %d, want 3", counter)
+	}
+	counter, err = db.IncrementKeysetCounter(id, 2)
+	check(t, err)
+	if counter != 5 {
+		t.Fatalf("IncrementKeysetCounter(2) = %d, want 5", counter)
+	}
+	counter, err = db.IncrementKeysetCounter(cdk.Id{Hex: keysetB.Id}, 1)
+	check(t, err)
+	if counter != 1 {
+		t.Fatalf("counters are not kept per keyset, got %d", counter)
+	}
+}
+
+func transaction(id string, mintUrl cdk.MintUrl, direction cdk.TransactionDirection, timestamp uint64) cdk.Transaction {
+	return cdk.Transaction{
+		Id:        cdk.TransactionId{Hex: id},
+		MintUrl:   mintUrl,
+		Direction: direction,
+		Amount:    cdk.Amount{Value: 10},
+		Unit:      cdk.CurrencyUnitSat{},
+		Ys:        []cdk.PublicKey{{Hex: pointG}},
+		Timestamp: timestamp,
+		Metadata:  map[string]string{},
+	}
+}
+
+func testTransactions(t *testing.T, db cdk.WalletDatabase) {
+	older := transaction("1111111111111111111111111111111111111111111111111111111111111111", mintA, cdk.TransactionDirectionIncoming, 100)
+	newer := transaction("2222222222222222222222222222222222222222222222222222222222222222", mintA, cdk.TransactionDirectionOutgoing, 200)
+	other := transaction("3333333333333333333333333333333333333333333333333333333333333333", mintB, cdk.TransactionDirectionIncoming, 300)
+	for _, tx := range []cdk.Transaction{older, newer, other} {
+		check(t, db.AddTransaction(tx))
+	}
+
+	stored, err := db.GetTransaction(newer.Id)
+	check(t, err)
+	if stored == nil || stored.Direction != cdk.TransactionDirectionOutgoing || stored.Timestamp != 200 {
+		t.Fatalf("GetTransaction = %+v, want %+v", stored, newer)
+	}
+
+	all, err := db.ListTransactions(nil, nil, nil)
+	check(t, err)
+	if len(all) != 3 || all[0].Id != other.Id || all[2].Id != older.Id {
+		t.Fatalf("ListTransactions is not newest first: %v", transactionIds(all))
+	}
+	incoming := cdk.TransactionDirectionIncoming
+	filtered, err := db.ListTransactions(&mintA, &incoming, nil)
+	check(t, err)
+	if len(filtered) != 1 || filtered[0].Id != older.Id {
+		t.Fatalf("ListTransactions(mint A, incoming) = %v, want only %s", transactionIds(filtered), older.Id.Hex)
+	}
+
+	check(t, db.RemoveTransaction(older.Id))
+	stored, err = db.GetTransaction(older.Id)
+	check(t, err)
+	if stored != nil {
+		t.Fatalf("GetTransaction after RemoveTransaction = %+v, want nil", stored)
+	}
+}
+
+func transactionIds(transactions []cdk.Transaction) []string {
+	ids := make([]string, 0, len(transactions))
+	for _, tx := range transactions {
+		ids = append(ids, tx.Id.Hex)
+	}
+	return ids
+}
+
+// proofInfos builds proofs with distinct secrets through the bindings, so
+// their Y values are real hash_to_curve points.
+func proofInfos(t *testing.T, mintUrl cdk.MintUrl, states ...cdk.ProofState) []cdk.ProofInfo {
+	t.Helper()
+	raw := make([]cdk.RawProof, len(states))
+	for i := range states {
+		raw[i] = cdk.RawProof{Id: keysetA.Id, Amount: 1 << i, Secret: mintUrl.Url + string(rune('a'+i)), C: pointG}
+	}
+	proofs, err := cdk.DecodeRawProofs(mintUrl, cdk.CurrencyUnitSat{}, raw)
+	check(t, err)
+	infos := make([]cdk.ProofInfo, len(proofs))
+	for i, proof := range proofs {
+		y, err := proof.Y()
+		check(t, err)
+		infos[i] = cdk.ProofInfo{Proof: proof, Y: cdk.PublicKey{Hex: y}, MintUrl: mintUrl, State: states[i], Unit: cdk.CurrencyUnitSat{}}
+	}
+	return infos
+}
+
+func ys(infos []cdk.ProofInfo) []string {
+	ys := make([]string, 0, len(infos))
+	for _, info := range infos {
+		ys = append(ys, info.Y.Hex)
+	}
+	return ys
+}
+
+func testProofs(t *testing.T, db cdk.WalletDatabase) {
+	infos := proofInfos(t, mintA, cdk.ProofStateUnspent, cdk.ProofStateUnspent, cdk.ProofStatePending)
+	others := proofInfos(t, mintB, cdk.ProofStateReserved)
+	check(t, db.UpdateProofs(append(append([]cdk.ProofInfo(nil), infos...), others...), nil))
+
+	all, err := db.GetProofs(nil, nil, nil, nil)
+	check(t, err)
+	if len(all) != 4 {
+		t.Fatalf("GetProofs returned %d proofs, want 4", len(all))
+	}
+	for _, info := range all {
+		if info.Proof == nil {
+			t.Fatalf("GetProofs returned proof %s without its Proof", info.Y.Hex)
+		}
+	}
+
+	fromA, err := db.GetProofs(&mintA, nil, nil, nil)
+	check(t, err)
+	if len(fromA) != 3 {
+		t.Fatalf("GetProofs(mint A) returned %v, want 3 proofs", ys(fromA))
+	}
+
+	unspent := []cdk.ProofState{cdk.ProofStateUnspent}
+	byState, err := db.GetProofs(nil, nil, &unspent, nil)
+	check(t, err)
+	if len(byState) != 2 {
+		t.Fatalf("GetProofs(unspent) returned %v, want 2 proofs", ys(byState))
+	}
+	repeated := []cdk.ProofState{cdk.ProofStateUnspent, cdk.ProofStatePending, cdk.ProofStateUnspent}
+	byState, err = db.GetProofs(nil, nil, &repeated, nil)
+	check(t, err)
+	if len(byState) != 3 {
+		t.Fatalf("GetProofs with a repeated state returned %v, want 3 proofs", ys(byState))
+	}
+
+	check(t, db.UpdateProofsState([]cdk.PublicKey{infos[0].Y}, cdk.ProofStateSpent))
+	spent := []cdk.ProofState{cdk.ProofStateSpent}
+	byState, err = db.GetProofs(nil, nil, &spent, nil)
+	check(t, err)
+	if len(byState) != 1 || byState[0].Y != infos[0].Y {
+		t.Fatalf("GetProofs(spent) after UpdateProofsState = %v, want %s", ys(byState), infos[0].Y.Hex)
+	}
+	byState, err = db.GetProofs(nil, nil, &unspent, nil)
+	check(t, err)
+	if len(byState) != 1 {
+		t.Fatalf("GetProofs(unspent) after UpdateProofsState = %v, want 1 proof", ys(byState))
+	}
+
+	replacement := proofInfos(t, mintB, cdk.ProofStateUnspent, cdk.ProofStateUnspent)[1]
+	check(t, db.UpdateProofs([]cdk.ProofInfo{replacement}, []cdk.PublicKey{infos[1].Y, others[0].Y}))
+	all, err = db.GetProofs(nil, nil, nil, nil)
+	check(t, err)
+	if len(all) != 3 {
+		t.Fatalf("GetProofs after UpdateProofs = %v, want 3 proofs", ys(all))
+	}
+	fromB, err := db.GetProofs(&mintB, nil, nil, nil)
+	check(t, err)
+	if len(fromB) != 1 || fromB[0].Y != replacement.Y {
+		t.Fatalf("GetProofs(mint B) = %v, want only %s", ys(fromB), replacement.Y.Hex)
+	}
+
+	conditions := []cdk.SpendingConditions{}
+	withConditions, err := db.GetProofs(nil, nil, nil, &conditions)
+	check(t, err)
+	if len(withConditions) != 0 {
+		t.Fatalf("GetProofs with a spending condition filter returned proofs without conditions: %v", ys(withConditions))
+	}
+}
+
+// RunMeltStore checks a cdk_ffi MeltStore implementation on a fresh empty
+// store returned by open.
+func RunMeltStore(t *testing.T, open func(t *testing.T) cdk.MeltStore) {
+	store := open(t)
+	records, err := store.GetMelts()
+	check(t, err)
+	if len(records) != 0 {
+		t.Fatalf("new store has %d melts", len(records))
+	}
+
+	first := cdk.MeltRecord{QuoteId: "q1", MintUrl: mintA.Url, Started: 300, Unspent: []string{pointG, point2G}}
+	second := cdk.MeltRecord{QuoteId: "q2", MintUrl: mintB.Url, Method: "bolt12", Started: 100}
+	third := cdk.MeltRecord{QuoteId: "q3", MintUrl: mintA.Url, Started: 200, Inputs: []string{pointG}}
+	for _, record := range []cdk.MeltRecord{first, second, third} {
+		check(t, store.PutMelt(record))
+	}
+	records, err = store.GetMelts()
+	check(t, err)
+	if want := []cdk.MeltRecord{second, third, first}; !reflect.DeepEqual(records, want) {
+		t.Fatalf("got %+v, want oldest first %+v", records, want)
+	}
+
+	// Replacing a record keeps one per quote
+	first.Inputs = []string{point2G}
+	check(t, store.PutMelt(first))
+	check(t, store.RemoveMelt("q2"))
+	check(t, store.RemoveMelt("unknown"))
+	records, err = store.GetMelts()
+	check(t, err)
+	if want := []cdk.MeltRecord{third, first}; !reflect.DeepEqual(records, want) {
+		t.Fatalf("got %+v, want %+v", records, want)
+	}
+}
@@ -0,0 +1,350 @@
+package walletrpc
+
+import (
+	cdk "github.com/lescuer97/cdkgo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The enums of wallet.proto use the numbering of CDK, so states convert
+// both ways with a plain conversion.
+
+func optionalAmount(amount *uint64) *cdk.Amount {
+	if amount == nil {
+		return nil
+	}
+	return &cdk.Amount{Value: *amount}
+}
+
+func amountValue(amount *cdk.Amount) *uint64 {
+	if amount == nil {
+		return nil
+	}
+	return &amount.Value
+}
+
+// proofsResponse converts proofs for a response and destroys them.
+func proofsResponse(proofs []*cdk.Proof) []*Proof {
+	converted := make([]*Proof, 0, len(proofs))
+	for _, proof := range proofs {
+		converted = append(converted, proofResponse(proof))
+		proof.Destroy()
+	}
+	return converted
+}
+
+func proofResponse(proof *cdk.Proof) *Proof {
+	converted := &Proof{KeysetId: proof.KeysetId(), Amount: proof.Amount().Value, Secret: proof.Secret(), C: proof.C()}
+	if dleq := proof.Dleq(); dleq != nil {
+		converted.Dleq = &ProofDleq{E: dleq.E, S: dleq.S, R: dleq.R}
+	}
+	if y, err := proof.Y(); err == nil {
+		converted.Y = y
+	}
+	return converted
+}
+
+// walletProofs converts the proofs of a request to proofs of the wallet.
+// The caller destroys them.
+func walletProofs(wallet *cdk.Wallet, proofs []*Proof) ([]*cdk.Proof, error) {
+	if len(proofs) == 0 {
+		return nil, nil
+	}
+	raw := make([]cdk.RawProof, 0, len(proofs))
+	for _, proof := range proofs {
+		encoded := cdk.RawProof{Id: proof.KeysetId, Amount: proof.Amount, Secret: proof.Secret, C: proof.C}
+		if proof.Dleq != nil {
+			encoded.Dleq = &cdk.RawProofDleq{E: proof.Dleq.E, S: proof.Dleq.S, R: proof.Dleq.R}
+		}
+		raw = append(raw, encoded)
+	}
+	converted, err := cdk.DecodeRawProofs(wallet.MintUrl(), wallet.Unit(), raw)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid proofs: %v", err)
+	}
+	return converted, nil
+}
+
+func destroyProofs(proofs []*cdk.Proof) {
+	for _, proof := range proofs {
+		proof.Destroy()
+	}
+}
+
+func decodeToken(encoded string) (*cdk.Token, error) {
+	token, err := cdk.TokenDecode(encoded)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %v", err)
+	}
+	return token, nil
+}
+
+func mintQuoteResponse(quote cdk.MintQuote) *MintQuote {
+	return &MintQuote{
+		Id:            quote.Id,
+		Amount:        amountValue(quote.Amount),
+		Unit:          cdk.CurrencyUnitString(quote.Unit),
+		Request:       quote.Request,
+		State:         QuoteState(quote.State),
+		Expiry:        quote.Expiry,
+		MintUrl:       quote.MintUrl.Url,
+		AmountIssued:  quote.AmountIssued.Value,
+		AmountPaid:    quote.AmountPaid.Value,
+		PaymentMethod: cdk.PaymentMethodString(quote.PaymentMethod),
+		SecretKey:     quote.SecretKey,
+	}
+}
+
+func meltQuoteResponse(quote cdk.MeltQuote) *MeltQuote {
+	return &MeltQuote{
+		Id:              quote.Id,
+		Amount:          quote.Amount.Value,
+		Unit:            cdk.CurrencyUnitString(quote.Unit),
+		Request:         quote.Request,
+		FeeReserve:      quote.FeeReserve.Value,
+		State:           QuoteState(quote.State),
+		Expiry:          quote.Expiry,
+		PaymentPreimage: quote.PaymentPreimage,
+		PaymentMethod:   cdk.PaymentMethodString(quote.PaymentMethod),
+	}
+}
+
+func meltedResponse(melted cdk.Melted) *Melted {
+	converted := &Melted{
+		State:    QuoteState(melted.State),
+		Preimage: melted.Preimage,
+		Amount:   melted.Amount.Value,
+		FeePaid:  melted.FeePaid.Value,
+	}
+	if melted.Change != nil {
+		converted.Change = proofsResponse(*melted.Change)
+	}
+	return converted
+}
+
+func transactionResponse(transaction cdk.Transaction) *Transaction {
+	ys := make([]string, 0, len(transaction.Ys))
+	for _, y := range transaction.Ys {
+		ys = append(ys, y.Hex)
+	}
+	return &Transaction{
+		Id:        transaction.Id.Hex,
+		MintUrl:   transaction.MintUrl.Url,
+		Direction: TransactionDirection(transaction.Direction),
+		Amount:    transaction.Amount.Value,
+		Fee:       transaction.Fee.Value,
+		Unit:      cdk.CurrencyUnitString(transaction.Unit),
+		Ys:        ys,
+		Timestamp: transaction.Timestamp,
+		Memo:      transaction.Memo,
+		Metadata:  transaction.Metadata,
+		QuoteId:   transaction.QuoteId,
+	}
+}
+
+func transactionsResponse(transactions []cdk.Transaction) []*Transaction {
+	converted := make([]*Transaction, 0, len(transactions))
+	for _, transaction := range transactions {
+		converted = append(converted, transactionResponse(transaction))
+	}
+	return converted
+}
+
+func transactionDirection(direction TransactionDirection) *cdk.TransactionDirection {
+	if direction == TransactionDirection_TRANSACTION_DIRECTION_UNSPECIFIED {
+		return nil
+	}
+	converted := cdk.TransactionDirection(direction)
+	return &converted
+}
+
+func keysetResponse(keyset cdk.KeySetInfo) *KeySetInfo {
+	return &KeySetInfo{Id: keyset.Id, Unit: cdk.CurrencyUnitString(keyset.Unit), Active: keyset.Active, InputFeePpk: keyset.InputFeePpk}
+}
+
+func preparedSendResponse(send *cdk.PreparedSend) *PreparedSend {
+	return &PreparedSend{
+		Id:     send.Id(),
+		Amount: send.Amount().Value,
+		Fee:    send.Fee().Value,
+		Proofs: proofsResponse(send.Proofs()),
+	}
+}
+
+func splitTarget(target *SplitTarget) cdk.SplitTarget {
+	switch target := target.GetTarget().(type) {
+	case *SplitTarget_Value:
+		return cdk.SplitTargetValue{Amount: cdk.Amount{Value: target.Value}}
+	case *SplitTarget_Values:
+		amounts := make([]cdk.Amount, 0, len(target.Values.GetAmounts()))
+		for _, amount := range target.Values.GetAmounts() {
+			amounts = append(amounts, cdk.Amount{Value: amount})
+		}
+		return cdk.SplitTargetValues{Amounts: amounts}
+	default:
+		return cdk.SplitTargetNone{}
+	}
+}
+
+func meltOptions(options *MeltOptions) *cdk.MeltOptions {
+	var converted cdk.MeltOptions
+	switch option := options.GetOption().(type) {
+	case *MeltOptions_MppAmount:
+		converted = cdk.MeltOptionsMpp{Amount: cdk.Amount{Value: option.MppAmount}}
+	case *MeltOptions_AmountlessAmountMsat:
+		converted = cdk.MeltOptionsAmountless{AmountMsat: cdk.Amount{Value: option.AmountlessAmountMsat}}
+	default:
+		return nil
+	}
+	return &converted
+}
+
+func conditions(conditions *Conditions) *cdk.Conditions {
+	if conditions == nil {
+		return nil
+	}
+	return &cdk.Conditions{
+		Locktime:      conditions.Locktime,
+		Pubkeys:       conditions.Pubkeys,
+		RefundKeys:    conditions.RefundKeys,
+		NumSigs:       conditions.NumSigs,
+		SigFlag:       uint8(conditions.SigFlag),
+		NumSigsRefund: conditions.NumSigsRefund,
+	}
+}
+
+func spendingConditions(spending *SpendingConditions) *cdk.SpendingConditions {
+	var converted cdk.SpendingConditions
+	switch kind := spending.GetKind().(type) {
+	case *SpendingConditions_P2Pk_:
+		converted = cdk.SpendingConditionsP2pk{Pubkey: kind.P2Pk.GetPubkey(), Conditions: conditions(kind.P2Pk.GetConditions())}
+	case *SpendingConditions_Htlc_:
+		converted = cdk.SpendingConditionsHtlc{Hash: kind.Htlc.GetHash(), Conditions: conditions(kind.Htlc.GetConditions())}
+	default:
+		return nil
+	}
+	return &converted
+}
+
+func sendKind(kind *SendKind) cdk.SendKind {
+	tolerance := cdk.Amount{Value: kind.GetTolerance()}
+	switch kind.GetMode() {
+	case SendKind_MODE_ONLINE_TOLERANCE:
+		return cdk.SendKindOnlineTolerance{Tolerance: tolerance}
+	case SendKind_MODE_OFFLINE_EXACT:
+		return cdk.SendKindOfflineExact{}
+	case SendKind_MODE_OFFLINE_TOLERANCE:
+		return cdk.SendKindOfflineTolerance{Tolerance: tolerance}
+	default:
+		return cdk.SendKindOnlineExact{}
+	}
+}
+
+func sendOptions(options *SendOptions) cdk.SendOptions {
+	converted := cdk.SendOptions{
+		Conditions:        spendingConditions(options.GetConditions()),
+		AmountSplitTarget: splitTarget(options.GetAmountSplitTarget()),
+		SendKind:          sendKind(options.GetSendKind()),
+		IncludeFee:        options.GetIncludeFee(),
+		MaxProofs:         options.MaxProofs,
+		Metadata:          options.GetMetadata(),
+	}
+	if options.Memo != nil {
+		converted.Memo = &cdk.SendMemo{Memo: *options.Memo, IncludeMemo: options.GetIncludeMemo()}
+	}
+	if converted.Metadata == nil {
+		converted.Metadata = map[string]string{}
+	}
+	return converted
+}
+
+func receiveOptions(options *ReceiveOptions) cdk.ReceiveOptions {
+	keys := make([]cdk.SecretKey, 0, len(options.GetP2PkSigningKeys()))
+	for _, key := range options.GetP2PkSigningKeys() {
+		keys = append(keys, cdk.SecretKey{Hex: key})
+	}
+	converted := cdk.ReceiveOptions{
+		AmountSplitTarget: splitTarget(options.GetAmountSplitTarget()),
+		P2pkSigningKeys:   keys,
+		Preimages:         options.GetPreimages(),
+		Metadata:          options.GetMetadata(),
+	}
+	if converted.Preimages == nil {
+		converted.Preimages = []string{}
+	}
+	if converted.Metadata == nil {
+		converted.Metadata = map[string]string{}
+	}
+	return converted
+}
+
+func mintUrls(urls []string) []cdk.MintUrl {
+	converted := make([]cdk.MintUrl, 0, len(urls))
+	for _, url := range urls {
+		converted = append(converted, cdk.MintUrl{Url: url})
+	}
+	return converted
+}
+
+func multiMintSendOptions(options *MultiMintSendOptions) cdk.MultiMintSendOptions {
+	return cdk.MultiMintSendOptions{
+		AllowTransfer:     options.GetAllowTransfer(),
+		MaxTransferAmount: optionalAmount(options.MaxTransferAmount),
+		AllowedMints:      mintUrls(options.GetAllowedMints()),
+		ExcludedMints:     mintUrls(options.GetExcludedMints()),
+		SendOptions:       sendOptions(options.GetSendOptions()),
+	}
+}
+
+func multiMintReceiveOptions(options *MultiMintReceiveOptions) cdk.MultiMintReceiveOptions {
+	converted := cdk.MultiMintReceiveOptions{
+		AllowUntrusted: options.GetAllowUntrusted(),
+		ReceiveOptions: receiveOptions(options.GetReceiveOptions()),
+	}
+	if options.TransferToMint != nil {
+		converted.TransferToMint = &cdk.MintUrl{Url: *options.TransferToMint}
+	}
+	return converted
+}
+
+// notification converts a NUT-17 notification, false for payloads the proto
+// does not know.
+func notification(payload cdk.NotificationPayload) (*SubscribeResponse, bool) {
+	switch payload := payload.(type) {
+	case cdk.NotificationPayloadMintQuoteUpdate:
+		update := &MintQuoteUpdate{
+			Quote:   payload.Quote.Quote(),
+			Request: payload.Quote.Request(),
+			State:   QuoteState(payload.Quote.State()),
+			Amount:  amountValue(payload.Quote.Amount()),
+			Expiry:  payload.Quote.Expiry(),
+			Pubkey:  payload.Quote.Pubkey(),
+		}
+		if unit := payload.Quote.Unit(); unit != nil {
+			update.Unit = cdk.CurrencyUnitString(*unit)
+		}
+		return &SubscribeResponse{Payload: &SubscribeResponse_MintQuote{MintQuote: update}}, true
+	case cdk.NotificationPayloadMeltQuoteUpdate:
+		update := &MeltQuoteUpdate{
+			Quote:           payload.Quote.Quote(),
+			Request:         payload.Quote.Request(),
+			State:           QuoteState(payload.Quote.State()),
+			Amount:          payload.Quote.Amount().Value,
+			FeeReserve:      payload.Quote.FeeReserve().Value,
+			Expiry:          payload.Quote.Expiry(),
+			PaymentPreimage: payload.Quote.PaymentPreimage(),
+		}
+		if unit := payload.Quote.Unit(); unit != nil {
+			update.Unit = cdk.CurrencyUnitString(*unit)
+		}
+		return &SubscribeResponse{Payload: &SubscribeResponse_MeltQuote{MeltQuote: update}}, true
+	case cdk.NotificationPayloadProofState:
+		updates := &ProofStateUpdates{}
+		for _, update := range payload.ProofStates {
+			updates.Updates = append(updates.Updates, &ProofStateUpdate{Y: update.Y, State: ProofState(update.State), Witness: update.Witness})
+		}
+		return &SubscribeResponse{Payload: &SubscribeResponse_ProofStates{ProofStates: updates}}, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,15 @@
+// Package walletrpc serves the wallet over gRPC, so services written in
+// other languages can use it. The services are defined in wallet.proto:
+// WalletService mirrors WalletInterface and MultiMintWalletService mirrors
+// MultiMintWalletInterface.
+//
+//	server := grpc.NewServer()
+//	walletrpc.NewWalletServer(walletrpc.MultiMintWallets(wallet, db, mnemonic)).Register(server)
+//	walletrpc.NewMultiMintWalletServer(wallet).Register(server)
+//
+// Go clients use the generated NewWalletServiceClient and
+// NewMultiMintWalletServiceClient. Errors are returned as gRPC statuses,
+// see Status.
+package walletrpc
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative wallet.proto
@@ -0,0 +1,132 @@
+package walletrpc
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	cdk "github.com/lescuer97/cdkgo"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain is the domain of the ErrorInfo of failed calls.
+const ErrorDomain = "cdk.cashu"
+
+// ffiStatuses maps the FfiError variants to a status code and the reason of
+// the ErrorInfo.
+var ffiStatuses = []struct {
+	err    error
+	code   codes.Code
+	reason string
+}{
+	{cdk.ErrFfiErrorInsufficientFunds, codes.FailedPrecondition, "INSUFFICIENT_FUNDS"},
+	// The melt failed and its proofs were returned, it can be retried
+	{cdk.ErrFfiErrorPaymentFailed, codes.Aborted, "PAYMENT_FAILED"},
+	// The outcome is not known yet, like a pending quote of the mint; the
+	// melt quote tells once it is settled
+	{cdk.ErrFfiErrorPaymentPending, codes.Aborted, "PAYMENT_PENDING"},
+	{cdk.ErrFfiErrorNetwork, codes.Unavailable, "NETWORK"},
+	{cdk.ErrFfiErrorInvalidToken, codes.InvalidArgument, "INVALID_TOKEN"},
+	{cdk.ErrFfiErrorInvalidUrl, codes.InvalidArgument, "INVALID_URL"},
+	{cdk.ErrFfiErrorInvalidHex, codes.InvalidArgument, "INVALID_HEX"},
+	{cdk.ErrFfiErrorInvalidCryptographicKey, codes.InvalidArgument, "INVALID_CRYPTOGRAPHIC_KEY"},
+	{cdk.ErrFfiErrorInvalidMnemonic, codes.InvalidArgument, "INVALID_MNEMONIC"},
+	{cdk.ErrFfiErrorAmount, codes.InvalidArgument, "AMOUNT"},
+	{cdk.ErrFfiErrorUnitNotSupported, codes.InvalidArgument, "UNIT_NOT_SUPPORTED"},
+	{cdk.ErrFfiErrorSerialization, codes.InvalidArgument, "SERIALIZATION"},
+	{cdk.ErrFfiErrorDivisionByZero, codes.InvalidArgument, "DIVISION_BY_ZERO"},
+	{cdk.ErrFfiErrorAmountOverflow, codes.OutOfRange, "AMOUNT_OVERFLOW"},
+	{cdk.ErrFfiErrorKeysetUnknown, codes.NotFound, "KEYSET_UNKNOWN"},
+	{cdk.ErrFfiErrorDatabase, codes.Internal, "DATABASE"},
+	{cdk.ErrFfiErrorRuntimeTaskJoin, codes.Internal, "RUNTIME_TASK_JOIN"},
+	{cdk.ErrFfiErrorWallet, codes.Unknown, "WALLET"},
+	{cdk.ErrFfiErrorGeneric, codes.Unknown, "GENERIC"},
+}
+
+// mintStatusCodes refines the code of errors carrying a NUT-00 error of the
+// mint.
+var mintStatusCodes = map[int]codes.Code{
+	cdk.MintErrorCodeBlindedMessageAlreadySigned: codes.AlreadyExists,
+	cdk.MintErrorCodeTokenNotVerified:            codes.InvalidArgument,
+	cdk.MintErrorCodeTokenAlreadySpent:           codes.FailedPrecondition,
+	cdk.MintErrorCodeTransactionNotBalanced:      codes.InvalidArgument,
+	cdk.MintErrorCodeUnitNotSupported:            codes.InvalidArgument,
+	cdk.MintErrorCodeAmountOutsideLimit:          codes.OutOfRange,
+	cdk.MintErrorCodeKeysetNotKnown:              codes.NotFound,
+	cdk.MintErrorCodeKeysetInactive:              codes.FailedPrecondition,
+	cdk.MintErrorCodeQuoteNotPaid:                codes.FailedPrecondition,
+	cdk.MintErrorCodeTokensAlreadyIssued:         codes.AlreadyExists,
+	cdk.MintErrorCodeMintingDisabled:             codes.FailedPrecondition,
+	cdk.MintErrorCodeQuotePending:                codes.Aborted,
+	cdk.MintErrorCodeInvoiceAlreadyPaid:          codes.AlreadyExists,
+	cdk.MintErrorCodeQuoteExpired:                codes.FailedPrecondition,
+}
+
+// Status converts an error of the wallet to the status returned to the
+// client. FfiError variants get the code of their kind of failure and an
+// ErrorInfo whose reason names the variant, e.g. INSUFFICIENT_FUNDS. Its
+// metadata holds what is known of the failure: "mint_code" for NUT-00
+// errors of the mint and "http_status" for network errors.
+func Status(err error) *status.Status {
+	if err == nil {
+		return status.New(codes.OK, "")
+	}
+	if s, ok := status.FromError(err); ok {
+		return s
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.New(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.New(codes.DeadlineExceeded, err.Error())
+	}
+
+	var ffiErr *cdk.FfiError
+	if !errors.As(err, &ffiErr) {
+		return status.New(codes.Unknown, err.Error())
+	}
+	code, reason := codes.Unknown, "GENERIC"
+	for _, mapping := range ffiStatuses {
+		if errors.Is(err, mapping.err) {
+			code, reason = mapping.code, mapping.reason
+			break
+		}
+	}
+
+	metadata := map[string]string{}
+	var networkErr *cdk.NetworkError
+	if errors.As(err, &networkErr) {
+		if networkErr.HttpStatus != 0 {
+			metadata["http_status"] = strconv.Itoa(networkErr.HttpStatus)
+		}
+		// Only failures worth retrying are Unavailable, clients may retry
+		// those automatically
+		if !networkErr.Retryable() {
+			code = codes.Unknown
+		}
+	}
+	var mintErr *cdk.MintErrorResponse
+	if errors.As(err, &mintErr) {
+		metadata["mint_code"] = strconv.Itoa(mintErr.Code)
+		if mintCode, ok := mintStatusCodes[mintErr.Code]; ok {
+			code = mintCode
+		}
+	}
+
+	s := status.New(code, ffiErr.Message())
+	withInfo, detailsErr := s.WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: ErrorDomain, Metadata: metadata})
+	if detailsErr != nil {
+		return s
+	}
+	return withInfo
+}
+
+// rpcError returns err as a status error, nil if err is nil.
+func rpcError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return Status(err).Err()
+}
@@ -0,0 +1,155 @@
+package walletrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"testing"
+
+	cdk "github.com/lescuer97/cdkgo"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ffiError returns an error of the variant of kind carrying message, as the
+// bindings lift it.
+func ffiError(kind *cdk.FfiError, message string) *cdk.FfiError {
+	var variant bytes.Buffer
+	cdk.FfiConverterFfiError{}.Write(&variant, kind)
+	var lifted bytes.Buffer
+	lifted.Write(variant.Bytes()[:4])
+	binary.Write(&lifted, binary.BigEndian, int32(len(message)))
+	lifted.WriteString(message)
+	return cdk.FfiConverterFfiError{}.Read(&lifted)
+}
+
+func errorInfo(t *testing.T, s *status.Status) *errdetails.ErrorInfo {
+	t.Helper()
+	for _, detail := range s.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+	t.Fatalf("status %v has no ErrorInfo", s)
+	return nil
+}
+
+func TestStatusFfiErrors(t *testing.T) {
+	tests := []struct {
+		err    *cdk.FfiError
+		code   codes.Code
+		reason string
+	}{
+		{cdk.NewFfiErrorInsufficientFunds(), codes.FailedPrecondition, "INSUFFICIENT_FUNDS"},
+		{cdk.NewFfiErrorPaymentFailed(), codes.Aborted, "PAYMENT_FAILED"},
+		{cdk.NewFfiErrorPaymentPending(), codes.Aborted, "PAYMENT_PENDING"},
+		{cdk.NewFfiErrorInvalidToken(), codes.InvalidArgument, "INVALID_TOKEN"},
+		{cdk.NewFfiErrorInvalidUrl(), codes.InvalidArgument, "INVALID_URL"},
+		{cdk.NewFfiErrorInvalidHex(), codes.InvalidArgument, "INVALID_HEX"},
+		{cdk.NewFfiErrorInvalidCryptographicKey(), codes.InvalidArgument, "INVALID_CRYPTOGRAPHIC_KEY"},
+		{cdk.NewFfiErrorInvalidMnemonic(), codes.InvalidArgument, "INVALID_MNEMONIC"},
+		{cdk.NewFfiErrorAmount(), codes.InvalidArgument, "AMOUNT"},
+		{cdk.NewFfiErrorUnitNotSupported(), codes.InvalidArgument, "UNIT_NOT_SUPPORTED"},
+		{cdk.NewFfiErrorSerialization(), codes.InvalidArgument, "SERIALIZATION"},
+		{cdk.NewFfiErrorDivisionByZero(), codes.InvalidArgument, "DIVISION_BY_ZERO"},
+		{cdk.NewFfiErrorAmountOverflow(), codes.OutOfRange, "AMOUNT_OVERFLOW"},
+		{cdk.NewFfiErrorKeysetUnknown(), codes.NotFound, "KEYSET_UNKNOWN"},
+		{cdk.NewFfiErrorDatabase(), codes.Internal, "DATABASE"},
+		{cdk.NewFfiErrorRuntimeTaskJoin(), codes.Internal, "RUNTIME_TASK_JOIN"},
+		{cdk.NewFfiErrorWallet(), codes.Unknown, "WALLET"},
+		{cdk.NewFfiErrorGeneric(), codes.Unknown, "GENERIC"},
+	}
+	tested := map[string]bool{"NETWORK": true}
+	for _, test := range tests {
+		err := fmt.Errorf("call: %w", ffiError(test.err, "it failed"))
+		s := Status(err)
+		if s.Code() != test.code || s.Message() != "it failed" {
+			t.Errorf("%s: got %v %q", test.reason, s.Code(), s.Message())
+		}
+		if info := errorInfo(t, s); info.Reason != test.reason || info.Domain != ErrorDomain || len(info.Metadata) != 0 {
+			t.Errorf("%s: got %+v", test.reason, info)
+		}
+		tested[test.reason] = true
+	}
+	for _, mapping := range ffiStatuses {
+		if !tested[mapping.reason] {
+			t.Errorf("%s is not tested", mapping.reason)
+		}
+	}
+}
+
+func TestStatusNetworkErrors(t *testing.T) {
+	tests := []struct {
+		message    string
+		code       codes.Code
+		httpStatus string
+	}{
+		{"error sending request for url (https://mint.example/v1/swap)", codes.Unavailable, ""},
+		{"HTTP status 503 Service Unavailable", codes.Unavailable, "503"},
+		{"HTTP status 429 Too Many Requests", codes.Unavailable, "429"},
+		// Retrying would fail the same way.
+		{"HTTP status 400 Bad Request", codes.Unknown, "400"},
+		{"error decoding response body: expected value at line 1", codes.Unknown, ""},
+	}
+	for _, test := range tests {
+		s := Status(ffiError(cdk.NewFfiErrorNetwork(), test.message))
+		info := errorInfo(t, s)
+		if s.Code() != test.code || info.Reason != "NETWORK" || info.Metadata["http_status"] != test.httpStatus {
+			t.Errorf("%q: got %v, %+v", test.message, s.Code(), info)
+		}
+	}
+}
+
+func TestStatusMintErrors(t *testing.T) {
+	tests := []struct {
+		code int
+		want codes.Code
+	}{
+		{cdk.MintErrorCodeTokenAlreadySpent, codes.FailedPrecondition},
+		{cdk.MintErrorCodeBlindedMessageAlreadySigned, codes.AlreadyExists},
+		{cdk.MintErrorCodeAmountOutsideLimit, codes.OutOfRange},
+		{cdk.MintErrorCodeKeysetNotKnown, codes.NotFound},
+		{cdk.MintErrorCodeQuotePending, codes.Aborted},
+		{cdk.MintErrorCodeTokenNotVerified, codes.InvalidArgument},
+		// Codes without an override keep the code of the variant.
+		{99999, codes.Unknown},
+	}
+	for _, test := range tests {
+		message := fmt.Sprintf(`{"detail":"mint says no","code":%d}`, test.code)
+		s := Status(ffiError(cdk.NewFfiErrorWallet(), message))
+		info := errorInfo(t, s)
+		if s.Code() != test.want || info.Reason != "WALLET" || info.Metadata["mint_code"] != fmt.Sprint(test.code) {
+			t.Errorf("mint code %d: got %v, %+v", test.code, s.Code(), info)
+		}
+	}
+
+	// The mint code overrides a downgraded network error too.
+	message := fmt.Sprintf(`HTTP status 400: {"detail":"Token already spent","code":%d}`, cdk.MintErrorCodeTokenAlreadySpent)
+	if s := Status(ffiError(cdk.NewFfiErrorNetwork(), message)); s.Code() != codes.FailedPrecondition {
+		t.Errorf("spent token over HTTP 400: got %v", s.Code())
+	}
+}
+
+func TestStatusOtherErrors(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{nil, codes.OK},
+		{context.Canceled, codes.Canceled},
+		{fmt.Errorf("melt: %w", context.DeadlineExceeded), codes.DeadlineExceeded},
+		{status.Error(codes.NotFound, "no such mint"), codes.NotFound},
+		{errors.New("plain"), codes.Unknown},
+	}
+	for _, test := range tests {
+		if s := Status(test.err); s.Code() != test.want {
+			t.Errorf("%v: got %v, want %v", test.err, s.Code(), test.want)
+		}
+	}
+	if rpcError(nil) != nil {
+		t.Fatal("rpcError(nil) is not nil")
+	}
+}
@@ -0,0 +1,260 @@
+package walletrpc
+
+import (
+	"context"
+
+	cdk "github.com/lescuer97/cdkgo"
+	"google.golang.org/grpc"
+)
+
+// MultiMintWalletServer implements MultiMintWalletService on a
+// MultiMintWallet.
+type MultiMintWalletServer struct {
+	UnimplementedMultiMintWalletServiceServer
+
+	wallet *cdk.MultiMintWallet
+	sends  preparedSends
+}
+
+func NewMultiMintWalletServer(wallet *cdk.MultiMintWallet) *MultiMintWalletServer {
+	return &MultiMintWalletServer{wallet: wallet}
+}
+
+// Register registers the service on a gRPC server.
+func (s *MultiMintWalletServer) Register(registrar grpc.ServiceRegistrar) {
+	RegisterMultiMintWalletServiceServer(registrar, s)
+}
+
+// Close cancels the sends that were not confirmed.
+func (s *MultiMintWalletServer) Close(ctx context.Context) {
+	s.sends.cancelAll(ctx)
+}
+
+func (s *MultiMintWalletServer) AddMint(ctx context.Context, req *AddMintRequest) (*AddMintResponse, error) {
+	if err := s.wallet.AddMintCtx(ctx, cdk.MintUrl{Url: req.MintUrl}, req.TargetProofCount); err != nil {
+		return nil, rpcError(err)
+	}
+	return &AddMintResponse{}, nil
+}
+
+func (s *MultiMintWalletServer) CheckAllMintQuotes(ctx context.Context, req *CheckAllMintQuotesRequest) (*CheckAllMintQuotesResponse, error) {
+	var mintUrl *cdk.MintUrl
+	if req.MintUrl != nil {
+		mintUrl = &cdk.MintUrl{Url: *req.MintUrl}
+	}
+	amount, err := s.wallet.CheckAllMintQuotesCtx(ctx, mintUrl)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &CheckAllMintQuotesResponse{Amount: amount.Value}, nil
+}
+
+func (s *MultiMintWalletServer) CheckMintQuote(ctx context.Context, req *CheckMintQuoteRequest) (*CheckMintQuoteResponse, error) {
+	quote, err := s.wallet.CheckMintQuoteCtx(ctx, cdk.MintUrl{Url: req.MintUrl}, req.QuoteId)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &CheckMintQuoteResponse{Quote: mintQuoteResponse(quote)}, nil
+}
+
+func (s *MultiMintWalletServer) Consolidate(ctx context.Context, req *ConsolidateRequest) (*ConsolidateResponse, error) {
+	amount, err := s.wallet.ConsolidateCtx(ctx)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &ConsolidateResponse{Amount: amount.Value}, nil
+}
+
+func (s *MultiMintWalletServer) GetBalances(ctx context.Context, req *GetBalancesRequest) (*GetBalancesResponse, error) {
+	balances, err := s.wallet.GetBalancesCtx(ctx)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	resp := &GetBalancesResponse{Balances: map[string]uint64{}}
+	for mintUrl, amount := range balances {
+		resp.Balances[mintUrl] = amount.Value
+	}
+	return resp, nil
+}
+
+func (s *MultiMintWalletServer) GetMintUrls(ctx context.Context, req *GetMintUrlsRequest) (*GetMintUrlsResponse, error) {
+	mintUrls, err := s.wallet.GetMintUrlsCtx(ctx)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &GetMintUrlsResponse{MintUrls: mintUrls}, nil
+}
+
+func (s *MultiMintWalletServer) HasMint(ctx context.Context, req *HasMintRequest) (*HasMintResponse, error) {
+	hasMint, err := s.wallet.HasMintCtx(ctx, cdk.MintUrl{Url: req.MintUrl})
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &HasMintResponse{HasMint: hasMint}, nil
+}
+
+func (s *MultiMintWalletServer) ListProofs(ctx context.Context, req *ListProofsRequest) (*ListProofsResponse, error) {
+	proofs, err := s.wallet.ListProofsCtx(ctx)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	resp := &ListProofsResponse{Proofs: map[string]*ProofList{}}
+	for mintUrl, mintProofs := range proofs {
+		resp.Proofs[mintUrl] = &ProofList{Proofs: proofsResponse(mintProofs)}
+	}
+	return resp, nil
+}
+
+func (s *MultiMintWalletServer) ListTransactions(ctx context.Context, req *MultiMintListTransactionsRequest) (*MultiMintListTransactionsResponse, error) {
+	transactions, err := s.wallet.ListTransactionsCtx(ctx, transactionDirection(req.Direction))
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &MultiMintListTransactionsResponse{Transactions: transactionsResponse(transactions)}, nil
+}
+
+func (s *MultiMintWalletServer) Melt(ctx context.Context, req *MultiMintMeltRequest) (*MultiMintMeltResponse, error) {
+	melted, err := s.wallet.MeltCtx(ctx, req.Bolt11, meltOptions(req.Options), optionalAmount(req.MaxFee))
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &MultiMintMeltResponse{Melted: meltedResponse(melted)}, nil
+}
+
+func (s *MultiMintWalletServer) MeltQuote(ctx context.Context, req *MultiMintMeltQuoteRequest) (*MultiMintMeltQuoteResponse, error) {
+	quote, err := s.wallet.MeltQuoteCtx(ctx, cdk.MintUrl{Url: req.MintUrl}, req.Request, meltOptions(req.Options))
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &MultiMintMeltQuoteResponse{Quote: meltQuoteResponse(quote)}, nil
+}
+
+func (s *MultiMintWalletServer) Mint(ctx context.Context, req *MultiMintMintRequest) (*MultiMintMintResponse, error) {
+	proofs, err := s.wallet.MintCtx(ctx, cdk.MintUrl{Url: req.MintUrl}, req.QuoteId, spendingConditions(req.SpendingConditions))
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &MultiMintMintResponse{Proofs: proofsResponse(proofs)}, nil
+}
+
+func (s *MultiMintWalletServer) MintQuote(ctx context.Context, req *MultiMintMintQuoteRequest) (*MultiMintMintQuoteResponse, error) {
+	quote, err := s.wallet.MintQuoteCtx(ctx, cdk.MintUrl{Url: req.MintUrl}, cdk.Amount{Value: req.Amount}, req.Description)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &MultiMintMintQuoteResponse{Quote: mintQuoteResponse(quote)}, nil
+}
+
+func (s *MultiMintWalletServer) GetUnit(ctx context.Context, req *MultiMintGetUnitRequest) (*MultiMintGetUnitResponse, error) {
+	return &MultiMintGetUnitResponse{Unit: cdk.CurrencyUnitString(s.wallet.Unit())}, nil
+}
+
+func (s *MultiMintWalletServer) PrepareSend(ctx context.Context, req *MultiMintPrepareSendRequest) (*MultiMintPrepareSendResponse, error) {
+	send, err := s.wallet.PrepareSendCtx(ctx, cdk.MintUrl{Url: req.MintUrl}, cdk.Amount{Value: req.Amount}, multiMintSendOptions(req.Options))
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	s.sends.add(send)
+	return &MultiMintPrepareSendResponse{Send: preparedSendResponse(send)}, nil
+}
+
+func (s *MultiMintWalletServer) ConfirmSend(ctx context.Context, req *MultiMintConfirmSendRequest) (*MultiMintConfirmSendResponse, error) {
+	token, err := s.sends.confirm(ctx, req.SendId, req.Memo)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiMintConfirmSendResponse{Token: token}, nil
+}
+
+func (s *MultiMintWalletServer) CancelSend(ctx context.Context, req *MultiMintCancelSendRequest) (*MultiMintCancelSendResponse, error) {
+	if err := s.sends.cancel(ctx, req.SendId); err != nil {
+		return nil, err
+	}
+	return &MultiMintCancelSendResponse{}, nil
+}
+
+func (s *MultiMintWalletServer) Receive(ctx context.Context, req *MultiMintReceiveRequest) (*MultiMintReceiveResponse, error) {
+	token, err := decodeToken(req.Token)
+	if err != nil {
+		return nil, err
+	}
+	defer token.Destroy()
+	amount, err := s.wallet.ReceiveCtx(ctx, token, multiMintReceiveOptions(req.Options))
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &MultiMintReceiveResponse{Amount: amount.Value}, nil
+}
+
+func (s *MultiMintWalletServer) RemoveMint(ctx context.Context, req *RemoveMintRequest) (*RemoveMintResponse, error) {
+	if err := s.wallet.RemoveMintCtx(ctx, cdk.MintUrl{Url: req.MintUrl}); err != nil {
+		return nil, rpcError(err)
+	}
+	return &RemoveMintResponse{}, nil
+}
+
+func (s *MultiMintWalletServer) Restore(ctx context.Context, req *MultiMintRestoreRequest) (*MultiMintRestoreResponse, error) {
+	amount, err := s.wallet.RestoreCtx(ctx, cdk.MintUrl{Url: req.MintUrl})
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &MultiMintRestoreResponse{Amount: amount.Value}, nil
+}
+
+func (s *MultiMintWalletServer) Swap(ctx context.Context, req *MultiMintSwapRequest) (*MultiMintSwapResponse, error) {
+	proofs, err := s.wallet.SwapCtx(ctx, optionalAmount(req.Amount), spendingConditions(req.SpendingConditions))
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	resp := &MultiMintSwapResponse{}
+	if proofs != nil {
+		resp.Proofs = proofsResponse(*proofs)
+	}
+	return resp, nil
+}
+
+func (s *MultiMintWalletServer) TotalBalance(ctx context.Context, req *MultiMintTotalBalanceRequest) (*MultiMintTotalBalanceResponse, error) {
+	amount, err := s.wallet.TotalBalanceCtx(ctx)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &MultiMintTotalBalanceResponse{Amount: amount.Value}, nil
+}
+
+func (s *MultiMintWalletServer) Transfer(ctx context.Context, req *TransferRequest) (*TransferResponse, error) {
+	var mode cdk.TransferMode = cdk.TransferModeFullBalance{}
+	if req.ExactReceiveAmount != nil {
+		mode = cdk.TransferModeExactReceive{Amount: cdk.Amount{Value: *req.ExactReceiveAmount}}
+	}
+	result, err := s.wallet.TransferCtx(ctx, cdk.MintUrl{Url: req.SourceMint}, cdk.MintUrl{Url: req.TargetMint}, mode)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &TransferResponse{
+		AmountSent:         result.AmountSent.Value,
+		AmountReceived:     result.AmountReceived.Value,
+		FeesPaid:           result.FeesPaid.Value,
+		SourceBalanceAfter: result.SourceBalanceAfter.Value,
+		TargetBalanceAfter: result.TargetBalanceAfter.Value,
+	}, nil
+}
+
+func (s *MultiMintWalletServer) VerifyTokenDleq(ctx context.Context, req *MultiMintVerifyTokenDleqRequest) (*MultiMintVerifyTokenDleqResponse, error) {
+	token, err := decodeToken(req.Token)
+	if err != nil {
+		return nil, err
+	}
+	defer token.Destroy()
+	if err := s.wallet.VerifyTokenDleqCtx(ctx, token); err != nil {
+		return nil, rpcError(err)
+	}
+	return &MultiMintVerifyTokenDleqResponse{}, nil
+}
+
+func (s *MultiMintWalletServer) WaitForMintQuote(ctx context.Context, req *WaitForMintQuoteRequest) (*WaitForMintQuoteResponse, error) {
+	proofs, err := s.wallet.WaitForMintQuoteCtx(ctx, cdk.MintUrl{Url: req.MintUrl}, req.QuoteId, splitTarget(req.SplitTarget), spendingConditions(req.SpendingConditions), req.TimeoutSecs)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &WaitForMintQuoteResponse{Proofs: proofsResponse(proofs)}, nil
+}
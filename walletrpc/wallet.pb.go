@@ -0,0 +1,9419 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: wallet.proto
+
+// gRPC surface of the cdkgo wallet. WalletService mirrors WalletInterface,
+// one single-mint wallet per mint_url; MultiMintWalletService mirrors
+// MultiMintWalletInterface.
+//
+// Amounts are in the unit of the wallet unless their name says otherwise.
+// Failed calls carry a google.rpc.ErrorInfo in the domain "cdk.cashu"
+// whose reason names the CDK error, for example INSUFFICIENT_FUNDS.
+
+package walletrpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type QuoteState int32
+
+const (
+	QuoteState_QUOTE_STATE_UNSPECIFIED QuoteState = 0
+	QuoteState_QUOTE_STATE_UNPAID      QuoteState = 1
+	QuoteState_QUOTE_STATE_PAID        QuoteState = 2
+	QuoteState_QUOTE_STATE_PENDING     QuoteState = 3
+	QuoteState_QUOTE_STATE_ISSUED      QuoteState = 4
+)
+
+// Enum value maps for QuoteState.
+var (
+	QuoteState_name = map[int32]string{
+		0: "QUOTE_STATE_UNSPECIFIED",
+		1: "QUOTE_STATE_UNPAID",
+		2: "QUOTE_STATE_PAID",
+		3: "QUOTE_STATE_PENDING",
+		4: "QUOTE_STATE_ISSUED",
+	}
+	QuoteState_value = map[string]int32{
+		"QUOTE_STATE_UNSPECIFIED": 0,
+		"QUOTE_STATE_UNPAID":      1,
+		"QUOTE_STATE_PAID":        2,
+		"QUOTE_STATE_PENDING":     3,
+		"QUOTE_STATE_ISSUED":      4,
+	}
+)
+
+func (x QuoteState) Enum() *QuoteState {
+	p := new(QuoteState)
+	*p = x
+	return p
+}
+
+func (x QuoteState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuoteState) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallet_proto_enumTypes[0].Descriptor()
+}
+
+func (QuoteState) Type() protoreflect.EnumType {
+	return &file_wallet_proto_enumTypes[0]
+}
+
+func (x QuoteState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuoteState.Descriptor instead.
+func (QuoteState) EnumDescriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{0}
+}
+
+type ProofState int32
+
+const (
+	ProofState_PROOF_STATE_UNSPECIFIED   ProofState = 0
+	ProofState_PROOF_STATE_UNSPENT       ProofState = 1
+	ProofState_PROOF_STATE_PENDING       ProofState = 2
+	ProofState_PROOF_STATE_SPENT         ProofState = 3
+	ProofState_PROOF_STATE_RESERVED      ProofState = 4
+	ProofState_PROOF_STATE_PENDING_SPENT ProofState = 5
+)
+
+// Enum value maps for ProofState.
+var (
+	ProofState_name = map[int32]string{
+		0: "PROOF_STATE_UNSPECIFIED",
+		1: "PROOF_STATE_UNSPENT",
+		2: "PROOF_STATE_PENDING",
+		3: "PROOF_STATE_SPENT",
+		4: "PROOF_STATE_RESERVED",
+		5: "PROOF_STATE_PENDING_SPENT",
+	}
+	ProofState_value = map[string]int32{
+		"PROOF_STATE_UNSPECIFIED":   0,
+		"PROOF_STATE_UNSPENT":       1,
+		"PROOF_STATE_PENDING":       2,
+		"PROOF_STATE_SPENT":         3,
+		"PROOF_STATE_RESERVED":      4,
+		"PROOF_STATE_PENDING_SPENT": 5,
+	}
+)
+
+func (x ProofState) Enum() *ProofState {
+	p := new(ProofState)
+	*p = x
+	return p
+}
+
+func (x ProofState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProofState) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallet_proto_enumTypes[1].Descriptor()
+}
+
+func (ProofState) Type() protoreflect.EnumType {
+	return &file_wallet_proto_enumTypes[1]
+}
+
+func (x ProofState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProofState.Descriptor instead.
+func (ProofState) EnumDescriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{1}
+}
+
+type TransactionDirection int32
+
+const (
+	TransactionDirection_TRANSACTION_DIRECTION_UNSPECIFIED TransactionDirection = 0
+	TransactionDirection_TRANSACTION_DIRECTION_INCOMING    TransactionDirection = 1
+	TransactionDirection_TRANSACTION_DIRECTION_OUTGOING    TransactionDirection = 2
+)
+
+// Enum value maps for TransactionDirection.
+var (
+	TransactionDirection_name = map[int32]string{
+		0: "TRANSACTION_DIRECTION_UNSPECIFIED",
+		1: "TRANSACTION_DIRECTION_INCOMING",
+		2: "TRANSACTION_DIRECTION_OUTGOING",
+	}
+	TransactionDirection_value = map[string]int32{
+		"TRANSACTION_DIRECTION_UNSPECIFIED": 0,
+		"TRANSACTION_DIRECTION_INCOMING":    1,
+		"TRANSACTION_DIRECTION_OUTGOING":    2,
+	}
+)
+
+func (x TransactionDirection) Enum() *TransactionDirection {
+	p := new(TransactionDirection)
+	*p = x
+	return p
+}
+
+func (x TransactionDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallet_proto_enumTypes[2].Descriptor()
+}
+
+func (TransactionDirection) Type() protoreflect.EnumType {
+	return &file_wallet_proto_enumTypes[2]
+}
+
+func (x TransactionDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionDirection.Descriptor instead.
+func (TransactionDirection) EnumDescriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{2}
+}
+
+type SubscriptionKind int32
+
+const (
+	SubscriptionKind_SUBSCRIPTION_KIND_UNSPECIFIED       SubscriptionKind = 0
+	SubscriptionKind_SUBSCRIPTION_KIND_BOLT11_MELT_QUOTE SubscriptionKind = 1
+	SubscriptionKind_SUBSCRIPTION_KIND_BOLT11_MINT_QUOTE SubscriptionKind = 2
+	SubscriptionKind_SUBSCRIPTION_KIND_BOLT12_MINT_QUOTE SubscriptionKind = 3
+	SubscriptionKind_SUBSCRIPTION_KIND_PROOF_STATE       SubscriptionKind = 4
+)
+
+// Enum value maps for SubscriptionKind.
+var (
+	SubscriptionKind_name = map[int32]string{
+		0: "SUBSCRIPTION_KIND_UNSPECIFIED",
+		1: "SUBSCRIPTION_KIND_BOLT11_MELT_QUOTE",
+		2: "SUBSCRIPTION_KIND_BOLT11_MINT_QUOTE",
+		3: "SUBSCRIPTION_KIND_BOLT12_MINT_QUOTE",
+		4: "SUBSCRIPTION_KIND_PROOF_STATE",
+	}
+	SubscriptionKind_value = map[string]int32{
+		"SUBSCRIPTION_KIND_UNSPECIFIED":       0,
+		"SUBSCRIPTION_KIND_BOLT11_MELT_QUOTE": 1,
+		"SUBSCRIPTION_KIND_BOLT11_MINT_QUOTE": 2,
+		"SUBSCRIPTION_KIND_BOLT12_MINT_QUOTE": 3,
+		"SUBSCRIPTION_KIND_PROOF_STATE":       4,
+	}
+)
+
+func (x SubscriptionKind) Enum() *SubscriptionKind {
+	p := new(SubscriptionKind)
+	*p = x
+	return p
+}
+
+func (x SubscriptionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubscriptionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallet_proto_enumTypes[3].Descriptor()
+}
+
+func (SubscriptionKind) Type() protoreflect.EnumType {
+	return &file_wallet_proto_enumTypes[3]
+}
+
+func (x SubscriptionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubscriptionKind.Descriptor instead.
+func (SubscriptionKind) EnumDescriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{3}
+}
+
+type SendKind_Mode int32
+
+const (
+	SendKind_MODE_ONLINE_EXACT      SendKind_Mode = 0
+	SendKind_MODE_ONLINE_TOLERANCE  SendKind_Mode = 1
+	SendKind_MODE_OFFLINE_EXACT     SendKind_Mode = 2
+	SendKind_MODE_OFFLINE_TOLERANCE SendKind_Mode = 3
+)
+
+// Enum value maps for SendKind_Mode.
+var (
+	SendKind_Mode_name = map[int32]string{
+		0: "MODE_ONLINE_EXACT",
+		1: "MODE_ONLINE_TOLERANCE",
+		2: "MODE_OFFLINE_EXACT",
+		3: "MODE_OFFLINE_TOLERANCE",
+	}
+	SendKind_Mode_value = map[string]int32{
+		"MODE_ONLINE_EXACT":      0,
+		"MODE_ONLINE_TOLERANCE":  1,
+		"MODE_OFFLINE_EXACT":     2,
+		"MODE_OFFLINE_TOLERANCE": 3,
+	}
+)
+
+func (x SendKind_Mode) Enum() *SendKind_Mode {
+	p := new(SendKind_Mode)
+	*p = x
+	return p
+}
+
+func (x SendKind_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SendKind_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallet_proto_enumTypes[4].Descriptor()
+}
+
+func (SendKind_Mode) Type() protoreflect.EnumType {
+	return &file_wallet_proto_enumTypes[4]
+}
+
+func (x SendKind_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SendKind_Mode.Descriptor instead.
+func (SendKind_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{13, 0}
+}
+
+type ProofDleq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	E             string                 `protobuf:"bytes,1,opt,name=e,proto3" json:"e,omitempty"`
+	S             string                 `protobuf:"bytes,2,opt,name=s,proto3" json:"s,omitempty"`
+	R             string                 `protobuf:"bytes,3,opt,name=r,proto3" json:"r,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProofDleq) Reset() {
+	*x = ProofDleq{}
+	mi := &file_wallet_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProofDleq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofDleq) ProtoMessage() {}
+
+func (x *ProofDleq) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofDleq.ProtoReflect.Descriptor instead.
+func (*ProofDleq) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProofDleq) GetE() string {
+	if x != nil {
+		return x.E
+	}
+	return ""
+}
+
+func (x *ProofDleq) GetS() string {
+	if x != nil {
+		return x.S
+	}
+	return ""
+}
+
+func (x *ProofDleq) GetR() string {
+	if x != nil {
+		return x.R
+	}
+	return ""
+}
+
+// Proof as serialized in tokens. y is only set in responses.
+type Proof struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeysetId      string                 `protobuf:"bytes,1,opt,name=keyset_id,json=keysetId,proto3" json:"keyset_id,omitempty"`
+	Amount        uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Secret        string                 `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	C             string                 `protobuf:"bytes,4,opt,name=c,proto3" json:"c,omitempty"`
+	Dleq          *ProofDleq             `protobuf:"bytes,5,opt,name=dleq,proto3" json:"dleq,omitempty"`
+	Y             string                 `protobuf:"bytes,6,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Proof) Reset() {
+	*x = Proof{}
+	mi := &file_wallet_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Proof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Proof) ProtoMessage() {}
+
+func (x *Proof) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Proof.ProtoReflect.Descriptor instead.
+func (*Proof) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Proof) GetKeysetId() string {
+	if x != nil {
+		return x.KeysetId
+	}
+	return ""
+}
+
+func (x *Proof) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Proof) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Proof) GetC() string {
+	if x != nil {
+		return x.C
+	}
+	return ""
+}
+
+func (x *Proof) GetDleq() *ProofDleq {
+	if x != nil {
+		return x.Dleq
+	}
+	return nil
+}
+
+func (x *Proof) GetY() string {
+	if x != nil {
+		return x.Y
+	}
+	return ""
+}
+
+type AuthProof struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeysetId      string                 `protobuf:"bytes,1,opt,name=keyset_id,json=keysetId,proto3" json:"keyset_id,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	C             string                 `protobuf:"bytes,3,opt,name=c,proto3" json:"c,omitempty"`
+	Y             string                 `protobuf:"bytes,4,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthProof) Reset() {
+	*x = AuthProof{}
+	mi := &file_wallet_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthProof) ProtoMessage() {}
+
+func (x *AuthProof) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthProof.ProtoReflect.Descriptor instead.
+func (*AuthProof) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AuthProof) GetKeysetId() string {
+	if x != nil {
+		return x.KeysetId
+	}
+	return ""
+}
+
+func (x *AuthProof) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *AuthProof) GetC() string {
+	if x != nil {
+		return x.C
+	}
+	return ""
+}
+
+func (x *AuthProof) GetY() string {
+	if x != nil {
+		return x.Y
+	}
+	return ""
+}
+
+type KeySetInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Unit          string                 `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	Active        bool                   `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	InputFeePpk   uint64                 `protobuf:"varint,4,opt,name=input_fee_ppk,json=inputFeePpk,proto3" json:"input_fee_ppk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeySetInfo) Reset() {
+	*x = KeySetInfo{}
+	mi := &file_wallet_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeySetInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeySetInfo) ProtoMessage() {}
+
+func (x *KeySetInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeySetInfo.ProtoReflect.Descriptor instead.
+func (*KeySetInfo) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *KeySetInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *KeySetInfo) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *KeySetInfo) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *KeySetInfo) GetInputFeePpk() uint64 {
+	if x != nil {
+		return x.InputFeePpk
+	}
+	return 0
+}
+
+type MintQuote struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount       *uint64                `protobuf:"varint,2,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	Unit         string                 `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	Request      string                 `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
+	State        QuoteState             `protobuf:"varint,5,opt,name=state,proto3,enum=cdkgo.wallet.v1.QuoteState" json:"state,omitempty"`
+	Expiry       uint64                 `protobuf:"varint,6,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	MintUrl      string                 `protobuf:"bytes,7,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	AmountIssued uint64                 `protobuf:"varint,8,opt,name=amount_issued,json=amountIssued,proto3" json:"amount_issued,omitempty"`
+	AmountPaid   uint64                 `protobuf:"varint,9,opt,name=amount_paid,json=amountPaid,proto3" json:"amount_paid,omitempty"`
+	// bolt11, bolt12 or a custom method
+	PaymentMethod string `protobuf:"bytes,10,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	// Key locking the quote (NUT-20)
+	SecretKey     *string `protobuf:"bytes,11,opt,name=secret_key,json=secretKey,proto3,oneof" json:"secret_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MintQuote) Reset() {
+	*x = MintQuote{}
+	mi := &file_wallet_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintQuote) ProtoMessage() {}
+
+func (x *MintQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintQuote.ProtoReflect.Descriptor instead.
+func (*MintQuote) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MintQuote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MintQuote) GetAmount() uint64 {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return 0
+}
+
+func (x *MintQuote) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *MintQuote) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *MintQuote) GetState() QuoteState {
+	if x != nil {
+		return x.State
+	}
+	return QuoteState_QUOTE_STATE_UNSPECIFIED
+}
+
+func (x *MintQuote) GetExpiry() uint64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *MintQuote) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *MintQuote) GetAmountIssued() uint64 {
+	if x != nil {
+		return x.AmountIssued
+	}
+	return 0
+}
+
+func (x *MintQuote) GetAmountPaid() uint64 {
+	if x != nil {
+		return x.AmountPaid
+	}
+	return 0
+}
+
+func (x *MintQuote) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *MintQuote) GetSecretKey() string {
+	if x != nil && x.SecretKey != nil {
+		return *x.SecretKey
+	}
+	return ""
+}
+
+type MeltQuote struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount          uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Unit            string                 `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	Request         string                 `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
+	FeeReserve      uint64                 `protobuf:"varint,5,opt,name=fee_reserve,json=feeReserve,proto3" json:"fee_reserve,omitempty"`
+	State           QuoteState             `protobuf:"varint,6,opt,name=state,proto3,enum=cdkgo.wallet.v1.QuoteState" json:"state,omitempty"`
+	Expiry          uint64                 `protobuf:"varint,7,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	PaymentPreimage *string                `protobuf:"bytes,8,opt,name=payment_preimage,json=paymentPreimage,proto3,oneof" json:"payment_preimage,omitempty"`
+	PaymentMethod   string                 `protobuf:"bytes,9,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MeltQuote) Reset() {
+	*x = MeltQuote{}
+	mi := &file_wallet_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeltQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeltQuote) ProtoMessage() {}
+
+func (x *MeltQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeltQuote.ProtoReflect.Descriptor instead.
+func (*MeltQuote) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MeltQuote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MeltQuote) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *MeltQuote) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *MeltQuote) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *MeltQuote) GetFeeReserve() uint64 {
+	if x != nil {
+		return x.FeeReserve
+	}
+	return 0
+}
+
+func (x *MeltQuote) GetState() QuoteState {
+	if x != nil {
+		return x.State
+	}
+	return QuoteState_QUOTE_STATE_UNSPECIFIED
+}
+
+func (x *MeltQuote) GetExpiry() uint64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *MeltQuote) GetPaymentPreimage() string {
+	if x != nil && x.PaymentPreimage != nil {
+		return *x.PaymentPreimage
+	}
+	return ""
+}
+
+func (x *MeltQuote) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+type Melted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         QuoteState             `protobuf:"varint,1,opt,name=state,proto3,enum=cdkgo.wallet.v1.QuoteState" json:"state,omitempty"`
+	Preimage      *string                `protobuf:"bytes,2,opt,name=preimage,proto3,oneof" json:"preimage,omitempty"`
+	Change        []*Proof               `protobuf:"bytes,3,rep,name=change,proto3" json:"change,omitempty"`
+	Amount        uint64                 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	FeePaid       uint64                 `protobuf:"varint,5,opt,name=fee_paid,json=feePaid,proto3" json:"fee_paid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Melted) Reset() {
+	*x = Melted{}
+	mi := &file_wallet_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Melted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Melted) ProtoMessage() {}
+
+func (x *Melted) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Melted.ProtoReflect.Descriptor instead.
+func (*Melted) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Melted) GetState() QuoteState {
+	if x != nil {
+		return x.State
+	}
+	return QuoteState_QUOTE_STATE_UNSPECIFIED
+}
+
+func (x *Melted) GetPreimage() string {
+	if x != nil && x.Preimage != nil {
+		return *x.Preimage
+	}
+	return ""
+}
+
+func (x *Melted) GetChange() []*Proof {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+func (x *Melted) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Melted) GetFeePaid() uint64 {
+	if x != nil {
+		return x.FeePaid
+	}
+	return 0
+}
+
+type Transaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MintUrl       string                 `protobuf:"bytes,2,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	Direction     TransactionDirection   `protobuf:"varint,3,opt,name=direction,proto3,enum=cdkgo.wallet.v1.TransactionDirection" json:"direction,omitempty"`
+	Amount        uint64                 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee           uint64                 `protobuf:"varint,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	Unit          string                 `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`
+	Ys            []string               `protobuf:"bytes,7,rep,name=ys,proto3" json:"ys,omitempty"`
+	Timestamp     uint64                 `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Memo          *string                `protobuf:"bytes,9,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	QuoteId       *string                `protobuf:"bytes,11,opt,name=quote_id,json=quoteId,proto3,oneof" json:"quote_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	mi := &file_wallet_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Transaction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Transaction) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *Transaction) GetDirection() TransactionDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return TransactionDirection_TRANSACTION_DIRECTION_UNSPECIFIED
+}
+
+func (x *Transaction) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Transaction) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *Transaction) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *Transaction) GetYs() []string {
+	if x != nil {
+		return x.Ys
+	}
+	return nil
+}
+
+func (x *Transaction) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Transaction) GetMemo() string {
+	if x != nil && x.Memo != nil {
+		return *x.Memo
+	}
+	return ""
+}
+
+func (x *Transaction) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Transaction) GetQuoteId() string {
+	if x != nil && x.QuoteId != nil {
+		return *x.QuoteId
+	}
+	return ""
+}
+
+type AmountList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amounts       []uint64               `protobuf:"varint,1,rep,packed,name=amounts,proto3" json:"amounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AmountList) Reset() {
+	*x = AmountList{}
+	mi := &file_wallet_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AmountList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmountList) ProtoMessage() {}
+
+func (x *AmountList) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmountList.ProtoReflect.Descriptor instead.
+func (*AmountList) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AmountList) GetAmounts() []uint64 {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+// Denominations of the new proofs. Unset lets the wallet choose.
+type SplitTarget struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*SplitTarget_Value
+	//	*SplitTarget_Values
+	Target        isSplitTarget_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SplitTarget) Reset() {
+	*x = SplitTarget{}
+	mi := &file_wallet_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitTarget) ProtoMessage() {}
+
+func (x *SplitTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitTarget.ProtoReflect.Descriptor instead.
+func (*SplitTarget) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SplitTarget) GetTarget() isSplitTarget_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *SplitTarget) GetValue() uint64 {
+	if x != nil {
+		if x, ok := x.Target.(*SplitTarget_Value); ok {
+			return x.Value
+		}
+	}
+	return 0
+}
+
+func (x *SplitTarget) GetValues() *AmountList {
+	if x != nil {
+		if x, ok := x.Target.(*SplitTarget_Values); ok {
+			return x.Values
+		}
+	}
+	return nil
+}
+
+type isSplitTarget_Target interface {
+	isSplitTarget_Target()
+}
+
+type SplitTarget_Value struct {
+	Value uint64 `protobuf:"varint,1,opt,name=value,proto3,oneof"`
+}
+
+type SplitTarget_Values struct {
+	Values *AmountList `protobuf:"bytes,2,opt,name=values,proto3,oneof"`
+}
+
+func (*SplitTarget_Value) isSplitTarget_Target() {}
+
+func (*SplitTarget_Values) isSplitTarget_Target() {}
+
+type MeltOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Option:
+	//
+	//	*MeltOptions_MppAmount
+	//	*MeltOptions_AmountlessAmountMsat
+	Option        isMeltOptions_Option `protobuf_oneof:"option"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MeltOptions) Reset() {
+	*x = MeltOptions{}
+	mi := &file_wallet_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeltOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeltOptions) ProtoMessage() {}
+
+func (x *MeltOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeltOptions.ProtoReflect.Descriptor instead.
+func (*MeltOptions) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MeltOptions) GetOption() isMeltOptions_Option {
+	if x != nil {
+		return x.Option
+	}
+	return nil
+}
+
+func (x *MeltOptions) GetMppAmount() uint64 {
+	if x != nil {
+		if x, ok := x.Option.(*MeltOptions_MppAmount); ok {
+			return x.MppAmount
+		}
+	}
+	return 0
+}
+
+func (x *MeltOptions) GetAmountlessAmountMsat() uint64 {
+	if x != nil {
+		if x, ok := x.Option.(*MeltOptions_AmountlessAmountMsat); ok {
+			return x.AmountlessAmountMsat
+		}
+	}
+	return 0
+}
+
+type isMeltOptions_Option interface {
+	isMeltOptions_Option()
+}
+
+type MeltOptions_MppAmount struct {
+	// Partial amount of a multi-path payment
+	MppAmount uint64 `protobuf:"varint,1,opt,name=mpp_amount,json=mppAmount,proto3,oneof"`
+}
+
+type MeltOptions_AmountlessAmountMsat struct {
+	// Amount to pay to an amountless invoice
+	AmountlessAmountMsat uint64 `protobuf:"varint,2,opt,name=amountless_amount_msat,json=amountlessAmountMsat,proto3,oneof"`
+}
+
+func (*MeltOptions_MppAmount) isMeltOptions_Option() {}
+
+func (*MeltOptions_AmountlessAmountMsat) isMeltOptions_Option() {}
+
+type Conditions struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Locktime   *uint64                `protobuf:"varint,1,opt,name=locktime,proto3,oneof" json:"locktime,omitempty"`
+	Pubkeys    []string               `protobuf:"bytes,2,rep,name=pubkeys,proto3" json:"pubkeys,omitempty"`
+	RefundKeys []string               `protobuf:"bytes,3,rep,name=refund_keys,json=refundKeys,proto3" json:"refund_keys,omitempty"`
+	NumSigs    *uint64                `protobuf:"varint,4,opt,name=num_sigs,json=numSigs,proto3,oneof" json:"num_sigs,omitempty"`
+	// 0 for SIG_INPUTS, 1 for SIG_ALL
+	SigFlag       uint32  `protobuf:"varint,5,opt,name=sig_flag,json=sigFlag,proto3" json:"sig_flag,omitempty"`
+	NumSigsRefund *uint64 `protobuf:"varint,6,opt,name=num_sigs_refund,json=numSigsRefund,proto3,oneof" json:"num_sigs_refund,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Conditions) Reset() {
+	*x = Conditions{}
+	mi := &file_wallet_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Conditions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Conditions) ProtoMessage() {}
+
+func (x *Conditions) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Conditions.ProtoReflect.Descriptor instead.
+func (*Conditions) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Conditions) GetLocktime() uint64 {
+	if x != nil && x.Locktime != nil {
+		return *x.Locktime
+	}
+	return 0
+}
+
+func (x *Conditions) GetPubkeys() []string {
+	if x != nil {
+		return x.Pubkeys
+	}
+	return nil
+}
+
+func (x *Conditions) GetRefundKeys() []string {
+	if x != nil {
+		return x.RefundKeys
+	}
+	return nil
+}
+
+func (x *Conditions) GetNumSigs() uint64 {
+	if x != nil && x.NumSigs != nil {
+		return *x.NumSigs
+	}
+	return 0
+}
+
+func (x *Conditions) GetSigFlag() uint32 {
+	if x != nil {
+		return x.SigFlag
+	}
+	return 0
+}
+
+func (x *Conditions) GetNumSigsRefund() uint64 {
+	if x != nil && x.NumSigsRefund != nil {
+		return *x.NumSigsRefund
+	}
+	return 0
+}
+
+type SpendingConditions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*SpendingConditions_P2Pk_
+	//	*SpendingConditions_Htlc_
+	Kind          isSpendingConditions_Kind `protobuf_oneof:"kind"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpendingConditions) Reset() {
+	*x = SpendingConditions{}
+	mi := &file_wallet_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpendingConditions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendingConditions) ProtoMessage() {}
+
+func (x *SpendingConditions) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendingConditions.ProtoReflect.Descriptor instead.
+func (*SpendingConditions) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SpendingConditions) GetKind() isSpendingConditions_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *SpendingConditions) GetP2Pk() *SpendingConditions_P2Pk {
+	if x != nil {
+		if x, ok := x.Kind.(*SpendingConditions_P2Pk_); ok {
+			return x.P2Pk
+		}
+	}
+	return nil
+}
+
+func (x *SpendingConditions) GetHtlc() *SpendingConditions_Htlc {
+	if x != nil {
+		if x, ok := x.Kind.(*SpendingConditions_Htlc_); ok {
+			return x.Htlc
+		}
+	}
+	return nil
+}
+
+type isSpendingConditions_Kind interface {
+	isSpendingConditions_Kind()
+}
+
+type SpendingConditions_P2Pk_ struct {
+	P2Pk *SpendingConditions_P2Pk `protobuf:"bytes,1,opt,name=p2pk,proto3,oneof"`
+}
+
+type SpendingConditions_Htlc_ struct {
+	Htlc *SpendingConditions_Htlc `protobuf:"bytes,2,opt,name=htlc,proto3,oneof"`
+}
+
+func (*SpendingConditions_P2Pk_) isSpendingConditions_Kind() {}
+
+func (*SpendingConditions_Htlc_) isSpendingConditions_Kind() {}
+
+type SendKind struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Mode  SendKind_Mode          `protobuf:"varint,1,opt,name=mode,proto3,enum=cdkgo.wallet.v1.SendKind_Mode" json:"mode,omitempty"`
+	// Extra amount accepted by the tolerance modes
+	Tolerance     uint64 `protobuf:"varint,2,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendKind) Reset() {
+	*x = SendKind{}
+	mi := &file_wallet_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendKind) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendKind) ProtoMessage() {}
+
+func (x *SendKind) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendKind.ProtoReflect.Descriptor instead.
+func (*SendKind) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SendKind) GetMode() SendKind_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return SendKind_MODE_ONLINE_EXACT
+}
+
+func (x *SendKind) GetTolerance() uint64 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
+}
+
+type SendOptions struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Memo              *string                `protobuf:"bytes,1,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	IncludeMemo       bool                   `protobuf:"varint,2,opt,name=include_memo,json=includeMemo,proto3" json:"include_memo,omitempty"`
+	Conditions        *SpendingConditions    `protobuf:"bytes,3,opt,name=conditions,proto3" json:"conditions,omitempty"`
+	AmountSplitTarget *SplitTarget           `protobuf:"bytes,4,opt,name=amount_split_target,json=amountSplitTarget,proto3" json:"amount_split_target,omitempty"`
+	SendKind          *SendKind              `protobuf:"bytes,5,opt,name=send_kind,json=sendKind,proto3" json:"send_kind,omitempty"`
+	IncludeFee        bool                   `protobuf:"varint,6,opt,name=include_fee,json=includeFee,proto3" json:"include_fee,omitempty"`
+	MaxProofs         *uint32                `protobuf:"varint,7,opt,name=max_proofs,json=maxProofs,proto3,oneof" json:"max_proofs,omitempty"`
+	Metadata          map[string]string      `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SendOptions) Reset() {
+	*x = SendOptions{}
+	mi := &file_wallet_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendOptions) ProtoMessage() {}
+
+func (x *SendOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendOptions.ProtoReflect.Descriptor instead.
+func (*SendOptions) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SendOptions) GetMemo() string {
+	if x != nil && x.Memo != nil {
+		return *x.Memo
+	}
+	return ""
+}
+
+func (x *SendOptions) GetIncludeMemo() bool {
+	if x != nil {
+		return x.IncludeMemo
+	}
+	return false
+}
+
+func (x *SendOptions) GetConditions() *SpendingConditions {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *SendOptions) GetAmountSplitTarget() *SplitTarget {
+	if x != nil {
+		return x.AmountSplitTarget
+	}
+	return nil
+}
+
+func (x *SendOptions) GetSendKind() *SendKind {
+	if x != nil {
+		return x.SendKind
+	}
+	return nil
+}
+
+func (x *SendOptions) GetIncludeFee() bool {
+	if x != nil {
+		return x.IncludeFee
+	}
+	return false
+}
+
+func (x *SendOptions) GetMaxProofs() uint32 {
+	if x != nil && x.MaxProofs != nil {
+		return *x.MaxProofs
+	}
+	return 0
+}
+
+func (x *SendOptions) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ReceiveOptions struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AmountSplitTarget *SplitTarget           `protobuf:"bytes,1,opt,name=amount_split_target,json=amountSplitTarget,proto3" json:"amount_split_target,omitempty"`
+	// Hex secret keys
+	P2PkSigningKeys []string          `protobuf:"bytes,2,rep,name=p2pk_signing_keys,json=p2pkSigningKeys,proto3" json:"p2pk_signing_keys,omitempty"`
+	Preimages       []string          `protobuf:"bytes,3,rep,name=preimages,proto3" json:"preimages,omitempty"`
+	Metadata        map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReceiveOptions) Reset() {
+	*x = ReceiveOptions{}
+	mi := &file_wallet_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveOptions) ProtoMessage() {}
+
+func (x *ReceiveOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveOptions.ProtoReflect.Descriptor instead.
+func (*ReceiveOptions) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReceiveOptions) GetAmountSplitTarget() *SplitTarget {
+	if x != nil {
+		return x.AmountSplitTarget
+	}
+	return nil
+}
+
+func (x *ReceiveOptions) GetP2PkSigningKeys() []string {
+	if x != nil {
+		return x.P2PkSigningKeys
+	}
+	return nil
+}
+
+func (x *ReceiveOptions) GetPreimages() []string {
+	if x != nil {
+		return x.Preimages
+	}
+	return nil
+}
+
+func (x *ReceiveOptions) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type MultiMintSendOptions struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AllowTransfer     bool                   `protobuf:"varint,1,opt,name=allow_transfer,json=allowTransfer,proto3" json:"allow_transfer,omitempty"`
+	MaxTransferAmount *uint64                `protobuf:"varint,2,opt,name=max_transfer_amount,json=maxTransferAmount,proto3,oneof" json:"max_transfer_amount,omitempty"`
+	AllowedMints      []string               `protobuf:"bytes,3,rep,name=allowed_mints,json=allowedMints,proto3" json:"allowed_mints,omitempty"`
+	ExcludedMints     []string               `protobuf:"bytes,4,rep,name=excluded_mints,json=excludedMints,proto3" json:"excluded_mints,omitempty"`
+	SendOptions       *SendOptions           `protobuf:"bytes,5,opt,name=send_options,json=sendOptions,proto3" json:"send_options,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MultiMintSendOptions) Reset() {
+	*x = MultiMintSendOptions{}
+	mi := &file_wallet_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintSendOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintSendOptions) ProtoMessage() {}
+
+func (x *MultiMintSendOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintSendOptions.ProtoReflect.Descriptor instead.
+func (*MultiMintSendOptions) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MultiMintSendOptions) GetAllowTransfer() bool {
+	if x != nil {
+		return x.AllowTransfer
+	}
+	return false
+}
+
+func (x *MultiMintSendOptions) GetMaxTransferAmount() uint64 {
+	if x != nil && x.MaxTransferAmount != nil {
+		return *x.MaxTransferAmount
+	}
+	return 0
+}
+
+func (x *MultiMintSendOptions) GetAllowedMints() []string {
+	if x != nil {
+		return x.AllowedMints
+	}
+	return nil
+}
+
+func (x *MultiMintSendOptions) GetExcludedMints() []string {
+	if x != nil {
+		return x.ExcludedMints
+	}
+	return nil
+}
+
+func (x *MultiMintSendOptions) GetSendOptions() *SendOptions {
+	if x != nil {
+		return x.SendOptions
+	}
+	return nil
+}
+
+type MultiMintReceiveOptions struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AllowUntrusted bool                   `protobuf:"varint,1,opt,name=allow_untrusted,json=allowUntrusted,proto3" json:"allow_untrusted,omitempty"`
+	TransferToMint *string                `protobuf:"bytes,2,opt,name=transfer_to_mint,json=transferToMint,proto3,oneof" json:"transfer_to_mint,omitempty"`
+	ReceiveOptions *ReceiveOptions        `protobuf:"bytes,3,opt,name=receive_options,json=receiveOptions,proto3" json:"receive_options,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MultiMintReceiveOptions) Reset() {
+	*x = MultiMintReceiveOptions{}
+	mi := &file_wallet_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintReceiveOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintReceiveOptions) ProtoMessage() {}
+
+func (x *MultiMintReceiveOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintReceiveOptions.ProtoReflect.Descriptor instead.
+func (*MultiMintReceiveOptions) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MultiMintReceiveOptions) GetAllowUntrusted() bool {
+	if x != nil {
+		return x.AllowUntrusted
+	}
+	return false
+}
+
+func (x *MultiMintReceiveOptions) GetTransferToMint() string {
+	if x != nil && x.TransferToMint != nil {
+		return *x.TransferToMint
+	}
+	return ""
+}
+
+func (x *MultiMintReceiveOptions) GetReceiveOptions() *ReceiveOptions {
+	if x != nil {
+		return x.ReceiveOptions
+	}
+	return nil
+}
+
+type PreparedSend struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount        uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee           uint64                 `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	Proofs        []*Proof               `protobuf:"bytes,4,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreparedSend) Reset() {
+	*x = PreparedSend{}
+	mi := &file_wallet_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreparedSend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreparedSend) ProtoMessage() {}
+
+func (x *PreparedSend) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreparedSend.ProtoReflect.Descriptor instead.
+func (*PreparedSend) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PreparedSend) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PreparedSend) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PreparedSend) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *PreparedSend) GetProofs() []*Proof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+type CalculateFeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	ProofCount    uint32                 `protobuf:"varint,2,opt,name=proof_count,json=proofCount,proto3" json:"proof_count,omitempty"`
+	KeysetId      string                 `protobuf:"bytes,3,opt,name=keyset_id,json=keysetId,proto3" json:"keyset_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateFeeRequest) Reset() {
+	*x = CalculateFeeRequest{}
+	mi := &file_wallet_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateFeeRequest) ProtoMessage() {}
+
+func (x *CalculateFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateFeeRequest.ProtoReflect.Descriptor instead.
+func (*CalculateFeeRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CalculateFeeRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *CalculateFeeRequest) GetProofCount() uint32 {
+	if x != nil {
+		return x.ProofCount
+	}
+	return 0
+}
+
+func (x *CalculateFeeRequest) GetKeysetId() string {
+	if x != nil {
+		return x.KeysetId
+	}
+	return ""
+}
+
+type CalculateFeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fee           uint64                 `protobuf:"varint,1,opt,name=fee,proto3" json:"fee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateFeeResponse) Reset() {
+	*x = CalculateFeeResponse{}
+	mi := &file_wallet_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateFeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateFeeResponse) ProtoMessage() {}
+
+func (x *CalculateFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateFeeResponse.ProtoReflect.Descriptor instead.
+func (*CalculateFeeResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CalculateFeeResponse) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+type CheckAllPendingProofsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAllPendingProofsRequest) Reset() {
+	*x = CheckAllPendingProofsRequest{}
+	mi := &file_wallet_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAllPendingProofsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAllPendingProofsRequest) ProtoMessage() {}
+
+func (x *CheckAllPendingProofsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAllPendingProofsRequest.ProtoReflect.Descriptor instead.
+func (*CheckAllPendingProofsRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CheckAllPendingProofsRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+type CheckAllPendingProofsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Amount of the pending proofs that were reclaimed
+	Amount        uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAllPendingProofsResponse) Reset() {
+	*x = CheckAllPendingProofsResponse{}
+	mi := &file_wallet_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAllPendingProofsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAllPendingProofsResponse) ProtoMessage() {}
+
+func (x *CheckAllPendingProofsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAllPendingProofsResponse.ProtoReflect.Descriptor instead.
+func (*CheckAllPendingProofsResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CheckAllPendingProofsResponse) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type CheckProofsSpentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	Proofs        []*Proof               `protobuf:"bytes,2,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckProofsSpentRequest) Reset() {
+	*x = CheckProofsSpentRequest{}
+	mi := &file_wallet_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckProofsSpentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckProofsSpentRequest) ProtoMessage() {}
+
+func (x *CheckProofsSpentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckProofsSpentRequest.ProtoReflect.Descriptor instead.
+func (*CheckProofsSpentRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CheckProofsSpentRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *CheckProofsSpentRequest) GetProofs() []*Proof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+type CheckProofsSpentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether each proof is spent, in the order of the request
+	Spent         []bool `protobuf:"varint,1,rep,packed,name=spent,proto3" json:"spent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckProofsSpentResponse) Reset() {
+	*x = CheckProofsSpentResponse{}
+	mi := &file_wallet_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckProofsSpentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckProofsSpentResponse) ProtoMessage() {}
+
+func (x *CheckProofsSpentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckProofsSpentResponse.ProtoReflect.Descriptor instead.
+func (*CheckProofsSpentResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CheckProofsSpentResponse) GetSpent() []bool {
+	if x != nil {
+		return x.Spent
+	}
+	return nil
+}
+
+type GetActiveKeysetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActiveKeysetRequest) Reset() {
+	*x = GetActiveKeysetRequest{}
+	mi := &file_wallet_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveKeysetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveKeysetRequest) ProtoMessage() {}
+
+func (x *GetActiveKeysetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveKeysetRequest.ProtoReflect.Descriptor instead.
+func (*GetActiveKeysetRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetActiveKeysetRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+type GetActiveKeysetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyset        *KeySetInfo            `protobuf:"bytes,1,opt,name=keyset,proto3" json:"keyset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActiveKeysetResponse) Reset() {
+	*x = GetActiveKeysetResponse{}
+	mi := &file_wallet_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveKeysetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveKeysetResponse) ProtoMessage() {}
+
+func (x *GetActiveKeysetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveKeysetResponse.ProtoReflect.Descriptor instead.
+func (*GetActiveKeysetResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetActiveKeysetResponse) GetKeyset() *KeySetInfo {
+	if x != nil {
+		return x.Keyset
+	}
+	return nil
+}
+
+type GetKeysetFeesByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	KeysetId      string                 `protobuf:"bytes,2,opt,name=keyset_id,json=keysetId,proto3" json:"keyset_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKeysetFeesByIdRequest) Reset() {
+	*x = GetKeysetFeesByIdRequest{}
+	mi := &file_wallet_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKeysetFeesByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeysetFeesByIdRequest) ProtoMessage() {}
+
+func (x *GetKeysetFeesByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKeysetFeesByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetKeysetFeesByIdRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetKeysetFeesByIdRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *GetKeysetFeesByIdRequest) GetKeysetId() string {
+	if x != nil {
+		return x.KeysetId
+	}
+	return ""
+}
+
+type GetKeysetFeesByIdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InputFeePpk   uint64                 `protobuf:"varint,1,opt,name=input_fee_ppk,json=inputFeePpk,proto3" json:"input_fee_ppk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKeysetFeesByIdResponse) Reset() {
+	*x = GetKeysetFeesByIdResponse{}
+	mi := &file_wallet_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKeysetFeesByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeysetFeesByIdResponse) ProtoMessage() {}
+
+func (x *GetKeysetFeesByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKeysetFeesByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetKeysetFeesByIdResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetKeysetFeesByIdResponse) GetInputFeePpk() uint64 {
+	if x != nil {
+		return x.InputFeePpk
+	}
+	return 0
+}
+
+type GetMintInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMintInfoRequest) Reset() {
+	*x = GetMintInfoRequest{}
+	mi := &file_wallet_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMintInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMintInfoRequest) ProtoMessage() {}
+
+func (x *GetMintInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMintInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetMintInfoRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetMintInfoRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+type GetMintInfoResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// NUT-06 mint info as JSON, empty if the mint has none
+	MintInfoJson  string `protobuf:"bytes,1,opt,name=mint_info_json,json=mintInfoJson,proto3" json:"mint_info_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMintInfoResponse) Reset() {
+	*x = GetMintInfoResponse{}
+	mi := &file_wallet_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMintInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMintInfoResponse) ProtoMessage() {}
+
+func (x *GetMintInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMintInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetMintInfoResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetMintInfoResponse) GetMintInfoJson() string {
+	if x != nil {
+		return x.MintInfoJson
+	}
+	return ""
+}
+
+type GetProofsByStatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	States        []ProofState           `protobuf:"varint,2,rep,packed,name=states,proto3,enum=cdkgo.wallet.v1.ProofState" json:"states,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProofsByStatesRequest) Reset() {
+	*x = GetProofsByStatesRequest{}
+	mi := &file_wallet_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProofsByStatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProofsByStatesRequest) ProtoMessage() {}
+
+func (x *GetProofsByStatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProofsByStatesRequest.ProtoReflect.Descriptor instead.
+func (*GetProofsByStatesRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetProofsByStatesRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *GetProofsByStatesRequest) GetStates() []ProofState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+type GetProofsByStatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proofs        []*Proof               `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProofsByStatesResponse) Reset() {
+	*x = GetProofsByStatesResponse{}
+	mi := &file_wallet_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProofsByStatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProofsByStatesResponse) ProtoMessage() {}
+
+func (x *GetProofsByStatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProofsByStatesResponse.ProtoReflect.Descriptor instead.
+func (*GetProofsByStatesResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetProofsByStatesResponse) GetProofs() []*Proof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+type GetTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionRequest) Reset() {
+	*x = GetTransactionRequest{}
+	mi := &file_wallet_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionRequest) ProtoMessage() {}
+
+func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetTransactionRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *GetTransactionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionResponse) Reset() {
+	*x = GetTransactionResponse{}
+	mi := &file_wallet_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionResponse) ProtoMessage() {}
+
+func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetTransactionResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type GetUnspentAuthProofsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnspentAuthProofsRequest) Reset() {
+	*x = GetUnspentAuthProofsRequest{}
+	mi := &file_wallet_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnspentAuthProofsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnspentAuthProofsRequest) ProtoMessage() {}
+
+func (x *GetUnspentAuthProofsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnspentAuthProofsRequest.ProtoReflect.Descriptor instead.
+func (*GetUnspentAuthProofsRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetUnspentAuthProofsRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+type GetUnspentAuthProofsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proofs        []*AuthProof           `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnspentAuthProofsResponse) Reset() {
+	*x = GetUnspentAuthProofsResponse{}
+	mi := &file_wallet_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnspentAuthProofsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnspentAuthProofsResponse) ProtoMessage() {}
+
+func (x *GetUnspentAuthProofsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnspentAuthProofsResponse.ProtoReflect.Descriptor instead.
+func (*GetUnspentAuthProofsResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetUnspentAuthProofsResponse) GetProofs() []*AuthProof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+type ListTransactionsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	// Unspecified lists both directions
+	Direction     TransactionDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=cdkgo.wallet.v1.TransactionDirection" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsRequest) Reset() {
+	*x = ListTransactionsRequest{}
+	mi := &file_wallet_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsRequest) ProtoMessage() {}
+
+func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListTransactionsRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetDirection() TransactionDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return TransactionDirection_TRANSACTION_DIRECTION_UNSPECIFIED
+}
+
+type ListTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsResponse) Reset() {
+	*x = ListTransactionsResponse{}
+	mi := &file_wallet_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsResponse) ProtoMessage() {}
+
+func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type MeltRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	QuoteId       string                 `protobuf:"bytes,2,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MeltRequest) Reset() {
+	*x = MeltRequest{}
+	mi := &file_wallet_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeltRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeltRequest) ProtoMessage() {}
+
+func (x *MeltRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeltRequest.ProtoReflect.Descriptor instead.
+func (*MeltRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *MeltRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *MeltRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+type MeltResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Melted        *Melted                `protobuf:"bytes,1,opt,name=melted,proto3" json:"melted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MeltResponse) Reset() {
+	*x = MeltResponse{}
+	mi := &file_wallet_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeltResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeltResponse) ProtoMessage() {}
+
+func (x *MeltResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeltResponse.ProtoReflect.Descriptor instead.
+func (*MeltResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *MeltResponse) GetMelted() *Melted {
+	if x != nil {
+		return x.Melted
+	}
+	return nil
+}
+
+type MeltBip353QuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	Bip353Address string                 `protobuf:"bytes,2,opt,name=bip353_address,json=bip353Address,proto3" json:"bip353_address,omitempty"`
+	AmountMsat    uint64                 `protobuf:"varint,3,opt,name=amount_msat,json=amountMsat,proto3" json:"amount_msat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MeltBip353QuoteRequest) Reset() {
+	*x = MeltBip353QuoteRequest{}
+	mi := &file_wallet_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeltBip353QuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeltBip353QuoteRequest) ProtoMessage() {}
+
+func (x *MeltBip353QuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeltBip353QuoteRequest.ProtoReflect.Descriptor instead.
+func (*MeltBip353QuoteRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *MeltBip353QuoteRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *MeltBip353QuoteRequest) GetBip353Address() string {
+	if x != nil {
+		return x.Bip353Address
+	}
+	return ""
+}
+
+func (x *MeltBip353QuoteRequest) GetAmountMsat() uint64 {
+	if x != nil {
+		return x.AmountMsat
+	}
+	return 0
+}
+
+type MeltBip353QuoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *MeltQuote             `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MeltBip353QuoteResponse) Reset() {
+	*x = MeltBip353QuoteResponse{}
+	mi := &file_wallet_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeltBip353QuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeltBip353QuoteResponse) ProtoMessage() {}
+
+func (x *MeltBip353QuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeltBip353QuoteResponse.ProtoReflect.Descriptor instead.
+func (*MeltBip353QuoteResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *MeltBip353QuoteResponse) GetQuote() *MeltQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+type MeltBolt12QuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	Request       string                 `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	Options       *MeltOptions           `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MeltBolt12QuoteRequest) Reset() {
+	*x = MeltBolt12QuoteRequest{}
+	mi := &file_wallet_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeltBolt12QuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeltBolt12QuoteRequest) ProtoMessage() {}
+
+func (x *MeltBolt12QuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeltBolt12QuoteRequest.ProtoReflect.Descriptor instead.
+func (*MeltBolt12QuoteRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *MeltBolt12QuoteRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *MeltBolt12QuoteRequest) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *MeltBolt12QuoteRequest) GetOptions() *MeltOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type MeltBolt12QuoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *MeltQuote             `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MeltBolt12QuoteResponse) Reset() {
+	*x = MeltBolt12QuoteResponse{}
+	mi := &file_wallet_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeltBolt12QuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeltBolt12QuoteResponse) ProtoMessage() {}
+
+func (x *MeltBolt12QuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeltBolt12QuoteResponse.ProtoReflect.Descriptor instead.
+func (*MeltBolt12QuoteResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MeltBolt12QuoteResponse) GetQuote() *MeltQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+type MeltQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	Request       string                 `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	Options       *MeltOptions           `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MeltQuoteRequest) Reset() {
+	*x = MeltQuoteRequest{}
+	mi := &file_wallet_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeltQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeltQuoteRequest) ProtoMessage() {}
+
+func (x *MeltQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeltQuoteRequest.ProtoReflect.Descriptor instead.
+func (*MeltQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MeltQuoteRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *MeltQuoteRequest) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *MeltQuoteRequest) GetOptions() *MeltOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type MeltQuoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *MeltQuote             `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MeltQuoteResponse) Reset() {
+	*x = MeltQuoteResponse{}
+	mi := &file_wallet_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeltQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeltQuoteResponse) ProtoMessage() {}
+
+func (x *MeltQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeltQuoteResponse.ProtoReflect.Descriptor instead.
+func (*MeltQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *MeltQuoteResponse) GetQuote() *MeltQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+type MintRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl            string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	QuoteId            string                 `protobuf:"bytes,2,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	AmountSplitTarget  *SplitTarget           `protobuf:"bytes,3,opt,name=amount_split_target,json=amountSplitTarget,proto3" json:"amount_split_target,omitempty"`
+	SpendingConditions *SpendingConditions    `protobuf:"bytes,4,opt,name=spending_conditions,json=spendingConditions,proto3" json:"spending_conditions,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MintRequest) Reset() {
+	*x = MintRequest{}
+	mi := &file_wallet_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintRequest) ProtoMessage() {}
+
+func (x *MintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintRequest.ProtoReflect.Descriptor instead.
+func (*MintRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *MintRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *MintRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *MintRequest) GetAmountSplitTarget() *SplitTarget {
+	if x != nil {
+		return x.AmountSplitTarget
+	}
+	return nil
+}
+
+func (x *MintRequest) GetSpendingConditions() *SpendingConditions {
+	if x != nil {
+		return x.SpendingConditions
+	}
+	return nil
+}
+
+type MintResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proofs        []*Proof               `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MintResponse) Reset() {
+	*x = MintResponse{}
+	mi := &file_wallet_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintResponse) ProtoMessage() {}
+
+func (x *MintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintResponse.ProtoReflect.Descriptor instead.
+func (*MintResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MintResponse) GetProofs() []*Proof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+type MintBlindAuthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	Amount        uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MintBlindAuthRequest) Reset() {
+	*x = MintBlindAuthRequest{}
+	mi := &file_wallet_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintBlindAuthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintBlindAuthRequest) ProtoMessage() {}
+
+func (x *MintBlindAuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintBlindAuthRequest.ProtoReflect.Descriptor instead.
+func (*MintBlindAuthRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MintBlindAuthRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *MintBlindAuthRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type MintBlindAuthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proofs        []*Proof               `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MintBlindAuthResponse) Reset() {
+	*x = MintBlindAuthResponse{}
+	mi := &file_wallet_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintBlindAuthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintBlindAuthResponse) ProtoMessage() {}
+
+func (x *MintBlindAuthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintBlindAuthResponse.ProtoReflect.Descriptor instead.
+func (*MintBlindAuthResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *MintBlindAuthResponse) GetProofs() []*Proof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+type MintBolt12Request struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl            string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	QuoteId            string                 `protobuf:"bytes,2,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	Amount             *uint64                `protobuf:"varint,3,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	AmountSplitTarget  *SplitTarget           `protobuf:"bytes,4,opt,name=amount_split_target,json=amountSplitTarget,proto3" json:"amount_split_target,omitempty"`
+	SpendingConditions *SpendingConditions    `protobuf:"bytes,5,opt,name=spending_conditions,json=spendingConditions,proto3" json:"spending_conditions,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MintBolt12Request) Reset() {
+	*x = MintBolt12Request{}
+	mi := &file_wallet_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintBolt12Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintBolt12Request) ProtoMessage() {}
+
+func (x *MintBolt12Request) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintBolt12Request.ProtoReflect.Descriptor instead.
+func (*MintBolt12Request) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MintBolt12Request) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *MintBolt12Request) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *MintBolt12Request) GetAmount() uint64 {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return 0
+}
+
+func (x *MintBolt12Request) GetAmountSplitTarget() *SplitTarget {
+	if x != nil {
+		return x.AmountSplitTarget
+	}
+	return nil
+}
+
+func (x *MintBolt12Request) GetSpendingConditions() *SpendingConditions {
+	if x != nil {
+		return x.SpendingConditions
+	}
+	return nil
+}
+
+type MintBolt12Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proofs        []*Proof               `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MintBolt12Response) Reset() {
+	*x = MintBolt12Response{}
+	mi := &file_wallet_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintBolt12Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintBolt12Response) ProtoMessage() {}
+
+func (x *MintBolt12Response) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintBolt12Response.ProtoReflect.Descriptor instead.
+func (*MintBolt12Response) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *MintBolt12Response) GetProofs() []*Proof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+type MintBolt12QuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	Amount        *uint64                `protobuf:"varint,2,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MintBolt12QuoteRequest) Reset() {
+	*x = MintBolt12QuoteRequest{}
+	mi := &file_wallet_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintBolt12QuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintBolt12QuoteRequest) ProtoMessage() {}
+
+func (x *MintBolt12QuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintBolt12QuoteRequest.ProtoReflect.Descriptor instead.
+func (*MintBolt12QuoteRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *MintBolt12QuoteRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *MintBolt12QuoteRequest) GetAmount() uint64 {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return 0
+}
+
+func (x *MintBolt12QuoteRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type MintBolt12QuoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *MintQuote             `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MintBolt12QuoteResponse) Reset() {
+	*x = MintBolt12QuoteResponse{}
+	mi := &file_wallet_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintBolt12QuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintBolt12QuoteResponse) ProtoMessage() {}
+
+func (x *MintBolt12QuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintBolt12QuoteResponse.ProtoReflect.Descriptor instead.
+func (*MintBolt12QuoteResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MintBolt12QuoteResponse) GetQuote() *MintQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+type MintQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	Amount        uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MintQuoteRequest) Reset() {
+	*x = MintQuoteRequest{}
+	mi := &file_wallet_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintQuoteRequest) ProtoMessage() {}
+
+func (x *MintQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintQuoteRequest.ProtoReflect.Descriptor instead.
+func (*MintQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *MintQuoteRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *MintQuoteRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *MintQuoteRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type MintQuoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *MintQuote             `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MintQuoteResponse) Reset() {
+	*x = MintQuoteResponse{}
+	mi := &file_wallet_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintQuoteResponse) ProtoMessage() {}
+
+func (x *MintQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintQuoteResponse.ProtoReflect.Descriptor instead.
+func (*MintQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MintQuoteResponse) GetQuote() *MintQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+type GetUnitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnitRequest) Reset() {
+	*x = GetUnitRequest{}
+	mi := &file_wallet_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnitRequest) ProtoMessage() {}
+
+func (x *GetUnitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnitRequest.ProtoReflect.Descriptor instead.
+func (*GetUnitRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetUnitRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+type GetUnitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Unit          string                 `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnitResponse) Reset() {
+	*x = GetUnitResponse{}
+	mi := &file_wallet_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnitResponse) ProtoMessage() {}
+
+func (x *GetUnitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnitResponse.ProtoReflect.Descriptor instead.
+func (*GetUnitResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetUnitResponse) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type PrepareSendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	Amount        uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Options       *SendOptions           `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareSendRequest) Reset() {
+	*x = PrepareSendRequest{}
+	mi := &file_wallet_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareSendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareSendRequest) ProtoMessage() {}
+
+func (x *PrepareSendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareSendRequest.ProtoReflect.Descriptor instead.
+func (*PrepareSendRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PrepareSendRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *PrepareSendRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PrepareSendRequest) GetOptions() *SendOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type PrepareSendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Send          *PreparedSend          `protobuf:"bytes,1,opt,name=send,proto3" json:"send,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareSendResponse) Reset() {
+	*x = PrepareSendResponse{}
+	mi := &file_wallet_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareSendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareSendResponse) ProtoMessage() {}
+
+func (x *PrepareSendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareSendResponse.ProtoReflect.Descriptor instead.
+func (*PrepareSendResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *PrepareSendResponse) GetSend() *PreparedSend {
+	if x != nil {
+		return x.Send
+	}
+	return nil
+}
+
+type ConfirmSendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SendId        string                 `protobuf:"bytes,1,opt,name=send_id,json=sendId,proto3" json:"send_id,omitempty"`
+	Memo          *string                `protobuf:"bytes,2,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmSendRequest) Reset() {
+	*x = ConfirmSendRequest{}
+	mi := &file_wallet_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmSendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmSendRequest) ProtoMessage() {}
+
+func (x *ConfirmSendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmSendRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmSendRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ConfirmSendRequest) GetSendId() string {
+	if x != nil {
+		return x.SendId
+	}
+	return ""
+}
+
+func (x *ConfirmSendRequest) GetMemo() string {
+	if x != nil && x.Memo != nil {
+		return *x.Memo
+	}
+	return ""
+}
+
+type ConfirmSendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmSendResponse) Reset() {
+	*x = ConfirmSendResponse{}
+	mi := &file_wallet_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmSendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmSendResponse) ProtoMessage() {}
+
+func (x *ConfirmSendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmSendResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmSendResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ConfirmSendResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type CancelSendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SendId        string                 `protobuf:"bytes,1,opt,name=send_id,json=sendId,proto3" json:"send_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSendRequest) Reset() {
+	*x = CancelSendRequest{}
+	mi := &file_wallet_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSendRequest) ProtoMessage() {}
+
+func (x *CancelSendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSendRequest.ProtoReflect.Descriptor instead.
+func (*CancelSendRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CancelSendRequest) GetSendId() string {
+	if x != nil {
+		return x.SendId
+	}
+	return ""
+}
+
+type CancelSendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSendResponse) Reset() {
+	*x = CancelSendResponse{}
+	mi := &file_wallet_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSendResponse) ProtoMessage() {}
+
+func (x *CancelSendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSendResponse.ProtoReflect.Descriptor instead.
+func (*CancelSendResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{64}
+}
+
+type ReceiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Options       *ReceiveOptions        `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveRequest) Reset() {
+	*x = ReceiveRequest{}
+	mi := &file_wallet_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveRequest) ProtoMessage() {}
+
+func (x *ReceiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ReceiveRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *ReceiveRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ReceiveRequest) GetOptions() *ReceiveOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type ReceiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        uint64                 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveResponse) Reset() {
+	*x = ReceiveResponse{}
+	mi := &file_wallet_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveResponse) ProtoMessage() {}
+
+func (x *ReceiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveResponse.ProtoReflect.Descriptor instead.
+func (*ReceiveResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ReceiveResponse) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type ReceiveProofsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	Proofs        []*Proof               `protobuf:"bytes,2,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	Options       *ReceiveOptions        `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	Memo          *string                `protobuf:"bytes,4,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveProofsRequest) Reset() {
+	*x = ReceiveProofsRequest{}
+	mi := &file_wallet_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveProofsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveProofsRequest) ProtoMessage() {}
+
+func (x *ReceiveProofsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveProofsRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveProofsRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ReceiveProofsRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *ReceiveProofsRequest) GetProofs() []*Proof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+func (x *ReceiveProofsRequest) GetOptions() *ReceiveOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ReceiveProofsRequest) GetMemo() string {
+	if x != nil && x.Memo != nil {
+		return *x.Memo
+	}
+	return ""
+}
+
+type ReceiveProofsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        uint64                 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveProofsResponse) Reset() {
+	*x = ReceiveProofsResponse{}
+	mi := &file_wallet_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveProofsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveProofsResponse) ProtoMessage() {}
+
+func (x *ReceiveProofsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveProofsResponse.ProtoReflect.Descriptor instead.
+func (*ReceiveProofsResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ReceiveProofsResponse) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type ReclaimUnspentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	Proofs        []*Proof               `protobuf:"bytes,2,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReclaimUnspentRequest) Reset() {
+	*x = ReclaimUnspentRequest{}
+	mi := &file_wallet_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReclaimUnspentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReclaimUnspentRequest) ProtoMessage() {}
+
+func (x *ReclaimUnspentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReclaimUnspentRequest.ProtoReflect.Descriptor instead.
+func (*ReclaimUnspentRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ReclaimUnspentRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *ReclaimUnspentRequest) GetProofs() []*Proof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+type ReclaimUnspentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReclaimUnspentResponse) Reset() {
+	*x = ReclaimUnspentResponse{}
+	mi := &file_wallet_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReclaimUnspentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReclaimUnspentResponse) ProtoMessage() {}
+
+func (x *ReclaimUnspentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReclaimUnspentResponse.ProtoReflect.Descriptor instead.
+func (*ReclaimUnspentResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{70}
+}
+
+type RefreshAccessTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshAccessTokenRequest) Reset() {
+	*x = RefreshAccessTokenRequest{}
+	mi := &file_wallet_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshAccessTokenRequest) ProtoMessage() {}
+
+func (x *RefreshAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RefreshAccessTokenRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+type RefreshAccessTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshAccessTokenResponse) Reset() {
+	*x = RefreshAccessTokenResponse{}
+	mi := &file_wallet_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshAccessTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshAccessTokenResponse) ProtoMessage() {}
+
+func (x *RefreshAccessTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshAccessTokenResponse.ProtoReflect.Descriptor instead.
+func (*RefreshAccessTokenResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{72}
+}
+
+type RefreshKeysetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshKeysetsRequest) Reset() {
+	*x = RefreshKeysetsRequest{}
+	mi := &file_wallet_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshKeysetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshKeysetsRequest) ProtoMessage() {}
+
+func (x *RefreshKeysetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshKeysetsRequest.ProtoReflect.Descriptor instead.
+func (*RefreshKeysetsRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *RefreshKeysetsRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+type RefreshKeysetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keysets       []*KeySetInfo          `protobuf:"bytes,1,rep,name=keysets,proto3" json:"keysets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshKeysetsResponse) Reset() {
+	*x = RefreshKeysetsResponse{}
+	mi := &file_wallet_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshKeysetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshKeysetsResponse) ProtoMessage() {}
+
+func (x *RefreshKeysetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshKeysetsResponse.ProtoReflect.Descriptor instead.
+func (*RefreshKeysetsResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RefreshKeysetsResponse) GetKeysets() []*KeySetInfo {
+	if x != nil {
+		return x.Keysets
+	}
+	return nil
+}
+
+type RestoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRequest) Reset() {
+	*x = RestoreRequest{}
+	mi := &file_wallet_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRequest) ProtoMessage() {}
+
+func (x *RestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *RestoreRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+type RestoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        uint64                 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreResponse) Reset() {
+	*x = RestoreResponse{}
+	mi := &file_wallet_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreResponse) ProtoMessage() {}
+
+func (x *RestoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreResponse.ProtoReflect.Descriptor instead.
+func (*RestoreResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *RestoreResponse) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type RevertTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertTransactionRequest) Reset() {
+	*x = RevertTransactionRequest{}
+	mi := &file_wallet_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertTransactionRequest) ProtoMessage() {}
+
+func (x *RevertTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertTransactionRequest.ProtoReflect.Descriptor instead.
+func (*RevertTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *RevertTransactionRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *RevertTransactionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevertTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertTransactionResponse) Reset() {
+	*x = RevertTransactionResponse{}
+	mi := &file_wallet_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertTransactionResponse) ProtoMessage() {}
+
+func (x *RevertTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertTransactionResponse.ProtoReflect.Descriptor instead.
+func (*RevertTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{78}
+}
+
+type SetCatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	Cat           string                 `protobuf:"bytes,2,opt,name=cat,proto3" json:"cat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCatRequest) Reset() {
+	*x = SetCatRequest{}
+	mi := &file_wallet_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCatRequest) ProtoMessage() {}
+
+func (x *SetCatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCatRequest.ProtoReflect.Descriptor instead.
+func (*SetCatRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SetCatRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *SetCatRequest) GetCat() string {
+	if x != nil {
+		return x.Cat
+	}
+	return ""
+}
+
+type SetCatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCatResponse) Reset() {
+	*x = SetCatResponse{}
+	mi := &file_wallet_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCatResponse) ProtoMessage() {}
+
+func (x *SetCatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCatResponse.ProtoReflect.Descriptor instead.
+func (*SetCatResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{80}
+}
+
+type SetRefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRefreshTokenRequest) Reset() {
+	*x = SetRefreshTokenRequest{}
+	mi := &file_wallet_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRefreshTokenRequest) ProtoMessage() {}
+
+func (x *SetRefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*SetRefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SetRefreshTokenRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *SetRefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type SetRefreshTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRefreshTokenResponse) Reset() {
+	*x = SetRefreshTokenResponse{}
+	mi := &file_wallet_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRefreshTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRefreshTokenResponse) ProtoMessage() {}
+
+func (x *SetRefreshTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*SetRefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{82}
+}
+
+type SubscribeRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	Kind    SubscriptionKind       `protobuf:"varint,2,opt,name=kind,proto3,enum=cdkgo.wallet.v1.SubscriptionKind" json:"kind,omitempty"`
+	// Quote ids, or proof Ys for SUBSCRIPTION_KIND_PROOF_STATE
+	Filters []string `protobuf:"bytes,3,rep,name=filters,proto3" json:"filters,omitempty"`
+	// Ignored, every call gets a subscription of its own; ids of clients
+	// could collide and take over each other's subscriptions
+	Id            *string `protobuf:"bytes,4,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	mi := &file_wallet_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SubscribeRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *SubscribeRequest) GetKind() SubscriptionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SubscriptionKind_SUBSCRIPTION_KIND_UNSPECIFIED
+}
+
+func (x *SubscribeRequest) GetFilters() []string {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+type MintQuoteUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         string                 `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	Request       string                 `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	State         QuoteState             `protobuf:"varint,3,opt,name=state,proto3,enum=cdkgo.wallet.v1.QuoteState" json:"state,omitempty"`
+	Amount        *uint64                `protobuf:"varint,4,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	Unit          string                 `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
+	Expiry        *uint64                `protobuf:"varint,6,opt,name=expiry,proto3,oneof" json:"expiry,omitempty"`
+	Pubkey        *string                `protobuf:"bytes,7,opt,name=pubkey,proto3,oneof" json:"pubkey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MintQuoteUpdate) Reset() {
+	*x = MintQuoteUpdate{}
+	mi := &file_wallet_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintQuoteUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintQuoteUpdate) ProtoMessage() {}
+
+func (x *MintQuoteUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintQuoteUpdate.ProtoReflect.Descriptor instead.
+func (*MintQuoteUpdate) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *MintQuoteUpdate) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+func (x *MintQuoteUpdate) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *MintQuoteUpdate) GetState() QuoteState {
+	if x != nil {
+		return x.State
+	}
+	return QuoteState_QUOTE_STATE_UNSPECIFIED
+}
+
+func (x *MintQuoteUpdate) GetAmount() uint64 {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return 0
+}
+
+func (x *MintQuoteUpdate) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *MintQuoteUpdate) GetExpiry() uint64 {
+	if x != nil && x.Expiry != nil {
+		return *x.Expiry
+	}
+	return 0
+}
+
+func (x *MintQuoteUpdate) GetPubkey() string {
+	if x != nil && x.Pubkey != nil {
+		return *x.Pubkey
+	}
+	return ""
+}
+
+type MeltQuoteUpdate struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Quote           string                 `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	Request         *string                `protobuf:"bytes,2,opt,name=request,proto3,oneof" json:"request,omitempty"`
+	State           QuoteState             `protobuf:"varint,3,opt,name=state,proto3,enum=cdkgo.wallet.v1.QuoteState" json:"state,omitempty"`
+	Amount          uint64                 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	FeeReserve      uint64                 `protobuf:"varint,5,opt,name=fee_reserve,json=feeReserve,proto3" json:"fee_reserve,omitempty"`
+	Unit            string                 `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`
+	Expiry          uint64                 `protobuf:"varint,7,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	PaymentPreimage *string                `protobuf:"bytes,8,opt,name=payment_preimage,json=paymentPreimage,proto3,oneof" json:"payment_preimage,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MeltQuoteUpdate) Reset() {
+	*x = MeltQuoteUpdate{}
+	mi := &file_wallet_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeltQuoteUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeltQuoteUpdate) ProtoMessage() {}
+
+func (x *MeltQuoteUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeltQuoteUpdate.ProtoReflect.Descriptor instead.
+func (*MeltQuoteUpdate) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *MeltQuoteUpdate) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+func (x *MeltQuoteUpdate) GetRequest() string {
+	if x != nil && x.Request != nil {
+		return *x.Request
+	}
+	return ""
+}
+
+func (x *MeltQuoteUpdate) GetState() QuoteState {
+	if x != nil {
+		return x.State
+	}
+	return QuoteState_QUOTE_STATE_UNSPECIFIED
+}
+
+func (x *MeltQuoteUpdate) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *MeltQuoteUpdate) GetFeeReserve() uint64 {
+	if x != nil {
+		return x.FeeReserve
+	}
+	return 0
+}
+
+func (x *MeltQuoteUpdate) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *MeltQuoteUpdate) GetExpiry() uint64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *MeltQuoteUpdate) GetPaymentPreimage() string {
+	if x != nil && x.PaymentPreimage != nil {
+		return *x.PaymentPreimage
+	}
+	return ""
+}
+
+type ProofStateUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Y             string                 `protobuf:"bytes,1,opt,name=y,proto3" json:"y,omitempty"`
+	State         ProofState             `protobuf:"varint,2,opt,name=state,proto3,enum=cdkgo.wallet.v1.ProofState" json:"state,omitempty"`
+	Witness       *string                `protobuf:"bytes,3,opt,name=witness,proto3,oneof" json:"witness,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProofStateUpdate) Reset() {
+	*x = ProofStateUpdate{}
+	mi := &file_wallet_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProofStateUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofStateUpdate) ProtoMessage() {}
+
+func (x *ProofStateUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofStateUpdate.ProtoReflect.Descriptor instead.
+func (*ProofStateUpdate) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ProofStateUpdate) GetY() string {
+	if x != nil {
+		return x.Y
+	}
+	return ""
+}
+
+func (x *ProofStateUpdate) GetState() ProofState {
+	if x != nil {
+		return x.State
+	}
+	return ProofState_PROOF_STATE_UNSPECIFIED
+}
+
+func (x *ProofStateUpdate) GetWitness() string {
+	if x != nil && x.Witness != nil {
+		return *x.Witness
+	}
+	return ""
+}
+
+type ProofStateUpdates struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Updates       []*ProofStateUpdate    `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProofStateUpdates) Reset() {
+	*x = ProofStateUpdates{}
+	mi := &file_wallet_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProofStateUpdates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofStateUpdates) ProtoMessage() {}
+
+func (x *ProofStateUpdates) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofStateUpdates.ProtoReflect.Descriptor instead.
+func (*ProofStateUpdates) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ProofStateUpdates) GetUpdates() []*ProofStateUpdate {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+type SubscribeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*SubscribeResponse_MintQuote
+	//	*SubscribeResponse_MeltQuote
+	//	*SubscribeResponse_ProofStates
+	Payload       isSubscribeResponse_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeResponse) Reset() {
+	*x = SubscribeResponse{}
+	mi := &file_wallet_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeResponse) ProtoMessage() {}
+
+func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SubscribeResponse) GetPayload() isSubscribeResponse_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *SubscribeResponse) GetMintQuote() *MintQuoteUpdate {
+	if x != nil {
+		if x, ok := x.Payload.(*SubscribeResponse_MintQuote); ok {
+			return x.MintQuote
+		}
+	}
+	return nil
+}
+
+func (x *SubscribeResponse) GetMeltQuote() *MeltQuoteUpdate {
+	if x != nil {
+		if x, ok := x.Payload.(*SubscribeResponse_MeltQuote); ok {
+			return x.MeltQuote
+		}
+	}
+	return nil
+}
+
+func (x *SubscribeResponse) GetProofStates() *ProofStateUpdates {
+	if x != nil {
+		if x, ok := x.Payload.(*SubscribeResponse_ProofStates); ok {
+			return x.ProofStates
+		}
+	}
+	return nil
+}
+
+type isSubscribeResponse_Payload interface {
+	isSubscribeResponse_Payload()
+}
+
+type SubscribeResponse_MintQuote struct {
+	MintQuote *MintQuoteUpdate `protobuf:"bytes,1,opt,name=mint_quote,json=mintQuote,proto3,oneof"`
+}
+
+type SubscribeResponse_MeltQuote struct {
+	MeltQuote *MeltQuoteUpdate `protobuf:"bytes,2,opt,name=melt_quote,json=meltQuote,proto3,oneof"`
+}
+
+type SubscribeResponse_ProofStates struct {
+	ProofStates *ProofStateUpdates `protobuf:"bytes,3,opt,name=proof_states,json=proofStates,proto3,oneof"`
+}
+
+func (*SubscribeResponse_MintQuote) isSubscribeResponse_Payload() {}
+
+func (*SubscribeResponse_MeltQuote) isSubscribeResponse_Payload() {}
+
+func (*SubscribeResponse_ProofStates) isSubscribeResponse_Payload() {}
+
+type SwapRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	// Unset swaps the whole amount of the input proofs
+	Amount             *uint64             `protobuf:"varint,2,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	AmountSplitTarget  *SplitTarget        `protobuf:"bytes,3,opt,name=amount_split_target,json=amountSplitTarget,proto3" json:"amount_split_target,omitempty"`
+	InputProofs        []*Proof            `protobuf:"bytes,4,rep,name=input_proofs,json=inputProofs,proto3" json:"input_proofs,omitempty"`
+	SpendingConditions *SpendingConditions `protobuf:"bytes,5,opt,name=spending_conditions,json=spendingConditions,proto3" json:"spending_conditions,omitempty"`
+	IncludeFees        bool                `protobuf:"varint,6,opt,name=include_fees,json=includeFees,proto3" json:"include_fees,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SwapRequest) Reset() {
+	*x = SwapRequest{}
+	mi := &file_wallet_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapRequest) ProtoMessage() {}
+
+func (x *SwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapRequest.ProtoReflect.Descriptor instead.
+func (*SwapRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SwapRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *SwapRequest) GetAmount() uint64 {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return 0
+}
+
+func (x *SwapRequest) GetAmountSplitTarget() *SplitTarget {
+	if x != nil {
+		return x.AmountSplitTarget
+	}
+	return nil
+}
+
+func (x *SwapRequest) GetInputProofs() []*Proof {
+	if x != nil {
+		return x.InputProofs
+	}
+	return nil
+}
+
+func (x *SwapRequest) GetSpendingConditions() *SpendingConditions {
+	if x != nil {
+		return x.SpendingConditions
+	}
+	return nil
+}
+
+func (x *SwapRequest) GetIncludeFees() bool {
+	if x != nil {
+		return x.IncludeFees
+	}
+	return false
+}
+
+type SwapResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Proofs of the requested amount, the change is kept by the wallet
+	Proofs        []*Proof `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwapResponse) Reset() {
+	*x = SwapResponse{}
+	mi := &file_wallet_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapResponse) ProtoMessage() {}
+
+func (x *SwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapResponse.ProtoReflect.Descriptor instead.
+func (*SwapResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *SwapResponse) GetProofs() []*Proof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+type TotalBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TotalBalanceRequest) Reset() {
+	*x = TotalBalanceRequest{}
+	mi := &file_wallet_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TotalBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TotalBalanceRequest) ProtoMessage() {}
+
+func (x *TotalBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TotalBalanceRequest.ProtoReflect.Descriptor instead.
+func (*TotalBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *TotalBalanceRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+type TotalBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        uint64                 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TotalBalanceResponse) Reset() {
+	*x = TotalBalanceResponse{}
+	mi := &file_wallet_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TotalBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TotalBalanceResponse) ProtoMessage() {}
+
+func (x *TotalBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TotalBalanceResponse.ProtoReflect.Descriptor instead.
+func (*TotalBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *TotalBalanceResponse) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type TotalPendingBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TotalPendingBalanceRequest) Reset() {
+	*x = TotalPendingBalanceRequest{}
+	mi := &file_wallet_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TotalPendingBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TotalPendingBalanceRequest) ProtoMessage() {}
+
+func (x *TotalPendingBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TotalPendingBalanceRequest.ProtoReflect.Descriptor instead.
+func (*TotalPendingBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *TotalPendingBalanceRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+type TotalPendingBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        uint64                 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TotalPendingBalanceResponse) Reset() {
+	*x = TotalPendingBalanceResponse{}
+	mi := &file_wallet_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TotalPendingBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TotalPendingBalanceResponse) ProtoMessage() {}
+
+func (x *TotalPendingBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TotalPendingBalanceResponse.ProtoReflect.Descriptor instead.
+func (*TotalPendingBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *TotalPendingBalanceResponse) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type TotalReservedBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TotalReservedBalanceRequest) Reset() {
+	*x = TotalReservedBalanceRequest{}
+	mi := &file_wallet_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TotalReservedBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TotalReservedBalanceRequest) ProtoMessage() {}
+
+func (x *TotalReservedBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TotalReservedBalanceRequest.ProtoReflect.Descriptor instead.
+func (*TotalReservedBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *TotalReservedBalanceRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+type TotalReservedBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        uint64                 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TotalReservedBalanceResponse) Reset() {
+	*x = TotalReservedBalanceResponse{}
+	mi := &file_wallet_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TotalReservedBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TotalReservedBalanceResponse) ProtoMessage() {}
+
+func (x *TotalReservedBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TotalReservedBalanceResponse.ProtoReflect.Descriptor instead.
+func (*TotalReservedBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *TotalReservedBalanceResponse) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type VerifyTokenDleqRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyTokenDleqRequest) Reset() {
+	*x = VerifyTokenDleqRequest{}
+	mi := &file_wallet_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTokenDleqRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTokenDleqRequest) ProtoMessage() {}
+
+func (x *VerifyTokenDleqRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTokenDleqRequest.ProtoReflect.Descriptor instead.
+func (*VerifyTokenDleqRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *VerifyTokenDleqRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *VerifyTokenDleqRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type VerifyTokenDleqResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyTokenDleqResponse) Reset() {
+	*x = VerifyTokenDleqResponse{}
+	mi := &file_wallet_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTokenDleqResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTokenDleqResponse) ProtoMessage() {}
+
+func (x *VerifyTokenDleqResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTokenDleqResponse.ProtoReflect.Descriptor instead.
+func (*VerifyTokenDleqResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{98}
+}
+
+type AddMintRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl          string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	TargetProofCount *uint32                `protobuf:"varint,2,opt,name=target_proof_count,json=targetProofCount,proto3,oneof" json:"target_proof_count,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AddMintRequest) Reset() {
+	*x = AddMintRequest{}
+	mi := &file_wallet_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMintRequest) ProtoMessage() {}
+
+func (x *AddMintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMintRequest.ProtoReflect.Descriptor instead.
+func (*AddMintRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *AddMintRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *AddMintRequest) GetTargetProofCount() uint32 {
+	if x != nil && x.TargetProofCount != nil {
+		return *x.TargetProofCount
+	}
+	return 0
+}
+
+type AddMintResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMintResponse) Reset() {
+	*x = AddMintResponse{}
+	mi := &file_wallet_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMintResponse) ProtoMessage() {}
+
+func (x *AddMintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMintResponse.ProtoReflect.Descriptor instead.
+func (*AddMintResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{100}
+}
+
+type CheckAllMintQuotesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unset checks the quotes of every mint
+	MintUrl       *string `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3,oneof" json:"mint_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAllMintQuotesRequest) Reset() {
+	*x = CheckAllMintQuotesRequest{}
+	mi := &file_wallet_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAllMintQuotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAllMintQuotesRequest) ProtoMessage() {}
+
+func (x *CheckAllMintQuotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAllMintQuotesRequest.ProtoReflect.Descriptor instead.
+func (*CheckAllMintQuotesRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *CheckAllMintQuotesRequest) GetMintUrl() string {
+	if x != nil && x.MintUrl != nil {
+		return *x.MintUrl
+	}
+	return ""
+}
+
+type CheckAllMintQuotesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Amount minted from the paid quotes
+	Amount        uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAllMintQuotesResponse) Reset() {
+	*x = CheckAllMintQuotesResponse{}
+	mi := &file_wallet_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAllMintQuotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAllMintQuotesResponse) ProtoMessage() {}
+
+func (x *CheckAllMintQuotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAllMintQuotesResponse.ProtoReflect.Descriptor instead.
+func (*CheckAllMintQuotesResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *CheckAllMintQuotesResponse) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type CheckMintQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	QuoteId       string                 `protobuf:"bytes,2,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckMintQuoteRequest) Reset() {
+	*x = CheckMintQuoteRequest{}
+	mi := &file_wallet_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckMintQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckMintQuoteRequest) ProtoMessage() {}
+
+func (x *CheckMintQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckMintQuoteRequest.ProtoReflect.Descriptor instead.
+func (*CheckMintQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *CheckMintQuoteRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *CheckMintQuoteRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+type CheckMintQuoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *MintQuote             `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckMintQuoteResponse) Reset() {
+	*x = CheckMintQuoteResponse{}
+	mi := &file_wallet_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckMintQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckMintQuoteResponse) ProtoMessage() {}
+
+func (x *CheckMintQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckMintQuoteResponse.ProtoReflect.Descriptor instead.
+func (*CheckMintQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *CheckMintQuoteResponse) GetQuote() *MintQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+type ConsolidateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsolidateRequest) Reset() {
+	*x = ConsolidateRequest{}
+	mi := &file_wallet_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsolidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsolidateRequest) ProtoMessage() {}
+
+func (x *ConsolidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsolidateRequest.ProtoReflect.Descriptor instead.
+func (*ConsolidateRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{105}
+}
+
+type ConsolidateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        uint64                 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsolidateResponse) Reset() {
+	*x = ConsolidateResponse{}
+	mi := &file_wallet_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsolidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsolidateResponse) ProtoMessage() {}
+
+func (x *ConsolidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsolidateResponse.ProtoReflect.Descriptor instead.
+func (*ConsolidateResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ConsolidateResponse) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type GetBalancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalancesRequest) Reset() {
+	*x = GetBalancesRequest{}
+	mi := &file_wallet_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalancesRequest) ProtoMessage() {}
+
+func (x *GetBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalancesRequest.ProtoReflect.Descriptor instead.
+func (*GetBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{107}
+}
+
+type GetBalancesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Balance by mint url
+	Balances      map[string]uint64 `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalancesResponse) Reset() {
+	*x = GetBalancesResponse{}
+	mi := &file_wallet_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalancesResponse) ProtoMessage() {}
+
+func (x *GetBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalancesResponse.ProtoReflect.Descriptor instead.
+func (*GetBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetBalancesResponse) GetBalances() map[string]uint64 {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+type GetMintUrlsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMintUrlsRequest) Reset() {
+	*x = GetMintUrlsRequest{}
+	mi := &file_wallet_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMintUrlsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMintUrlsRequest) ProtoMessage() {}
+
+func (x *GetMintUrlsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMintUrlsRequest.ProtoReflect.Descriptor instead.
+func (*GetMintUrlsRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{109}
+}
+
+type GetMintUrlsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrls      []string               `protobuf:"bytes,1,rep,name=mint_urls,json=mintUrls,proto3" json:"mint_urls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMintUrlsResponse) Reset() {
+	*x = GetMintUrlsResponse{}
+	mi := &file_wallet_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMintUrlsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMintUrlsResponse) ProtoMessage() {}
+
+func (x *GetMintUrlsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMintUrlsResponse.ProtoReflect.Descriptor instead.
+func (*GetMintUrlsResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GetMintUrlsResponse) GetMintUrls() []string {
+	if x != nil {
+		return x.MintUrls
+	}
+	return nil
+}
+
+type HasMintRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HasMintRequest) Reset() {
+	*x = HasMintRequest{}
+	mi := &file_wallet_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HasMintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasMintRequest) ProtoMessage() {}
+
+func (x *HasMintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasMintRequest.ProtoReflect.Descriptor instead.
+func (*HasMintRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *HasMintRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+type HasMintResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HasMint       bool                   `protobuf:"varint,1,opt,name=has_mint,json=hasMint,proto3" json:"has_mint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HasMintResponse) Reset() {
+	*x = HasMintResponse{}
+	mi := &file_wallet_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HasMintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasMintResponse) ProtoMessage() {}
+
+func (x *HasMintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasMintResponse.ProtoReflect.Descriptor instead.
+func (*HasMintResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *HasMintResponse) GetHasMint() bool {
+	if x != nil {
+		return x.HasMint
+	}
+	return false
+}
+
+type ListProofsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProofsRequest) Reset() {
+	*x = ListProofsRequest{}
+	mi := &file_wallet_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProofsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProofsRequest) ProtoMessage() {}
+
+func (x *ListProofsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProofsRequest.ProtoReflect.Descriptor instead.
+func (*ListProofsRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{113}
+}
+
+type ProofList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proofs        []*Proof               `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProofList) Reset() {
+	*x = ProofList{}
+	mi := &file_wallet_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProofList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofList) ProtoMessage() {}
+
+func (x *ProofList) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofList.ProtoReflect.Descriptor instead.
+func (*ProofList) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ProofList) GetProofs() []*Proof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+type ListProofsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unspent proofs by mint url
+	Proofs        map[string]*ProofList `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProofsResponse) Reset() {
+	*x = ListProofsResponse{}
+	mi := &file_wallet_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProofsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProofsResponse) ProtoMessage() {}
+
+func (x *ListProofsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProofsResponse.ProtoReflect.Descriptor instead.
+func (*ListProofsResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ListProofsResponse) GetProofs() map[string]*ProofList {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+type MultiMintListTransactionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unspecified lists both directions
+	Direction     TransactionDirection `protobuf:"varint,1,opt,name=direction,proto3,enum=cdkgo.wallet.v1.TransactionDirection" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintListTransactionsRequest) Reset() {
+	*x = MultiMintListTransactionsRequest{}
+	mi := &file_wallet_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintListTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintListTransactionsRequest) ProtoMessage() {}
+
+func (x *MultiMintListTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintListTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*MultiMintListTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *MultiMintListTransactionsRequest) GetDirection() TransactionDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return TransactionDirection_TRANSACTION_DIRECTION_UNSPECIFIED
+}
+
+type MultiMintListTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintListTransactionsResponse) Reset() {
+	*x = MultiMintListTransactionsResponse{}
+	mi := &file_wallet_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintListTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintListTransactionsResponse) ProtoMessage() {}
+
+func (x *MultiMintListTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintListTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*MultiMintListTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *MultiMintListTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type MultiMintMeltRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bolt11        string                 `protobuf:"bytes,1,opt,name=bolt11,proto3" json:"bolt11,omitempty"`
+	Options       *MeltOptions           `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	MaxFee        *uint64                `protobuf:"varint,3,opt,name=max_fee,json=maxFee,proto3,oneof" json:"max_fee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintMeltRequest) Reset() {
+	*x = MultiMintMeltRequest{}
+	mi := &file_wallet_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintMeltRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintMeltRequest) ProtoMessage() {}
+
+func (x *MultiMintMeltRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintMeltRequest.ProtoReflect.Descriptor instead.
+func (*MultiMintMeltRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *MultiMintMeltRequest) GetBolt11() string {
+	if x != nil {
+		return x.Bolt11
+	}
+	return ""
+}
+
+func (x *MultiMintMeltRequest) GetOptions() *MeltOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *MultiMintMeltRequest) GetMaxFee() uint64 {
+	if x != nil && x.MaxFee != nil {
+		return *x.MaxFee
+	}
+	return 0
+}
+
+type MultiMintMeltResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Melted        *Melted                `protobuf:"bytes,1,opt,name=melted,proto3" json:"melted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintMeltResponse) Reset() {
+	*x = MultiMintMeltResponse{}
+	mi := &file_wallet_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintMeltResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintMeltResponse) ProtoMessage() {}
+
+func (x *MultiMintMeltResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintMeltResponse.ProtoReflect.Descriptor instead.
+func (*MultiMintMeltResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *MultiMintMeltResponse) GetMelted() *Melted {
+	if x != nil {
+		return x.Melted
+	}
+	return nil
+}
+
+type MultiMintMeltQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	Request       string                 `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	Options       *MeltOptions           `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintMeltQuoteRequest) Reset() {
+	*x = MultiMintMeltQuoteRequest{}
+	mi := &file_wallet_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintMeltQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintMeltQuoteRequest) ProtoMessage() {}
+
+func (x *MultiMintMeltQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintMeltQuoteRequest.ProtoReflect.Descriptor instead.
+func (*MultiMintMeltQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *MultiMintMeltQuoteRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *MultiMintMeltQuoteRequest) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *MultiMintMeltQuoteRequest) GetOptions() *MeltOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type MultiMintMeltQuoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *MeltQuote             `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintMeltQuoteResponse) Reset() {
+	*x = MultiMintMeltQuoteResponse{}
+	mi := &file_wallet_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintMeltQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintMeltQuoteResponse) ProtoMessage() {}
+
+func (x *MultiMintMeltQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintMeltQuoteResponse.ProtoReflect.Descriptor instead.
+func (*MultiMintMeltQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *MultiMintMeltQuoteResponse) GetQuote() *MeltQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+type MultiMintMintRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl            string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	QuoteId            string                 `protobuf:"bytes,2,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	SpendingConditions *SpendingConditions    `protobuf:"bytes,3,opt,name=spending_conditions,json=spendingConditions,proto3" json:"spending_conditions,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MultiMintMintRequest) Reset() {
+	*x = MultiMintMintRequest{}
+	mi := &file_wallet_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintMintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintMintRequest) ProtoMessage() {}
+
+func (x *MultiMintMintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintMintRequest.ProtoReflect.Descriptor instead.
+func (*MultiMintMintRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *MultiMintMintRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *MultiMintMintRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *MultiMintMintRequest) GetSpendingConditions() *SpendingConditions {
+	if x != nil {
+		return x.SpendingConditions
+	}
+	return nil
+}
+
+type MultiMintMintResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proofs        []*Proof               `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintMintResponse) Reset() {
+	*x = MultiMintMintResponse{}
+	mi := &file_wallet_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintMintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintMintResponse) ProtoMessage() {}
+
+func (x *MultiMintMintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintMintResponse.ProtoReflect.Descriptor instead.
+func (*MultiMintMintResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *MultiMintMintResponse) GetProofs() []*Proof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+type MultiMintMintQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	Amount        uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintMintQuoteRequest) Reset() {
+	*x = MultiMintMintQuoteRequest{}
+	mi := &file_wallet_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintMintQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintMintQuoteRequest) ProtoMessage() {}
+
+func (x *MultiMintMintQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintMintQuoteRequest.ProtoReflect.Descriptor instead.
+func (*MultiMintMintQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *MultiMintMintQuoteRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *MultiMintMintQuoteRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *MultiMintMintQuoteRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type MultiMintMintQuoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *MintQuote             `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintMintQuoteResponse) Reset() {
+	*x = MultiMintMintQuoteResponse{}
+	mi := &file_wallet_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintMintQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintMintQuoteResponse) ProtoMessage() {}
+
+func (x *MultiMintMintQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintMintQuoteResponse.ProtoReflect.Descriptor instead.
+func (*MultiMintMintQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *MultiMintMintQuoteResponse) GetQuote() *MintQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+type MultiMintGetUnitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintGetUnitRequest) Reset() {
+	*x = MultiMintGetUnitRequest{}
+	mi := &file_wallet_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintGetUnitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintGetUnitRequest) ProtoMessage() {}
+
+func (x *MultiMintGetUnitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintGetUnitRequest.ProtoReflect.Descriptor instead.
+func (*MultiMintGetUnitRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{126}
+}
+
+type MultiMintGetUnitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Unit          string                 `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintGetUnitResponse) Reset() {
+	*x = MultiMintGetUnitResponse{}
+	mi := &file_wallet_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintGetUnitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintGetUnitResponse) ProtoMessage() {}
+
+func (x *MultiMintGetUnitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintGetUnitResponse.ProtoReflect.Descriptor instead.
+func (*MultiMintGetUnitResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *MultiMintGetUnitResponse) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type MultiMintPrepareSendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	Amount        uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Options       *MultiMintSendOptions  `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintPrepareSendRequest) Reset() {
+	*x = MultiMintPrepareSendRequest{}
+	mi := &file_wallet_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintPrepareSendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintPrepareSendRequest) ProtoMessage() {}
+
+func (x *MultiMintPrepareSendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintPrepareSendRequest.ProtoReflect.Descriptor instead.
+func (*MultiMintPrepareSendRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *MultiMintPrepareSendRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *MultiMintPrepareSendRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *MultiMintPrepareSendRequest) GetOptions() *MultiMintSendOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type MultiMintPrepareSendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Send          *PreparedSend          `protobuf:"bytes,1,opt,name=send,proto3" json:"send,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintPrepareSendResponse) Reset() {
+	*x = MultiMintPrepareSendResponse{}
+	mi := &file_wallet_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintPrepareSendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintPrepareSendResponse) ProtoMessage() {}
+
+func (x *MultiMintPrepareSendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintPrepareSendResponse.ProtoReflect.Descriptor instead.
+func (*MultiMintPrepareSendResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *MultiMintPrepareSendResponse) GetSend() *PreparedSend {
+	if x != nil {
+		return x.Send
+	}
+	return nil
+}
+
+type MultiMintConfirmSendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SendId        string                 `protobuf:"bytes,1,opt,name=send_id,json=sendId,proto3" json:"send_id,omitempty"`
+	Memo          *string                `protobuf:"bytes,2,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintConfirmSendRequest) Reset() {
+	*x = MultiMintConfirmSendRequest{}
+	mi := &file_wallet_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintConfirmSendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintConfirmSendRequest) ProtoMessage() {}
+
+func (x *MultiMintConfirmSendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintConfirmSendRequest.ProtoReflect.Descriptor instead.
+func (*MultiMintConfirmSendRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *MultiMintConfirmSendRequest) GetSendId() string {
+	if x != nil {
+		return x.SendId
+	}
+	return ""
+}
+
+func (x *MultiMintConfirmSendRequest) GetMemo() string {
+	if x != nil && x.Memo != nil {
+		return *x.Memo
+	}
+	return ""
+}
+
+type MultiMintConfirmSendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintConfirmSendResponse) Reset() {
+	*x = MultiMintConfirmSendResponse{}
+	mi := &file_wallet_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintConfirmSendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintConfirmSendResponse) ProtoMessage() {}
+
+func (x *MultiMintConfirmSendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintConfirmSendResponse.ProtoReflect.Descriptor instead.
+func (*MultiMintConfirmSendResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *MultiMintConfirmSendResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type MultiMintCancelSendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SendId        string                 `protobuf:"bytes,1,opt,name=send_id,json=sendId,proto3" json:"send_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintCancelSendRequest) Reset() {
+	*x = MultiMintCancelSendRequest{}
+	mi := &file_wallet_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintCancelSendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintCancelSendRequest) ProtoMessage() {}
+
+func (x *MultiMintCancelSendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintCancelSendRequest.ProtoReflect.Descriptor instead.
+func (*MultiMintCancelSendRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *MultiMintCancelSendRequest) GetSendId() string {
+	if x != nil {
+		return x.SendId
+	}
+	return ""
+}
+
+type MultiMintCancelSendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintCancelSendResponse) Reset() {
+	*x = MultiMintCancelSendResponse{}
+	mi := &file_wallet_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintCancelSendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintCancelSendResponse) ProtoMessage() {}
+
+func (x *MultiMintCancelSendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintCancelSendResponse.ProtoReflect.Descriptor instead.
+func (*MultiMintCancelSendResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{133}
+}
+
+type MultiMintReceiveRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Token         string                   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Options       *MultiMintReceiveOptions `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintReceiveRequest) Reset() {
+	*x = MultiMintReceiveRequest{}
+	mi := &file_wallet_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintReceiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintReceiveRequest) ProtoMessage() {}
+
+func (x *MultiMintReceiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintReceiveRequest.ProtoReflect.Descriptor instead.
+func (*MultiMintReceiveRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *MultiMintReceiveRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *MultiMintReceiveRequest) GetOptions() *MultiMintReceiveOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type MultiMintReceiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        uint64                 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintReceiveResponse) Reset() {
+	*x = MultiMintReceiveResponse{}
+	mi := &file_wallet_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintReceiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintReceiveResponse) ProtoMessage() {}
+
+func (x *MultiMintReceiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintReceiveResponse.ProtoReflect.Descriptor instead.
+func (*MultiMintReceiveResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *MultiMintReceiveResponse) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type RemoveMintRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMintRequest) Reset() {
+	*x = RemoveMintRequest{}
+	mi := &file_wallet_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMintRequest) ProtoMessage() {}
+
+func (x *RemoveMintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMintRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMintRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *RemoveMintRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+type RemoveMintResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMintResponse) Reset() {
+	*x = RemoveMintResponse{}
+	mi := &file_wallet_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMintResponse) ProtoMessage() {}
+
+func (x *RemoveMintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMintResponse.ProtoReflect.Descriptor instead.
+func (*RemoveMintResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{137}
+}
+
+type MultiMintRestoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl       string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintRestoreRequest) Reset() {
+	*x = MultiMintRestoreRequest{}
+	mi := &file_wallet_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintRestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintRestoreRequest) ProtoMessage() {}
+
+func (x *MultiMintRestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintRestoreRequest.ProtoReflect.Descriptor instead.
+func (*MultiMintRestoreRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *MultiMintRestoreRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+type MultiMintRestoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        uint64                 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintRestoreResponse) Reset() {
+	*x = MultiMintRestoreResponse{}
+	mi := &file_wallet_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintRestoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintRestoreResponse) ProtoMessage() {}
+
+func (x *MultiMintRestoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintRestoreResponse.ProtoReflect.Descriptor instead.
+func (*MultiMintRestoreResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *MultiMintRestoreResponse) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type MultiMintSwapRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Amount             *uint64                `protobuf:"varint,1,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	SpendingConditions *SpendingConditions    `protobuf:"bytes,2,opt,name=spending_conditions,json=spendingConditions,proto3" json:"spending_conditions,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MultiMintSwapRequest) Reset() {
+	*x = MultiMintSwapRequest{}
+	mi := &file_wallet_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintSwapRequest) ProtoMessage() {}
+
+func (x *MultiMintSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintSwapRequest.ProtoReflect.Descriptor instead.
+func (*MultiMintSwapRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *MultiMintSwapRequest) GetAmount() uint64 {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return 0
+}
+
+func (x *MultiMintSwapRequest) GetSpendingConditions() *SpendingConditions {
+	if x != nil {
+		return x.SpendingConditions
+	}
+	return nil
+}
+
+type MultiMintSwapResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proofs        []*Proof               `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintSwapResponse) Reset() {
+	*x = MultiMintSwapResponse{}
+	mi := &file_wallet_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintSwapResponse) ProtoMessage() {}
+
+func (x *MultiMintSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintSwapResponse.ProtoReflect.Descriptor instead.
+func (*MultiMintSwapResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *MultiMintSwapResponse) GetProofs() []*Proof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+type MultiMintTotalBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintTotalBalanceRequest) Reset() {
+	*x = MultiMintTotalBalanceRequest{}
+	mi := &file_wallet_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintTotalBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintTotalBalanceRequest) ProtoMessage() {}
+
+func (x *MultiMintTotalBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintTotalBalanceRequest.ProtoReflect.Descriptor instead.
+func (*MultiMintTotalBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{142}
+}
+
+type MultiMintTotalBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        uint64                 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintTotalBalanceResponse) Reset() {
+	*x = MultiMintTotalBalanceResponse{}
+	mi := &file_wallet_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintTotalBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintTotalBalanceResponse) ProtoMessage() {}
+
+func (x *MultiMintTotalBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintTotalBalanceResponse.ProtoReflect.Descriptor instead.
+func (*MultiMintTotalBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *MultiMintTotalBalanceResponse) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type TransferRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SourceMint string                 `protobuf:"bytes,1,opt,name=source_mint,json=sourceMint,proto3" json:"source_mint,omitempty"`
+	TargetMint string                 `protobuf:"bytes,2,opt,name=target_mint,json=targetMint,proto3" json:"target_mint,omitempty"`
+	// Amount the target mint must receive; unset transfers the full balance
+	ExactReceiveAmount *uint64 `protobuf:"varint,3,opt,name=exact_receive_amount,json=exactReceiveAmount,proto3,oneof" json:"exact_receive_amount,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TransferRequest) Reset() {
+	*x = TransferRequest{}
+	mi := &file_wallet_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferRequest) ProtoMessage() {}
+
+func (x *TransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferRequest.ProtoReflect.Descriptor instead.
+func (*TransferRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *TransferRequest) GetSourceMint() string {
+	if x != nil {
+		return x.SourceMint
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetTargetMint() string {
+	if x != nil {
+		return x.TargetMint
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetExactReceiveAmount() uint64 {
+	if x != nil && x.ExactReceiveAmount != nil {
+		return *x.ExactReceiveAmount
+	}
+	return 0
+}
+
+type TransferResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	AmountSent         uint64                 `protobuf:"varint,1,opt,name=amount_sent,json=amountSent,proto3" json:"amount_sent,omitempty"`
+	AmountReceived     uint64                 `protobuf:"varint,2,opt,name=amount_received,json=amountReceived,proto3" json:"amount_received,omitempty"`
+	FeesPaid           uint64                 `protobuf:"varint,3,opt,name=fees_paid,json=feesPaid,proto3" json:"fees_paid,omitempty"`
+	SourceBalanceAfter uint64                 `protobuf:"varint,4,opt,name=source_balance_after,json=sourceBalanceAfter,proto3" json:"source_balance_after,omitempty"`
+	TargetBalanceAfter uint64                 `protobuf:"varint,5,opt,name=target_balance_after,json=targetBalanceAfter,proto3" json:"target_balance_after,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TransferResponse) Reset() {
+	*x = TransferResponse{}
+	mi := &file_wallet_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferResponse) ProtoMessage() {}
+
+func (x *TransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferResponse.ProtoReflect.Descriptor instead.
+func (*TransferResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *TransferResponse) GetAmountSent() uint64 {
+	if x != nil {
+		return x.AmountSent
+	}
+	return 0
+}
+
+func (x *TransferResponse) GetAmountReceived() uint64 {
+	if x != nil {
+		return x.AmountReceived
+	}
+	return 0
+}
+
+func (x *TransferResponse) GetFeesPaid() uint64 {
+	if x != nil {
+		return x.FeesPaid
+	}
+	return 0
+}
+
+func (x *TransferResponse) GetSourceBalanceAfter() uint64 {
+	if x != nil {
+		return x.SourceBalanceAfter
+	}
+	return 0
+}
+
+func (x *TransferResponse) GetTargetBalanceAfter() uint64 {
+	if x != nil {
+		return x.TargetBalanceAfter
+	}
+	return 0
+}
+
+type MultiMintVerifyTokenDleqRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintVerifyTokenDleqRequest) Reset() {
+	*x = MultiMintVerifyTokenDleqRequest{}
+	mi := &file_wallet_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintVerifyTokenDleqRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintVerifyTokenDleqRequest) ProtoMessage() {}
+
+func (x *MultiMintVerifyTokenDleqRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintVerifyTokenDleqRequest.ProtoReflect.Descriptor instead.
+func (*MultiMintVerifyTokenDleqRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *MultiMintVerifyTokenDleqRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type MultiMintVerifyTokenDleqResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiMintVerifyTokenDleqResponse) Reset() {
+	*x = MultiMintVerifyTokenDleqResponse{}
+	mi := &file_wallet_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiMintVerifyTokenDleqResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiMintVerifyTokenDleqResponse) ProtoMessage() {}
+
+func (x *MultiMintVerifyTokenDleqResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiMintVerifyTokenDleqResponse.ProtoReflect.Descriptor instead.
+func (*MultiMintVerifyTokenDleqResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{147}
+}
+
+type WaitForMintQuoteRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	MintUrl            string                 `protobuf:"bytes,1,opt,name=mint_url,json=mintUrl,proto3" json:"mint_url,omitempty"`
+	QuoteId            string                 `protobuf:"bytes,2,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	SplitTarget        *SplitTarget           `protobuf:"bytes,3,opt,name=split_target,json=splitTarget,proto3" json:"split_target,omitempty"`
+	SpendingConditions *SpendingConditions    `protobuf:"bytes,4,opt,name=spending_conditions,json=spendingConditions,proto3" json:"spending_conditions,omitempty"`
+	TimeoutSecs        uint64                 `protobuf:"varint,5,opt,name=timeout_secs,json=timeoutSecs,proto3" json:"timeout_secs,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *WaitForMintQuoteRequest) Reset() {
+	*x = WaitForMintQuoteRequest{}
+	mi := &file_wallet_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitForMintQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForMintQuoteRequest) ProtoMessage() {}
+
+func (x *WaitForMintQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForMintQuoteRequest.ProtoReflect.Descriptor instead.
+func (*WaitForMintQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *WaitForMintQuoteRequest) GetMintUrl() string {
+	if x != nil {
+		return x.MintUrl
+	}
+	return ""
+}
+
+func (x *WaitForMintQuoteRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *WaitForMintQuoteRequest) GetSplitTarget() *SplitTarget {
+	if x != nil {
+		return x.SplitTarget
+	}
+	return nil
+}
+
+func (x *WaitForMintQuoteRequest) GetSpendingConditions() *SpendingConditions {
+	if x != nil {
+		return x.SpendingConditions
+	}
+	return nil
+}
+
+func (x *WaitForMintQuoteRequest) GetTimeoutSecs() uint64 {
+	if x != nil {
+		return x.TimeoutSecs
+	}
+	return 0
+}
+
+type WaitForMintQuoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proofs        []*Proof               `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitForMintQuoteResponse) Reset() {
+	*x = WaitForMintQuoteResponse{}
+	mi := &file_wallet_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitForMintQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForMintQuoteResponse) ProtoMessage() {}
+
+func (x *WaitForMintQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForMintQuoteResponse.ProtoReflect.Descriptor instead.
+func (*WaitForMintQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *WaitForMintQuoteResponse) GetProofs() []*Proof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+type SpendingConditions_P2Pk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pubkey        string                 `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Conditions    *Conditions            `protobuf:"bytes,2,opt,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpendingConditions_P2Pk) Reset() {
+	*x = SpendingConditions_P2Pk{}
+	mi := &file_wallet_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpendingConditions_P2Pk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendingConditions_P2Pk) ProtoMessage() {}
+
+func (x *SpendingConditions_P2Pk) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendingConditions_P2Pk.ProtoReflect.Descriptor instead.
+func (*SpendingConditions_P2Pk) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *SpendingConditions_P2Pk) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *SpendingConditions_P2Pk) GetConditions() *Conditions {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+type SpendingConditions_Htlc struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Conditions    *Conditions            `protobuf:"bytes,2,opt,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpendingConditions_Htlc) Reset() {
+	*x = SpendingConditions_Htlc{}
+	mi := &file_wallet_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpendingConditions_Htlc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendingConditions_Htlc) ProtoMessage() {}
+
+func (x *SpendingConditions_Htlc) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendingConditions_Htlc.ProtoReflect.Descriptor instead.
+func (*SpendingConditions_Htlc) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{12, 1}
+}
+
+func (x *SpendingConditions_Htlc) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *SpendingConditions_Htlc) GetConditions() *Conditions {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+var File_wallet_proto protoreflect.FileDescriptor
+
+const file_wallet_proto_rawDesc = "" +
+	"\n" +
+	"\fwallet.proto\x12\x0fcdkgo.wallet.v1\"5\n" +
+	"\tProofDleq\x12\f\n" +
+	"\x01e\x18\x01 \x01(\tR\x01e\x12\f\n" +
+	"\x01s\x18\x02 \x01(\tR\x01s\x12\f\n" +
+	"\x01r\x18\x03 \x01(\tR\x01r\"\xa0\x01\n" +
+	"\x05Proof\x12\x1b\n" +
+	"\tkeyset_id\x18\x01 \x01(\tR\bkeysetId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x04R\x06amount\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\x12\f\n" +
+	"\x01c\x18\x04 \x01(\tR\x01c\x12.\n" +
+	"\x04dleq\x18\x05 \x01(\v2\x1a.cdkgo.wallet.v1.ProofDleqR\x04dleq\x12\f\n" +
+	"\x01y\x18\x06 \x01(\tR\x01y\"\\\n" +
+	"\tAuthProof\x12\x1b\n" +
+	"\tkeyset_id\x18\x01 \x01(\tR\bkeysetId\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\f\n" +
+	"\x01c\x18\x03 \x01(\tR\x01c\x12\f\n" +
+	"\x01y\x18\x04 \x01(\tR\x01y\"l\n" +
+	"\n" +
+	"KeySetInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04unit\x18\x02 \x01(\tR\x04unit\x12\x16\n" +
+	"\x06active\x18\x03 \x01(\bR\x06active\x12\"\n" +
+	"\rinput_fee_ppk\x18\x04 \x01(\x04R\vinputFeePpk\"\xf7\x02\n" +
+	"\tMintQuote\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\x06amount\x18\x02 \x01(\x04H\x00R\x06amount\x88\x01\x01\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\x12\x18\n" +
+	"\arequest\x18\x04 \x01(\tR\arequest\x121\n" +
+	"\x05state\x18\x05 \x01(\x0e2\x1b.cdkgo.wallet.v1.QuoteStateR\x05state\x12\x16\n" +
+	"\x06expiry\x18\x06 \x01(\x04R\x06expiry\x12\x19\n" +
+	"\bmint_url\x18\a \x01(\tR\amintUrl\x12#\n" +
+	"\ramount_issued\x18\b \x01(\x04R\famountIssued\x12\x1f\n" +
+	"\vamount_paid\x18\t \x01(\x04R\n" +
+	"amountPaid\x12%\n" +
+	"\x0epayment_method\x18\n" +
+	" \x01(\tR\rpaymentMethod\x12\"\n" +
+	"\n" +
+	"secret_key\x18\v \x01(\tH\x01R\tsecretKey\x88\x01\x01B\t\n" +
+	"\a_amountB\r\n" +
+	"\v_secret_key\"\xb9\x02\n" +
+	"\tMeltQuote\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x04R\x06amount\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\x12\x18\n" +
+	"\arequest\x18\x04 \x01(\tR\arequest\x12\x1f\n" +
+	"\vfee_reserve\x18\x05 \x01(\x04R\n" +
+	"feeReserve\x121\n" +
+	"\x05state\x18\x06 \x01(\x0e2\x1b.cdkgo.wallet.v1.QuoteStateR\x05state\x12\x16\n" +
+	"\x06expiry\x18\a \x01(\x04R\x06expiry\x12.\n" +
+	"\x10payment_preimage\x18\b \x01(\tH\x00R\x0fpaymentPreimage\x88\x01\x01\x12%\n" +
+	"\x0epayment_method\x18\t \x01(\tR\rpaymentMethodB\x13\n" +
+	"\x11_payment_preimage\"\xcc\x01\n" +
+	"\x06Melted\x121\n" +
+	"\x05state\x18\x01 \x01(\x0e2\x1b.cdkgo.wallet.v1.QuoteStateR\x05state\x12\x1f\n" +
+	"\bpreimage\x18\x02 \x01(\tH\x00R\bpreimage\x88\x01\x01\x12.\n" +
+	"\x06change\x18\x03 \x03(\v2\x16.cdkgo.wallet.v1.ProofR\x06change\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x04R\x06amount\x12\x19\n" +
+	"\bfee_paid\x18\x05 \x01(\x04R\afeePaidB\v\n" +
+	"\t_preimage\"\xbd\x03\n" +
+	"\vTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bmint_url\x18\x02 \x01(\tR\amintUrl\x12C\n" +
+	"\tdirection\x18\x03 \x01(\x0e2%.cdkgo.wallet.v1.TransactionDirectionR\tdirection\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x04R\x06amount\x12\x10\n" +
+	"\x03fee\x18\x05 \x01(\x04R\x03fee\x12\x12\n" +
+	"\x04unit\x18\x06 \x01(\tR\x04unit\x12\x0e\n" +
+	"\x02ys\x18\a \x03(\tR\x02ys\x12\x1c\n" +
+	"\ttimestamp\x18\b \x01(\x04R\ttimestamp\x12\x17\n" +
+	"\x04memo\x18\t \x01(\tH\x00R\x04memo\x88\x01\x01\x12F\n" +
+	"\bmetadata\x18\n" +
+	" \x03(\v2*.cdkgo.wallet.v1.Transaction.MetadataEntryR\bmetadata\x12\x1e\n" +
+	"\bquote_id\x18\v \x01(\tH\x01R\aquoteId\x88\x01\x01\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
+	"\x05_memoB\v\n" +
+	"\t_quote_id\"&\n" +
+	"\n" +
+	"AmountList\x12\x18\n" +
+	"\aamounts\x18\x01 \x03(\x04R\aamounts\"f\n" +
+	"\vSplitTarget\x12\x16\n" +
+	"\x05value\x18\x01 \x01(\x04H\x00R\x05value\x125\n" +
+	"\x06values\x18\x02 \x01(\v2\x1b.cdkgo.wallet.v1.AmountListH\x00R\x06valuesB\b\n" +
+	"\x06target\"p\n" +
+	"\vMeltOptions\x12\x1f\n" +
+	"\n" +
+	"mpp_amount\x18\x01 \x01(\x04H\x00R\tmppAmount\x126\n" +
+	"\x16amountless_amount_msat\x18\x02 \x01(\x04H\x00R\x14amountlessAmountMsatB\b\n" +
+	"\x06option\"\xfe\x01\n" +
+	"\n" +
+	"Conditions\x12\x1f\n" +
+	"\blocktime\x18\x01 \x01(\x04H\x00R\blocktime\x88\x01\x01\x12\x18\n" +
+	"\apubkeys\x18\x02 \x03(\tR\apubkeys\x12\x1f\n" +
+	"\vrefund_keys\x18\x03 \x03(\tR\n" +
+	"refundKeys\x12\x1e\n" +
+	"\bnum_sigs\x18\x04 \x01(\x04H\x01R\anumSigs\x88\x01\x01\x12\x19\n" +
+	"\bsig_flag\x18\x05 \x01(\rR\asigFlag\x12+\n" +
+	"\x0fnum_sigs_refund\x18\x06 \x01(\x04H\x02R\rnumSigsRefund\x88\x01\x01B\v\n" +
+	"\t_locktimeB\v\n" +
+	"\t_num_sigsB\x12\n" +
+	"\x10_num_sigs_refund\"\xd2\x02\n" +
+	"\x12SpendingConditions\x12>\n" +
+	"\x04p2pk\x18\x01 \x01(\v2(.cdkgo.wallet.v1.SpendingConditions.P2pkH\x00R\x04p2pk\x12>\n" +
+	"\x04htlc\x18\x02 \x01(\v2(.cdkgo.wallet.v1.SpendingConditions.HtlcH\x00R\x04htlc\x1a[\n" +
+	"\x04P2pk\x12\x16\n" +
+	"\x06pubkey\x18\x01 \x01(\tR\x06pubkey\x12;\n" +
+	"\n" +
+	"conditions\x18\x02 \x01(\v2\x1b.cdkgo.wallet.v1.ConditionsR\n" +
+	"conditions\x1aW\n" +
+	"\x04Htlc\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12;\n" +
+	"\n" +
+	"conditions\x18\x02 \x01(\v2\x1b.cdkgo.wallet.v1.ConditionsR\n" +
+	"conditionsB\x06\n" +
+	"\x04kind\"\xca\x01\n" +
+	"\bSendKind\x122\n" +
+	"\x04mode\x18\x01 \x01(\x0e2\x1e.cdkgo.wallet.v1.SendKind.ModeR\x04mode\x12\x1c\n" +
+	"\ttolerance\x18\x02 \x01(\x04R\ttolerance\"l\n" +
+	"\x04Mode\x12\x15\n" +
+	"\x11MODE_ONLINE_EXACT\x10\x00\x12\x19\n" +
+	"\x15MODE_ONLINE_TOLERANCE\x10\x01\x12\x16\n" +
+	"\x12MODE_OFFLINE_EXACT\x10\x02\x12\x1a\n" +
+	"\x16MODE_OFFLINE_TOLERANCE\x10\x03\"\xf6\x03\n" +
+	"\vSendOptions\x12\x17\n" +
+	"\x04memo\x18\x01 \x01(\tH\x00R\x04memo\x88\x01\x01\x12!\n" +
+	"\finclude_memo\x18\x02 \x01(\bR\vincludeMemo\x12C\n" +
+	"\n" +
+	"conditions\x18\x03 \x01(\v2#.cdkgo.wallet.v1.SpendingConditionsR\n" +
+	"conditions\x12L\n" +
+	"\x13amount_split_target\x18\x04 \x01(\v2\x1c.cdkgo.wallet.v1.SplitTargetR\x11amountSplitTarget\x126\n" +
+	"\tsend_kind\x18\x05 \x01(\v2\x19.cdkgo.wallet.v1.SendKindR\bsendKind\x12\x1f\n" +
+	"\vinclude_fee\x18\x06 \x01(\bR\n" +
+	"includeFee\x12\"\n" +
+	"\n" +
+	"max_proofs\x18\a \x01(\rH\x01R\tmaxProofs\x88\x01\x01\x12F\n" +
+	"\bmetadata\x18\b \x03(\v2*.cdkgo.wallet.v1.SendOptions.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
+	"\x05_memoB\r\n" +
+	"\v_max_proofs\"\xb0\x02\n" +
+	"\x0eReceiveOptions\x12L\n" +
+	"\x13amount_split_target\x18\x01 \x01(\v2\x1c.cdkgo.wallet.v1.SplitTargetR\x11amountSplitTarget\x12*\n" +
+	"\x11p2pk_signing_keys\x18\x02 \x03(\tR\x0fp2pkSigningKeys\x12\x1c\n" +
+	"\tpreimages\x18\x03 \x03(\tR\tpreimages\x12I\n" +
+	"\bmetadata\x18\x04 \x03(\v2-.cdkgo.wallet.v1.ReceiveOptions.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x97\x02\n" +
+	"\x14MultiMintSendOptions\x12%\n" +
+	"\x0eallow_transfer\x18\x01 \x01(\bR\rallowTransfer\x123\n" +
+	"\x13max_transfer_amount\x18\x02 \x01(\x04H\x00R\x11maxTransferAmount\x88\x01\x01\x12#\n" +
+	"\rallowed_mints\x18\x03 \x03(\tR\fallowedMints\x12%\n" +
+	"\x0eexcluded_mints\x18\x04 \x03(\tR\rexcludedMints\x12?\n" +
+	"\fsend_options\x18\x05 \x01(\v2\x1c.cdkgo.wallet.v1.SendOptionsR\vsendOptionsB\x16\n" +
+	"\x14_max_transfer_amount\"\xd0\x01\n" +
+	"\x17MultiMintReceiveOptions\x12'\n" +
+	"\x0fallow_untrusted\x18\x01 \x01(\bR\x0eallowUntrusted\x12-\n" +
+	"\x10transfer_to_mint\x18\x02 \x01(\tH\x00R\x0etransferToMint\x88\x01\x01\x12H\n" +
+	"\x0freceive_options\x18\x03 \x01(\v2\x1f.cdkgo.wallet.v1.ReceiveOptionsR\x0ereceiveOptionsB\x13\n" +
+	"\x11_transfer_to_mint\"x\n" +
+	"\fPreparedSend\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x04R\x06amount\x12\x10\n" +
+	"\x03fee\x18\x03 \x01(\x04R\x03fee\x12.\n" +
+	"\x06proofs\x18\x04 \x03(\v2\x16.cdkgo.wallet.v1.ProofR\x06proofs\"n\n" +
+	"\x13CalculateFeeRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x1f\n" +
+	"\vproof_count\x18\x02 \x01(\rR\n" +
+	"proofCount\x12\x1b\n" +
+	"\tkeyset_id\x18\x03 \x01(\tR\bkeysetId\"(\n" +
+	"\x14CalculateFeeResponse\x12\x10\n" +
+	"\x03fee\x18\x01 \x01(\x04R\x03fee\"9\n" +
+	"\x1cCheckAllPendingProofsRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\"7\n" +
+	"\x1dCheckAllPendingProofsResponse\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x04R\x06amount\"d\n" +
+	"\x17CheckProofsSpentRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12.\n" +
+	"\x06proofs\x18\x02 \x03(\v2\x16.cdkgo.wallet.v1.ProofR\x06proofs\"0\n" +
+	"\x18CheckProofsSpentResponse\x12\x14\n" +
+	"\x05spent\x18\x01 \x03(\bR\x05spent\"3\n" +
+	"\x16GetActiveKeysetRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\"N\n" +
+	"\x17GetActiveKeysetResponse\x123\n" +
+	"\x06keyset\x18\x01 \x01(\v2\x1b.cdkgo.wallet.v1.KeySetInfoR\x06keyset\"R\n" +
+	"\x18GetKeysetFeesByIdRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x1b\n" +
+	"\tkeyset_id\x18\x02 \x01(\tR\bkeysetId\"?\n" +
+	"\x19GetKeysetFeesByIdResponse\x12\"\n" +
+	"\rinput_fee_ppk\x18\x01 \x01(\x04R\vinputFeePpk\"/\n" +
+	"\x12GetMintInfoRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\";\n" +
+	"\x13GetMintInfoResponse\x12$\n" +
+	"\x0emint_info_json\x18\x01 \x01(\tR\fmintInfoJson\"j\n" +
+	"\x18GetProofsByStatesRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x123\n" +
+	"\x06states\x18\x02 \x03(\x0e2\x1b.cdkgo.wallet.v1.ProofStateR\x06states\"K\n" +
+	"\x19GetProofsByStatesResponse\x12.\n" +
+	"\x06proofs\x18\x01 \x03(\v2\x16.cdkgo.wallet.v1.ProofR\x06proofs\"B\n" +
+	"\x15GetTransactionRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"X\n" +
+	"\x16GetTransactionResponse\x12>\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x1c.cdkgo.wallet.v1.TransactionR\vtransaction\"8\n" +
+	"\x1bGetUnspentAuthProofsRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\"R\n" +
+	"\x1cGetUnspentAuthProofsResponse\x122\n" +
+	"\x06proofs\x18\x01 \x03(\v2\x1a.cdkgo.wallet.v1.AuthProofR\x06proofs\"y\n" +
+	"\x17ListTransactionsRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12C\n" +
+	"\tdirection\x18\x02 \x01(\x0e2%.cdkgo.wallet.v1.TransactionDirectionR\tdirection\"\\\n" +
+	"\x18ListTransactionsResponse\x12@\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1c.cdkgo.wallet.v1.TransactionR\ftransactions\"C\n" +
+	"\vMeltRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x19\n" +
+	"\bquote_id\x18\x02 \x01(\tR\aquoteId\"?\n" +
+	"\fMeltResponse\x12/\n" +
+	"\x06melted\x18\x01 \x01(\v2\x17.cdkgo.wallet.v1.MeltedR\x06melted\"{\n" +
+	"\x16MeltBip353QuoteRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12%\n" +
+	"\x0ebip353_address\x18\x02 \x01(\tR\rbip353Address\x12\x1f\n" +
+	"\vamount_msat\x18\x03 \x01(\x04R\n" +
+	"amountMsat\"K\n" +
+	"\x17MeltBip353QuoteResponse\x120\n" +
+	"\x05quote\x18\x01 \x01(\v2\x1a.cdkgo.wallet.v1.MeltQuoteR\x05quote\"\x85\x01\n" +
+	"\x16MeltBolt12QuoteRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x18\n" +
+	"\arequest\x18\x02 \x01(\tR\arequest\x126\n" +
+	"\aoptions\x18\x03 \x01(\v2\x1c.cdkgo.wallet.v1.MeltOptionsR\aoptions\"K\n" +
+	"\x17MeltBolt12QuoteResponse\x120\n" +
+	"\x05quote\x18\x01 \x01(\v2\x1a.cdkgo.wallet.v1.MeltQuoteR\x05quote\"\x7f\n" +
+	"\x10MeltQuoteRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x18\n" +
+	"\arequest\x18\x02 \x01(\tR\arequest\x126\n" +
+	"\aoptions\x18\x03 \x01(\v2\x1c.cdkgo.wallet.v1.MeltOptionsR\aoptions\"E\n" +
+	"\x11MeltQuoteResponse\x120\n" +
+	"\x05quote\x18\x01 \x01(\v2\x1a.cdkgo.wallet.v1.MeltQuoteR\x05quote\"\xe7\x01\n" +
+	"\vMintRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x19\n" +
+	"\bquote_id\x18\x02 \x01(\tR\aquoteId\x12L\n" +
+	"\x13amount_split_target\x18\x03 \x01(\v2\x1c.cdkgo.wallet.v1.SplitTargetR\x11amountSplitTarget\x12T\n" +
+	"\x13spending_conditions\x18\x04 \x01(\v2#.cdkgo.wallet.v1.SpendingConditionsR\x12spendingConditions\">\n" +
+	"\fMintResponse\x12.\n" +
+	"\x06proofs\x18\x01 \x03(\v2\x16.cdkgo.wallet.v1.ProofR\x06proofs\"I\n" +
+	"\x14MintBlindAuthRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x04R\x06amount\"G\n" +
+	"\x15MintBlindAuthResponse\x12.\n" +
+	"\x06proofs\x18\x01 \x03(\v2\x16.cdkgo.wallet.v1.ProofR\x06proofs\"\x95\x02\n" +
+	"\x11MintBolt12Request\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x19\n" +
+	"\bquote_id\x18\x02 \x01(\tR\aquoteId\x12\x1b\n" +
+	"\x06amount\x18\x03 \x01(\x04H\x00R\x06amount\x88\x01\x01\x12L\n" +
+	"\x13amount_split_target\x18\x04 \x01(\v2\x1c.cdkgo.wallet.v1.SplitTargetR\x11amountSplitTarget\x12T\n" +
+	"\x13spending_conditions\x18\x05 \x01(\v2#.cdkgo.wallet.v1.SpendingConditionsR\x12spendingConditionsB\t\n" +
+	"\a_amount\"D\n" +
+	"\x12MintBolt12Response\x12.\n" +
+	"\x06proofs\x18\x01 \x03(\v2\x16.cdkgo.wallet.v1.ProofR\x06proofs\"\x92\x01\n" +
+	"\x16MintBolt12QuoteRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x1b\n" +
+	"\x06amount\x18\x02 \x01(\x04H\x00R\x06amount\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01B\t\n" +
+	"\a_amountB\x0e\n" +
+	"\f_description\"K\n" +
+	"\x17MintBolt12QuoteResponse\x120\n" +
+	"\x05quote\x18\x01 \x01(\v2\x1a.cdkgo.wallet.v1.MintQuoteR\x05quote\"|\n" +
+	"\x10MintQuoteRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x04R\x06amount\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01B\x0e\n" +
+	"\f_description\"E\n" +
+	"\x11MintQuoteResponse\x120\n" +
+	"\x05quote\x18\x01 \x01(\v2\x1a.cdkgo.wallet.v1.MintQuoteR\x05quote\"+\n" +
+	"\x0eGetUnitRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\"%\n" +
+	"\x0fGetUnitResponse\x12\x12\n" +
+	"\x04unit\x18\x01 \x01(\tR\x04unit\"\x7f\n" +
+	"\x12PrepareSendRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x04R\x06amount\x126\n" +
+	"\aoptions\x18\x03 \x01(\v2\x1c.cdkgo.wallet.v1.SendOptionsR\aoptions\"H\n" +
+	"\x13PrepareSendResponse\x121\n" +
+	"\x04send\x18\x01 \x01(\v2\x1d.cdkgo.wallet.v1.PreparedSendR\x04send\"O\n" +
+	"\x12ConfirmSendRequest\x12\x17\n" +
+	"\asend_id\x18\x01 \x01(\tR\x06sendId\x12\x17\n" +
+	"\x04memo\x18\x02 \x01(\tH\x00R\x04memo\x88\x01\x01B\a\n" +
+	"\x05_memo\"+\n" +
+	"\x13ConfirmSendResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\",\n" +
+	"\x11CancelSendRequest\x12\x17\n" +
+	"\asend_id\x18\x01 \x01(\tR\x06sendId\"\x14\n" +
+	"\x12CancelSendResponse\"|\n" +
+	"\x0eReceiveRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x129\n" +
+	"\aoptions\x18\x03 \x01(\v2\x1f.cdkgo.wallet.v1.ReceiveOptionsR\aoptions\")\n" +
+	"\x0fReceiveResponse\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x04R\x06amount\"\xbe\x01\n" +
+	"\x14ReceiveProofsRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12.\n" +
+	"\x06proofs\x18\x02 \x03(\v2\x16.cdkgo.wallet.v1.ProofR\x06proofs\x129\n" +
+	"\aoptions\x18\x03 \x01(\v2\x1f.cdkgo.wallet.v1.ReceiveOptionsR\aoptions\x12\x17\n" +
+	"\x04memo\x18\x04 \x01(\tH\x00R\x04memo\x88\x01\x01B\a\n" +
+	"\x05_memo\"/\n" +
+	"\x15ReceiveProofsResponse\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x04R\x06amount\"b\n" +
+	"\x15ReclaimUnspentRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12.\n" +
+	"\x06proofs\x18\x02 \x03(\v2\x16.cdkgo.wallet.v1.ProofR\x06proofs\"\x18\n" +
+	"\x16ReclaimUnspentResponse\"6\n" +
+	"\x19RefreshAccessTokenRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\"\x1c\n" +
+	"\x1aRefreshAccessTokenResponse\"2\n" +
+	"\x15RefreshKeysetsRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\"O\n" +
+	"\x16RefreshKeysetsResponse\x125\n" +
+	"\akeysets\x18\x01 \x03(\v2\x1b.cdkgo.wallet.v1.KeySetInfoR\akeysets\"+\n" +
+	"\x0eRestoreRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\")\n" +
+	"\x0fRestoreResponse\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x04R\x06amount\"E\n" +
+	"\x18RevertTransactionRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x1b\n" +
+	"\x19RevertTransactionResponse\"<\n" +
+	"\rSetCatRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x10\n" +
+	"\x03cat\x18\x02 \x01(\tR\x03cat\"\x10\n" +
+	"\x0eSetCatResponse\"X\n" +
+	"\x16SetRefreshTokenRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"\x19\n" +
+	"\x17SetRefreshTokenResponse\"\x9a\x01\n" +
+	"\x10SubscribeRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x125\n" +
+	"\x04kind\x18\x02 \x01(\x0e2!.cdkgo.wallet.v1.SubscriptionKindR\x04kind\x12\x18\n" +
+	"\afilters\x18\x03 \x03(\tR\afilters\x12\x13\n" +
+	"\x02id\x18\x04 \x01(\tH\x00R\x02id\x88\x01\x01B\x05\n" +
+	"\x03_id\"\x80\x02\n" +
+	"\x0fMintQuoteUpdate\x12\x14\n" +
+	"\x05quote\x18\x01 \x01(\tR\x05quote\x12\x18\n" +
+	"\arequest\x18\x02 \x01(\tR\arequest\x121\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x1b.cdkgo.wallet.v1.QuoteStateR\x05state\x12\x1b\n" +
+	"\x06amount\x18\x04 \x01(\x04H\x00R\x06amount\x88\x01\x01\x12\x12\n" +
+	"\x04unit\x18\x05 \x01(\tR\x04unit\x12\x1b\n" +
+	"\x06expiry\x18\x06 \x01(\x04H\x01R\x06expiry\x88\x01\x01\x12\x1b\n" +
+	"\x06pubkey\x18\a \x01(\tH\x02R\x06pubkey\x88\x01\x01B\t\n" +
+	"\a_amountB\t\n" +
+	"\a_expiryB\t\n" +
+	"\a_pubkey\"\xaf\x02\n" +
+	"\x0fMeltQuoteUpdate\x12\x14\n" +
+	"\x05quote\x18\x01 \x01(\tR\x05quote\x12\x1d\n" +
+	"\arequest\x18\x02 \x01(\tH\x00R\arequest\x88\x01\x01\x121\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x1b.cdkgo.wallet.v1.QuoteStateR\x05state\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x04R\x06amount\x12\x1f\n" +
+	"\vfee_reserve\x18\x05 \x01(\x04R\n" +
+	"feeReserve\x12\x12\n" +
+	"\x04unit\x18\x06 \x01(\tR\x04unit\x12\x16\n" +
+	"\x06expiry\x18\a \x01(\x04R\x06expiry\x12.\n" +
+	"\x10payment_preimage\x18\b \x01(\tH\x01R\x0fpaymentPreimage\x88\x01\x01B\n" +
+	"\n" +
+	"\b_requestB\x13\n" +
+	"\x11_payment_preimage\"~\n" +
+	"\x10ProofStateUpdate\x12\f\n" +
+	"\x01y\x18\x01 \x01(\tR\x01y\x121\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x1b.cdkgo.wallet.v1.ProofStateR\x05state\x12\x1d\n" +
+	"\awitness\x18\x03 \x01(\tH\x00R\awitness\x88\x01\x01B\n" +
+	"\n" +
+	"\b_witness\"P\n" +
+	"\x11ProofStateUpdates\x12;\n" +
+	"\aupdates\x18\x01 \x03(\v2!.cdkgo.wallet.v1.ProofStateUpdateR\aupdates\"\xed\x01\n" +
+	"\x11SubscribeResponse\x12A\n" +
+	"\n" +
+	"mint_quote\x18\x01 \x01(\v2 .cdkgo.wallet.v1.MintQuoteUpdateH\x00R\tmintQuote\x12A\n" +
+	"\n" +
+	"melt_quote\x18\x02 \x01(\v2 .cdkgo.wallet.v1.MeltQuoteUpdateH\x00R\tmeltQuote\x12G\n" +
+	"\fproof_states\x18\x03 \x01(\v2\".cdkgo.wallet.v1.ProofStateUpdatesH\x00R\vproofStatesB\t\n" +
+	"\apayload\"\xd2\x02\n" +
+	"\vSwapRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x1b\n" +
+	"\x06amount\x18\x02 \x01(\x04H\x00R\x06amount\x88\x01\x01\x12L\n" +
+	"\x13amount_split_target\x18\x03 \x01(\v2\x1c.cdkgo.wallet.v1.SplitTargetR\x11amountSplitTarget\x129\n" +
+	"\finput_proofs\x18\x04 \x03(\v2\x16.cdkgo.wallet.v1.ProofR\vinputProofs\x12T\n" +
+	"\x13spending_conditions\x18\x05 \x01(\v2#.cdkgo.wallet.v1.SpendingConditionsR\x12spendingConditions\x12!\n" +
+	"\finclude_fees\x18\x06 \x01(\bR\vincludeFeesB\t\n" +
+	"\a_amount\">\n" +
+	"\fSwapResponse\x12.\n" +
+	"\x06proofs\x18\x01 \x03(\v2\x16.cdkgo.wallet.v1.ProofR\x06proofs\"0\n" +
+	"\x13TotalBalanceRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\".\n" +
+	"\x14TotalBalanceResponse\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x04R\x06amount\"7\n" +
+	"\x1aTotalPendingBalanceRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\"5\n" +
+	"\x1bTotalPendingBalanceResponse\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x04R\x06amount\"8\n" +
+	"\x1bTotalReservedBalanceRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\"6\n" +
+	"\x1cTotalReservedBalanceResponse\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x04R\x06amount\"I\n" +
+	"\x16VerifyTokenDleqRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x19\n" +
+	"\x17VerifyTokenDleqResponse\"u\n" +
+	"\x0eAddMintRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x121\n" +
+	"\x12target_proof_count\x18\x02 \x01(\rH\x00R\x10targetProofCount\x88\x01\x01B\x15\n" +
+	"\x13_target_proof_count\"\x11\n" +
+	"\x0fAddMintResponse\"H\n" +
+	"\x19CheckAllMintQuotesRequest\x12\x1e\n" +
+	"\bmint_url\x18\x01 \x01(\tH\x00R\amintUrl\x88\x01\x01B\v\n" +
+	"\t_mint_url\"4\n" +
+	"\x1aCheckAllMintQuotesResponse\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x04R\x06amount\"M\n" +
+	"\x15CheckMintQuoteRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x19\n" +
+	"\bquote_id\x18\x02 \x01(\tR\aquoteId\"J\n" +
+	"\x16CheckMintQuoteResponse\x120\n" +
+	"\x05quote\x18\x01 \x01(\v2\x1a.cdkgo.wallet.v1.MintQuoteR\x05quote\"\x14\n" +
+	"\x12ConsolidateRequest\"-\n" +
+	"\x13ConsolidateResponse\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x04R\x06amount\"\x14\n" +
+	"\x12GetBalancesRequest\"\xa2\x01\n" +
+	"\x13GetBalancesResponse\x12N\n" +
+	"\bbalances\x18\x01 \x03(\v22.cdkgo.wallet.v1.GetBalancesResponse.BalancesEntryR\bbalances\x1a;\n" +
+	"\rBalancesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x04R\x05value:\x028\x01\"\x14\n" +
+	"\x12GetMintUrlsRequest\"2\n" +
+	"\x13GetMintUrlsResponse\x12\x1b\n" +
+	"\tmint_urls\x18\x01 \x03(\tR\bmintUrls\"+\n" +
+	"\x0eHasMintRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\",\n" +
+	"\x0fHasMintResponse\x12\x19\n" +
+	"\bhas_mint\x18\x01 \x01(\bR\ahasMint\"\x13\n" +
+	"\x11ListProofsRequest\";\n" +
+	"\tProofList\x12.\n" +
+	"\x06proofs\x18\x01 \x03(\v2\x16.cdkgo.wallet.v1.ProofR\x06proofs\"\xb4\x01\n" +
+	"\x12ListProofsResponse\x12G\n" +
+	"\x06proofs\x18\x01 \x03(\v2/.cdkgo.wallet.v1.ListProofsResponse.ProofsEntryR\x06proofs\x1aU\n" +
+	"\vProofsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.cdkgo.wallet.v1.ProofListR\x05value:\x028\x01\"g\n" +
+	" MultiMintListTransactionsRequest\x12C\n" +
+	"\tdirection\x18\x01 \x01(\x0e2%.cdkgo.wallet.v1.TransactionDirectionR\tdirection\"e\n" +
+	"!MultiMintListTransactionsResponse\x12@\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1c.cdkgo.wallet.v1.TransactionR\ftransactions\"\x90\x01\n" +
+	"\x14MultiMintMeltRequest\x12\x16\n" +
+	"\x06bolt11\x18\x01 \x01(\tR\x06bolt11\x126\n" +
+	"\aoptions\x18\x02 \x01(\v2\x1c.cdkgo.wallet.v1.MeltOptionsR\aoptions\x12\x1c\n" +
+	"\amax_fee\x18\x03 \x01(\x04H\x00R\x06maxFee\x88\x01\x01B\n" +
+	"\n" +
+	"\b_max_fee\"H\n" +
+	"\x15MultiMintMeltResponse\x12/\n" +
+	"\x06melted\x18\x01 \x01(\v2\x17.cdkgo.wallet.v1.MeltedR\x06melted\"\x88\x01\n" +
+	"\x19MultiMintMeltQuoteRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x18\n" +
+	"\arequest\x18\x02 \x01(\tR\arequest\x126\n" +
+	"\aoptions\x18\x03 \x01(\v2\x1c.cdkgo.wallet.v1.MeltOptionsR\aoptions\"N\n" +
+	"\x1aMultiMintMeltQuoteResponse\x120\n" +
+	"\x05quote\x18\x01 \x01(\v2\x1a.cdkgo.wallet.v1.MeltQuoteR\x05quote\"\xa2\x01\n" +
+	"\x14MultiMintMintRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x19\n" +
+	"\bquote_id\x18\x02 \x01(\tR\aquoteId\x12T\n" +
+	"\x13spending_conditions\x18\x03 \x01(\v2#.cdkgo.wallet.v1.SpendingConditionsR\x12spendingConditions\"G\n" +
+	"\x15MultiMintMintResponse\x12.\n" +
+	"\x06proofs\x18\x01 \x03(\v2\x16.cdkgo.wallet.v1.ProofR\x06proofs\"\x85\x01\n" +
+	"\x19MultiMintMintQuoteRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x04R\x06amount\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01B\x0e\n" +
+	"\f_description\"N\n" +
+	"\x1aMultiMintMintQuoteResponse\x120\n" +
+	"\x05quote\x18\x01 \x01(\v2\x1a.cdkgo.wallet.v1.MintQuoteR\x05quote\"\x19\n" +
+	"\x17MultiMintGetUnitRequest\".\n" +
+	"\x18MultiMintGetUnitResponse\x12\x12\n" +
+	"\x04unit\x18\x01 \x01(\tR\x04unit\"\x91\x01\n" +
+	"\x1bMultiMintPrepareSendRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x04R\x06amount\x12?\n" +
+	"\aoptions\x18\x03 \x01(\v2%.cdkgo.wallet.v1.MultiMintSendOptionsR\aoptions\"Q\n" +
+	"\x1cMultiMintPrepareSendResponse\x121\n" +
+	"\x04send\x18\x01 \x01(\v2\x1d.cdkgo.wallet.v1.PreparedSendR\x04send\"X\n" +
+	"\x1bMultiMintConfirmSendRequest\x12\x17\n" +
+	"\asend_id\x18\x01 \x01(\tR\x06sendId\x12\x17\n" +
+	"\x04memo\x18\x02 \x01(\tH\x00R\x04memo\x88\x01\x01B\a\n" +
+	"\x05_memo\"4\n" +
+	"\x1cMultiMintConfirmSendResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"5\n" +
+	"\x1aMultiMintCancelSendRequest\x12\x17\n" +
+	"\asend_id\x18\x01 \x01(\tR\x06sendId\"\x1d\n" +
+	"\x1bMultiMintCancelSendResponse\"s\n" +
+	"\x17MultiMintReceiveRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12B\n" +
+	"\aoptions\x18\x02 \x01(\v2(.cdkgo.wallet.v1.MultiMintReceiveOptionsR\aoptions\"2\n" +
+	"\x18MultiMintReceiveResponse\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x04R\x06amount\".\n" +
+	"\x11RemoveMintRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\"\x14\n" +
+	"\x12RemoveMintResponse\"4\n" +
+	"\x17MultiMintRestoreRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\"2\n" +
+	"\x18MultiMintRestoreResponse\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x04R\x06amount\"\x94\x01\n" +
+	"\x14MultiMintSwapRequest\x12\x1b\n" +
+	"\x06amount\x18\x01 \x01(\x04H\x00R\x06amount\x88\x01\x01\x12T\n" +
+	"\x13spending_conditions\x18\x02 \x01(\v2#.cdkgo.wallet.v1.SpendingConditionsR\x12spendingConditionsB\t\n" +
+	"\a_amount\"G\n" +
+	"\x15MultiMintSwapResponse\x12.\n" +
+	"\x06proofs\x18\x01 \x03(\v2\x16.cdkgo.wallet.v1.ProofR\x06proofs\"\x1e\n" +
+	"\x1cMultiMintTotalBalanceRequest\"7\n" +
+	"\x1dMultiMintTotalBalanceResponse\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x04R\x06amount\"\xa3\x01\n" +
+	"\x0fTransferRequest\x12\x1f\n" +
+	"\vsource_mint\x18\x01 \x01(\tR\n" +
+	"sourceMint\x12\x1f\n" +
+	"\vtarget_mint\x18\x02 \x01(\tR\n" +
+	"targetMint\x125\n" +
+	"\x14exact_receive_amount\x18\x03 \x01(\x04H\x00R\x12exactReceiveAmount\x88\x01\x01B\x17\n" +
+	"\x15_exact_receive_amount\"\xdd\x01\n" +
+	"\x10TransferResponse\x12\x1f\n" +
+	"\vamount_sent\x18\x01 \x01(\x04R\n" +
+	"amountSent\x12'\n" +
+	"\x0famount_received\x18\x02 \x01(\x04R\x0eamountReceived\x12\x1b\n" +
+	"\tfees_paid\x18\x03 \x01(\x04R\bfeesPaid\x120\n" +
+	"\x14source_balance_after\x18\x04 \x01(\x04R\x12sourceBalanceAfter\x120\n" +
+	"\x14target_balance_after\x18\x05 \x01(\x04R\x12targetBalanceAfter\"7\n" +
+	"\x1fMultiMintVerifyTokenDleqRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\"\n" +
+	" MultiMintVerifyTokenDleqResponse\"\x89\x02\n" +
+	"\x17WaitForMintQuoteRequest\x12\x19\n" +
+	"\bmint_url\x18\x01 \x01(\tR\amintUrl\x12\x19\n" +
+	"\bquote_id\x18\x02 \x01(\tR\aquoteId\x12?\n" +
+	"\fsplit_target\x18\x03 \x01(\v2\x1c.cdkgo.wallet.v1.SplitTargetR\vsplitTarget\x12T\n" +
+	"\x13spending_conditions\x18\x04 \x01(\v2#.cdkgo.wallet.v1.SpendingConditionsR\x12spendingConditions\x12!\n" +
+	"\ftimeout_secs\x18\x05 \x01(\x04R\vtimeoutSecs\"J\n" +
+	"\x18WaitForMintQuoteResponse\x12.\n" +
+	"\x06proofs\x18\x01 \x03(\v2\x16.cdkgo.wallet.v1.ProofR\x06proofs*\x88\x01\n" +
+	"\n" +
+	"QuoteState\x12\x1b\n" +
+	"\x17QUOTE_STATE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12QUOTE_STATE_UNPAID\x10\x01\x12\x14\n" +
+	"\x10QUOTE_STATE_PAID\x10\x02\x12\x17\n" +
+	"\x13QUOTE_STATE_PENDING\x10\x03\x12\x16\n" +
+	"\x12QUOTE_STATE_ISSUED\x10\x04*\xab\x01\n" +
+	"\n" +
+	"ProofState\x12\x1b\n" +
+	"\x17PROOF_STATE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13PROOF_STATE_UNSPENT\x10\x01\x12\x17\n" +
+	"\x13PROOF_STATE_PENDING\x10\x02\x12\x15\n" +
+	"\x11PROOF_STATE_SPENT\x10\x03\x12\x18\n" +
+	"\x14PROOF_STATE_RESERVED\x10\x04\x12\x1d\n" +
+	"\x19PROOF_STATE_PENDING_SPENT\x10\x05*\x85\x01\n" +
+	"\x14TransactionDirection\x12%\n" +
+	"!TRANSACTION_DIRECTION_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eTRANSACTION_DIRECTION_INCOMING\x10\x01\x12\"\n" +
+	"\x1eTRANSACTION_DIRECTION_OUTGOING\x10\x02*\xd3\x01\n" +
+	"\x10SubscriptionKind\x12!\n" +
+	"\x1dSUBSCRIPTION_KIND_UNSPECIFIED\x10\x00\x12'\n" +
+	"#SUBSCRIPTION_KIND_BOLT11_MELT_QUOTE\x10\x01\x12'\n" +
+	"#SUBSCRIPTION_KIND_BOLT11_MINT_QUOTE\x10\x02\x12'\n" +
+	"#SUBSCRIPTION_KIND_BOLT12_MINT_QUOTE\x10\x03\x12!\n" +
+	"\x1dSUBSCRIPTION_KIND_PROOF_STATE\x10\x042\xad\x1c\n" +
+	"\rWalletService\x12[\n" +
+	"\fCalculateFee\x12$.cdkgo.wallet.v1.CalculateFeeRequest\x1a%.cdkgo.wallet.v1.CalculateFeeResponse\x12v\n" +
+	"\x15CheckAllPendingProofs\x12-.cdkgo.wallet.v1.CheckAllPendingProofsRequest\x1a..cdkgo.wallet.v1.CheckAllPendingProofsResponse\x12g\n" +
+	"\x10CheckProofsSpent\x12(.cdkgo.wallet.v1.CheckProofsSpentRequest\x1a).cdkgo.wallet.v1.CheckProofsSpentResponse\x12d\n" +
+	"\x0fGetActiveKeyset\x12'.cdkgo.wallet.v1.GetActiveKeysetRequest\x1a(.cdkgo.wallet.v1.GetActiveKeysetResponse\x12j\n" +
+	"\x11GetKeysetFeesById\x12).cdkgo.wallet.v1.GetKeysetFeesByIdRequest\x1a*.cdkgo.wallet.v1.GetKeysetFeesByIdResponse\x12X\n" +
+	"\vGetMintInfo\x12#.cdkgo.wallet.v1.GetMintInfoRequest\x1a$.cdkgo.wallet.v1.GetMintInfoResponse\x12j\n" +
+	"\x11GetProofsByStates\x12).cdkgo.wallet.v1.GetProofsByStatesRequest\x1a*.cdkgo.wallet.v1.GetProofsByStatesResponse\x12a\n" +
+	"\x0eGetTransaction\x12&.cdkgo.wallet.v1.GetTransactionRequest\x1a'.cdkgo.wallet.v1.GetTransactionResponse\x12s\n" +
+	"\x14GetUnspentAuthProofs\x12,.cdkgo.wallet.v1.GetUnspentAuthProofsRequest\x1a-.cdkgo.wallet.v1.GetUnspentAuthProofsResponse\x12g\n" +
+	"\x10ListTransactions\x12(.cdkgo.wallet.v1.ListTransactionsRequest\x1a).cdkgo.wallet.v1.ListTransactionsResponse\x12C\n" +
+	"\x04Melt\x12\x1c.cdkgo.wallet.v1.MeltRequest\x1a\x1d.cdkgo.wallet.v1.MeltResponse\x12d\n" +
+	"\x0fMeltBip353Quote\x12'.cdkgo.wallet.v1.MeltBip353QuoteRequest\x1a(.cdkgo.wallet.v1.MeltBip353QuoteResponse\x12d\n" +
+	"\x0fMeltBolt12Quote\x12'.cdkgo.wallet.v1.MeltBolt12QuoteRequest\x1a(.cdkgo.wallet.v1.MeltBolt12QuoteResponse\x12R\n" +
+	"\tMeltQuote\x12!.cdkgo.wallet.v1.MeltQuoteRequest\x1a\".cdkgo.wallet.v1.MeltQuoteResponse\x12C\n" +
+	"\x04Mint\x12\x1c.cdkgo.wallet.v1.MintRequest\x1a\x1d.cdkgo.wallet.v1.MintResponse\x12^\n" +
+	"\rMintBlindAuth\x12%.cdkgo.wallet.v1.MintBlindAuthRequest\x1a&.cdkgo.wallet.v1.MintBlindAuthResponse\x12U\n" +
+	"\n" +
+	"MintBolt12\x12\".cdkgo.wallet.v1.MintBolt12Request\x1a#.cdkgo.wallet.v1.MintBolt12Response\x12d\n" +
+	"\x0fMintBolt12Quote\x12'.cdkgo.wallet.v1.MintBolt12QuoteRequest\x1a(.cdkgo.wallet.v1.MintBolt12QuoteResponse\x12R\n" +
+	"\tMintQuote\x12!.cdkgo.wallet.v1.MintQuoteRequest\x1a\".cdkgo.wallet.v1.MintQuoteResponse\x12L\n" +
+	"\aGetUnit\x12\x1f.cdkgo.wallet.v1.GetUnitRequest\x1a .cdkgo.wallet.v1.GetUnitResponse\x12X\n" +
+	"\vPrepareSend\x12#.cdkgo.wallet.v1.PrepareSendRequest\x1a$.cdkgo.wallet.v1.PrepareSendResponse\x12X\n" +
+	"\vConfirmSend\x12#.cdkgo.wallet.v1.ConfirmSendRequest\x1a$.cdkgo.wallet.v1.ConfirmSendResponse\x12U\n" +
+	"\n" +
+	"CancelSend\x12\".cdkgo.wallet.v1.CancelSendRequest\x1a#.cdkgo.wallet.v1.CancelSendResponse\x12L\n" +
+	"\aReceive\x12\x1f.cdkgo.wallet.v1.ReceiveRequest\x1a .cdkgo.wallet.v1.ReceiveResponse\x12^\n" +
+	"\rReceiveProofs\x12%.cdkgo.wallet.v1.ReceiveProofsRequest\x1a&.cdkgo.wallet.v1.ReceiveProofsResponse\x12a\n" +
+	"\x0eReclaimUnspent\x12&.cdkgo.wallet.v1.ReclaimUnspentRequest\x1a'.cdkgo.wallet.v1.ReclaimUnspentResponse\x12m\n" +
+	"\x12RefreshAccessToken\x12*.cdkgo.wallet.v1.RefreshAccessTokenRequest\x1a+.cdkgo.wallet.v1.RefreshAccessTokenResponse\x12a\n" +
+	"\x0eRefreshKeysets\x12&.cdkgo.wallet.v1.RefreshKeysetsRequest\x1a'.cdkgo.wallet.v1.RefreshKeysetsResponse\x12L\n" +
+	"\aRestore\x12\x1f.cdkgo.wallet.v1.RestoreRequest\x1a .cdkgo.wallet.v1.RestoreResponse\x12j\n" +
+	"\x11RevertTransaction\x12).cdkgo.wallet.v1.RevertTransactionRequest\x1a*.cdkgo.wallet.v1.RevertTransactionResponse\x12I\n" +
+	"\x06SetCat\x12\x1e.cdkgo.wallet.v1.SetCatRequest\x1a\x1f.cdkgo.wallet.v1.SetCatResponse\x12d\n" +
+	"\x0fSetRefreshToken\x12'.cdkgo.wallet.v1.SetRefreshTokenRequest\x1a(.cdkgo.wallet.v1.SetRefreshTokenResponse\x12T\n" +
+	"\tSubscribe\x12!.cdkgo.wallet.v1.SubscribeRequest\x1a\".cdkgo.wallet.v1.SubscribeResponse0\x01\x12C\n" +
+	"\x04Swap\x12\x1c.cdkgo.wallet.v1.SwapRequest\x1a\x1d.cdkgo.wallet.v1.SwapResponse\x12[\n" +
+	"\fTotalBalance\x12$.cdkgo.wallet.v1.TotalBalanceRequest\x1a%.cdkgo.wallet.v1.TotalBalanceResponse\x12p\n" +
+	"\x13TotalPendingBalance\x12+.cdkgo.wallet.v1.TotalPendingBalanceRequest\x1a,.cdkgo.wallet.v1.TotalPendingBalanceResponse\x12s\n" +
+	"\x14TotalReservedBalance\x12,.cdkgo.wallet.v1.TotalReservedBalanceRequest\x1a-.cdkgo.wallet.v1.TotalReservedBalanceResponse\x12d\n" +
+	"\x0fVerifyTokenDleq\x12'.cdkgo.wallet.v1.VerifyTokenDleqRequest\x1a(.cdkgo.wallet.v1.VerifyTokenDleqResponse2\x90\x13\n" +
+	"\x16MultiMintWalletService\x12L\n" +
+	"\aAddMint\x12\x1f.cdkgo.wallet.v1.AddMintRequest\x1a .cdkgo.wallet.v1.AddMintResponse\x12m\n" +
+	"\x12CheckAllMintQuotes\x12*.cdkgo.wallet.v1.CheckAllMintQuotesRequest\x1a+.cdkgo.wallet.v1.CheckAllMintQuotesResponse\x12a\n" +
+	"\x0eCheckMintQuote\x12&.cdkgo.wallet.v1.CheckMintQuoteRequest\x1a'.cdkgo.wallet.v1.CheckMintQuoteResponse\x12X\n" +
+	"\vConsolidate\x12#.cdkgo.wallet.v1.ConsolidateRequest\x1a$.cdkgo.wallet.v1.ConsolidateResponse\x12X\n" +
+	"\vGetBalances\x12#.cdkgo.wallet.v1.GetBalancesRequest\x1a$.cdkgo.wallet.v1.GetBalancesResponse\x12X\n" +
+	"\vGetMintUrls\x12#.cdkgo.wallet.v1.GetMintUrlsRequest\x1a$.cdkgo.wallet.v1.GetMintUrlsResponse\x12L\n" +
+	"\aHasMint\x12\x1f.cdkgo.wallet.v1.HasMintRequest\x1a .cdkgo.wallet.v1.HasMintResponse\x12U\n" +
+	"\n" +
+	"ListProofs\x12\".cdkgo.wallet.v1.ListProofsRequest\x1a#.cdkgo.wallet.v1.ListProofsResponse\x12y\n" +
+	"\x10ListTransactions\x121.cdkgo.wallet.v1.MultiMintListTransactionsRequest\x1a2.cdkgo.wallet.v1.MultiMintListTransactionsResponse\x12U\n" +
+	"\x04Melt\x12%.cdkgo.wallet.v1.MultiMintMeltRequest\x1a&.cdkgo.wallet.v1.MultiMintMeltResponse\x12d\n" +
+	"\tMeltQuote\x12*.cdkgo.wallet.v1.MultiMintMeltQuoteRequest\x1a+.cdkgo.wallet.v1.MultiMintMeltQuoteResponse\x12U\n" +
+	"\x04Mint\x12%.cdkgo.wallet.v1.MultiMintMintRequest\x1a&.cdkgo.wallet.v1.MultiMintMintResponse\x12d\n" +
+	"\tMintQuote\x12*.cdkgo.wallet.v1.MultiMintMintQuoteRequest\x1a+.cdkgo.wallet.v1.MultiMintMintQuoteResponse\x12^\n" +
+	"\aGetUnit\x12(.cdkgo.wallet.v1.MultiMintGetUnitRequest\x1a).cdkgo.wallet.v1.MultiMintGetUnitResponse\x12j\n" +
+	"\vPrepareSend\x12,.cdkgo.wallet.v1.MultiMintPrepareSendRequest\x1a-.cdkgo.wallet.v1.MultiMintPrepareSendResponse\x12j\n" +
+	"\vConfirmSend\x12,.cdkgo.wallet.v1.MultiMintConfirmSendRequest\x1a-.cdkgo.wallet.v1.MultiMintConfirmSendResponse\x12g\n" +
+	"\n" +
+	"CancelSend\x12+.cdkgo.wallet.v1.MultiMintCancelSendRequest\x1a,.cdkgo.wallet.v1.MultiMintCancelSendResponse\x12^\n" +
+	"\aReceive\x12(.cdkgo.wallet.v1.MultiMintReceiveRequest\x1a).cdkgo.wallet.v1.MultiMintReceiveResponse\x12U\n" +
+	"\n" +
+	"RemoveMint\x12\".cdkgo.wallet.v1.RemoveMintRequest\x1a#.cdkgo.wallet.v1.RemoveMintResponse\x12^\n" +
+	"\aRestore\x12(.cdkgo.wallet.v1.MultiMintRestoreRequest\x1a).cdkgo.wallet.v1.MultiMintRestoreResponse\x12U\n" +
+	"\x04Swap\x12%.cdkgo.wallet.v1.MultiMintSwapRequest\x1a&.cdkgo.wallet.v1.MultiMintSwapResponse\x12m\n" +
+	"\fTotalBalance\x12-.cdkgo.wallet.v1.MultiMintTotalBalanceRequest\x1a..cdkgo.wallet.v1.MultiMintTotalBalanceResponse\x12O\n" +
+	"\bTransfer\x12 .cdkgo.wallet.v1.TransferRequest\x1a!.cdkgo.wallet.v1.TransferResponse\x12v\n" +
+	"\x0fVerifyTokenDleq\x120.cdkgo.wallet.v1.MultiMintVerifyTokenDleqRequest\x1a1.cdkgo.wallet.v1.MultiMintVerifyTokenDleqResponse\x12g\n" +
+	"\x10WaitForMintQuote\x12(.cdkgo.wallet.v1.WaitForMintQuoteRequest\x1a).cdkgo.wallet.v1.WaitForMintQuoteResponseB&Z$github.com/lescuer97/cdkgo/walletrpcb\x06proto3"
+
+var (
+	file_wallet_proto_rawDescOnce sync.Once
+	file_wallet_proto_rawDescData []byte
+)
+
+func file_wallet_proto_rawDescGZIP() []byte {
+	file_wallet_proto_rawDescOnce.Do(func() {
+		file_wallet_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_wallet_proto_rawDesc), len(file_wallet_proto_rawDesc)))
+	})
+	return file_wallet_proto_rawDescData
+}
+
+var file_wallet_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_wallet_proto_msgTypes = make([]protoimpl.MessageInfo, 157)
+var file_wallet_proto_goTypes = []any{
+	(QuoteState)(0),                           // 0: cdkgo.wallet.v1.QuoteState
+	(ProofState)(0),                           // 1: cdkgo.wallet.v1.ProofState
+	(TransactionDirection)(0),                 // 2: cdkgo.wallet.v1.TransactionDirection
+	(SubscriptionKind)(0),                     // 3: cdkgo.wallet.v1.SubscriptionKind
+	(SendKind_Mode)(0),                        // 4: cdkgo.wallet.v1.SendKind.Mode
+	(*ProofDleq)(nil),                         // 5: cdkgo.wallet.v1.ProofDleq
+	(*Proof)(nil),                             // 6: cdkgo.wallet.v1.Proof
+	(*AuthProof)(nil),                         // 7: cdkgo.wallet.v1.AuthProof
+	(*KeySetInfo)(nil),                        // 8: cdkgo.wallet.v1.KeySetInfo
+	(*MintQuote)(nil),                         // 9: cdkgo.wallet.v1.MintQuote
+	(*MeltQuote)(nil),                         // 10: cdkgo.wallet.v1.MeltQuote
+	(*Melted)(nil),                            // 11: cdkgo.wallet.v1.Melted
+	(*Transaction)(nil),                       // 12: cdkgo.wallet.v1.Transaction
+	(*AmountList)(nil),                        // 13: cdkgo.wallet.v1.AmountList
+	(*SplitTarget)(nil),                       // 14: cdkgo.wallet.v1.SplitTarget
+	(*MeltOptions)(nil),                       // 15: cdkgo.wallet.v1.MeltOptions
+	(*Conditions)(nil),                        // 16: cdkgo.wallet.v1.Conditions
+	(*SpendingConditions)(nil),                // 17: cdkgo.wallet.v1.SpendingConditions
+	(*SendKind)(nil),                          // 18: cdkgo.wallet.v1.SendKind
+	(*SendOptions)(nil),                       // 19: cdkgo.wallet.v1.SendOptions
+	(*ReceiveOptions)(nil),                    // 20: cdkgo.wallet.v1.ReceiveOptions
+	(*MultiMintSendOptions)(nil),              // 21: cdkgo.wallet.v1.MultiMintSendOptions
+	(*MultiMintReceiveOptions)(nil),           // 22: cdkgo.wallet.v1.MultiMintReceiveOptions
+	(*PreparedSend)(nil),                      // 23: cdkgo.wallet.v1.PreparedSend
+	(*CalculateFeeRequest)(nil),               // 24: cdkgo.wallet.v1.CalculateFeeRequest
+	(*CalculateFeeResponse)(nil),              // 25: cdkgo.wallet.v1.CalculateFeeResponse
+	(*CheckAllPendingProofsRequest)(nil),      // 26: cdkgo.wallet.v1.CheckAllPendingProofsRequest
+	(*CheckAllPendingProofsResponse)(nil),     // 27: cdkgo.wallet.v1.CheckAllPendingProofsResponse
+	(*CheckProofsSpentRequest)(nil),           // 28: cdkgo.wallet.v1.CheckProofsSpentRequest
+	(*CheckProofsSpentResponse)(nil),          // 29: cdkgo.wallet.v1.CheckProofsSpentResponse
+	(*GetActiveKeysetRequest)(nil),            // 30: cdkgo.wallet.v1.GetActiveKeysetRequest
+	(*GetActiveKeysetResponse)(nil),           // 31: cdkgo.wallet.v1.GetActiveKeysetResponse
+	(*GetKeysetFeesByIdRequest)(nil),          // 32: cdkgo.wallet.v1.GetKeysetFeesByIdRequest
+	(*GetKeysetFeesByIdResponse)(nil),         // 33: cdkgo.wallet.v1.GetKeysetFeesByIdResponse
+	(*GetMintInfoRequest)(nil),                // 34: cdkgo.wallet.v1.GetMintInfoRequest
+	(*GetMintInfoResponse)(nil),               // 35: cdkgo.wallet.v1.GetMintInfoResponse
+	(*GetProofsByStatesRequest)(nil),          // 36: cdkgo.wallet.v1.GetProofsByStatesRequest
+	(*GetProofsByStatesResponse)(nil),         // 37: cdkgo.wallet.v1.GetProofsByStatesResponse
+	(*GetTransactionRequest)(nil),             // 38: cdkgo.wallet.v1.GetTransactionRequest
+	(*GetTransactionResponse)(nil),            // 39: cdkgo.wallet.v1.GetTransactionResponse
+	(*GetUnspentAuthProofsRequest)(nil),       // 40: cdkgo.wallet.v1.GetUnspentAuthProofsRequest
+	(*GetUnspentAuthProofsResponse)(nil),      // 41: cdkgo.wallet.v1.GetUnspentAuthProofsResponse
+	(*ListTransactionsRequest)(nil),           // 42: cdkgo.wallet.v1.ListTransactionsRequest
+	(*ListTransactionsResponse)(nil),          // 43: cdkgo.wallet.v1.ListTransactionsResponse
+	(*MeltRequest)(nil),                       // 44: cdkgo.wallet.v1.MeltRequest
+	(*MeltResponse)(nil),                      // 45: cdkgo.wallet.v1.MeltResponse
+	(*MeltBip353QuoteRequest)(nil),            // 46: cdkgo.wallet.v1.MeltBip353QuoteRequest
+	(*MeltBip353QuoteResponse)(nil),           // 47: cdkgo.wallet.v1.MeltBip353QuoteResponse
+	(*MeltBolt12QuoteRequest)(nil),            // 48: cdkgo.wallet.v1.MeltBolt12QuoteRequest
+	(*MeltBolt12QuoteResponse)(nil),           // 49: cdkgo.wallet.v1.MeltBolt12QuoteResponse
+	(*MeltQuoteRequest)(nil),                  // 50: cdkgo.wallet.v1.MeltQuoteRequest
+	(*MeltQuoteResponse)(nil),                 // 51: cdkgo.wallet.v1.MeltQuoteResponse
+	(*MintRequest)(nil),                       // 52: cdkgo.wallet.v1.MintRequest
+	(*MintResponse)(nil),                      // 53: cdkgo.wallet.v1.MintResponse
+	(*MintBlindAuthRequest)(nil),              // 54: cdkgo.wallet.v1.MintBlindAuthRequest
+	(*MintBlindAuthResponse)(nil),             // 55: cdkgo.wallet.v1.MintBlindAuthResponse
+	(*MintBolt12Request)(nil),                 // 56: cdkgo.wallet.v1.MintBolt12Request
+	(*MintBolt12Response)(nil),                // 57: cdkgo.wallet.v1.MintBolt12Response
+	(*MintBolt12QuoteRequest)(nil),            // 58: cdkgo.wallet.v1.MintBolt12QuoteRequest
+	(*MintBolt12QuoteResponse)(nil),           // 59: cdkgo.wallet.v1.MintBolt12QuoteResponse
+	(*MintQuoteRequest)(nil),                  // 60: cdkgo.wallet.v1.MintQuoteRequest
+	(*MintQuoteResponse)(nil),                 // 61: cdkgo.wallet.v1.MintQuoteResponse
+	(*GetUnitRequest)(nil),                    // 62: cdkgo.wallet.v1.GetUnitRequest
+	(*GetUnitResponse)(nil),                   // 63: cdkgo.wallet.v1.GetUnitResponse
+	(*PrepareSendRequest)(nil),                // 64: cdkgo.wallet.v1.PrepareSendRequest
+	(*PrepareSendResponse)(nil),               // 65: cdkgo.wallet.v1.PrepareSendResponse
+	(*ConfirmSendRequest)(nil),                // 66: cdkgo.wallet.v1.ConfirmSendRequest
+	(*ConfirmSendResponse)(nil),               // 67: cdkgo.wallet.v1.ConfirmSendResponse
+	(*CancelSendRequest)(nil),                 // 68: cdkgo.wallet.v1.CancelSendRequest
+	(*CancelSendResponse)(nil),                // 69: cdkgo.wallet.v1.CancelSendResponse
+	(*ReceiveRequest)(nil),                    // 70: cdkgo.wallet.v1.ReceiveRequest
+	(*ReceiveResponse)(nil),                   // 71: cdkgo.wallet.v1.ReceiveResponse
+	(*ReceiveProofsRequest)(nil),              // 72: cdkgo.wallet.v1.ReceiveProofsRequest
+	(*ReceiveProofsResponse)(nil),             // 73: cdkgo.wallet.v1.ReceiveProofsResponse
+	(*ReclaimUnspentRequest)(nil),             // 74: cdkgo.wallet.v1.ReclaimUnspentRequest
+	(*ReclaimUnspentResponse)(nil),            // 75: cdkgo.wallet.v1.ReclaimUnspentResponse
+	(*RefreshAccessTokenRequest)(nil),         // 76: cdkgo.wallet.v1.RefreshAccessTokenRequest
+	(*RefreshAccessTokenResponse)(nil),        // 77: cdkgo.wallet.v1.RefreshAccessTokenResponse
+	(*RefreshKeysetsRequest)(nil),             // 78: cdkgo.wallet.v1.RefreshKeysetsRequest
+	(*RefreshKeysetsResponse)(nil),            // 79: cdkgo.wallet.v1.RefreshKeysetsResponse
+	(*RestoreRequest)(nil),                    // 80: cdkgo.wallet.v1.RestoreRequest
+	(*RestoreResponse)(nil),                   // 81: cdkgo.wallet.v1.RestoreResponse
+	(*RevertTransactionRequest)(nil),          // 82: cdkgo.wallet.v1.RevertTransactionRequest
+	(*RevertTransactionResponse)(nil),         // 83: cdkgo.wallet.v1.RevertTransactionResponse
+	(*SetCatRequest)(nil),                     // 84: cdkgo.wallet.v1.SetCatRequest
+	(*SetCatResponse)(nil),                    // 85: cdkgo.wallet.v1.SetCatResponse
+	(*SetRefreshTokenRequest)(nil),            // 86: cdkgo.wallet.v1.SetRefreshTokenRequest
+	(*SetRefreshTokenResponse)(nil),           // 87: cdkgo.wallet.v1.SetRefreshTokenResponse
+	(*SubscribeRequest)(nil),                  // 88: cdkgo.wallet.v1.SubscribeRequest
+	(*MintQuoteUpdate)(nil),                   // 89: cdkgo.wallet.v1.MintQuoteUpdate
+	(*MeltQuoteUpdate)(nil),                   // 90: cdkgo.wallet.v1.MeltQuoteUpdate
+	(*ProofStateUpdate)(nil),                  // 91: cdkgo.wallet.v1.ProofStateUpdate
+	(*ProofStateUpdates)(nil),                 // 92: cdkgo.wallet.v1.ProofStateUpdates
+	(*SubscribeResponse)(nil),                 // 93: cdkgo.wallet.v1.SubscribeResponse
+	(*SwapRequest)(nil),                       // 94: cdkgo.wallet.v1.SwapRequest
+	(*SwapResponse)(nil),                      // 95: cdkgo.wallet.v1.SwapResponse
+	(*TotalBalanceRequest)(nil),               // 96: cdkgo.wallet.v1.TotalBalanceRequest
+	(*TotalBalanceResponse)(nil),              // 97: cdkgo.wallet.v1.TotalBalanceResponse
+	(*TotalPendingBalanceRequest)(nil),        // 98: cdkgo.wallet.v1.TotalPendingBalanceRequest
+	(*TotalPendingBalanceResponse)(nil),       // 99: cdkgo.wallet.v1.TotalPendingBalanceResponse
+	(*TotalReservedBalanceRequest)(nil),       // 100: cdkgo.wallet.v1.TotalReservedBalanceRequest
+	(*TotalReservedBalanceResponse)(nil),      // 101: cdkgo.wallet.v1.TotalReservedBalanceResponse
+	(*VerifyTokenDleqRequest)(nil),            // 102: cdkgo.wallet.v1.VerifyTokenDleqRequest
+	(*VerifyTokenDleqResponse)(nil),           // 103: cdkgo.wallet.v1.VerifyTokenDleqResponse
+	(*AddMintRequest)(nil),                    // 104: cdkgo.wallet.v1.AddMintRequest
+	(*AddMintResponse)(nil),                   // 105: cdkgo.wallet.v1.AddMintResponse
+	(*CheckAllMintQuotesRequest)(nil),         // 106: cdkgo.wallet.v1.CheckAllMintQuotesRequest
+	(*CheckAllMintQuotesResponse)(nil),        // 107: cdkgo.wallet.v1.CheckAllMintQuotesResponse
+	(*CheckMintQuoteRequest)(nil),             // 108: cdkgo.wallet.v1.CheckMintQuoteRequest
+	(*CheckMintQuoteResponse)(nil),            // 109: cdkgo.wallet.v1.CheckMintQuoteResponse
+	(*ConsolidateRequest)(nil),                // 110: cdkgo.wallet.v1.ConsolidateRequest
+	(*ConsolidateResponse)(nil),               // 111: cdkgo.wallet.v1.ConsolidateResponse
+	(*GetBalancesRequest)(nil),                // 112: cdkgo.wallet.v1.GetBalancesRequest
+	(*GetBalancesResponse)(nil),               // 113: cdkgo.wallet.v1.GetBalancesResponse
+	(*GetMintUrlsRequest)(nil),                // 114: cdkgo.wallet.v1.GetMintUrlsRequest
+	(*GetMintUrlsResponse)(nil),               // 115: cdkgo.wallet.v1.GetMintUrlsResponse
+	(*HasMintRequest)(nil),                    // 116: cdkgo.wallet.v1.HasMintRequest
+	(*HasMintResponse)(nil),                   // 117: cdkgo.wallet.v1.HasMintResponse
+	(*ListProofsRequest)(nil),                 // 118: cdkgo.wallet.v1.ListProofsRequest
+	(*ProofList)(nil),                         // 119: cdkgo.wallet.v1.ProofList
+	(*ListProofsResponse)(nil),                // 120: cdkgo.wallet.v1.ListProofsResponse
+	(*MultiMintListTransactionsRequest)(nil),  // 121: cdkgo.wallet.v1.MultiMintListTransactionsRequest
+	(*MultiMintListTransactionsResponse)(nil), // 122: cdkgo.wallet.v1.MultiMintListTransactionsResponse
+	(*MultiMintMeltRequest)(nil),              // 123: cdkgo.wallet.v1.MultiMintMeltRequest
+	(*MultiMintMeltResponse)(nil),             // 124: cdkgo.wallet.v1.MultiMintMeltResponse
+	(*MultiMintMeltQuoteRequest)(nil),         // 125: cdkgo.wallet.v1.MultiMintMeltQuoteRequest
+	(*MultiMintMeltQuoteResponse)(nil),        // 126: cdkgo.wallet.v1.MultiMintMeltQuoteResponse
+	(*MultiMintMintRequest)(nil),              // 127: cdkgo.wallet.v1.MultiMintMintRequest
+	(*MultiMintMintResponse)(nil),             // 128: cdkgo.wallet.v1.MultiMintMintResponse
+	(*MultiMintMintQuoteRequest)(nil),         // 129: cdkgo.wallet.v1.MultiMintMintQuoteRequest
+	(*MultiMintMintQuoteResponse)(nil),        // 130: cdkgo.wallet.v1.MultiMintMintQuoteResponse
+	(*MultiMintGetUnitRequest)(nil),           // 131: cdkgo.wallet.v1.MultiMintGetUnitRequest
+	(*MultiMintGetUnitResponse)(nil),          // 132: cdkgo.wallet.v1.MultiMintGetUnitResponse
+	(*MultiMintPrepareSendRequest)(nil),       // 133: cdkgo.wallet.v1.MultiMintPrepareSendRequest
+	(*MultiMintPrepareSendResponse)(nil),      // 134: cdkgo.wallet.v1.MultiMintPrepareSendResponse
+	(*MultiMintConfirmSendRequest)(nil),       // 135: cdkgo.wallet.v1.MultiMintConfirmSendRequest
+	(*MultiMintConfirmSendResponse)(nil),      // 136: cdkgo.wallet.v1.MultiMintConfirmSendResponse
+	(*MultiMintCancelSendRequest)(nil),        // 137: cdkgo.wallet.v1.MultiMintCancelSendRequest
+	(*MultiMintCancelSendResponse)(nil),       // 138: cdkgo.wallet.v1.MultiMintCancelSendResponse
+	(*MultiMintReceiveRequest)(nil),           // 139: cdkgo.wallet.v1.MultiMintReceiveRequest
+	(*MultiMintReceiveResponse)(nil),          // 140: cdkgo.wallet.v1.MultiMintReceiveResponse
+	(*RemoveMintRequest)(nil),                 // 141: cdkgo.wallet.v1.RemoveMintRequest
+	(*RemoveMintResponse)(nil),                // 142: cdkgo.wallet.v1.RemoveMintResponse
+	(*MultiMintRestoreRequest)(nil),           // 143: cdkgo.wallet.v1.MultiMintRestoreRequest
+	(*MultiMintRestoreResponse)(nil),          // 144: cdkgo.wallet.v1.MultiMintRestoreResponse
+	(*MultiMintSwapRequest)(nil),              // 145: cdkgo.wallet.v1.MultiMintSwapRequest
+	(*MultiMintSwapResponse)(nil),             // 146: cdkgo.wallet.v1.MultiMintSwapResponse
+	(*MultiMintTotalBalanceRequest)(nil),      // 147: cdkgo.wallet.v1.MultiMintTotalBalanceRequest
+	(*MultiMintTotalBalanceResponse)(nil),     // 148: cdkgo.wallet.v1.MultiMintTotalBalanceResponse
+	(*TransferRequest)(nil),                   // 149: cdkgo.wallet.v1.TransferRequest
+	(*TransferResponse)(nil),                  // 150: cdkgo.wallet.v1.TransferResponse
+	(*MultiMintVerifyTokenDleqRequest)(nil),   // 151: cdkgo.wallet.v1.MultiMintVerifyTokenDleqRequest
+	(*MultiMintVerifyTokenDleqResponse)(nil),  // 152: cdkgo.wallet.v1.MultiMintVerifyTokenDleqResponse
+	(*WaitForMintQuoteRequest)(nil),           // 153: cdkgo.wallet.v1.WaitForMintQuoteRequest
+	(*WaitForMintQuoteResponse)(nil),          // 154: cdkgo.wallet.v1.WaitForMintQuoteResponse
+	nil,                                       // 155: cdkgo.wallet.v1.Transaction.MetadataEntry
+	(*SpendingConditions_P2Pk)(nil),           // 156: cdkgo.wallet.v1.SpendingConditions.P2pk
+	(*SpendingConditions_Htlc)(nil),           // 157: cdkgo.wallet.v1.SpendingConditions.Htlc
+	nil,                                       // 158: cdkgo.wallet.v1.SendOptions.MetadataEntry
+	nil,                                       // 159: cdkgo.wallet.v1.ReceiveOptions.MetadataEntry
+	nil,                                       // 160: cdkgo.wallet.v1.GetBalancesResponse.BalancesEntry
+	nil,                                       // 161: cdkgo.wallet.v1.ListProofsResponse.ProofsEntry
+}
+var file_wallet_proto_depIdxs = []int32{
+	5,   // 0: cdkgo.wallet.v1.Proof.dleq:type_name -> cdkgo.wallet.v1.ProofDleq
+	0,   // 1: cdkgo.wallet.v1.MintQuote.state:type_name -> cdkgo.wallet.v1.QuoteState
+	0,   // 2: cdkgo.wallet.v1.MeltQuote.state:type_name -> cdkgo.wallet.v1.QuoteState
+	0,   // 3: cdkgo.wallet.v1.Melted.state:type_name -> cdkgo.wallet.v1.QuoteState
+	6,   // 4: cdkgo.wallet.v1.Melted.change:type_name -> cdkgo.wallet.v1.Proof
+	2,   // 5: cdkgo.wallet.v1.Transaction.direction:type_name -> cdkgo.wallet.v1.TransactionDirection
+	155, // 6: cdkgo.wallet.v1.Transaction.metadata:type_name -> cdkgo.wallet.v1.Transaction.MetadataEntry
+	13,  // 7: cdkgo.wallet.v1.SplitTarget.values:type_name -> cdkgo.wallet.v1.AmountList
+	156, // 8: cdkgo.wallet.v1.SpendingConditions.p2pk:type_name -> cdkgo.wallet.v1.SpendingConditions.P2pk
+	157, // 9: cdkgo.wallet.v1.SpendingConditions.htlc:type_name -> cdkgo.wallet.v1.SpendingConditions.Htlc
+	4,   // 10: cdkgo.wallet.v1.SendKind.mode:type_name -> cdkgo.wallet.v1.SendKind.Mode
+	17,  // 11: cdkgo.wallet.v1.SendOptions.conditions:type_name -> cdkgo.wallet.v1.SpendingConditions
+	14,  // 12: cdkgo.wallet.v1.SendOptions.amount_split_target:type_name -> cdkgo.wallet.v1.SplitTarget
+	18,  // 13: cdkgo.wallet.v1.SendOptions.send_kind:type_name -> cdkgo.wallet.v1.SendKind
+	158, // 14: cdkgo.wallet.v1.SendOptions.metadata:type_name -> cdkgo.wallet.v1.SendOptions.MetadataEntry
+	14,  // 15: cdkgo.wallet.v1.ReceiveOptions.amount_split_target:type_name -> cdkgo.wallet.v1.SplitTarget
+	159, // 16: cdkgo.wallet.v1.ReceiveOptions.metadata:type_name -> cdkgo.wallet.v1.ReceiveOptions.MetadataEntry
+	19,  // 17: cdkgo.wallet.v1.MultiMintSendOptions.send_options:type_name -> cdkgo.wallet.v1.SendOptions
+	20,  // 18: cdkgo.wallet.v1.MultiMintReceiveOptions.receive_options:type_name -> cdkgo.wallet.v1.ReceiveOptions
+	6,   // 19: cdkgo.wallet.v1.PreparedSend.proofs:type_name -> cdkgo.wallet.v1.Proof
+	6,   // 20: cdkgo.wallet.v1.CheckProofsSpentRequest.proofs:type_name -> cdkgo.wallet.v1.Proof
+	8,   // 21: cdkgo.wallet.v1.GetActiveKeysetResponse.keyset:type_name -> cdkgo.wallet.v1.KeySetInfo
+	1,   // 22: cdkgo.wallet.v1.GetProofsByStatesRequest.states:type_name -> cdkgo.wallet.v1.ProofState
+	6,   // 23: cdkgo.wallet.v1.GetProofsByStatesResponse.proofs:type_name -> cdkgo.wallet.v1.Proof
+	12,  // 24: cdkgo.wallet.v1.GetTransactionResponse.transaction:type_name -> cdkgo.wallet.v1.Transaction
+	7,   // 25: cdkgo.wallet.v1.GetUnspentAuthProofsResponse.proofs:type_name -> cdkgo.wallet.v1.AuthProof
+	2,   // 26: cdkgo.wallet.v1.ListTransactionsRequest.direction:type_name -> cdkgo.wallet.v1.TransactionDirection
+	12,  // 27: cdkgo.wallet.v1.ListTransactionsResponse.transactions:type_name -> cdkgo.wallet.v1.Transaction
+	11,  // 28: cdkgo.wallet.v1.MeltResponse.melted:type_name -> cdkgo.wallet.v1.Melted
+	10,  // 29: cdkgo.wallet.v1.MeltBip353QuoteResponse.quote:type_name -> cdkgo.wallet.v1.MeltQuote
+	15,  // 30: cdkgo.wallet.v1.MeltBolt12QuoteRequest.options:type_name -> cdkgo.wallet.v1.MeltOptions
+	10,  // 31: cdkgo.wallet.v1.MeltBolt12QuoteResponse.quote:type_name -> cdkgo.wallet.v1.MeltQuote
+	15,  // 32: cdkgo.wallet.v1.MeltQuoteRequest.options:type_name -> cdkgo.wallet.v1.MeltOptions
+	10,  // 33: cdkgo.wallet.v1.MeltQuoteResponse.quote:type_name -> cdkgo.wallet.v1.MeltQuote
+	14,  // 34: cdkgo.wallet.v1.MintRequest.amount_split_target:type_name -> cdkgo.wallet.v1.SplitTarget
+	17,  // 35: cdkgo.wallet.v1.MintRequest.spending_conditions:type_name -> cdkgo.wallet.v1.SpendingConditions
+	6,   // 36: cdkgo.wallet.v1.MintResponse.proofs:type_name -> cdkgo.wallet.v1.Proof
+	6,   // 37: cdkgo.wallet.v1.MintBlindAuthResponse.proofs:type_name -> cdkgo.wallet.v1.Proof
+	14,  // 38: cdkgo.wallet.v1.MintBolt12Request.amount_split_target:type_name -> cdkgo.wallet.v1.SplitTarget
+	17,  // 39: cdkgo.wallet.v1.MintBolt12Request.spending_conditions:type_name -> cdkgo.wallet.v1.SpendingConditions
+	6,   // 40: cdkgo.wallet.v1.MintBolt12Response.proofs:type_name -> cdkgo.wallet.v1.Proof
+	9,   // 41: cdkgo.wallet.v1.MintBolt12QuoteResponse.quote:type_name -> cdkgo.wallet.v1.MintQuote
+	9,   // 42: cdkgo.wallet.v1.MintQuoteResponse.quote:type_name -> cdkgo.wallet.v1.MintQuote
+	19,  // 43: cdkgo.wallet.v1.PrepareSendRequest.options:type_name -> cdkgo.wallet.v1.SendOptions
+	23,  // 44: cdkgo.wallet.v1.PrepareSendResponse.send:type_name -> cdkgo.wallet.v1.PreparedSend
+	20,  // 45: cdkgo.wallet.v1.ReceiveRequest.options:type_name -> cdkgo.wallet.v1.ReceiveOptions
+	6,   // 46: cdkgo.wallet.v1.ReceiveProofsRequest.proofs:type_name -> cdkgo.wallet.v1.Proof
+	20,  // 47: cdkgo.wallet.v1.ReceiveProofsRequest.options:type_name -> cdkgo.wallet.v1.ReceiveOptions
+	6,   // 48: cdkgo.wallet.v1.ReclaimUnspentRequest.proofs:type_name -> cdkgo.wallet.v1.Proof
+	8,   // 49: cdkgo.wallet.v1.RefreshKeysetsResponse.keysets:type_name -> cdkgo.wallet.v1.KeySetInfo
+	3,   // 50: cdkgo.wallet.v1.SubscribeRequest.kind:type_name -> cdkgo.wallet.v1.SubscriptionKind
+	0,   // 51: cdkgo.wallet.v1.MintQuoteUpdate.state:type_name -> cdkgo.wallet.v1.QuoteState
+	0,   // 52: cdkgo.wallet.v1.MeltQuoteUpdate.state:type_name -> cdkgo.wallet.v1.QuoteState
+	1,   // 53: cdkgo.wallet.v1.ProofStateUpdate.state:type_name -> cdkgo.wallet.v1.ProofState
+	91,  // 54: cdkgo.wallet.v1.ProofStateUpdates.updates:type_name -> cdkgo.wallet.v1.ProofStateUpdate
+	89,  // 55: cdkgo.wallet.v1.SubscribeResponse.mint_quote:type_name -> cdkgo.wallet.v1.MintQuoteUpdate
+	90,  // 56: cdkgo.wallet.v1.SubscribeResponse.melt_quote:type_name -> cdkgo.wallet.v1.MeltQuoteUpdate
+	92,  // 57: cdkgo.wallet.v1.SubscribeResponse.proof_states:type_name -> cdkgo.wallet.v1.ProofStateUpdates
+	14,  // 58: cdkgo.wallet.v1.SwapRequest.amount_split_target:type_name -> cdkgo.wallet.v1.SplitTarget
+	6,   // 59: cdkgo.wallet.v1.SwapRequest.input_proofs:type_name -> cdkgo.wallet.v1.Proof
+	17,  // 60: cdkgo.wallet.v1.SwapRequest.spending_conditions:type_name -> cdkgo.wallet.v1.SpendingConditions
+	6,   // 61: cdkgo.wallet.v1.SwapResponse.proofs:type_name -> cdkgo.wallet.v1.Proof
+	9,   // 62: cdkgo.wallet.v1.CheckMintQuoteResponse.quote:type_name -> cdkgo.wallet.v1.MintQuote
+	160, // 63: cdkgo.wallet.v1.GetBalancesResponse.balances:type_name -> cdkgo.wallet.v1.GetBalancesResponse.BalancesEntry
+	6,   // 64: cdkgo.wallet.v1.ProofList.proofs:type_name -> cdkgo.wallet.v1.Proof
+	161, // 65: cdkgo.wallet.v1.ListProofsResponse.proofs:type_name -> cdkgo.wallet.v1.ListProofsResponse.ProofsEntry
+	2,   // 66: cdkgo.wallet.v1.MultiMintListTransactionsRequest.direction:type_name -> cdkgo.wallet.v1.TransactionDirection
+	12,  // 67: cdkgo.wallet.v1.MultiMintListTransactionsResponse.transactions:type_name -> cdkgo.wallet.v1.Transaction
+	15,  // 68: cdkgo.wallet.v1.MultiMintMeltRequest.options:type_name -> cdkgo.wallet.v1.MeltOptions
+	11,  // 69: cdkgo.wallet.v1.MultiMintMeltResponse.melted:type_name -> cdkgo.wallet.v1.Melted
+	15,  // 70: cdkgo.wallet.v1.MultiMintMeltQuoteRequest.options:type_name -> cdkgo.wallet.v1.MeltOptions
+	10,  // 71: cdkgo.wallet.v1.MultiMintMeltQuoteResponse.quote:type_name -> cdkgo.wallet.v1.MeltQuote
+	17,  // 72: cdkgo.wallet.v1.MultiMintMintRequest.spending_conditions:type_name -> cdkgo.wallet.v1.SpendingConditions
+	6,   // 73: cdkgo.wallet.v1.MultiMintMintResponse.proofs:type_name -> cdkgo.wallet.v1.Proof
+	9,   // 74: cdkgo.wallet.v1.MultiMintMintQuoteResponse.quote:type_name -> cdkgo.wallet.v1.MintQuote
+	21,  // 75: cdkgo.wallet.v1.MultiMintPrepareSendRequest.options:type_name -> cdkgo.wallet.v1.MultiMintSendOptions
+	23,  // 76: cdkgo.wallet.v1.MultiMintPrepareSendResponse.send:type_name -> cdkgo.wallet.v1.PreparedSend
+	22,  // 77: cdkgo.wallet.v1.MultiMintReceiveRequest.options:type_name -> cdkgo.wallet.v1.MultiMintReceiveOptions
+	17,  // 78: cdkgo.wallet.v1.MultiMintSwapRequest.spending_conditions:type_name -> cdkgo.wallet.v1.SpendingConditions
+	6,   // 79: cdkgo.wallet.v1.MultiMintSwapResponse.proofs:type_name -> cdkgo.wallet.v1.Proof
+	14,  // 80: cdkgo.wallet.v1.WaitForMintQuoteRequest.split_target:type_name -> cdkgo.wallet.v1.SplitTarget
+	17,  // 81: cdkgo.wallet.v1.WaitForMintQuoteRequest.spending_conditions:type_name -> cdkgo.wallet.v1.SpendingConditions
+	6,   // 82: cdkgo.wallet.v1.WaitForMintQuoteResponse.proofs:type_name -> cdkgo.wallet.v1.Proof
+	16,  // 83: cdkgo.wallet.v1.SpendingConditions.P2pk.conditions:type_name -> cdkgo.wallet.v1.Conditions
+	16,  // 84: cdkgo.wallet.v1.SpendingConditions.Htlc.conditions:type_name -> cdkgo.wallet.v1.Conditions
+	119, // 85: cdkgo.wallet.v1.ListProofsResponse.ProofsEntry.value:type_name -> cdkgo.wallet.v1.ProofList
+	24,  // 86: cdkgo.wallet.v1.WalletService.CalculateFee:input_type -> cdkgo.wallet.v1.CalculateFeeRequest
+	26,  // 87: cdkgo.wallet.v1.WalletService.CheckAllPendingProofs:input_type -> cdkgo.wallet.v1.CheckAllPendingProofsRequest
+	28,  // 88: cdkgo.wallet.v1.WalletService.CheckProofsSpent:input_type -> cdkgo.wallet.v1.CheckProofsSpentRequest
+	30,  // 89: cdkgo.wallet.v1.WalletService.GetActiveKeyset:input_type -> cdkgo.wallet.v1.GetActiveKeysetRequest
+	32,  // 90: cdkgo.wallet.v1.WalletService.GetKeysetFeesById:input_type -> cdkgo.wallet.v1.GetKeysetFeesByIdRequest
+	34,  // 91: cdkgo.wallet.v1.WalletService.GetMintInfo:input_type -> cdkgo.wallet.v1.GetMintInfoRequest
+	36,  // 92: cdkgo.wallet.v1.WalletService.GetProofsByStates:input_type -> cdkgo.wallet.v1.GetProofsByStatesRequest
+	38,  // 93: cdkgo.wallet.v1.WalletService.GetTransaction:input_type -> cdkgo.wallet.v1.GetTransactionRequest
+	40,  // 94: cdkgo.wallet.v1.WalletService.GetUnspentAuthProofs:input_type -> cdkgo.wallet.v1.GetUnspentAuthProofsRequest
+	42,  // 95: cdkgo.wallet.v1.WalletService.ListTransactions:input_type -> cdkgo.wallet.v1.ListTransactionsRequest
+	44,  // 96: cdkgo.wallet.v1.WalletService.Melt:input_type -> cdkgo.wallet.v1.MeltRequest
+	46,  // 97: cdkgo.wallet.v1.WalletService.MeltBip353Quote:input_type -> cdkgo.wallet.v1.MeltBip353QuoteRequest
+	48,  // 98: cdkgo.wallet.v1.WalletService.MeltBolt12Quote:input_type -> cdkgo.wallet.v1.MeltBolt12QuoteRequest
+	50,  // 99: cdkgo.wallet.v1.WalletService.MeltQuote:input_type -> cdkgo.wallet.v1.MeltQuoteRequest
+	52,  // 100: cdkgo.wallet.v1.WalletService.Mint:input_type -> cdkgo.wallet.v1.MintRequest
+	54,  // 101: cdkgo.wallet.v1.WalletService.MintBlindAuth:input_type -> cdkgo.wallet.v1.MintBlindAuthRequest
+	56,  // 102: cdkgo.wallet.v1.WalletService.MintBolt12:input_type -> cdkgo.wallet.v1.MintBolt12Request
+	58,  // 103: cdkgo.wallet.v1.WalletService.MintBolt12Quote:input_type -> cdkgo.wallet.v1.MintBolt12QuoteRequest
+	60,  // 104: cdkgo.wallet.v1.WalletService.MintQuote:input_type -> cdkgo.wallet.v1.MintQuoteRequest
+	62,  // 105: cdkgo.wallet.v1.WalletService.GetUnit:input_type -> cdkgo.wallet.v1.GetUnitRequest
+	64,  // 106: cdkgo.wallet.v1.WalletService.PrepareSend:input_type -> cdkgo.wallet.v1.PrepareSendRequest
+	66,  // 107: cdkgo.wallet.v1.WalletService.ConfirmSend:input_type -> cdkgo.wallet.v1.ConfirmSendRequest
+	68,  // 108: cdkgo.wallet.v1.WalletService.CancelSend:input_type -> cdkgo.wallet.v1.CancelSendRequest
+	70,  // 109: cdkgo.wallet.v1.WalletService.Receive:input_type -> cdkgo.wallet.v1.ReceiveRequest
+	72,  // 110: cdkgo.wallet.v1.WalletService.ReceiveProofs:input_type -> cdkgo.wallet.v1.ReceiveProofsRequest
+	74,  // 111: cdkgo.wallet.v1.WalletService.ReclaimUnspent:input_type -> cdkgo.wallet.v1.ReclaimUnspentRequest
+	76,  // 112: cdkgo.wallet.v1.WalletService.RefreshAccessToken:input_type -> cdkgo.wallet.v1.RefreshAccessTokenRequest
+	78,  // 113: cdkgo.wallet.v1.WalletService.RefreshKeysets:input_type -> cdkgo.wallet.v1.RefreshKeysetsRequest
+	80,  // 114: cdkgo.wallet.v1.WalletService.Restore:input_type -> cdkgo.wallet.v1.RestoreRequest
+	82,  // 115: cdkgo.wallet.v1.WalletService.RevertTransaction:input_type -> cdkgo.wallet.v1.RevertTransactionRequest
+	84,  // 116: cdkgo.wallet.v1.WalletService.SetCat:input_type -> cdkgo.wallet.v1.SetCatRequest
+	86,  // 117: cdkgo.wallet.v1.WalletService.SetRefreshToken:input_type -> cdkgo.wallet.v1.SetRefreshTokenRequest
+	88,  // 118: cdkgo.wallet.v1.WalletService.Subscribe:input_type -> cdkgo.wallet.v1.SubscribeRequest
+	94,  // 119: cdkgo.wallet.v1.WalletService.Swap:input_type -> cdkgo.wallet.v1.SwapRequest
+	96,  // 120: cdkgo.wallet.v1.WalletService.TotalBalance:input_type -> cdkgo.wallet.v1.TotalBalanceRequest
+	98,  // 121: cdkgo.wallet.v1.WalletService.TotalPendingBalance:input_type -> cdkgo.wallet.v1.TotalPendingBalanceRequest
+	100, // 122: cdkgo.wallet.v1.WalletService.TotalReservedBalance:input_type -> cdkgo.wallet.v1.TotalReservedBalanceRequest
+	102, // 123: cdkgo.wallet.v1.WalletService.VerifyTokenDleq:input_type -> cdkgo.wallet.v1.VerifyTokenDleqRequest
+	104, // 124: cdkgo.wallet.v1.MultiMintWalletService.AddMint:input_type -> cdkgo.wallet.v1.AddMintRequest
+	106, // 125: cdkgo.wallet.v1.MultiMintWalletService.CheckAllMintQuotes:input_type -> cdkgo.wallet.v1.CheckAllMintQuotesRequest
+	108, // 126: cdkgo.wallet.v1.MultiMintWalletService.CheckMintQuote:input_type -> cdkgo.wallet.v1.CheckMintQuoteRequest
+	110, // 127: cdkgo.wallet.v1.MultiMintWalletService.Consolidate:input_type -> cdkgo.wallet.v1.ConsolidateRequest
+	112, // 128: cdkgo.wallet.v1.MultiMintWalletService.GetBalances:input_type -> cdkgo.wallet.v1.GetBalancesRequest
+	114, // 129: cdkgo.wallet.v1.MultiMintWalletService.GetMintUrls:input_type -> cdkgo.wallet.v1.GetMintUrlsRequest
+	116, // 130: cdkgo.wallet.v1.MultiMintWalletService.HasMint:input_type -> cdkgo.wallet.v1.HasMintRequest
+	118, // 131: cdkgo.wallet.v1.MultiMintWalletService.ListProofs:input_type -> cdkgo.wallet.v1.ListProofsRequest
+	121, // 132: cdkgo.wallet.v1.MultiMintWalletService.ListTransactions:input_type -> cdkgo.wallet.v1.MultiMintListTransactionsRequest
+	123, // 133: cdkgo.wallet.v1.MultiMintWalletService.Melt:input_type -> cdkgo.wallet.v1.MultiMintMeltRequest
+	125, // 134: cdkgo.wallet.v1.MultiMintWalletService.MeltQuote:input_type -> cdkgo.wallet.v1.MultiMintMeltQuoteRequest
+	127, // 135: cdkgo.wallet.v1.MultiMintWalletService.Mint:input_type -> cdkgo.wallet.v1.MultiMintMintRequest
+	129, // 136: cdkgo.wallet.v1.MultiMintWalletService.MintQuote:input_type -> cdkgo.wallet.v1.MultiMintMintQuoteRequest
+	131, // 137: cdkgo.wallet.v1.MultiMintWalletService.GetUnit:input_type -> cdkgo.wallet.v1.MultiMintGetUnitRequest
+	133, // 138: cdkgo.wallet.v1.MultiMintWalletService.PrepareSend:input_type -> cdkgo.wallet.v1.MultiMintPrepareSendRequest
+	135, // 139: cdkgo.wallet.v1.MultiMintWalletService.ConfirmSend:input_type -> cdkgo.wallet.v1.MultiMintConfirmSendRequest
+	137, // 140: cdkgo.wallet.v1.MultiMintWalletService.CancelSend:input_type -> cdkgo.wallet.v1.MultiMintCancelSendRequest
+	139, // 141: cdkgo.wallet.v1.MultiMintWalletService.Receive:input_type -> cdkgo.wallet.v1.MultiMintReceiveRequest
+	141, // 142: cdkgo.wallet.v1.MultiMintWalletService.RemoveMint:input_type -> cdkgo.wallet.v1.RemoveMintRequest
+	143, // 143: cdkgo.wallet.v1.MultiMintWalletService.Restore:input_type -> cdkgo.wallet.v1.MultiMintRestoreRequest
+	145, // 144: cdkgo.wallet.v1.MultiMintWalletService.Swap:input_type -> cdkgo.wallet.v1.MultiMintSwapRequest
+	147, // 145: cdkgo.wallet.v1.MultiMintWalletService.TotalBalance:input_type -> cdkgo.wallet.v1.MultiMintTotalBalanceRequest
+	149, // 146: cdkgo.wallet.v1.MultiMintWalletService.Transfer:input_type -> cdkgo.wallet.v1.TransferRequest
+	151, // 147: cdkgo.wallet.v1.MultiMintWalletService.VerifyTokenDleq:input_type -> cdkgo.wallet.v1.MultiMintVerifyTokenDleqRequest
+	153, // 148: cdkgo.wallet.v1.MultiMintWalletService.WaitForMintQuote:input_type -> cdkgo.wallet.v1.WaitForMintQuoteRequest
+	25,  // 149: cdkgo.wallet.v1.WalletService.CalculateFee:output_type -> cdkgo.wallet.v1.CalculateFeeResponse
+	27,  // 150: cdkgo.wallet.v1.WalletService.CheckAllPendingProofs:output_type -> cdkgo.wallet.v1.CheckAllPendingProofsResponse
+	29,  // 151: cdkgo.wallet.v1.WalletService.CheckProofsSpent:output_type -> cdkgo.wallet.v1.CheckProofsSpentResponse
+	31,  // 152: cdkgo.wallet.v1.WalletService.GetActiveKeyset:output_type -> cdkgo.wallet.v1.GetActiveKeysetResponse
+	33,  // 153: cdkgo.wallet.v1.WalletService.GetKeysetFeesById:output_type -> cdkgo.wallet.v1.GetKeysetFeesByIdResponse
+	35,  // 154: cdkgo.wallet.v1.WalletService.GetMintInfo:output_type -> cdkgo.wallet.v1.GetMintInfoResponse
+	37,  // 155: cdkgo.wallet.v1.WalletService.GetProofsByStates:output_type -> cdkgo.wallet.v1.GetProofsByStatesResponse
+	39,  // 156: cdkgo.wallet.v1.WalletService.GetTransaction:output_type -> cdkgo.wallet.v1.GetTransactionResponse
+	41,  // 157: cdkgo.wallet.v1.WalletService.GetUnspentAuthProofs:output_type -> cdkgo.wallet.v1.GetUnspentAuthProofsResponse
+	43,  // 158: cdkgo.wallet.v1.WalletService.ListTransactions:output_type -> cdkgo.wallet.v1.ListTransactionsResponse
+	45,  // 159: cdkgo.wallet.v1.WalletService.Melt:output_type -> cdkgo.wallet.v1.MeltResponse
+	47,  // 160: cdkgo.wallet.v1.WalletService.MeltBip353Quote:output_type -> cdkgo.wallet.v1.MeltBip353QuoteResponse
+	49,  // 161: cdkgo.wallet.v1.WalletService.MeltBolt12Quote:output_type -> cdkgo.wallet.v1.MeltBolt12QuoteResponse
+	51,  // 162: cdkgo.wallet.v1.WalletService.MeltQuote:output_type -> cdkgo.wallet.v1.MeltQuoteResponse
+	53,  // 163: cdkgo.wallet.v1.WalletService.Mint:output_type -> cdkgo.wallet.v1.MintResponse
+	55,  // 164: cdkgo.wallet.v1.WalletService.MintBlindAuth:output_type -> cdkgo.wallet.v1.MintBlindAuthResponse
+	57,  // 165: cdkgo.wallet.v1.WalletService.MintBolt12:output_type -> cdkgo.wallet.v1.MintBolt12Response
+	59,  // 166: cdkgo.wallet.v1.WalletService.MintBolt12Quote:output_type -> cdkgo.wallet.v1.MintBolt12QuoteResponse
+	61,  // 167: cdkgo.wallet.v1.WalletService.MintQuote:output_type -> cdkgo.wallet.v1.MintQuoteResponse
+	63,  // 168: cdkgo.wallet.v1.WalletService.GetUnit:output_type -> cdkgo.wallet.v1.GetUnitResponse
+	65,  // 169: cdkgo.wallet.v1.WalletService.PrepareSend:output_type -> cdkgo.wallet.v1.PrepareSendResponse
+	67,  // 170: cdkgo.wallet.v1.WalletService.ConfirmSend:output_type -> cdkgo.wallet.v1.ConfirmSendResponse
+	69,  // 171: cdkgo.wallet.v1.WalletService.CancelSend:output_type -> cdkgo.wallet.v1.CancelSendResponse
+	71,  // 172: cdkgo.wallet.v1.WalletService.Receive:output_type -> cdkgo.wallet.v1.ReceiveResponse
+	73,  // 173: cdkgo.wallet.v1.WalletService.ReceiveProofs:output_type -> cdkgo.wallet.v1.ReceiveProofsResponse
+	75,  // 174: cdkgo.wallet.v1.WalletService.ReclaimUnspent:output_type -> cdkgo.wallet.v1.ReclaimUnspentResponse
+	77,  // 175: cdkgo.wallet.v1.WalletService.RefreshAccessToken:output_type -> cdkgo.wallet.v1.RefreshAccessTokenResponse
+	79,  // 176: cdkgo.wallet.v1.WalletService.RefreshKeysets:output_type -> cdkgo.wallet.v1.RefreshKeysetsResponse
+	81,  // 177: cdkgo.wallet.v1.WalletService.Restore:output_type -> cdkgo.wallet.v1.RestoreResponse
+	83,  // 178: cdkgo.wallet.v1.WalletService.RevertTransaction:output_type -> cdkgo.wallet.v1.RevertTransactionResponse
+	85,  // 179: cdkgo.wallet.v1.WalletService.SetCat:output_type -> cdkgo.wallet.v1.SetCatResponse
+	87,  // 180: cdkgo.wallet.v1.WalletService.SetRefreshToken:output_type -> cdkgo.wallet.v1.SetRefreshTokenResponse
+	93,  // 181: cdkgo.wallet.v1.WalletService.Subscribe:output_type -> cdkgo.wallet.v1.SubscribeResponse
+	95,  // 182: cdkgo.wallet.v1.WalletService.Swap:output_type -> cdkgo.wallet.v1.SwapResponse
+	97,  // 183: cdkgo.wallet.v1.WalletService.TotalBalance:output_type -> cdkgo.wallet.v1.TotalBalanceResponse
+	99,  // 184: cdkgo.wallet.v1.WalletService.TotalPendingBalance:output_type -> cdkgo.wallet.v1.TotalPendingBalanceResponse
+	101, // 185: cdkgo.wallet.v1.WalletService.TotalReservedBalance:output_type -> cdkgo.wallet.v1.TotalReservedBalanceResponse
+	103, // 186: cdkgo.wallet.v1.WalletService.VerifyTokenDleq:output_type -> cdkgo.wallet.v1.VerifyTokenDleqResponse
+	105, // 187: cdkgo.wallet.v1.MultiMintWalletService.AddMint:output_type -> cdkgo.wallet.v1.AddMintResponse
+	107, // 188: cdkgo.wallet.v1.MultiMintWalletService.CheckAllMintQuotes:output_type -> cdkgo.wallet.v1.CheckAllMintQuotesResponse
+	109, // 189: cdkgo.wallet.v1.MultiMintWalletService.CheckMintQuote:output_type -> cdkgo.wallet.v1.CheckMintQuoteResponse
+	111, // 190: cdkgo.wallet.v1.MultiMintWalletService.Consolidate:output_type -> cdkgo.wallet.v1.ConsolidateResponse
+	113, // 191: cdkgo.wallet.v1.MultiMintWalletService.GetBalances:output_type -> cdkgo.wallet.v1.GetBalancesResponse
+	115, // 192: cdkgo.wallet.v1.MultiMintWalletService.GetMintUrls:output_type -> cdkgo.wallet.v1.GetMintUrlsResponse
+	117, // 193: cdkgo.wallet.v1.MultiMintWalletService.HasMint:output_type -> cdkgo.wallet.v1.HasMintResponse
+	120, // 194: cdkgo.wallet.v1.MultiMintWalletService.ListProofs:output_type -> cdkgo.wallet.v1.ListProofsResponse
+	122, // 195: cdkgo.wallet.v1.MultiMintWalletService.ListTransactions:output_type -> cdkgo.wallet.v1.MultiMintListTransactionsResponse
+	124, // 196: cdkgo.wallet.v1.MultiMintWalletService.Melt:output_type -> cdkgo.wallet.v1.MultiMintMeltResponse
+	126, // 197: cdkgo.wallet.v1.MultiMintWalletService.MeltQuote:output_type -> cdkgo.wallet.v1.MultiMintMeltQuoteResponse
+	128, // 198: cdkgo.wallet.v1.MultiMintWalletService.Mint:output_type -> cdkgo.wallet.v1.MultiMintMintResponse
+	130, // 199: cdkgo.wallet.v1.MultiMintWalletService.MintQuote:output_type -> cdkgo.wallet.v1.MultiMintMintQuoteResponse
+	132, // 200: cdkgo.wallet.v1.MultiMintWalletService.GetUnit:output_type -> cdkgo.wallet.v1.MultiMintGetUnitResponse
+	134, // 201: cdkgo.wallet.v1.MultiMintWalletService.PrepareSend:output_type -> cdkgo.wallet.v1.MultiMintPrepareSendResponse
+	136, // 202: cdkgo.wallet.v1.MultiMintWalletService.ConfirmSend:output_type -> cdkgo.wallet.v1.MultiMintConfirmSendResponse
+	138, // 203: cdkgo.wallet.v1.MultiMintWalletService.CancelSend:output_type -> cdkgo.wallet.v1.MultiMintCancelSendResponse
+	140, // 204: cdkgo.wallet.v1.MultiMintWalletService.Receive:output_type -> cdkgo.wallet.v1.MultiMintReceiveResponse
+	142, // 205: cdkgo.wallet.v1.MultiMintWalletService.RemoveMint:output_type -> cdkgo.wallet.v1.RemoveMintResponse
+	144, // 206: cdkgo.wallet.v1.MultiMintWalletService.Restore:output_type -> cdkgo.wallet.v1.MultiMintRestoreResponse
+	146, // 207: cdkgo.wallet.v1.MultiMintWalletService.Swap:output_type -> cdkgo.wallet.v1.MultiMintSwapResponse
+	148, // 208: cdkgo.wallet.v1.MultiMintWalletService.TotalBalance:output_type -> cdkgo.wallet.v1.MultiMintTotalBalanceResponse
+	150, // 209: cdkgo.wallet.v1.MultiMintWalletService.Transfer:output_type -> cdkgo.wallet.v1.TransferResponse
+	152, // 210: cdkgo.wallet.v1.MultiMintWalletService.VerifyTokenDleq:output_type -> cdkgo.wallet.v1.MultiMintVerifyTokenDleqResponse
+	154, // 211: cdkgo.wallet.v1.MultiMintWalletService.WaitForMintQuote:output_type -> cdkgo.wallet.v1.WaitForMintQuoteResponse
+	149, // [149:212] is the sub-list for method output_type
+	86,  // [86:149] is the sub-list for method input_type
+	86,  // [86:86] is the sub-list for extension type_name
+	86,  // [86:86] is the sub-list for extension extendee
+	0,   // [0:86] is the sub-list for field type_name
+}
+
+func init() { file_wallet_proto_init() }
+func file_wallet_proto_init() {
+	if File_wallet_proto != nil {
+		return
+	}
+	file_wallet_proto_msgTypes[4].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[5].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[6].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[7].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[9].OneofWrappers = []any{
+		(*SplitTarget_Value)(nil),
+		(*SplitTarget_Values)(nil),
+	}
+	file_wallet_proto_msgTypes[10].OneofWrappers = []any{
+		(*MeltOptions_MppAmount)(nil),
+		(*MeltOptions_AmountlessAmountMsat)(nil),
+	}
+	file_wallet_proto_msgTypes[11].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[12].OneofWrappers = []any{
+		(*SpendingConditions_P2Pk_)(nil),
+		(*SpendingConditions_Htlc_)(nil),
+	}
+	file_wallet_proto_msgTypes[14].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[16].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[17].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[51].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[53].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[55].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[61].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[67].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[83].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[84].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[85].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[86].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[88].OneofWrappers = []any{
+		(*SubscribeResponse_MintQuote)(nil),
+		(*SubscribeResponse_MeltQuote)(nil),
+		(*SubscribeResponse_ProofStates)(nil),
+	}
+	file_wallet_proto_msgTypes[89].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[99].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[101].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[118].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[124].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[130].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[140].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[144].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wallet_proto_rawDesc), len(file_wallet_proto_rawDesc)),
+			NumEnums:      5,
+			NumMessages:   157,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_wallet_proto_goTypes,
+		DependencyIndexes: file_wallet_proto_depIdxs,
+		EnumInfos:         file_wallet_proto_enumTypes,
+		MessageInfos:      file_wallet_proto_msgTypes,
+	}.Build()
+	File_wallet_proto = out.File
+	file_wallet_proto_goTypes = nil
+	file_wallet_proto_depIdxs = nil
+}
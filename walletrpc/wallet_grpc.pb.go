@@ -0,0 +1,2564 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: wallet.proto
+
+// gRPC surface of the cdkgo wallet. WalletService mirrors WalletInterface,
+// one single-mint wallet per mint_url; MultiMintWalletService mirrors
+// MultiMintWalletInterface.
+//
+// Amounts are in the unit of the wallet unless their name says otherwise.
+// Failed calls carry a google.rpc.ErrorInfo in the domain "cdk.cashu"
+// whose reason names the CDK error, for example INSUFFICIENT_FUNDS.
+
+package walletrpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WalletService_CalculateFee_FullMethodName          = "/cdkgo.wallet.v1.WalletService/CalculateFee"
+	WalletService_CheckAllPendingProofs_FullMethodName = "/cdkgo.wallet.v1.WalletService/CheckAllPendingProofs"
+	WalletService_CheckProofsSpent_FullMethodName      = "/cdkgo.wallet.v1.WalletService/CheckProofsSpent"
+	WalletService_GetActiveKeyset_FullMethodName       = "/cdkgo.wallet.v1.WalletService/GetActiveKeyset"
+	WalletService_GetKeysetFeesById_FullMethodName     = "/cdkgo.wallet.v1.WalletService/GetKeysetFeesById"
+	WalletService_GetMintInfo_FullMethodName           = "/cdkgo.wallet.v1.WalletService/GetMintInfo"
+	WalletService_GetProofsByStates_FullMethodName     = "/cdkgo.wallet.v1.WalletService/GetProofsByStates"
+	WalletService_GetTransaction_FullMethodName        = "/cdkgo.wallet.v1.WalletService/GetTransaction"
+	WalletService_GetUnspentAuthProofs_FullMethodName  = "/cdkgo.wallet.v1.WalletService/GetUnspentAuthProofs"
+	WalletService_ListTransactions_FullMethodName      = "/cdkgo.wallet.v1.WalletService/ListTransactions"
+	WalletService_Melt_FullMethodName                  = "/cdkgo.wallet.v1.WalletService/Melt"
+	WalletService_MeltBip353Quote_FullMethodName       = "/cdkgo.wallet.v1.WalletService/MeltBip353Quote"
+	WalletService_MeltBolt12Quote_FullMethodName       = "/cdkgo.wallet.v1.WalletService/MeltBolt12Quote"
+	WalletService_MeltQuote_FullMethodName             = "/cdkgo.wallet.v1.WalletService/MeltQuote"
+	WalletService_Mint_FullMethodName                  = "/cdkgo.wallet.v1.WalletService/Mint"
+	WalletService_MintBlindAuth_FullMethodName         = "/cdkgo.wallet.v1.WalletService/MintBlindAuth"
+	WalletService_MintBolt12_FullMethodName            = "/cdkgo.wallet.v1.WalletService/MintBolt12"
+	WalletService_MintBolt12Quote_FullMethodName       = "/cdkgo.wallet.v1.WalletService/MintBolt12Quote"
+	WalletService_MintQuote_FullMethodName             = "/cdkgo.wallet.v1.WalletService/MintQuote"
+	WalletService_GetUnit_FullMethodName               = "/cdkgo.wallet.v1.WalletService/GetUnit"
+	WalletService_PrepareSend_FullMethodName           = "/cdkgo.wallet.v1.WalletService/PrepareSend"
+	WalletService_ConfirmSend_FullMethodName           = "/cdkgo.wallet.v1.WalletService/ConfirmSend"
+	WalletService_CancelSend_FullMethodName            = "/cdkgo.wallet.v1.WalletService/CancelSend"
+	WalletService_Receive_FullMethodName               = "/cdkgo.wallet.v1.WalletService/Receive"
+	WalletService_ReceiveProofs_FullMethodName         = "/cdkgo.wallet.v1.WalletService/ReceiveProofs"
+	WalletService_ReclaimUnspent_FullMethodName        = "/cdkgo.wallet.v1.WalletService/ReclaimUnspent"
+	WalletService_RefreshAccessToken_FullMethodName    = "/cdkgo.wallet.v1.WalletService/RefreshAccessToken"
+	WalletService_RefreshKeysets_FullMethodName        = "/cdkgo.wallet.v1.WalletService/RefreshKeysets"
+	WalletService_Restore_FullMethodName               = "/cdkgo.wallet.v1.WalletService/Restore"
+	WalletService_RevertTransaction_FullMethodName     = "/cdkgo.wallet.v1.WalletService/RevertTransaction"
+	WalletService_SetCat_FullMethodName                = "/cdkgo.wallet.v1.WalletService/SetCat"
+	WalletService_SetRefreshToken_FullMethodName       = "/cdkgo.wallet.v1.WalletService/SetRefreshToken"
+	WalletService_Subscribe_FullMethodName             = "/cdkgo.wallet.v1.WalletService/Subscribe"
+	WalletService_Swap_FullMethodName                  = "/cdkgo.wallet.v1.WalletService/Swap"
+	WalletService_TotalBalance_FullMethodName          = "/cdkgo.wallet.v1.WalletService/TotalBalance"
+	WalletService_TotalPendingBalance_FullMethodName   = "/cdkgo.wallet.v1.WalletService/TotalPendingBalance"
+	WalletService_TotalReservedBalance_FullMethodName  = "/cdkgo.wallet.v1.WalletService/TotalReservedBalance"
+	WalletService_VerifyTokenDleq_FullMethodName       = "/cdkgo.wallet.v1.WalletService/VerifyTokenDleq"
+)
+
+// WalletServiceClient is the client API for WalletService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WalletServiceClient interface {
+	CalculateFee(ctx context.Context, in *CalculateFeeRequest, opts ...grpc.CallOption) (*CalculateFeeResponse, error)
+	CheckAllPendingProofs(ctx context.Context, in *CheckAllPendingProofsRequest, opts ...grpc.CallOption) (*CheckAllPendingProofsResponse, error)
+	CheckProofsSpent(ctx context.Context, in *CheckProofsSpentRequest, opts ...grpc.CallOption) (*CheckProofsSpentResponse, error)
+	GetActiveKeyset(ctx context.Context, in *GetActiveKeysetRequest, opts ...grpc.CallOption) (*GetActiveKeysetResponse, error)
+	GetKeysetFeesById(ctx context.Context, in *GetKeysetFeesByIdRequest, opts ...grpc.CallOption) (*GetKeysetFeesByIdResponse, error)
+	GetMintInfo(ctx context.Context, in *GetMintInfoRequest, opts ...grpc.CallOption) (*GetMintInfoResponse, error)
+	GetProofsByStates(ctx context.Context, in *GetProofsByStatesRequest, opts ...grpc.CallOption) (*GetProofsByStatesResponse, error)
+	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error)
+	GetUnspentAuthProofs(ctx context.Context, in *GetUnspentAuthProofsRequest, opts ...grpc.CallOption) (*GetUnspentAuthProofsResponse, error)
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	Melt(ctx context.Context, in *MeltRequest, opts ...grpc.CallOption) (*MeltResponse, error)
+	MeltBip353Quote(ctx context.Context, in *MeltBip353QuoteRequest, opts ...grpc.CallOption) (*MeltBip353QuoteResponse, error)
+	MeltBolt12Quote(ctx context.Context, in *MeltBolt12QuoteRequest, opts ...grpc.CallOption) (*MeltBolt12QuoteResponse, error)
+	MeltQuote(ctx context.Context, in *MeltQuoteRequest, opts ...grpc.CallOption) (*MeltQuoteResponse, error)
+	Mint(ctx context.Context, in *MintRequest, opts ...grpc.CallOption) (*MintResponse, error)
+	MintBlindAuth(ctx context.Context, in *MintBlindAuthRequest, opts ...grpc.CallOption) (*MintBlindAuthResponse, error)
+	MintBolt12(ctx context.Context, in *MintBolt12Request, opts ...grpc.CallOption) (*MintBolt12Response, error)
+	MintBolt12Quote(ctx context.Context, in *MintBolt12QuoteRequest, opts ...grpc.CallOption) (*MintBolt12QuoteResponse, error)
+	MintQuote(ctx context.Context, in *MintQuoteRequest, opts ...grpc.CallOption) (*MintQuoteResponse, error)
+	GetUnit(ctx context.Context, in *GetUnitRequest, opts ...grpc.CallOption) (*GetUnitResponse, error)
+	// Selects the proofs of a send. The send is kept by the server until
+	// ConfirmSend or CancelSend.
+	PrepareSend(ctx context.Context, in *PrepareSendRequest, opts ...grpc.CallOption) (*PrepareSendResponse, error)
+	ConfirmSend(ctx context.Context, in *ConfirmSendRequest, opts ...grpc.CallOption) (*ConfirmSendResponse, error)
+	CancelSend(ctx context.Context, in *CancelSendRequest, opts ...grpc.CallOption) (*CancelSendResponse, error)
+	Receive(ctx context.Context, in *ReceiveRequest, opts ...grpc.CallOption) (*ReceiveResponse, error)
+	ReceiveProofs(ctx context.Context, in *ReceiveProofsRequest, opts ...grpc.CallOption) (*ReceiveProofsResponse, error)
+	ReclaimUnspent(ctx context.Context, in *ReclaimUnspentRequest, opts ...grpc.CallOption) (*ReclaimUnspentResponse, error)
+	RefreshAccessToken(ctx context.Context, in *RefreshAccessTokenRequest, opts ...grpc.CallOption) (*RefreshAccessTokenResponse, error)
+	RefreshKeysets(ctx context.Context, in *RefreshKeysetsRequest, opts ...grpc.CallOption) (*RefreshKeysetsResponse, error)
+	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*RestoreResponse, error)
+	RevertTransaction(ctx context.Context, in *RevertTransactionRequest, opts ...grpc.CallOption) (*RevertTransactionResponse, error)
+	SetCat(ctx context.Context, in *SetCatRequest, opts ...grpc.CallOption) (*SetCatResponse, error)
+	SetRefreshToken(ctx context.Context, in *SetRefreshTokenRequest, opts ...grpc.CallOption) (*SetRefreshTokenResponse, error)
+	// Streams the NUT-17 notifications of the mint until the client cancels.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SubscribeResponse], error)
+	Swap(ctx context.Context, in *SwapRequest, opts ...grpc.CallOption) (*SwapResponse, error)
+	TotalBalance(ctx context.Context, in *TotalBalanceRequest, opts ...grpc.CallOption) (*TotalBalanceResponse, error)
+	TotalPendingBalance(ctx context.Context, in *TotalPendingBalanceRequest, opts ...grpc.CallOption) (*TotalPendingBalanceResponse, error)
+	TotalReservedBalance(ctx context.Context, in *TotalReservedBalanceRequest, opts ...grpc.CallOption) (*TotalReservedBalanceResponse, error)
+	VerifyTokenDleq(ctx context.Context, in *VerifyTokenDleqRequest, opts ...grpc.CallOption) (*VerifyTokenDleqResponse, error)
+}
+
+type walletServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWalletServiceClient(cc grpc.ClientConnInterface) WalletServiceClient {
+	return &walletServiceClient{cc}
+}
+
+func (c *walletServiceClient) CalculateFee(ctx context.Context, in *CalculateFeeRequest, opts ...grpc.CallOption) (*CalculateFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculateFeeResponse)
+	err := c.cc.Invoke(ctx, WalletService_CalculateFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CheckAllPendingProofs(ctx context.Context, in *CheckAllPendingProofsRequest, opts ...grpc.CallOption) (*CheckAllPendingProofsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckAllPendingProofsResponse)
+	err := c.cc.Invoke(ctx, WalletService_CheckAllPendingProofs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CheckProofsSpent(ctx context.Context, in *CheckProofsSpentRequest, opts ...grpc.CallOption) (*CheckProofsSpentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckProofsSpentResponse)
+	err := c.cc.Invoke(ctx, WalletService_CheckProofsSpent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetActiveKeyset(ctx context.Context, in *GetActiveKeysetRequest, opts ...grpc.CallOption) (*GetActiveKeysetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActiveKeysetResponse)
+	err := c.cc.Invoke(ctx, WalletService_GetActiveKeyset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetKeysetFeesById(ctx context.Context, in *GetKeysetFeesByIdRequest, opts ...grpc.CallOption) (*GetKeysetFeesByIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKeysetFeesByIdResponse)
+	err := c.cc.Invoke(ctx, WalletService_GetKeysetFeesById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetMintInfo(ctx context.Context, in *GetMintInfoRequest, opts ...grpc.CallOption) (*GetMintInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMintInfoResponse)
+	err := c.cc.Invoke(ctx, WalletService_GetMintInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetProofsByStates(ctx context.Context, in *GetProofsByStatesRequest, opts ...grpc.CallOption) (*GetProofsByStatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProofsByStatesResponse)
+	err := c.cc.Invoke(ctx, WalletService_GetProofsByStates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransactionResponse)
+	err := c.cc.Invoke(ctx, WalletService_GetTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetUnspentAuthProofs(ctx context.Context, in *GetUnspentAuthProofsRequest, opts ...grpc.CallOption) (*GetUnspentAuthProofsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUnspentAuthProofsResponse)
+	err := c.cc.Invoke(ctx, WalletService_GetUnspentAuthProofs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTransactionsResponse)
+	err := c.cc.Invoke(ctx, WalletService_ListTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Melt(ctx context.Context, in *MeltRequest, opts ...grpc.CallOption) (*MeltResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MeltResponse)
+	err := c.cc.Invoke(ctx, WalletService_Melt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) MeltBip353Quote(ctx context.Context, in *MeltBip353QuoteRequest, opts ...grpc.CallOption) (*MeltBip353QuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MeltBip353QuoteResponse)
+	err := c.cc.Invoke(ctx, WalletService_MeltBip353Quote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) MeltBolt12Quote(ctx context.Context, in *MeltBolt12QuoteRequest, opts ...grpc.CallOption) (*MeltBolt12QuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MeltBolt12QuoteResponse)
+	err := c.cc.Invoke(ctx, WalletService_MeltBolt12Quote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) MeltQuote(ctx context.Context, in *MeltQuoteRequest, opts ...grpc.CallOption) (*MeltQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MeltQuoteResponse)
+	err := c.cc.Invoke(ctx, WalletService_MeltQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Mint(ctx context.Context, in *MintRequest, opts ...grpc.CallOption) (*MintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MintResponse)
+	err := c.cc.Invoke(ctx, WalletService_Mint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) MintBlindAuth(ctx context.Context, in *MintBlindAuthRequest, opts ...grpc.CallOption) (*MintBlindAuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MintBlindAuthResponse)
+	err := c.cc.Invoke(ctx, WalletService_MintBlindAuth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) MintBolt12(ctx context.Context, in *MintBolt12Request, opts ...grpc.CallOption) (*MintBolt12Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MintBolt12Response)
+	err := c.cc.Invoke(ctx, WalletService_MintBolt12_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) MintBolt12Quote(ctx context.Context, in *MintBolt12QuoteRequest, opts ...grpc.CallOption) (*MintBolt12QuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MintBolt12QuoteResponse)
+	err := c.cc.Invoke(ctx, WalletService_MintBolt12Quote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) MintQuote(ctx context.Context, in *MintQuoteRequest, opts ...grpc.CallOption) (*MintQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MintQuoteResponse)
+	err := c.cc.Invoke(ctx, WalletService_MintQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetUnit(ctx context.Context, in *GetUnitRequest, opts ...grpc.CallOption) (*GetUnitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUnitResponse)
+	err := c.cc.Invoke(ctx, WalletService_GetUnit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) PrepareSend(ctx context.Context, in *PrepareSendRequest, opts ...grpc.CallOption) (*PrepareSendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrepareSendResponse)
+	err := c.cc.Invoke(ctx, WalletService_PrepareSend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ConfirmSend(ctx context.Context, in *ConfirmSendRequest, opts ...grpc.CallOption) (*ConfirmSendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmSendResponse)
+	err := c.cc.Invoke(ctx, WalletService_ConfirmSend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CancelSend(ctx context.Context, in *CancelSendRequest, opts ...grpc.CallOption) (*CancelSendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelSendResponse)
+	err := c.cc.Invoke(ctx, WalletService_CancelSend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Receive(ctx context.Context, in *ReceiveRequest, opts ...grpc.CallOption) (*ReceiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReceiveResponse)
+	err := c.cc.Invoke(ctx, WalletService_Receive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ReceiveProofs(ctx context.Context, in *ReceiveProofsRequest, opts ...grpc.CallOption) (*ReceiveProofsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReceiveProofsResponse)
+	err := c.cc.Invoke(ctx, WalletService_ReceiveProofs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ReclaimUnspent(ctx context.Context, in *ReclaimUnspentRequest, opts ...grpc.CallOption) (*ReclaimUnspentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReclaimUnspentResponse)
+	err := c.cc.Invoke(ctx, WalletService_ReclaimUnspent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) RefreshAccessToken(ctx context.Context, in *RefreshAccessTokenRequest, opts ...grpc.CallOption) (*RefreshAccessTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshAccessTokenResponse)
+	err := c.cc.Invoke(ctx, WalletService_RefreshAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) RefreshKeysets(ctx context.Context, in *RefreshKeysetsRequest, opts ...grpc.CallOption) (*RefreshKeysetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshKeysetsResponse)
+	err := c.cc.Invoke(ctx, WalletService_RefreshKeysets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*RestoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreResponse)
+	err := c.cc.Invoke(ctx, WalletService_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) RevertTransaction(ctx context.Context, in *RevertTransactionRequest, opts ...grpc.CallOption) (*RevertTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertTransactionResponse)
+	err := c.cc.Invoke(ctx, WalletService_RevertTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SetCat(ctx context.Context, in *SetCatRequest, opts ...grpc.CallOption) (*SetCatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCatResponse)
+	err := c.cc.Invoke(ctx, WalletService_SetCat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SetRefreshToken(ctx context.Context, in *SetRefreshTokenRequest, opts ...grpc.CallOption) (*SetRefreshTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRefreshTokenResponse)
+	err := c.cc.Invoke(ctx, WalletService_SetRefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SubscribeResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[0], WalletService_Subscribe_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeRequest, SubscribeResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WalletService_SubscribeClient = grpc.ServerStreamingClient[SubscribeResponse]
+
+func (c *walletServiceClient) Swap(ctx context.Context, in *SwapRequest, opts ...grpc.CallOption) (*SwapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SwapResponse)
+	err := c.cc.Invoke(ctx, WalletService_Swap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) TotalBalance(ctx context.Context, in *TotalBalanceRequest, opts ...grpc.CallOption) (*TotalBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TotalBalanceResponse)
+	err := c.cc.Invoke(ctx, WalletService_TotalBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) TotalPendingBalance(ctx context.Context, in *TotalPendingBalanceRequest, opts ...grpc.CallOption) (*TotalPendingBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TotalPendingBalanceResponse)
+	err := c.cc.Invoke(ctx, WalletService_TotalPendingBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) TotalReservedBalance(ctx context.Context, in *TotalReservedBalanceRequest, opts ...grpc.CallOption) (*TotalReservedBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TotalReservedBalanceResponse)
+	err := c.cc.Invoke(ctx, WalletService_TotalReservedBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) VerifyTokenDleq(ctx context.Context, in *VerifyTokenDleqRequest, opts ...grpc.CallOption) (*VerifyTokenDleqResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyTokenDleqResponse)
+	err := c.cc.Invoke(ctx, WalletService_VerifyTokenDleq_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WalletServiceServer is the server API for WalletService service.
+// All implementations must embed UnimplementedWalletServiceServer
+// for forward compatibility.
+type WalletServiceServer interface {
+	CalculateFee(context.Context, *CalculateFeeRequest) (*CalculateFeeResponse, error)
+	CheckAllPendingProofs(context.Context, *CheckAllPendingProofsRequest) (*CheckAllPendingProofsResponse, error)
+	CheckProofsSpent(context.Context, *CheckProofsSpentRequest) (*CheckProofsSpentResponse, error)
+	GetActiveKeyset(context.Context, *GetActiveKeysetRequest) (*GetActiveKeysetResponse, error)
+	GetKeysetFeesById(context.Context, *GetKeysetFeesByIdRequest) (*GetKeysetFeesByIdResponse, error)
+	GetMintInfo(context.Context, *GetMintInfoRequest) (*GetMintInfoResponse, error)
+	GetProofsByStates(context.Context, *GetProofsByStatesRequest) (*GetProofsByStatesResponse, error)
+	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
+	GetUnspentAuthProofs(context.Context, *GetUnspentAuthProofsRequest) (*GetUnspentAuthProofsResponse, error)
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
+	Melt(context.Context, *MeltRequest) (*MeltResponse, error)
+	MeltBip353Quote(context.Context, *MeltBip353QuoteRequest) (*MeltBip353QuoteResponse, error)
+	MeltBolt12Quote(context.Context, *MeltBolt12QuoteRequest) (*MeltBolt12QuoteResponse, error)
+	MeltQuote(context.Context, *MeltQuoteRequest) (*MeltQuoteResponse, error)
+	Mint(context.Context, *MintRequest) (*MintResponse, error)
+	MintBlindAuth(context.Context, *MintBlindAuthRequest) (*MintBlindAuthResponse, error)
+	MintBolt12(context.Context, *MintBolt12Request) (*MintBolt12Response, error)
+	MintBolt12Quote(context.Context, *MintBolt12QuoteRequest) (*MintBolt12QuoteResponse, error)
+	MintQuote(context.Context, *MintQuoteRequest) (*MintQuoteResponse, error)
+	GetUnit(context.Context, *GetUnitRequest) (*GetUnitResponse, error)
+	// Selects the proofs of a send. The send is kept by the server until
+	// ConfirmSend or CancelSend.
+	PrepareSend(context.Context, *PrepareSendRequest) (*PrepareSendResponse, error)
+	ConfirmSend(context.Context, *ConfirmSendRequest) (*ConfirmSendResponse, error)
+	CancelSend(context.Context, *CancelSendRequest) (*CancelSendResponse, error)
+	Receive(context.Context, *ReceiveRequest) (*ReceiveResponse, error)
+	ReceiveProofs(context.Context, *ReceiveProofsRequest) (*ReceiveProofsResponse, error)
+	ReclaimUnspent(context.Context, *ReclaimUnspentRequest) (*ReclaimUnspentResponse, error)
+	RefreshAccessToken(context.Context, *RefreshAccessTokenRequest) (*RefreshAccessTokenResponse, error)
+	RefreshKeysets(context.Context, *RefreshKeysetsRequest) (*RefreshKeysetsResponse, error)
+	Restore(context.Context, *RestoreRequest) (*RestoreResponse, error)
+	RevertTransaction(context.Context, *RevertTransactionRequest) (*RevertTransactionResponse, error)
+	SetCat(context.Context, *SetCatRequest) (*SetCatResponse, error)
+	SetRefreshToken(context.Context, *SetRefreshTokenRequest) (*SetRefreshTokenResponse, error)
+	// Streams the NUT-17 notifications of the mint until the client cancels.
+	Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[SubscribeResponse]) error
+	Swap(context.Context, *SwapRequest) (*SwapResponse, error)
+	TotalBalance(context.Context, *TotalBalanceRequest) (*TotalBalanceResponse, error)
+	TotalPendingBalance(context.Context, *TotalPendingBalanceRequest) (*TotalPendingBalanceResponse, error)
+	TotalReservedBalance(context.Context, *TotalReservedBalanceRequest) (*TotalReservedBalanceResponse, error)
+	VerifyTokenDleq(context.Context, *VerifyTokenDleqRequest) (*VerifyTokenDleqResponse, error)
+	mustEmbedUnimplementedWalletServiceServer()
+}
+
+// UnimplementedWalletServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWalletServiceServer struct{}
+
+func (UnimplementedWalletServiceServer) CalculateFee(context.Context, *CalculateFeeRequest) (*CalculateFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateFee not implemented")
+}
+func (UnimplementedWalletServiceServer) CheckAllPendingProofs(context.Context, *CheckAllPendingProofsRequest) (*CheckAllPendingProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAllPendingProofs not implemented")
+}
+func (UnimplementedWalletServiceServer) CheckProofsSpent(context.Context, *CheckProofsSpentRequest) (*CheckProofsSpentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckProofsSpent not implemented")
+}
+func (UnimplementedWalletServiceServer) GetActiveKeyset(context.Context, *GetActiveKeysetRequest) (*GetActiveKeysetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActiveKeyset not implemented")
+}
+func (UnimplementedWalletServiceServer) GetKeysetFeesById(context.Context, *GetKeysetFeesByIdRequest) (*GetKeysetFeesByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeysetFeesById not implemented")
+}
+func (UnimplementedWalletServiceServer) GetMintInfo(context.Context, *GetMintInfoRequest) (*GetMintInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMintInfo not implemented")
+}
+func (UnimplementedWalletServiceServer) GetProofsByStates(context.Context, *GetProofsByStatesRequest) (*GetProofsByStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProofsByStates not implemented")
+}
+func (UnimplementedWalletServiceServer) GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (UnimplementedWalletServiceServer) GetUnspentAuthProofs(context.Context, *GetUnspentAuthProofsRequest) (*GetUnspentAuthProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnspentAuthProofs not implemented")
+}
+func (UnimplementedWalletServiceServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
+}
+func (UnimplementedWalletServiceServer) Melt(context.Context, *MeltRequest) (*MeltResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Melt not implemented")
+}
+func (UnimplementedWalletServiceServer) MeltBip353Quote(context.Context, *MeltBip353QuoteRequest) (*MeltBip353QuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MeltBip353Quote not implemented")
+}
+func (UnimplementedWalletServiceServer) MeltBolt12Quote(context.Context, *MeltBolt12QuoteRequest) (*MeltBolt12QuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MeltBolt12Quote not implemented")
+}
+func (UnimplementedWalletServiceServer) MeltQuote(context.Context, *MeltQuoteRequest) (*MeltQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MeltQuote not implemented")
+}
+func (UnimplementedWalletServiceServer) Mint(context.Context, *MintRequest) (*MintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+}
+func (UnimplementedWalletServiceServer) MintBlindAuth(context.Context, *MintBlindAuthRequest) (*MintBlindAuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintBlindAuth not implemented")
+}
+func (UnimplementedWalletServiceServer) MintBolt12(context.Context, *MintBolt12Request) (*MintBolt12Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintBolt12 not implemented")
+}
+func (UnimplementedWalletServiceServer) MintBolt12Quote(context.Context, *MintBolt12QuoteRequest) (*MintBolt12QuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintBolt12Quote not implemented")
+}
+func (UnimplementedWalletServiceServer) MintQuote(context.Context, *MintQuoteRequest) (*MintQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintQuote not implemented")
+}
+func (UnimplementedWalletServiceServer) GetUnit(context.Context, *GetUnitRequest) (*GetUnitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnit not implemented")
+}
+func (UnimplementedWalletServiceServer) PrepareSend(context.Context, *PrepareSendRequest) (*PrepareSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareSend not implemented")
+}
+func (UnimplementedWalletServiceServer) ConfirmSend(context.Context, *ConfirmSendRequest) (*ConfirmSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmSend not implemented")
+}
+func (UnimplementedWalletServiceServer) CancelSend(context.Context, *CancelSendRequest) (*CancelSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSend not implemented")
+}
+func (UnimplementedWalletServiceServer) Receive(context.Context, *ReceiveRequest) (*ReceiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Receive not implemented")
+}
+func (UnimplementedWalletServiceServer) ReceiveProofs(context.Context, *ReceiveProofsRequest) (*ReceiveProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveProofs not implemented")
+}
+func (UnimplementedWalletServiceServer) ReclaimUnspent(context.Context, *ReclaimUnspentRequest) (*ReclaimUnspentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReclaimUnspent not implemented")
+}
+func (UnimplementedWalletServiceServer) RefreshAccessToken(context.Context, *RefreshAccessTokenRequest) (*RefreshAccessTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshAccessToken not implemented")
+}
+func (UnimplementedWalletServiceServer) RefreshKeysets(context.Context, *RefreshKeysetsRequest) (*RefreshKeysetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshKeysets not implemented")
+}
+func (UnimplementedWalletServiceServer) Restore(context.Context, *RestoreRequest) (*RestoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedWalletServiceServer) RevertTransaction(context.Context, *RevertTransactionRequest) (*RevertTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertTransaction not implemented")
+}
+func (UnimplementedWalletServiceServer) SetCat(context.Context, *SetCatRequest) (*SetCatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCat not implemented")
+}
+func (UnimplementedWalletServiceServer) SetRefreshToken(context.Context, *SetRefreshTokenRequest) (*SetRefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRefreshToken not implemented")
+}
+func (UnimplementedWalletServiceServer) Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[SubscribeResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedWalletServiceServer) Swap(context.Context, *SwapRequest) (*SwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+}
+func (UnimplementedWalletServiceServer) TotalBalance(context.Context, *TotalBalanceRequest) (*TotalBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalBalance not implemented")
+}
+func (UnimplementedWalletServiceServer) TotalPendingBalance(context.Context, *TotalPendingBalanceRequest) (*TotalPendingBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalPendingBalance not implemented")
+}
+func (UnimplementedWalletServiceServer) TotalReservedBalance(context.Context, *TotalReservedBalanceRequest) (*TotalReservedBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalReservedBalance not implemented")
+}
+func (UnimplementedWalletServiceServer) VerifyTokenDleq(context.Context, *VerifyTokenDleqRequest) (*VerifyTokenDleqResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTokenDleq not implemented")
+}
+func (UnimplementedWalletServiceServer) mustEmbedUnimplementedWalletServiceServer() {}
+func (UnimplementedWalletServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeWalletServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WalletServiceServer will
+// result in compilation errors.
+type UnsafeWalletServiceServer interface {
+	mustEmbedUnimplementedWalletServiceServer()
+}
+
+func RegisterWalletServiceServer(s grpc.ServiceRegistrar, srv WalletServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWalletServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WalletService_ServiceDesc, srv)
+}
+
+func _WalletService_CalculateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CalculateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_CalculateFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CalculateFee(ctx, req.(*CalculateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CheckAllPendingProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAllPendingProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CheckAllPendingProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_CheckAllPendingProofs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CheckAllPendingProofs(ctx, req.(*CheckAllPendingProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CheckProofsSpent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckProofsSpentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CheckProofsSpent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_CheckProofsSpent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CheckProofsSpent(ctx, req.(*CheckProofsSpentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetActiveKeyset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActiveKeysetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetActiveKeyset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetActiveKeyset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetActiveKeyset(ctx, req.(*GetActiveKeysetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetKeysetFeesById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKeysetFeesByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetKeysetFeesById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetKeysetFeesById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetKeysetFeesById(ctx, req.(*GetKeysetFeesByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetMintInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMintInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetMintInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetMintInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetMintInfo(ctx, req.(*GetMintInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetProofsByStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProofsByStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetProofsByStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetProofsByStates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetProofsByStates(ctx, req.(*GetProofsByStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetUnspentAuthProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnspentAuthProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetUnspentAuthProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetUnspentAuthProofs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetUnspentAuthProofs(ctx, req.(*GetUnspentAuthProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_ListTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_Melt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MeltRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Melt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_Melt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Melt(ctx, req.(*MeltRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_MeltBip353Quote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MeltBip353QuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).MeltBip353Quote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_MeltBip353Quote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).MeltBip353Quote(ctx, req.(*MeltBip353QuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_MeltBolt12Quote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MeltBolt12QuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).MeltBolt12Quote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_MeltBolt12Quote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).MeltBolt12Quote(ctx, req.(*MeltBolt12QuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_MeltQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MeltQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).MeltQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_MeltQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).MeltQuote(ctx, req.(*MeltQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Mint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_Mint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Mint(ctx, req.(*MintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_MintBlindAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MintBlindAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).MintBlindAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_MintBlindAuth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).MintBlindAuth(ctx, req.(*MintBlindAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_MintBolt12_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MintBolt12Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).MintBolt12(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_MintBolt12_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).MintBolt12(ctx, req.(*MintBolt12Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_MintBolt12Quote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MintBolt12QuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).MintBolt12Quote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_MintBolt12Quote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).MintBolt12Quote(ctx, req.(*MintBolt12QuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_MintQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MintQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).MintQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_MintQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).MintQuote(ctx, req.(*MintQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetUnit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetUnit(ctx, req.(*GetUnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_PrepareSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).PrepareSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_PrepareSend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).PrepareSend(ctx, req.(*PrepareSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ConfirmSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ConfirmSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_ConfirmSend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ConfirmSend(ctx, req.(*ConfirmSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CancelSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CancelSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_CancelSend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CancelSend(ctx, req.(*CancelSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_Receive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Receive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_Receive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Receive(ctx, req.(*ReceiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ReceiveProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ReceiveProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_ReceiveProofs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ReceiveProofs(ctx, req.(*ReceiveProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ReclaimUnspent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReclaimUnspentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ReclaimUnspent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_ReclaimUnspent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ReclaimUnspent(ctx, req.(*ReclaimUnspentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_RefreshAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).RefreshAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_RefreshAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).RefreshAccessToken(ctx, req.(*RefreshAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_RefreshKeysets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshKeysetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).RefreshKeysets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_RefreshKeysets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).RefreshKeysets(ctx, req.(*RefreshKeysetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Restore(ctx, req.(*RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_RevertTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).RevertTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_RevertTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).RevertTransaction(ctx, req.(*RevertTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SetCat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SetCat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_SetCat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SetCat(ctx, req.(*SetCatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SetRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SetRefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_SetRefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SetRefreshToken(ctx, req.(*SetRefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).Subscribe(m, &grpc.GenericServerStream[SubscribeRequest, SubscribeResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WalletService_SubscribeServer = grpc.ServerStreamingServer[SubscribeResponse]
+
+func _WalletService_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Swap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_Swap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Swap(ctx, req.(*SwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_TotalBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotalBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).TotalBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_TotalBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).TotalBalance(ctx, req.(*TotalBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_TotalPendingBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotalPendingBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).TotalPendingBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_TotalPendingBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).TotalPendingBalance(ctx, req.(*TotalPendingBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_TotalReservedBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotalReservedBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).TotalReservedBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_TotalReservedBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).TotalReservedBalance(ctx, req.(*TotalReservedBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_VerifyTokenDleq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTokenDleqRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).VerifyTokenDleq(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_VerifyTokenDleq_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).VerifyTokenDleq(ctx, req.(*VerifyTokenDleqRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WalletService_ServiceDesc is the grpc.ServiceDesc for WalletService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WalletService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cdkgo.wallet.v1.WalletService",
+	HandlerType: (*WalletServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CalculateFee",
+			Handler:    _WalletService_CalculateFee_Handler,
+		},
+		{
+			MethodName: "CheckAllPendingProofs",
+			Handler:    _WalletService_CheckAllPendingProofs_Handler,
+		},
+		{
+			MethodName: "CheckProofsSpent",
+			Handler:    _WalletService_CheckProofsSpent_Handler,
+		},
+		{
+			MethodName: "GetActiveKeyset",
+			Handler:    _WalletService_GetActiveKeyset_Handler,
+		},
+		{
+			MethodName: "GetKeysetFeesById",
+			Handler:    _WalletService_GetKeysetFeesById_Handler,
+		},
+		{
+			MethodName: "GetMintInfo",
+			Handler:    _WalletService_GetMintInfo_Handler,
+		},
+		{
+			MethodName: "GetProofsByStates",
+			Handler:    _WalletService_GetProofsByStates_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _WalletService_GetTransaction_Handler,
+		},
+		{
+			MethodName: "GetUnspentAuthProofs",
+			Handler:    _WalletService_GetUnspentAuthProofs_Handler,
+		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _WalletService_ListTransactions_Handler,
+		},
+		{
+			MethodName: "Melt",
+			Handler:    _WalletService_Melt_Handler,
+		},
+		{
+			MethodName: "MeltBip353Quote",
+			Handler:    _WalletService_MeltBip353Quote_Handler,
+		},
+		{
+			MethodName: "MeltBolt12Quote",
+			Handler:    _WalletService_MeltBolt12Quote_Handler,
+		},
+		{
+			MethodName: "MeltQuote",
+			Handler:    _WalletService_MeltQuote_Handler,
+		},
+		{
+			MethodName: "Mint",
+			Handler:    _WalletService_Mint_Handler,
+		},
+		{
+			MethodName: "MintBlindAuth",
+			Handler:    _WalletService_MintBlindAuth_Handler,
+		},
+		{
+			MethodName: "MintBolt12",
+			Handler:    _WalletService_MintBolt12_Handler,
+		},
+		{
+			MethodName: "MintBolt12Quote",
+			Handler:    _WalletService_MintBolt12Quote_Handler,
+		},
+		{
+			MethodName: "MintQuote",
+			Handler:    _WalletService_MintQuote_Handler,
+		},
+		{
+			MethodName: "GetUnit",
+			Handler:    _WalletService_GetUnit_Handler,
+		},
+		{
+			MethodName: "PrepareSend",
+			Handler:    _WalletService_PrepareSend_Handler,
+		},
+		{
+			MethodName: "ConfirmSend",
+			Handler:    _WalletService_ConfirmSend_Handler,
+		},
+		{
+			MethodName: "CancelSend",
+			Handler:    _WalletService_CancelSend_Handler,
+		},
+		{
+			MethodName: "Receive",
+			Handler:    _WalletService_Receive_Handler,
+		},
+		{
+			MethodName: "ReceiveProofs",
+			Handler:    _WalletService_ReceiveProofs_Handler,
+		},
+		{
+			MethodName: "ReclaimUnspent",
+			Handler:    _WalletService_ReclaimUnspent_Handler,
+		},
+		{
+			MethodName: "RefreshAccessToken",
+			Handler:    _WalletService_RefreshAccessToken_Handler,
+		},
+		{
+			MethodName: "RefreshKeysets",
+			Handler:    _WalletService_RefreshKeysets_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _WalletService_Restore_Handler,
+		},
+		{
+			MethodName: "RevertTransaction",
+			Handler:    _WalletService_RevertTransaction_Handler,
+		},
+		{
+			MethodName: "SetCat",
+			Handler:    _WalletService_SetCat_Handler,
+		},
+		{
+			MethodName: "SetRefreshToken",
+			Handler:    _WalletService_SetRefreshToken_Handler,
+		},
+		{
+			MethodName: "Swap",
+			Handler:    _WalletService_Swap_Handler,
+		},
+		{
+			MethodName: "TotalBalance",
+			Handler:    _WalletService_TotalBalance_Handler,
+		},
+		{
+			MethodName: "TotalPendingBalance",
+			Handler:    _WalletService_TotalPendingBalance_Handler,
+		},
+		{
+			MethodName: "TotalReservedBalance",
+			Handler:    _WalletService_TotalReservedBalance_Handler,
+		},
+		{
+			MethodName: "VerifyTokenDleq",
+			Handler:    _WalletService_VerifyTokenDleq_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _WalletService_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "wallet.proto",
+}
+
+const (
+	MultiMintWalletService_AddMint_FullMethodName            = "/cdkgo.wallet.v1.MultiMintWalletService/AddMint"
+	MultiMintWalletService_CheckAllMintQuotes_FullMethodName = "/cdkgo.wallet.v1.MultiMintWalletService/CheckAllMintQuotes"
+	MultiMintWalletService_CheckMintQuote_FullMethodName     = "/cdkgo.wallet.v1.MultiMintWalletService/CheckMintQuote"
+	MultiMintWalletService_Consolidate_FullMethodName        = "/cdkgo.wallet.v1.MultiMintWalletService/Consolidate"
+	MultiMintWalletService_GetBalances_FullMethodName        = "/cdkgo.wallet.v1.MultiMintWalletService/GetBalances"
+	MultiMintWalletService_GetMintUrls_FullMethodName        = "/cdkgo.wallet.v1.MultiMintWalletService/GetMintUrls"
+	MultiMintWalletService_HasMint_FullMethodName            = "/cdkgo.wallet.v1.MultiMintWalletService/HasMint"
+	MultiMintWalletService_ListProofs_FullMethodName         = "/cdkgo.wallet.v1.MultiMintWalletService/ListProofs"
+	MultiMintWalletService_ListTransactions_FullMethodName   = "/cdkgo.wallet.v1.MultiMintWalletService/ListTransactions"
+	MultiMintWalletService_Melt_FullMethodName               = "/cdkgo.wallet.v1.MultiMintWalletService/Melt"
+	MultiMintWalletService_MeltQuote_FullMethodName          = "/cdkgo.wallet.v1.MultiMintWalletService/MeltQuote"
+	MultiMintWalletService_Mint_FullMethodName               = "/cdkgo.wallet.v1.MultiMintWalletService/Mint"
+	MultiMintWalletService_MintQuote_FullMethodName          = "/cdkgo.wallet.v1.MultiMintWalletService/MintQuote"
+	MultiMintWalletService_GetUnit_FullMethodName            = "/cdkgo.wallet.v1.MultiMintWalletService/GetUnit"
+	MultiMintWalletService_PrepareSend_FullMethodName        = "/cdkgo.wallet.v1.MultiMintWalletService/PrepareSend"
+	MultiMintWalletService_ConfirmSend_FullMethodName        = "/cdkgo.wallet.v1.MultiMintWalletService/ConfirmSend"
+	MultiMintWalletService_CancelSend_FullMethodName         = "/cdkgo.wallet.v1.MultiMintWalletService/CancelSend"
+	MultiMintWalletService_Receive_FullMethodName            = "/cdkgo.wallet.v1.MultiMintWalletService/Receive"
+	MultiMintWalletService_RemoveMint_FullMethodName         = "/cdkgo.wallet.v1.MultiMintWalletService/RemoveMint"
+	MultiMintWalletService_Restore_FullMethodName            = "/cdkgo.wallet.v1.MultiMintWalletService/Restore"
+	MultiMintWalletService_Swap_FullMethodName               = "/cdkgo.wallet.v1.MultiMintWalletService/Swap"
+	MultiMintWalletService_TotalBalance_FullMethodName       = "/cdkgo.wallet.v1.MultiMintWalletService/TotalBalance"
+	MultiMintWalletService_Transfer_FullMethodName           = "/cdkgo.wallet.v1.MultiMintWalletService/Transfer"
+	MultiMintWalletService_VerifyTokenDleq_FullMethodName    = "/cdkgo.wallet.v1.MultiMintWalletService/VerifyTokenDleq"
+	MultiMintWalletService_WaitForMintQuote_FullMethodName   = "/cdkgo.wallet.v1.MultiMintWalletService/WaitForMintQuote"
+)
+
+// MultiMintWalletServiceClient is the client API for MultiMintWalletService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MultiMintWalletServiceClient interface {
+	AddMint(ctx context.Context, in *AddMintRequest, opts ...grpc.CallOption) (*AddMintResponse, error)
+	CheckAllMintQuotes(ctx context.Context, in *CheckAllMintQuotesRequest, opts ...grpc.CallOption) (*CheckAllMintQuotesResponse, error)
+	CheckMintQuote(ctx context.Context, in *CheckMintQuoteRequest, opts ...grpc.CallOption) (*CheckMintQuoteResponse, error)
+	Consolidate(ctx context.Context, in *ConsolidateRequest, opts ...grpc.CallOption) (*ConsolidateResponse, error)
+	GetBalances(ctx context.Context, in *GetBalancesRequest, opts ...grpc.CallOption) (*GetBalancesResponse, error)
+	GetMintUrls(ctx context.Context, in *GetMintUrlsRequest, opts ...grpc.CallOption) (*GetMintUrlsResponse, error)
+	HasMint(ctx context.Context, in *HasMintRequest, opts ...grpc.CallOption) (*HasMintResponse, error)
+	ListProofs(ctx context.Context, in *ListProofsRequest, opts ...grpc.CallOption) (*ListProofsResponse, error)
+	ListTransactions(ctx context.Context, in *MultiMintListTransactionsRequest, opts ...grpc.CallOption) (*MultiMintListTransactionsResponse, error)
+	Melt(ctx context.Context, in *MultiMintMeltRequest, opts ...grpc.CallOption) (*MultiMintMeltResponse, error)
+	MeltQuote(ctx context.Context, in *MultiMintMeltQuoteRequest, opts ...grpc.CallOption) (*MultiMintMeltQuoteResponse, error)
+	Mint(ctx context.Context, in *MultiMintMintRequest, opts ...grpc.CallOption) (*MultiMintMintResponse, error)
+	MintQuote(ctx context.Context, in *MultiMintMintQuoteRequest, opts ...grpc.CallOption) (*MultiMintMintQuoteResponse, error)
+	GetUnit(ctx context.Context, in *MultiMintGetUnitRequest, opts ...grpc.CallOption) (*MultiMintGetUnitResponse, error)
+	// Selects the proofs of a send. The send is kept by the server until
+	// ConfirmSend or CancelSend.
+	PrepareSend(ctx context.Context, in *MultiMintPrepareSendRequest, opts ...grpc.CallOption) (*MultiMintPrepareSendResponse, error)
+	ConfirmSend(ctx context.Context, in *MultiMintConfirmSendRequest, opts ...grpc.CallOption) (*MultiMintConfirmSendResponse, error)
+	CancelSend(ctx context.Context, in *MultiMintCancelSendRequest, opts ...grpc.CallOption) (*MultiMintCancelSendResponse, error)
+	Receive(ctx context.Context, in *MultiMintReceiveRequest, opts ...grpc.CallOption) (*MultiMintReceiveResponse, error)
+	RemoveMint(ctx context.Context, in *RemoveMintRequest, opts ...grpc.CallOption) (*RemoveMintResponse, error)
+	Restore(ctx context.Context, in *MultiMintRestoreRequest, opts ...grpc.CallOption) (*MultiMintRestoreResponse, error)
+	Swap(ctx context.Context, in *MultiMintSwapRequest, opts ...grpc.CallOption) (*MultiMintSwapResponse, error)
+	TotalBalance(ctx context.Context, in *MultiMintTotalBalanceRequest, opts ...grpc.CallOption) (*MultiMintTotalBalanceResponse, error)
+	Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error)
+	VerifyTokenDleq(ctx context.Context, in *MultiMintVerifyTokenDleqRequest, opts ...grpc.CallOption) (*MultiMintVerifyTokenDleqResponse, error)
+	WaitForMintQuote(ctx context.Context, in *WaitForMintQuoteRequest, opts ...grpc.CallOption) (*WaitForMintQuoteResponse, error)
+}
+
+type multiMintWalletServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMultiMintWalletServiceClient(cc grpc.ClientConnInterface) MultiMintWalletServiceClient {
+	return &multiMintWalletServiceClient{cc}
+}
+
+func (c *multiMintWalletServiceClient) AddMint(ctx context.Context, in *AddMintRequest, opts ...grpc.CallOption) (*AddMintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddMintResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_AddMint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) CheckAllMintQuotes(ctx context.Context, in *CheckAllMintQuotesRequest, opts ...grpc.CallOption) (*CheckAllMintQuotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckAllMintQuotesResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_CheckAllMintQuotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) CheckMintQuote(ctx context.Context, in *CheckMintQuoteRequest, opts ...grpc.CallOption) (*CheckMintQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckMintQuoteResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_CheckMintQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) Consolidate(ctx context.Context, in *ConsolidateRequest, opts ...grpc.CallOption) (*ConsolidateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsolidateResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_Consolidate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) GetBalances(ctx context.Context, in *GetBalancesRequest, opts ...grpc.CallOption) (*GetBalancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBalancesResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_GetBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) GetMintUrls(ctx context.Context, in *GetMintUrlsRequest, opts ...grpc.CallOption) (*GetMintUrlsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMintUrlsResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_GetMintUrls_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) HasMint(ctx context.Context, in *HasMintRequest, opts ...grpc.CallOption) (*HasMintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HasMintResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_HasMint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) ListProofs(ctx context.Context, in *ListProofsRequest, opts ...grpc.CallOption) (*ListProofsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProofsResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_ListProofs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) ListTransactions(ctx context.Context, in *MultiMintListTransactionsRequest, opts ...grpc.CallOption) (*MultiMintListTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultiMintListTransactionsResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_ListTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) Melt(ctx context.Context, in *MultiMintMeltRequest, opts ...grpc.CallOption) (*MultiMintMeltResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultiMintMeltResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_Melt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) MeltQuote(ctx context.Context, in *MultiMintMeltQuoteRequest, opts ...grpc.CallOption) (*MultiMintMeltQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultiMintMeltQuoteResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_MeltQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) Mint(ctx context.Context, in *MultiMintMintRequest, opts ...grpc.CallOption) (*MultiMintMintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultiMintMintResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_Mint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) MintQuote(ctx context.Context, in *MultiMintMintQuoteRequest, opts ...grpc.CallOption) (*MultiMintMintQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultiMintMintQuoteResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_MintQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) GetUnit(ctx context.Context, in *MultiMintGetUnitRequest, opts ...grpc.CallOption) (*MultiMintGetUnitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultiMintGetUnitResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_GetUnit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) PrepareSend(ctx context.Context, in *MultiMintPrepareSendRequest, opts ...grpc.CallOption) (*MultiMintPrepareSendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultiMintPrepareSendResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_PrepareSend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) ConfirmSend(ctx context.Context, in *MultiMintConfirmSendRequest, opts ...grpc.CallOption) (*MultiMintConfirmSendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultiMintConfirmSendResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_ConfirmSend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) CancelSend(ctx context.Context, in *MultiMintCancelSendRequest, opts ...grpc.CallOption) (*MultiMintCancelSendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultiMintCancelSendResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_CancelSend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) Receive(ctx context.Context, in *MultiMintReceiveRequest, opts ...grpc.CallOption) (*MultiMintReceiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultiMintReceiveResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_Receive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) RemoveMint(ctx context.Context, in *RemoveMintRequest, opts ...grpc.CallOption) (*RemoveMintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveMintResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_RemoveMint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) Restore(ctx context.Context, in *MultiMintRestoreRequest, opts ...grpc.CallOption) (*MultiMintRestoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultiMintRestoreResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) Swap(ctx context.Context, in *MultiMintSwapRequest, opts ...grpc.CallOption) (*MultiMintSwapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultiMintSwapResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_Swap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) TotalBalance(ctx context.Context, in *MultiMintTotalBalanceRequest, opts ...grpc.CallOption) (*MultiMintTotalBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultiMintTotalBalanceResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_TotalBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_Transfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) VerifyTokenDleq(ctx context.Context, in *MultiMintVerifyTokenDleqRequest, opts ...grpc.CallOption) (*MultiMintVerifyTokenDleqResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultiMintVerifyTokenDleqResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_VerifyTokenDleq_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiMintWalletServiceClient) WaitForMintQuote(ctx context.Context, in *WaitForMintQuoteRequest, opts ...grpc.CallOption) (*WaitForMintQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WaitForMintQuoteResponse)
+	err := c.cc.Invoke(ctx, MultiMintWalletService_WaitForMintQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MultiMintWalletServiceServer is the server API for MultiMintWalletService service.
+// All implementations must embed UnimplementedMultiMintWalletServiceServer
+// for forward compatibility.
+type MultiMintWalletServiceServer interface {
+	AddMint(context.Context, *AddMintRequest) (*AddMintResponse, error)
+	CheckAllMintQuotes(context.Context, *CheckAllMintQuotesRequest) (*CheckAllMintQuotesResponse, error)
+	CheckMintQuote(context.Context, *CheckMintQuoteRequest) (*CheckMintQuoteResponse, error)
+	Consolidate(context.Context, *ConsolidateRequest) (*ConsolidateResponse, error)
+	GetBalances(context.Context, *GetBalancesRequest) (*GetBalancesResponse, error)
+	GetMintUrls(context.Context, *GetMintUrlsRequest) (*GetMintUrlsResponse, error)
+	HasMint(context.Context, *HasMintRequest) (*HasMintResponse, error)
+	ListProofs(context.Context, *ListProofsRequest) (*ListProofsResponse, error)
+	ListTransactions(context.Context, *MultiMintListTransactionsRequest) (*MultiMintListTransactionsResponse, error)
+	Melt(context.Context, *MultiMintMeltRequest) (*MultiMintMeltResponse, error)
+	MeltQuote(context.Context, *MultiMintMeltQuoteRequest) (*MultiMintMeltQuoteResponse, error)
+	Mint(context.Context, *MultiMintMintRequest) (*MultiMintMintResponse, error)
+	MintQuote(context.Context, *MultiMintMintQuoteRequest) (*MultiMintMintQuoteResponse, error)
+	GetUnit(context.Context, *MultiMintGetUnitRequest) (*MultiMintGetUnitResponse, error)
+	// Selects the proofs of a send. The send is kept by the server until
+	// ConfirmSend or CancelSend.
+	PrepareSend(context.Context, *MultiMintPrepareSendRequest) (*MultiMintPrepareSendResponse, error)
+	ConfirmSend(context.Context, *MultiMintConfirmSendRequest) (*MultiMintConfirmSendResponse, error)
+	CancelSend(context.Context, *MultiMintCancelSendRequest) (*MultiMintCancelSendResponse, error)
+	Receive(context.Context, *MultiMintReceiveRequest) (*MultiMintReceiveResponse, error)
+	RemoveMint(context.Context, *RemoveMintRequest) (*RemoveMintResponse, error)
+	Restore(context.Context, *MultiMintRestoreRequest) (*MultiMintRestoreResponse, error)
+	Swap(context.Context, *MultiMintSwapRequest) (*MultiMintSwapResponse, error)
+	TotalBalance(context.Context, *MultiMintTotalBalanceRequest) (*MultiMintTotalBalanceResponse, error)
+	Transfer(context.Context, *TransferRequest) (*TransferResponse, error)
+	VerifyTokenDleq(context.Context, *MultiMintVerifyTokenDleqRequest) (*MultiMintVerifyTokenDleqResponse, error)
+	WaitForMintQuote(context.Context, *WaitForMintQuoteRequest) (*WaitForMintQuoteResponse, error)
+	mustEmbedUnimplementedMultiMintWalletServiceServer()
+}
+
+// UnimplementedMultiMintWalletServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMultiMintWalletServiceServer struct{}
+
+func (UnimplementedMultiMintWalletServiceServer) AddMint(context.Context, *AddMintRequest) (*AddMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMint not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) CheckAllMintQuotes(context.Context, *CheckAllMintQuotesRequest) (*CheckAllMintQuotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAllMintQuotes not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) CheckMintQuote(context.Context, *CheckMintQuoteRequest) (*CheckMintQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckMintQuote not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) Consolidate(context.Context, *ConsolidateRequest) (*ConsolidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Consolidate not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) GetBalances(context.Context, *GetBalancesRequest) (*GetBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalances not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) GetMintUrls(context.Context, *GetMintUrlsRequest) (*GetMintUrlsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMintUrls not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) HasMint(context.Context, *HasMintRequest) (*HasMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasMint not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) ListProofs(context.Context, *ListProofsRequest) (*ListProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProofs not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) ListTransactions(context.Context, *MultiMintListTransactionsRequest) (*MultiMintListTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) Melt(context.Context, *MultiMintMeltRequest) (*MultiMintMeltResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Melt not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) MeltQuote(context.Context, *MultiMintMeltQuoteRequest) (*MultiMintMeltQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MeltQuote not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) Mint(context.Context, *MultiMintMintRequest) (*MultiMintMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) MintQuote(context.Context, *MultiMintMintQuoteRequest) (*MultiMintMintQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintQuote not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) GetUnit(context.Context, *MultiMintGetUnitRequest) (*MultiMintGetUnitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnit not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) PrepareSend(context.Context, *MultiMintPrepareSendRequest) (*MultiMintPrepareSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareSend not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) ConfirmSend(context.Context, *MultiMintConfirmSendRequest) (*MultiMintConfirmSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmSend not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) CancelSend(context.Context, *MultiMintCancelSendRequest) (*MultiMintCancelSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSend not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) Receive(context.Context, *MultiMintReceiveRequest) (*MultiMintReceiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Receive not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) RemoveMint(context.Context, *RemoveMintRequest) (*RemoveMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMint not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) Restore(context.Context, *MultiMintRestoreRequest) (*MultiMintRestoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) Swap(context.Context, *MultiMintSwapRequest) (*MultiMintSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) TotalBalance(context.Context, *MultiMintTotalBalanceRequest) (*MultiMintTotalBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalBalance not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) Transfer(context.Context, *TransferRequest) (*TransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) VerifyTokenDleq(context.Context, *MultiMintVerifyTokenDleqRequest) (*MultiMintVerifyTokenDleqResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTokenDleq not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) WaitForMintQuote(context.Context, *WaitForMintQuoteRequest) (*WaitForMintQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitForMintQuote not implemented")
+}
+func (UnimplementedMultiMintWalletServiceServer) mustEmbedUnimplementedMultiMintWalletServiceServer() {
+}
+func (UnimplementedMultiMintWalletServiceServer) testEmbeddedByValue() {}
+
+// UnsafeMultiMintWalletServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MultiMintWalletServiceServer will
+// result in compilation errors.
+type UnsafeMultiMintWalletServiceServer interface {
+	mustEmbedUnimplementedMultiMintWalletServiceServer()
+}
+
+func RegisterMultiMintWalletServiceServer(s grpc.ServiceRegistrar, srv MultiMintWalletServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMultiMintWalletServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MultiMintWalletService_ServiceDesc, srv)
+}
+
+func _MultiMintWalletService_AddMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).AddMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_AddMint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).AddMint(ctx, req.(*AddMintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_CheckAllMintQuotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAllMintQuotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).CheckAllMintQuotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_CheckAllMintQuotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).CheckAllMintQuotes(ctx, req.(*CheckAllMintQuotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_CheckMintQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckMintQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).CheckMintQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_CheckMintQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).CheckMintQuote(ctx, req.(*CheckMintQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_Consolidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsolidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).Consolidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_Consolidate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).Consolidate(ctx, req.(*ConsolidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_GetBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).GetBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_GetBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).GetBalances(ctx, req.(*GetBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_GetMintUrls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMintUrlsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).GetMintUrls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_GetMintUrls_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).GetMintUrls(ctx, req.(*GetMintUrlsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_HasMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HasMintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).HasMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_HasMint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).HasMint(ctx, req.(*HasMintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_ListProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).ListProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_ListProofs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).ListProofs(ctx, req.(*ListProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiMintListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_ListTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).ListTransactions(ctx, req.(*MultiMintListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_Melt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiMintMeltRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).Melt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_Melt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).Melt(ctx, req.(*MultiMintMeltRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_MeltQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiMintMeltQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).MeltQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_MeltQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).MeltQuote(ctx, req.(*MultiMintMeltQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiMintMintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).Mint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_Mint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).Mint(ctx, req.(*MultiMintMintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_MintQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiMintMintQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).MintQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_MintQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).MintQuote(ctx, req.(*MultiMintMintQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_GetUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiMintGetUnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).GetUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_GetUnit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).GetUnit(ctx, req.(*MultiMintGetUnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_PrepareSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiMintPrepareSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).PrepareSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_PrepareSend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).PrepareSend(ctx, req.(*MultiMintPrepareSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_ConfirmSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiMintConfirmSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).ConfirmSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_ConfirmSend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).ConfirmSend(ctx, req.(*MultiMintConfirmSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_CancelSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiMintCancelSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).CancelSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_CancelSend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).CancelSend(ctx, req.(*MultiMintCancelSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_Receive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiMintReceiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).Receive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_Receive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).Receive(ctx, req.(*MultiMintReceiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_RemoveMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).RemoveMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_RemoveMint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).RemoveMint(ctx, req.(*RemoveMintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiMintRestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).Restore(ctx, req.(*MultiMintRestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiMintSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).Swap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_Swap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).Swap(ctx, req.(*MultiMintSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_TotalBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiMintTotalBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).TotalBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_TotalBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).TotalBalance(ctx, req.(*MultiMintTotalBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_Transfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).Transfer(ctx, req.(*TransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_VerifyTokenDleq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiMintVerifyTokenDleqRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).VerifyTokenDleq(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_VerifyTokenDleq_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).VerifyTokenDleq(ctx, req.(*MultiMintVerifyTokenDleqRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiMintWalletService_WaitForMintQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitForMintQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiMintWalletServiceServer).WaitForMintQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiMintWalletService_WaitForMintQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiMintWalletServiceServer).WaitForMintQuote(ctx, req.(*WaitForMintQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MultiMintWalletService_ServiceDesc is the grpc.ServiceDesc for MultiMintWalletService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MultiMintWalletService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cdkgo.wallet.v1.MultiMintWalletService",
+	HandlerType: (*MultiMintWalletServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddMint",
+			Handler:    _MultiMintWalletService_AddMint_Handler,
+		},
+		{
+			MethodName: "CheckAllMintQuotes",
+			Handler:    _MultiMintWalletService_CheckAllMintQuotes_Handler,
+		},
+		{
+			MethodName: "CheckMintQuote",
+			Handler:    _MultiMintWalletService_CheckMintQuote_Handler,
+		},
+		{
+			MethodName: "Consolidate",
+			Handler:    _MultiMintWalletService_Consolidate_Handler,
+		},
+		{
+			MethodName: "GetBalances",
+			Handler:    _MultiMintWalletService_GetBalances_Handler,
+		},
+		{
+			MethodName: "GetMintUrls",
+			Handler:    _MultiMintWalletService_GetMintUrls_Handler,
+		},
+		{
+			MethodName: "HasMint",
+			Handler:    _MultiMintWalletService_HasMint_Handler,
+		},
+		{
+			MethodName: "ListProofs",
+			Handler:    _MultiMintWalletService_ListProofs_Handler,
+		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _MultiMintWalletService_ListTransactions_Handler,
+		},
+		{
+			MethodName: "Melt",
+			Handler:    _MultiMintWalletService_Melt_Handler,
+		},
+		{
+			MethodName: "MeltQuote",
+			Handler:    _MultiMintWalletService_MeltQuote_Handler,
+		},
+		{
+			MethodName: "Mint",
+			Handler:    _MultiMintWalletService_Mint_Handler,
+		},
+		{
+			MethodName: "MintQuote",
+			Handler:    _MultiMintWalletService_MintQuote_Handler,
+		},
+		{
+			MethodName: "GetUnit",
+			Handler:    _MultiMintWalletService_GetUnit_Handler,
+		},
+		{
+			MethodName: "PrepareSend",
+			Handler:    _MultiMintWalletService_PrepareSend_Handler,
+		},
+		{
+			MethodName: "ConfirmSend",
+			Handler:    _MultiMintWalletService_ConfirmSend_Handler,
+		},
+		{
+			MethodName: "CancelSend",
+			Handler:    _MultiMintWalletService_CancelSend_Handler,
+		},
+		{
+			MethodName: "Receive",
+			Handler:    _MultiMintWalletService_Receive_Handler,
+		},
+		{
+			MethodName: "RemoveMint",
+			Handler:    _MultiMintWalletService_RemoveMint_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _MultiMintWalletService_Restore_Handler,
+		},
+		{
+			MethodName: "Swap",
+			Handler:    _MultiMintWalletService_Swap_Handler,
+		},
+		{
+			MethodName: "TotalBalance",
+			Handler:    _MultiMintWalletService_TotalBalance_Handler,
+		},
+		{
+			MethodName: "Transfer",
+			Handler:    _MultiMintWalletService_Transfer_Handler,
+		},
+		{
+			MethodName: "VerifyTokenDleq",
+			Handler:    _MultiMintWalletService_VerifyTokenDleq_Handler,
+		},
+		{
+			MethodName: "WaitForMintQuote",
+			Handler:    _MultiMintWalletService_WaitForMintQuote_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "wallet.proto",
+}
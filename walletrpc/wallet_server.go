@@ -0,0 +1,685 @@
+package walletrpc
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	cdk "github.com/lescuer97/cdkgo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WalletResolver returns the wallet of the mint a WalletService call is
+// addressed to.
+type WalletResolver func(ctx context.Context, mintUrl string) (*cdk.Wallet, error)
+
+// SingleWallet serves one wallet. Calls addressed to another mint fail with
+// NotFound; an empty mint_url addresses the wallet.
+func SingleWallet(wallet *cdk.Wallet) WalletResolver {
+	url := strings.TrimSuffix(wallet.MintUrl().Url, "/")
+	return func(ctx context.Context, mintUrl string) (*cdk.Wallet, error) {
+		if mintUrl != "" && strings.TrimSuffix(mintUrl, "/") != url {
+			return nil, status.Errorf(codes.NotFound, "mint %s is not served", mintUrl)
+		}
+		return wallet, nil
+	}
+}
+
+// MultiMintWallets serves a wallet for every mint added to multi. The
+// wallets share the database of multi and are created when first used.
+func MultiMintWallets(multi *cdk.MultiMintWallet, db cdk.WalletDatabase, mnemonic string) WalletResolver {
+	var mu sync.Mutex
+	wallets := map[string]*cdk.Wallet{}
+	return func(ctx context.Context, mintUrl string) (*cdk.Wallet, error) {
+		mintUrl = strings.TrimSuffix(mintUrl, "/")
+		if !multi.HasMint(cdk.MintUrl{Url: mintUrl}) {
+			return nil, status.Errorf(codes.NotFound, "mint %s is not added", mintUrl)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if wallet, ok := wallets[mintUrl]; ok {
+			return wallet, nil
+		}
+		wallet, err := cdk.NewWallet(mintUrl, multi.Unit(), mnemonic, db, cdk.WalletConfig{})
+		if err != nil {
+			return nil, err
+		}
+		wallets[mintUrl] = wallet
+		return wallet, nil
+	}
+}
+
+// preparedSends holds the sends of PrepareSend until they are confirmed or
+// cancelled.
+type preparedSends struct {
+	mu    sync.Mutex
+	sends map[string]*cdk.PreparedSend
+}
+
+func (p *preparedSends) add(send *cdk.PreparedSend) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.sends == nil {
+		p.sends = map[string]*cdk.PreparedSend{}
+	}
+	p.sends[send.Id()] = send
+}
+
+func (p *preparedSends) take(id string) (*cdk.PreparedSend, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	send, ok := p.sends[id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no prepared send %s", id)
+	}
+	delete(p.sends, id)
+	return send, nil
+}
+
+func (p *preparedSends) confirm(ctx context.Context, id string, memo *string) (string, error) {
+	send, err := p.take(id)
+	if err != nil {
+		return "", err
+	}
+	token, err := send.ConfirmCtx(ctx, memo)
+	if err != nil {
+		// Put back, so it can be confirmed again or cancelled.
+		p.add(send)
+		return "", rpcError(err)
+	}
+	defer send.Destroy()
+	defer token.Destroy()
+	return token.Encode(), nil
+}
+
+func (p *preparedSends) cancel(ctx context.Context, id string) error {
+	send, err := p.take(id)
+	if err != nil {
+		return err
+	}
+	defer send.Destroy()
+	return rpcError(send.CancelCtx(ctx))
+}
+
+// cancelAll cancels the sends that were not confirmed, releasing their
+// proofs.
+func (p *preparedSends) cancelAll(ctx context.Context) {
+	p.mu.Lock()
+	sends := p.sends
+	p.sends = nil
+	p.mu.Unlock()
+	for _, send := range sends {
+		send.CancelCtx(ctx)
+		send.Destroy()
+	}
+}
+
+// WalletServer implements WalletService on the wallets of a WalletResolver.
+type WalletServer struct {
+	UnimplementedWalletServiceServer
+
+	wallets   WalletResolver
+	sends     preparedSends
+	stopping  chan struct{}
+	closeOnce sync.Once
+}
+
+func NewWalletServer(wallets WalletResolver) *WalletServer {
+	return &WalletServer{wallets: wallets, stopping: make(chan struct{})}
+}
+
+// Register registers the service on a gRPC server.
+func (s *WalletServer) Register(registrar grpc.ServiceRegistrar) {
+	RegisterWalletServiceServer(registrar, s)
+}
+
+// Close ends the Subscribe streams, which grpc.Server.GracefulStop would
+// otherwise wait for, and cancels the sends that were not confirmed.
+func (s *WalletServer) Close(ctx context.Context) {
+	s.closeOnce.Do(func() { close(s.stopping) })
+	s.sends.cancelAll(ctx)
+}
+
+func (s *WalletServer) wallet(ctx context.Context, mintUrl string) (*cdk.Wallet, error) {
+	wallet, err := s.wallets(ctx, mintUrl)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return wallet, nil
+}
+
+func (s *WalletServer) CalculateFee(ctx context.Context, req *CalculateFeeRequest) (*CalculateFeeResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	fee, err := wallet.CalculateFeeCtx(ctx, req.ProofCount, req.KeysetId)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &CalculateFeeResponse{Fee: fee.Value}, nil
+}
+
+func (s *WalletServer) CheckAllPendingProofs(ctx context.Context, req *CheckAllPendingProofsRequest) (*CheckAllPendingProofsResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := wallet.CheckAllPendingProofsCtx(ctx)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &CheckAllPendingProofsResponse{Amount: amount.Value}, nil
+}
+
+func (s *WalletServer) CheckProofsSpent(ctx context.Context, req *CheckProofsSpentRequest) (*CheckProofsSpentResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	proofs, err := walletProofs(wallet, req.Proofs)
+	if err != nil {
+		return nil, err
+	}
+	defer destroyProofs(proofs)
+	spent, err := wallet.CheckProofsSpentCtx(ctx, proofs)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &CheckProofsSpentResponse{Spent: spent}, nil
+}
+
+func (s *WalletServer) GetActiveKeyset(ctx context.Context, req *GetActiveKeysetRequest) (*GetActiveKeysetResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	keyset, err := wallet.GetActiveKeysetCtx(ctx)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &GetActiveKeysetResponse{Keyset: keysetResponse(keyset)}, nil
+}
+
+func (s *WalletServer) GetKeysetFeesById(ctx context.Context, req *GetKeysetFeesByIdRequest) (*GetKeysetFeesByIdResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	fee, err := wallet.GetKeysetFeesByIdCtx(ctx, req.KeysetId)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &GetKeysetFeesByIdResponse{InputFeePpk: fee}, nil
+}
+
+func (s *WalletServer) GetMintInfo(ctx context.Context, req *GetMintInfoRequest) (*GetMintInfoResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	info, err := wallet.GetMintInfoCtx(ctx)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	if info == nil {
+		return &GetMintInfoResponse{}, nil
+	}
+	encoded, err := cdk.EncodeMintInfo(*info)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &GetMintInfoResponse{MintInfoJson: encoded}, nil
+}
+
+func (s *WalletServer) GetProofsByStates(ctx context.Context, req *GetProofsByStatesRequest) (*GetProofsByStatesResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	states := make([]cdk.ProofState, 0, len(req.States))
+	for _, state := range req.States {
+		if state == ProofState_PROOF_STATE_UNSPECIFIED {
+			return nil, status.Error(codes.InvalidArgument, "unspecified proof state")
+		}
+		states = append(states, cdk.ProofState(state))
+	}
+	proofs, err := wallet.GetProofsByStatesCtx(ctx, states)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &GetProofsByStatesResponse{Proofs: proofsResponse(proofs)}, nil
+}
+
+func (s *WalletServer) GetTransaction(ctx context.Context, req *GetTransactionRequest) (*GetTransactionResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	transaction, err := wallet.GetTransactionCtx(ctx, cdk.TransactionId{Hex: req.Id})
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	if transaction == nil {
+		return nil, status.Errorf(codes.NotFound, "no transaction %s", req.Id)
+	}
+	return &GetTransactionResponse{Transaction: transactionResponse(*transaction)}, nil
+}
+
+func (s *WalletServer) GetUnspentAuthProofs(ctx context.Context, req *GetUnspentAuthProofsRequest) (*GetUnspentAuthProofsResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	proofs, err := wallet.GetUnspentAuthProofsCtx(ctx)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	resp := &GetUnspentAuthProofsResponse{}
+	for _, proof := range proofs {
+		resp.Proofs = append(resp.Proofs, &AuthProof{KeysetId: proof.KeysetId, Secret: proof.Secret, C: proof.C, Y: proof.Y})
+	}
+	return resp, nil
+}
+
+func (s *WalletServer) ListTransactions(ctx context.Context, req *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	transactions, err := wallet.ListTransactionsCtx(ctx, transactionDirection(req.Direction))
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &ListTransactionsResponse{Transactions: transactionsResponse(transactions)}, nil
+}
+
+func (s *WalletServer) Melt(ctx context.Context, req *MeltRequest) (*MeltResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	melted, err := wallet.MeltCtx(ctx, req.QuoteId)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &MeltResponse{Melted: meltedResponse(melted)}, nil
+}
+
+func (s *WalletServer) MeltBip353Quote(ctx context.Context, req *MeltBip353QuoteRequest) (*MeltBip353QuoteResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	quote, err := wallet.MeltBip353QuoteCtx(ctx, req.Bip353Address, cdk.Amount{Value: req.AmountMsat})
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &MeltBip353QuoteResponse{Quote: meltQuoteResponse(quote)}, nil
+}
+
+func (s *WalletServer) MeltBolt12Quote(ctx context.Context, req *MeltBolt12QuoteRequest) (*MeltBolt12QuoteResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	quote, err := wallet.MeltBolt12QuoteCtx(ctx, req.Request, meltOptions(req.Options))
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &MeltBolt12QuoteResponse{Quote: meltQuoteResponse(quote)}, nil
+}
+
+func (s *WalletServer) MeltQuote(ctx context.Context, req *MeltQuoteRequest) (*MeltQuoteResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	quote, err := wallet.MeltQuoteCtx(ctx, req.Request, meltOptions(req.Options))
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &MeltQuoteResponse{Quote: meltQuoteResponse(quote)}, nil
+}
+
+func (s *WalletServer) Mint(ctx context.Context, req *MintRequest) (*MintResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	proofs, err := wallet.MintCtx(ctx, req.QuoteId, splitTarget(req.AmountSplitTarget), spendingConditions(req.SpendingConditions))
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &MintResponse{Proofs: proofsResponse(proofs)}, nil
+}
+
+func (s *WalletServer) MintBlindAuth(ctx context.Context, req *MintBlindAuthRequest) (*MintBlindAuthResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	proofs, err := wallet.MintBlindAuthCtx(ctx, cdk.Amount{Value: req.Amount})
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &MintBlindAuthResponse{Proofs: proofsResponse(proofs)}, nil
+}
+
+func (s *WalletServer) MintBolt12(ctx context.Context, req *MintBolt12Request) (*MintBolt12Response, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	proofs, err := wallet.MintBolt12Ctx(ctx, req.QuoteId, optionalAmount(req.Amount), splitTarget(req.AmountSplitTarget), spendingConditions(req.SpendingConditions))
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &MintBolt12Response{Proofs: proofsResponse(proofs)}, nil
+}
+
+func (s *WalletServer) MintBolt12Quote(ctx context.Context, req *MintBolt12QuoteRequest) (*MintBolt12QuoteResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	quote, err := wallet.MintBolt12QuoteCtx(ctx, optionalAmount(req.Amount), req.Description)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &MintBolt12QuoteResponse{Quote: mintQuoteResponse(quote)}, nil
+}
+
+func (s *WalletServer) MintQuote(ctx context.Context, req *MintQuoteRequest) (*MintQuoteResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	quote, err := wallet.MintQuoteCtx(ctx, cdk.Amount{Value: req.Amount}, req.Description)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &MintQuoteResponse{Quote: mintQuoteResponse(quote)}, nil
+}
+
+func (s *WalletServer) GetUnit(ctx context.Context, req *GetUnitRequest) (*GetUnitResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	return &GetUnitResponse{Unit: cdk.CurrencyUnitString(wallet.Unit())}, nil
+}
+
+func (s *WalletServer) PrepareSend(ctx context.Context, req *PrepareSendRequest) (*PrepareSendResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	send, err := wallet.PrepareSendCtx(ctx, cdk.Amount{Value: req.Amount}, sendOptions(req.Options))
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	s.sends.add(send)
+	return &PrepareSendResponse{Send: preparedSendResponse(send)}, nil
+}
+
+func (s *WalletServer) ConfirmSend(ctx context.Context, req *ConfirmSendRequest) (*ConfirmSendResponse, error) {
+	token, err := s.sends.confirm(ctx, req.SendId, req.Memo)
+	if err != nil {
+		return nil, err
+	}
+	return &ConfirmSendResponse{Token: token}, nil
+}
+
+func (s *WalletServer) CancelSend(ctx context.Context, req *CancelSendRequest) (*CancelSendResponse, error) {
+	if err := s.sends.cancel(ctx, req.SendId); err != nil {
+		return nil, err
+	}
+	return &CancelSendResponse{}, nil
+}
+
+func (s *WalletServer) Receive(ctx context.Context, req *ReceiveRequest) (*ReceiveResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	token, err := decodeToken(req.Token)
+	if err != nil {
+		return nil, err
+	}
+	defer token.Destroy()
+	amount, err := wallet.ReceiveCtx(ctx, token, receiveOptions(req.Options))
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &ReceiveResponse{Amount: amount.Value}, nil
+}
+
+func (s *WalletServer) ReceiveProofs(ctx context.Context, req *ReceiveProofsRequest) (*ReceiveProofsResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	proofs, err := walletProofs(wallet, req.Proofs)
+	if err != nil {
+		return nil, err
+	}
+	defer destroyProofs(proofs)
+	amount, err := wallet.ReceiveProofsCtx(ctx, proofs, receiveOptions(req.Options), req.Memo)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &ReceiveProofsResponse{Amount: amount.Value}, nil
+}
+
+func (s *WalletServer) ReclaimUnspent(ctx context.Context, req *ReclaimUnspentRequest) (*ReclaimUnspentResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	proofs, err := walletProofs(wallet, req.Proofs)
+	if err != nil {
+		return nil, err
+	}
+	defer destroyProofs(proofs)
+	if err := wallet.ReclaimUnspentCtx(ctx, proofs); err != nil {
+		return nil, rpcError(err)
+	}
+	return &ReclaimUnspentResponse{}, nil
+}
+
+func (s *WalletServer) RefreshAccessToken(ctx context.Context, req *RefreshAccessTokenRequest) (*RefreshAccessTokenResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	if err := wallet.RefreshAccessTokenCtx(ctx); err != nil {
+		return nil, rpcError(err)
+	}
+	return &RefreshAccessTokenResponse{}, nil
+}
+
+func (s *WalletServer) RefreshKeysets(ctx context.Context, req *RefreshKeysetsRequest) (*RefreshKeysetsResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	keysets, err := wallet.RefreshKeysetsCtx(ctx)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	resp := &RefreshKeysetsResponse{}
+	for _, keyset := range keysets {
+		resp.Keysets = append(resp.Keysets, keysetResponse(keyset))
+	}
+	return resp, nil
+}
+
+func (s *WalletServer) Restore(ctx context.Context, req *RestoreRequest) (*RestoreResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := wallet.RestoreCtx(ctx)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &RestoreResponse{Amount: amount.Value}, nil
+}
+
+func (s *WalletServer) RevertTransaction(ctx context.Context, req *RevertTransactionRequest) (*RevertTransactionResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	if err := wallet.RevertTransactionCtx(ctx, cdk.TransactionId{Hex: req.Id}); err != nil {
+		return nil, rpcError(err)
+	}
+	return &RevertTransactionResponse{}, nil
+}
+
+func (s *WalletServer) SetCat(ctx context.Context, req *SetCatRequest) (*SetCatResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	if err := wallet.SetCatCtx(ctx, req.Cat); err != nil {
+		return nil, rpcError(err)
+	}
+	return &SetCatResponse{}, nil
+}
+
+func (s *WalletServer) SetRefreshToken(ctx context.Context, req *SetRefreshTokenRequest) (*SetRefreshTokenResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	if err := wallet.SetRefreshTokenCtx(ctx, req.RefreshToken); err != nil {
+		return nil, rpcError(err)
+	}
+	return &SetRefreshTokenResponse{}, nil
+}
+
+// Subscribe streams the notifications of an ActiveSubscription until the
+// client cancels the call or the server is closed.
+func (s *WalletServer) Subscribe(req *SubscribeRequest, stream grpc.ServerStreamingServer[SubscribeResponse]) error {
+	ctx := stream.Context()
+	if req.Kind == SubscriptionKind_SUBSCRIPTION_KIND_UNSPECIFIED {
+		return status.Error(codes.InvalidArgument, "kind is required")
+	}
+	if _, ok := SubscriptionKind_name[int32(req.Kind)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown kind %d", req.Kind)
+	}
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return err
+	}
+	filters := req.Filters
+	if filters == nil {
+		filters = []string{}
+	}
+	notifications, errs, err := wallet.SubscribeChan(ctx, cdk.SubscribeParams{Kind: cdk.SubscriptionKind(req.Kind), Filters: filters})
+	if err != nil {
+		return rpcError(err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return rpcError(ctx.Err())
+		case <-s.stopping:
+			return status.Error(codes.Unavailable, "server is shutting down")
+		case payload, ok := <-notifications:
+			if !ok {
+				select {
+				case err := <-errs:
+					return rpcError(err)
+				default:
+					return nil
+				}
+			}
+			resp, ok := notification(payload)
+			if !ok {
+				continue
+			}
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *WalletServer) Swap(ctx context.Context, req *SwapRequest) (*SwapResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	inputs, err := walletProofs(wallet, req.InputProofs)
+	if err != nil {
+		return nil, err
+	}
+	defer destroyProofs(inputs)
+	proofs, err := wallet.SwapCtx(ctx, optionalAmount(req.Amount), splitTarget(req.AmountSplitTarget), inputs, spendingConditions(req.SpendingConditions), req.IncludeFees)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	resp := &SwapResponse{}
+	if proofs != nil {
+		resp.Proofs = proofsResponse(*proofs)
+	}
+	return resp, nil
+}
+
+func (s *WalletServer) TotalBalance(ctx context.Context, req *TotalBalanceRequest) (*TotalBalanceResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := wallet.TotalBalanceCtx(ctx)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &TotalBalanceResponse{Amount: amount.Value}, nil
+}
+
+func (s *WalletServer) TotalPendingBalance(ctx context.Context, req *TotalPendingBalanceRequest) (*TotalPendingBalanceResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := wallet.TotalPendingBalanceCtx(ctx)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &TotalPendingBalanceResponse{Amount: amount.Value}, nil
+}
+
+func (s *WalletServer) TotalReservedBalance(ctx context.Context, req *TotalReservedBalanceRequest) (*TotalReservedBalanceResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := wallet.TotalReservedBalanceCtx(ctx)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return &TotalReservedBalanceResponse{Amount: amount.Value}, nil
+}
+
+func (s *WalletServer) VerifyTokenDleq(ctx context.Context, req *VerifyTokenDleqRequest) (*VerifyTokenDleqResponse, error) {
+	wallet, err := s.wallet(ctx, req.MintUrl)
+	if err != nil {
+		return nil, err
+	}
+	token, err := decodeToken(req.Token)
+	if err != nil {
+		return nil, err
+	}
+	defer token.Destroy()
+	if err := wallet.VerifyTokenDleqCtx(ctx, token); err != nil {
+		return nil, rpcError(err)
+	}
+	return &VerifyTokenDleqResponse{}, nil
+}
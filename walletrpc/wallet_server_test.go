@@ -0,0 +1,140 @@
+package walletrpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	cdk "github.com/lescuer97/cdkgo"
+	"github.com/lescuer97/cdkgo/mockmint"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// dialWalletServer serves server over an in-memory connection and returns a
+// client of it.
+func dialWalletServer(t *testing.T, server *WalletServer) WalletServiceClient {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	server.Register(grpcServer)
+	go grpcServer.Serve(listener)
+	t.Cleanup(func() {
+		server.Close(context.Background())
+		grpcServer.Stop()
+	})
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return NewWalletServiceClient(conn)
+}
+
+func testContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+// failingWallets resolves no wallet: the mint "down" fails with a network
+// error, every other mint is not served.
+func failingWallets(_ context.Context, mintUrl string) (*cdk.Wallet, error) {
+	if mintUrl == "down" {
+		return nil, ffiError(cdk.NewFfiErrorNetwork(), "HTTP status 503 Service Unavailable")
+	}
+	return nil, status.Errorf(codes.NotFound, "mint %s is not served", mintUrl)
+}
+
+func TestWalletServiceErrors(t *testing.T) {
+	ctx := testContext(t)
+	client := dialWalletServer(t, NewWalletServer(failingWallets))
+
+	_, err := client.CalculateFee(ctx, &CalculateFeeRequest{MintUrl: "down", ProofCount: 1})
+	s := status.Convert(err)
+	if s.Code() != codes.Unavailable {
+		t.Fatalf("got %v", err)
+	}
+	if info := errorInfo(t, s); info.Reason != "NETWORK" || info.Metadata["http_status"] != "503" {
+		t.Fatalf("got %+v", info)
+	}
+
+	if _, err := client.TotalBalance(ctx, &TotalBalanceRequest{MintUrl: "elsewhere"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("got %v", err)
+	}
+}
+
+func TestSubscribeKind(t *testing.T) {
+	ctx := testContext(t)
+	client := dialWalletServer(t, NewWalletServer(failingWallets))
+
+	tests := []struct {
+		kind SubscriptionKind
+		want codes.Code
+	}{
+		{SubscriptionKind_SUBSCRIPTION_KIND_UNSPECIFIED, codes.InvalidArgument},
+		{SubscriptionKind(5), codes.InvalidArgument},
+		{SubscriptionKind(99), codes.InvalidArgument},
+		// Valid kinds get as far as resolving the wallet.
+		{SubscriptionKind_SUBSCRIPTION_KIND_BOLT11_MINT_QUOTE, codes.NotFound},
+		{SubscriptionKind_SUBSCRIPTION_KIND_PROOF_STATE, codes.NotFound},
+	}
+	for _, test := range tests {
+		stream, err := client.Subscribe(ctx, &SubscribeRequest{MintUrl: "elsewhere", Kind: test.kind, Filters: []string{"q1"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := stream.Recv(); status.Code(err) != test.want {
+			t.Errorf("kind %d: got %v, want %v", test.kind, err, test.want)
+		}
+	}
+}
+
+func TestWalletServiceMint(t *testing.T) {
+	ctx := testContext(t)
+	mint := mockmint.New(mockmint.Options{})
+	defer mint.Close()
+	mnemonic, err := cdk.GenerateMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wallet, err := cdk.NewWallet(mint.URL(), cdk.CurrencyUnitSat{}, mnemonic, cdk.NewMemoryWalletDatabase(), cdk.WalletConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wallet.Destroy()
+	client := dialWalletServer(t, NewWalletServer(SingleWallet(wallet)))
+
+	quote, err := client.MintQuote(ctx, &MintQuoteRequest{Amount: 50})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Mint(ctx, &MintRequest{QuoteId: quote.Quote.Id}); err == nil {
+		t.Fatal("minted an unpaid quote")
+	}
+	if err := mint.PayMintQuote(quote.Quote.Id); err != nil {
+		t.Fatal(err)
+	}
+	minted, err := client.Mint(ctx, &MintRequest{QuoteId: quote.Quote.Id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var total uint64
+	for _, proof := range minted.Proofs {
+		total += proof.Amount
+	}
+	balance, err := client.TotalBalance(ctx, &TotalBalanceRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 50 || balance.Amount != 50 {
+		t.Fatalf("minted %d, balance %d", total, balance.Amount)
+	}
+}